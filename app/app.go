@@ -4,6 +4,7 @@ import (
 	"amcds/pb"
 	"amcds/utils"
 	"errors"
+	"strings"
 )
 
 type App struct {
@@ -36,24 +37,183 @@ func (app *App) Handle(m *pb.Message) error {
 					},
 				},
 			}
-		case pb.Message_APP_VALUE:
+		case pb.Message_APP_RELIABLE_BROADCAST:
 			msgToSend = &pb.Message{
-				Type:              pb.Message_PL_SEND,
+				Type:              pb.Message_RB_BROADCAST,
 				FromAbstractionId: "app",
-				ToAbstractionId:   "app.pl",
+				ToAbstractionId:   "app.rb",
 				SystemId:          m.SystemId,
-				PlSend: &pb.PlSend{
-					Destination: &pb.ProcessId{
-						Host:  app.HubAddress,
-						Port:  app.HubPort,
-						Owner: "hub",
+				RbBroadcast: &pb.RbBroadcast{
+					Message: &pb.Message{
+						Type:              pb.Message_APP_VALUE,
+						FromAbstractionId: "app",
+						ToAbstractionId:   "app",
+						SystemId:          m.SystemId,
+						AppValue: &pb.AppValue{
+							Value: m.PlDeliver.Message.AppReliableBroadcast.Value,
+						},
 					},
+				},
+			}
+		case pb.Message_APP_UNIFORM_BROADCAST:
+			msgToSend = &pb.Message{
+				Type:              pb.Message_URB_BROADCAST,
+				FromAbstractionId: "app",
+				ToAbstractionId:   "app.urb",
+				SystemId:          m.SystemId,
+				UrbBroadcast: &pb.UrbBroadcast{
 					Message: &pb.Message{
-						Type:     pb.Message_APP_VALUE,
-						AppValue: m.PlDeliver.Message.AppValue,
+						Type:              pb.Message_APP_VALUE,
+						FromAbstractionId: "app",
+						ToAbstractionId:   "app",
+						SystemId:          m.SystemId,
+						AppValue: &pb.AppValue{
+							Value: m.PlDeliver.Message.AppUniformBroadcast.Value,
+						},
+					},
+				},
+			}
+		case pb.Message_APP_FIFO_BROADCAST:
+			msgToSend = &pb.Message{
+				Type:              pb.Message_FRB_BROADCAST,
+				FromAbstractionId: "app",
+				ToAbstractionId:   "app.frb",
+				SystemId:          m.SystemId,
+				FrbBroadcast: &pb.FrbBroadcast{
+					Message: &pb.Message{
+						Type:              pb.Message_APP_VALUE,
+						FromAbstractionId: "app",
+						ToAbstractionId:   "app",
+						SystemId:          m.SystemId,
+						AppValue: &pb.AppValue{
+							Value: m.PlDeliver.Message.AppFifoBroadcast.Value,
+						},
+					},
+				},
+			}
+		case pb.Message_APP_CAUSAL_BROADCAST:
+			msgToSend = &pb.Message{
+				Type:              pb.Message_CRB_BROADCAST,
+				FromAbstractionId: "app",
+				ToAbstractionId:   "app.crb",
+				SystemId:          m.SystemId,
+				CrbBroadcast: &pb.CrbBroadcast{
+					Message: &pb.Message{
+						Type:              pb.Message_APP_VALUE,
+						FromAbstractionId: "app",
+						ToAbstractionId:   "app",
+						SystemId:          m.SystemId,
+						AppValue: &pb.AppValue{
+							Value: m.PlDeliver.Message.AppCausalBroadcast.Value,
+						},
+					},
+				},
+			}
+		case pb.Message_APP_PROBABILISTIC_BROADCAST:
+			msgToSend = &pb.Message{
+				Type:              pb.Message_PB_BROADCAST,
+				FromAbstractionId: "app",
+				ToAbstractionId:   "app.pb",
+				SystemId:          m.SystemId,
+				PbBroadcast: &pb.PbBroadcast{
+					Message: &pb.Message{
+						Type:              pb.Message_APP_VALUE,
+						FromAbstractionId: "app",
+						ToAbstractionId:   "app",
+						SystemId:          m.SystemId,
+						AppValue: &pb.AppValue{
+							Value: m.PlDeliver.Message.AppProbabilisticBroadcast.Value,
+						},
+					},
+				},
+			}
+		case pb.Message_APP_BCB_BROADCAST:
+			msgToSend = &pb.Message{
+				Type:              pb.Message_BCB_BROADCAST,
+				FromAbstractionId: "app",
+				ToAbstractionId:   "app.bcb",
+				SystemId:          m.SystemId,
+				BcbBroadcast: &pb.BcbBroadcast{
+					Message: &pb.Message{
+						Type:              pb.Message_APP_VALUE,
+						FromAbstractionId: "app",
+						ToAbstractionId:   "app",
+						SystemId:          m.SystemId,
+						AppValue: &pb.AppValue{
+							Value: m.PlDeliver.Message.AppBcbBroadcast.Value,
+						},
+					},
+				},
+			}
+		case pb.Message_APP_BRB_BROADCAST:
+			msgToSend = &pb.Message{
+				Type:              pb.Message_BRB_BROADCAST,
+				FromAbstractionId: "app",
+				ToAbstractionId:   "app.brb",
+				SystemId:          m.SystemId,
+				BrbBroadcast: &pb.BrbBroadcast{
+					Message: &pb.Message{
+						Type:              pb.Message_APP_VALUE,
+						FromAbstractionId: "app",
+						ToAbstractionId:   "app",
+						SystemId:          m.SystemId,
+						AppValue: &pb.AppValue{
+							Value: m.PlDeliver.Message.AppBrbBroadcast.Value,
+						},
+					},
+				},
+			}
+		case pb.Message_APP_TOB_BROADCAST:
+			msgToSend = &pb.Message{
+				Type:              pb.Message_TOB_BROADCAST,
+				FromAbstractionId: "app",
+				ToAbstractionId:   "app.tob",
+				SystemId:          m.SystemId,
+				TobBroadcast: &pb.TobBroadcast{
+					Message: &pb.Message{
+						Type:              pb.Message_APP_VALUE,
+						FromAbstractionId: "app",
+						ToAbstractionId:   "app",
+						SystemId:          m.SystemId,
+						AppValue: &pb.AppValue{
+							Value: m.PlDeliver.Message.AppTobBroadcast.Value,
+						},
 					},
 				},
 			}
+		case pb.Message_APP_LOG_APPEND:
+			msgToSend = &pb.Message{
+				Type:              pb.Message_LOG_APPEND,
+				FromAbstractionId: "app",
+				ToAbstractionId:   "app.log",
+				SystemId:          m.SystemId,
+				LogAppend: &pb.LogAppend{
+					Value: m.PlDeliver.Message.AppLogAppend.Value,
+				},
+			}
+		case pb.Message_APP_VS_BROADCAST:
+			msgToSend = &pb.Message{
+				Type:              pb.Message_VS_BROADCAST,
+				FromAbstractionId: "app",
+				ToAbstractionId:   "app.vs",
+				SystemId:          m.SystemId,
+				VsBroadcast: &pb.VsBroadcast{
+					Message: &pb.Message{
+						Type:              pb.Message_APP_VALUE,
+						FromAbstractionId: "app",
+						ToAbstractionId:   "app",
+						SystemId:          m.SystemId,
+						AppValue: &pb.AppValue{
+							Value: m.PlDeliver.Message.AppVsBroadcast.Value,
+						},
+					},
+				},
+			}
+		case pb.Message_APP_VALUE:
+			app.sendToHub(m.SystemId, &pb.Message{
+				Type:     pb.Message_APP_VALUE,
+				AppValue: m.PlDeliver.Message.AppValue,
+			})
 		case pb.Message_APP_WRITE:
 			msgToSend = &pb.Message{
 				Type:              pb.Message_NNAR_WRITE,
@@ -64,7 +224,49 @@ func (app *App) Handle(m *pb.Message) error {
 					Value: m.PlDeliver.Message.AppWrite.Value,
 				},
 			}
+			if m.PlDeliver.Message.AppWrite.Kind == pb.RegisterKind_ONRR {
+				msgToSend = &pb.Message{
+					Type:              pb.Message_ONRR_WRITE,
+					FromAbstractionId: "app",
+					ToAbstractionId:   "app.onrr[" + m.PlDeliver.Message.AppWrite.Register + "]",
+					SystemId:          m.SystemId,
+					OnrrWrite: &pb.OnrrWrite{
+						Value: m.PlDeliver.Message.AppWrite.Value,
+					},
+				}
+			}
+			if m.PlDeliver.Message.AppWrite.Kind == pb.RegisterKind_ONAR {
+				msgToSend = &pb.Message{
+					Type:              pb.Message_ONAR_WRITE,
+					FromAbstractionId: "app",
+					ToAbstractionId:   "app.onar[" + m.PlDeliver.Message.AppWrite.Register + "]",
+					SystemId:          m.SystemId,
+					OnarWrite: &pb.OnarWrite{
+						Value: m.PlDeliver.Message.AppWrite.Value,
+					},
+				}
+			}
 		case pb.Message_APP_READ:
+			if m.PlDeliver.Message.AppRead.Kind == pb.RegisterKind_ONRR {
+				msgToSend = &pb.Message{
+					Type:              pb.Message_ONRR_READ,
+					FromAbstractionId: "app",
+					ToAbstractionId:   "app.onrr[" + m.PlDeliver.Message.AppRead.Register + "]",
+					SystemId:          m.SystemId,
+					OnrrRead:          &pb.OnrrRead{},
+				}
+				break
+			}
+			if m.PlDeliver.Message.AppRead.Kind == pb.RegisterKind_ONAR {
+				msgToSend = &pb.Message{
+					Type:              pb.Message_ONAR_READ,
+					FromAbstractionId: "app",
+					ToAbstractionId:   "app.onar[" + m.PlDeliver.Message.AppRead.Register + "]",
+					SystemId:          m.SystemId,
+					OnarRead:          &pb.OnarRead{},
+				}
+				break
+			}
 			msgToSend = &pb.Message{
 				Type:              pb.Message_NNAR_READ,
 				FromAbstractionId: "app",
@@ -81,96 +283,285 @@ func (app *App) Handle(m *pb.Message) error {
 					Value: m.PlDeliver.Message.AppPropose.Value,
 				},
 			}
+		case pb.Message_APP_ELECT:
+			msgToSend = &pb.Message{
+				Type:              pb.Message_LE_START,
+				FromAbstractionId: "app",
+				ToAbstractionId:   "app.le",
+				SystemId:          m.SystemId,
+				LeStart:           &pb.LeStart{},
+			}
+		case pb.Message_APP_RC_PROPOSE:
+			msgToSend = &pb.Message{
+				Type:              pb.Message_UC_PROPOSE,
+				FromAbstractionId: "app",
+				ToAbstractionId:   "app.rc[" + m.PlDeliver.Message.AppRcPropose.Topic + "]",
+				SystemId:          m.SystemId,
+				UcPropose: &pb.UcPropose{
+					Value: m.PlDeliver.Message.AppRcPropose.Value,
+				},
+			}
+		case pb.Message_APP_NBAC_PROPOSE:
+			msgToSend = &pb.Message{
+				Type:              pb.Message_NBAC_PROPOSE,
+				FromAbstractionId: "app",
+				ToAbstractionId:   "app.nbac[" + m.PlDeliver.Message.AppNbacPropose.Topic + "]",
+				SystemId:          m.SystemId,
+				NbacPropose: &pb.NbacPropose{
+					Commit: m.PlDeliver.Message.AppNbacPropose.Commit,
+				},
+			}
+		case pb.Message_APP_COMMIT:
+			c := m.PlDeliver.Message.AppCommit
+			msgToSend = &pb.Message{
+				Type:              pb.Message_COMMIT_START,
+				FromAbstractionId: "app",
+				ToAbstractionId:   "app." + c.Protocol + "[" + c.Topic + "]",
+				SystemId:          m.SystemId,
+				CommitStart: &pb.CommitStart{
+					Coordinator: c.Coordinator,
+					Commit:      c.Commit,
+				},
+			}
+		case pb.Message_APP_TRB:
+			trb := m.PlDeliver.Message.AppTrb
+			msgToSend = &pb.Message{
+				Type:              pb.Message_TRB_BROADCAST,
+				FromAbstractionId: "app",
+				ToAbstractionId:   "app.trb[" + trb.Topic + "]",
+				SystemId:          m.SystemId,
+				TrbBroadcast: &pb.TrbBroadcast{
+					Sender: trb.Sender,
+					Message: &pb.Message{
+						Type:              pb.Message_APP_VALUE,
+						FromAbstractionId: "app",
+						ToAbstractionId:   "app",
+						SystemId:          m.SystemId,
+						AppValue: &pb.AppValue{
+							Value: trb.Value,
+						},
+					},
+				},
+			}
+		case pb.Message_PROC_RECONFIGURE_SYSTEM:
+			msgToSend = &pb.Message{
+				Type:                  pb.Message_PROC_RECONFIGURE_SYSTEM,
+				FromAbstractionId:     "app",
+				ToAbstractionId:       "app.reconf",
+				SystemId:              m.SystemId,
+				ProcReconfigureSystem: m.PlDeliver.Message.ProcReconfigureSystem,
+			}
 		default:
 			return errors.New("app pl message not supported")
 		}
 	case pb.Message_BEB_DELIVER:
-		msgToSend = &pb.Message{
-			Type:              pb.Message_PL_SEND,
-			FromAbstractionId: "app",
-			ToAbstractionId:   "app.pl",
-			SystemId:          m.SystemId,
-			PlSend: &pb.PlSend{
-				Destination: &pb.ProcessId{
-					Host:  app.HubAddress,
-					Port:  app.HubPort,
-					Owner: "hub",
-				},
-				Message: &pb.Message{
-					Type:     pb.Message_APP_VALUE,
-					AppValue: m.BebDeliver.Message.AppValue,
-				},
+		app.sendToHub(m.SystemId, &pb.Message{
+			Type:     pb.Message_APP_VALUE,
+			AppValue: m.BebDeliver.Message.AppValue,
+		})
+	case pb.Message_RB_DELIVER:
+		app.sendToHub(m.SystemId, &pb.Message{
+			Type:     pb.Message_APP_VALUE,
+			AppValue: m.RbDeliver.Message.AppValue,
+		})
+	case pb.Message_URB_DELIVER:
+		app.sendToHub(m.SystemId, &pb.Message{
+			Type:     pb.Message_APP_VALUE,
+			AppValue: m.UrbDeliver.Message.AppValue,
+		})
+	case pb.Message_FRB_DELIVER:
+		app.sendToHub(m.SystemId, &pb.Message{
+			Type:     pb.Message_APP_VALUE,
+			AppValue: m.FrbDeliver.Message.AppValue,
+		})
+	case pb.Message_CRB_DELIVER:
+		app.sendToHub(m.SystemId, &pb.Message{
+			Type:     pb.Message_APP_VALUE,
+			AppValue: m.CrbDeliver.Message.AppValue,
+		})
+	case pb.Message_PB_DELIVER:
+		app.sendToHub(m.SystemId, &pb.Message{
+			Type:     pb.Message_APP_VALUE,
+			AppValue: m.PbDeliver.Message.AppValue,
+		})
+	case pb.Message_BCB_DELIVER:
+		app.sendToHub(m.SystemId, &pb.Message{
+			Type:     pb.Message_APP_VALUE,
+			AppValue: m.BcbDeliver.Message.AppValue,
+		})
+	case pb.Message_BRB_DELIVER:
+		app.sendToHub(m.SystemId, &pb.Message{
+			Type:     pb.Message_APP_VALUE,
+			AppValue: m.BrbDeliver.Message.AppValue,
+		})
+	case pb.Message_TOB_DELIVER:
+		app.sendToHub(m.SystemId, &pb.Message{
+			Type: pb.Message_APP_TOB_DELIVER,
+			AppTobDeliver: &pb.AppTobDeliver{
+				SequenceNumber: m.TobDeliver.SequenceNumber,
+				Sender:         m.TobDeliver.Sender,
+				Value:          m.TobDeliver.GetMessage().GetAppValue().GetValue(),
+			},
+		})
+	case pb.Message_LOG_APPENDED:
+		app.sendToHub(m.SystemId, &pb.Message{
+			Type: pb.Message_APP_LOG_APPENDED,
+			AppLogAppended: &pb.AppLogAppended{
+				Slot:  m.LogAppended.Slot,
+				Value: m.LogAppended.Value,
 			},
+		})
+	case pb.Message_LOG_COMMITTED:
+		app.sendToHub(m.SystemId, &pb.Message{
+			Type: pb.Message_APP_LOG_COMMITTED,
+			AppLogCommitted: &pb.AppLogCommitted{
+				Slot:  m.LogCommitted.Slot,
+				Value: m.LogCommitted.Value,
+			},
+		})
+	case pb.Message_VS_DELIVER:
+		app.sendToHub(m.SystemId, &pb.Message{
+			Type: pb.Message_APP_VS_DELIVER,
+			AppVsDeliver: &pb.AppVsDeliver{
+				Sender: m.VsDeliver.Sender,
+				Value:  m.VsDeliver.GetMessage().GetAppValue().GetValue(),
+				ViewId: m.VsDeliver.ViewId,
+			},
+		})
+	case pb.Message_GM_VIEW, pb.Message_VS_VIEW:
+		view := &pb.AppView{Source: "gm", Id: m.GmView.GetId(), Members: m.GmView.GetMembers()}
+		if m.Type == pb.Message_VS_VIEW {
+			view = &pb.AppView{Source: "vs", Id: m.VsView.Id, Members: m.VsView.Members}
 		}
-	case pb.Message_NNAR_WRITE_RETURN:
-		msgToSend = &pb.Message{
-			Type:              pb.Message_PL_SEND,
-			FromAbstractionId: "app",
-			ToAbstractionId:   "app.pl",
-			SystemId:          m.SystemId,
-			PlSend: &pb.PlSend{
-				Destination: &pb.ProcessId{
-					Host:  app.HubAddress,
-					Port:  app.HubPort,
-					Owner: "hub",
-				},
-				Message: &pb.Message{
-					Type:              pb.Message_APP_WRITE_RETURN,
-					FromAbstractionId: m.FromAbstractionId,
-					ToAbstractionId:   "hub",
-					SystemId:          m.SystemId,
-					AppWriteReturn: &pb.AppWriteReturn{
-						Register: utils.GetRegisterId(m.FromAbstractionId),
-					},
-				},
+
+		app.sendToHub(m.SystemId, &pb.Message{
+			Type:    pb.Message_APP_VIEW,
+			AppView: view,
+		})
+	case pb.Message_NBAC_DECIDE:
+		app.sendToHub(m.SystemId, &pb.Message{
+			Type: pb.Message_APP_NBAC_DECIDE,
+			AppNbacDecide: &pb.AppNbacDecide{
+				Topic:  utils.GetRegisterId(m.FromAbstractionId),
+				Commit: m.NbacDecide.Commit,
+			},
+		})
+	case pb.Message_COMMIT_OUTCOME:
+		// the instance id is app.2pc[topic] or app.3pc[topic]
+		protocol := strings.TrimPrefix(m.FromAbstractionId[:strings.Index(m.FromAbstractionId, "[")], "app.")
+
+		app.sendToHub(m.SystemId, &pb.Message{
+			Type: pb.Message_APP_COMMIT_OUTCOME,
+			AppCommitOutcome: &pb.AppCommitOutcome{
+				Protocol:  protocol,
+				Topic:     utils.GetRegisterId(m.FromAbstractionId),
+				Committed: m.CommitOutcome.Committed,
+				Blocked:   m.CommitOutcome.Blocked,
+				Latency:   m.CommitOutcome.Latency,
 			},
+		})
+	case pb.Message_TRB_DELIVER:
+		deliver := &pb.AppTrbDeliver{
+			Topic:  utils.GetRegisterId(m.FromAbstractionId),
+			Sender: m.TrbDeliver.Sender,
+			Failed: m.TrbDeliver.Failed,
 		}
-	case pb.Message_NNAR_READ_RETURN:
-		msgToSend = &pb.Message{
-			Type:              pb.Message_PL_SEND,
-			FromAbstractionId: "app",
-			ToAbstractionId:   "app.pl",
+		if !deliver.Failed {
+			deliver.Value = m.TrbDeliver.GetMessage().GetAppValue().GetValue()
+		}
+
+		app.sendToHub(m.SystemId, &pb.Message{
+			Type:          pb.Message_APP_TRB_DELIVER,
+			AppTrbDeliver: deliver,
+		})
+	case pb.Message_NNAR_WRITE_RETURN, pb.Message_ONRR_WRITE_RETURN, pb.Message_ONAR_WRITE_RETURN:
+		kind, writeErr := pb.RegisterKind_NNAR, ""
+		if m.Type == pb.Message_ONRR_WRITE_RETURN {
+			kind = pb.RegisterKind_ONRR
+		}
+		if m.Type == pb.Message_ONAR_WRITE_RETURN {
+			kind, writeErr = pb.RegisterKind_ONAR, m.OnarWriteReturn.Error
+		}
+		app.sendToHub(m.SystemId, &pb.Message{
+			Type:              pb.Message_APP_WRITE_RETURN,
+			FromAbstractionId: m.FromAbstractionId,
+			ToAbstractionId:   "hub",
 			SystemId:          m.SystemId,
-			PlSend: &pb.PlSend{
-				Destination: &pb.ProcessId{
-					Host:  app.HubAddress,
-					Port:  app.HubPort,
-					Owner: "hub",
-				},
-				Message: &pb.Message{
-					Type:              pb.Message_APP_READ_RETURN,
-					FromAbstractionId: m.FromAbstractionId,
-					ToAbstractionId:   "hub",
-					SystemId:          m.SystemId,
-					AppReadReturn: &pb.AppReadReturn{
-						Register: utils.GetRegisterId(m.FromAbstractionId),
-						Value:    m.NnarReadReturn.Value,
-					},
-				},
+			AppWriteReturn: &pb.AppWriteReturn{
+				Register: utils.GetRegisterId(m.FromAbstractionId),
+				Kind:     kind,
+				Error:    writeErr,
 			},
+		})
+	case pb.Message_NNAR_READ_RETURN, pb.Message_ONRR_READ_RETURN, pb.Message_ONAR_READ_RETURN:
+		kind, value := pb.RegisterKind_NNAR, m.NnarReadReturn.GetValue()
+		if m.Type == pb.Message_ONRR_READ_RETURN {
+			kind, value = pb.RegisterKind_ONRR, m.OnrrReadReturn.Value
+		}
+		if m.Type == pb.Message_ONAR_READ_RETURN {
+			kind, value = pb.RegisterKind_ONAR, m.OnarReadReturn.Value
 		}
+		app.sendToHub(m.SystemId, &pb.Message{
+			Type:              pb.Message_APP_READ_RETURN,
+			FromAbstractionId: m.FromAbstractionId,
+			ToAbstractionId:   "hub",
+			SystemId:          m.SystemId,
+			AppReadReturn: &pb.AppReadReturn{
+				Register: utils.GetRegisterId(m.FromAbstractionId),
+				Value:    value,
+				Kind:     kind,
+			},
+		})
+	case pb.Message_LE_LEADER:
+		app.sendToHub(m.SystemId, &pb.Message{
+			Type:      pb.Message_APP_LEADER,
+			AppLeader: &pb.AppLeader{Leader: m.LeLeader.Process},
+		})
 	case pb.Message_UC_DECIDE:
-		msgToSend = &pb.Message{
-			Type:              pb.Message_PL_SEND,
-			FromAbstractionId: "app",
-			ToAbstractionId:   "app.pl",
-			PlSend: &pb.PlSend{
-				Message: &pb.Message{
-					Type:            pb.Message_APP_DECIDE,
-					ToAbstractionId: "app",
-					AppDecide: &pb.AppDecide{
-						Value: m.UcDecide.Value,
-					},
+		if strings.HasPrefix(m.FromAbstractionId, "app.rc[") {
+			app.sendToHub(m.SystemId, &pb.Message{
+				Type: pb.Message_APP_RC_DECIDE,
+				AppRcDecide: &pb.AppRcDecide{
+					Topic: utils.GetRegisterId(m.FromAbstractionId),
+					Value: m.UcDecide.Value,
 				},
-			},
+			})
+			break
 		}
+		app.sendToHub(m.SystemId, &pb.Message{
+			Type:            pb.Message_APP_DECIDE,
+			ToAbstractionId: "app",
+			AppDecide: &pb.AppDecide{
+				Value: m.UcDecide.Value,
+			},
+		})
 	default:
 		return errors.New("app message not supported")
 	}
 
-	app.MsgQueue <- msgToSend
+	if msgToSend != nil {
+		app.MsgQueue <- msgToSend
+	}
 
 	return nil
 }
 
 func (app *App) Destroy() {}
+
+// sendToHub sends the message of the system to the hub through app.pl
+func (app *App) sendToHub(systemId string, msg *pb.Message) {
+	app.MsgQueue <- &pb.Message{
+		Type:              pb.Message_PL_SEND,
+		FromAbstractionId: "app",
+		ToAbstractionId:   "app.pl",
+		SystemId:          systemId,
+		PlSend: &pb.PlSend{
+			Destination: &pb.ProcessId{
+				Host:  app.HubAddress,
+				Port:  app.HubPort,
+				Owner: "hub",
+			},
+			Message: msg,
+		},
+	}
+}
@@ -47,6 +47,9 @@ func (beb *BestEffortBroadcast) Handle(m *pb.Message) error {
 			},
 		}
 		beb.msgQueue <- msgToSend
+	case pb.Message_GM_VIEW:
+		// broadcast to the members of the view installed by the group membership
+		beb.processes = m.GmView.Members
 	default:
 		return errors.New("message not supported")
 	}
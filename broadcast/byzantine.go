@@ -0,0 +1,255 @@
+package broadcast
+
+import (
+	"amcds/pb"
+	"amcds/utils"
+	"amcds/utils/log"
+	"errors"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// ByzantineBroadcast is the byzantine consistent broadcast (authenticated echo
+// broadcast) or, when reliable, the byzantine reliable broadcast
+// (authenticated double-echo broadcast, Bracha's), for N > 3f. The sender
+// sends its message to all, every process echoing the first message it gets
+// from the sender of the instance. More than (N+f)/2 echoes of a message mean
+// that no other message of the instance can gather as many, as the quorums
+// share a correct process which echoes once. bcb delivers the message then, so
+// the correct processes never deliver different ones, though a faulty sender
+// may have only some of them deliver. brb gets ready for it instead, or once
+// f+1 processes are ready (one of them correct), and delivers it once 2f+1
+// are, so that f+1 correct ones are ready and all the correct ones follow
+type ByzantineBroadcast struct {
+	id        string
+	parentId  string
+	msgQueue  chan *pb.Message
+	processes []*pb.ProcessId
+	self      *pb.ProcessId
+	reliable  bool
+	seq       int32
+	instances map[string]*byzantineInstance
+}
+
+// byzantineInstance tracks one broadcast, the echoes and readies being kept
+// by process and counted by message
+type byzantineInstance struct {
+	sentEcho  bool
+	sentReady bool
+	delivered bool
+	echoes    map[string]string
+	readies   map[string]string
+	messages  map[string]*pb.Message
+}
+
+// byzantineData is the content shared by the send, echo and ready messages
+type byzantineData interface {
+	GetSender() *pb.ProcessId
+	GetSequenceNumber() int32
+	GetMessage() *pb.Message
+}
+
+func CreateBcb(parentAbstraction, abstractionId string, mQ chan *pb.Message, processes []*pb.ProcessId, self *pb.ProcessId) *ByzantineBroadcast {
+	return createByzantine(parentAbstraction, abstractionId, mQ, processes, self, false)
+}
+
+func CreateBrb(parentAbstraction, abstractionId string, mQ chan *pb.Message, processes []*pb.ProcessId, self *pb.ProcessId) *ByzantineBroadcast {
+	return createByzantine(parentAbstraction, abstractionId, mQ, processes, self, true)
+}
+
+func createByzantine(parentAbstraction, abstractionId string, mQ chan *pb.Message, processes []*pb.ProcessId, self *pb.ProcessId, reliable bool) *ByzantineBroadcast {
+	return &ByzantineBroadcast{
+		id:        abstractionId,
+		parentId:  parentAbstraction,
+		msgQueue:  mQ,
+		processes: processes,
+		self:      self,
+		reliable:  reliable,
+		instances: make(map[string]*byzantineInstance),
+	}
+}
+
+func (bb *ByzantineBroadcast) Handle(m *pb.Message) error {
+	switch m.Type {
+	case pb.Message_BCB_BROADCAST, pb.Message_BRB_BROADCAST:
+		message := m.GetBcbBroadcast().GetMessage()
+		if bb.reliable {
+			message = m.GetBrbBroadcast().GetMessage()
+		}
+
+		bb.seq++
+		bb.sendAll(m.SystemId, &pb.Message{
+			Type: pb.Message_BYZANTINE_INTERNAL_SEND,
+			ByzantineInternalSend: &pb.ByzantineInternalSend{
+				Sender:         bb.self,
+				SequenceNumber: bb.seq,
+				Message:        message,
+			},
+		})
+	case pb.Message_PL_DELIVER:
+		return bb.handleDeliver(m.SystemId, m.PlDeliver.Sender, m.PlDeliver.Message)
+	default:
+		return errors.New("byzantine broadcast message type not supported")
+	}
+
+	return nil
+}
+
+func (bb *ByzantineBroadcast) Destroy() {}
+
+func (bb *ByzantineBroadcast) handleDeliver(systemId string, from *pb.ProcessId, m *pb.Message) error {
+	sender := utils.GetProcessKey(from)
+
+	switch m.Type {
+	case pb.Message_BYZANTINE_INTERNAL_SEND:
+		d := m.ByzantineInternalSend
+		// the links are authenticated, so only the sender can start its instances
+		if utils.GetProcessKey(d.Sender) != sender {
+			log.Warn("%v ignores a message of %v-%v sent by %v", bb.id, d.Sender.GetOwner(), d.Sender.GetIndex(), sender)
+			return nil
+		}
+
+		inst := bb.instance(d)
+		if inst.sentEcho {
+			return nil
+		}
+		inst.sentEcho = true
+		bb.sendAll(systemId, &pb.Message{
+			Type:                  pb.Message_BYZANTINE_INTERNAL_ECHO,
+			ByzantineInternalEcho: &pb.ByzantineInternalEcho{Sender: d.Sender, SequenceNumber: d.SequenceNumber, Message: d.Message},
+		})
+	case pb.Message_BYZANTINE_INTERNAL_ECHO:
+		d := m.ByzantineInternalEcho
+		inst := bb.instance(d)
+		if _, ok := inst.echoes[sender]; !ok {
+			inst.echoes[sender] = inst.keep(d.Message)
+		}
+		bb.check(systemId, d, inst)
+	case pb.Message_BYZANTINE_INTERNAL_READY:
+		if !bb.reliable {
+			return errors.New("bcb does not expect ready messages")
+		}
+		d := m.ByzantineInternalReady
+		inst := bb.instance(d)
+		if _, ok := inst.readies[sender]; !ok {
+			inst.readies[sender] = inst.keep(d.Message)
+		}
+		bb.check(systemId, d, inst)
+	default:
+		return errors.New("byzantine broadcast pl deliver message type not supported")
+	}
+
+	return nil
+}
+
+// check moves the instance on as the quorums of echoes and readies fill up
+func (bb *ByzantineBroadcast) check(systemId string, d byzantineData, inst *byzantineInstance) {
+	n := len(bb.processes)
+	f := (n - 1) / 3
+
+	echoed, echoes := mostFrequent(inst.echoes)
+	if !bb.reliable {
+		if !inst.delivered && 2*echoes > n+f {
+			inst.delivered = true
+			bb.deliver(systemId, d.GetSender(), inst.messages[echoed])
+		}
+		return
+	}
+
+	ready, readies := mostFrequent(inst.readies)
+	if !inst.sentReady && (2*echoes > n+f || readies > f) {
+		if 2*echoes <= n+f {
+			echoed = ready
+		}
+		inst.sentReady = true
+		bb.sendAll(systemId, &pb.Message{
+			Type: pb.Message_BYZANTINE_INTERNAL_READY,
+			ByzantineInternalReady: &pb.ByzantineInternalReady{
+				Sender:         d.GetSender(),
+				SequenceNumber: d.GetSequenceNumber(),
+				Message:        inst.messages[echoed],
+			},
+		})
+	}
+	if !inst.delivered && readies > 2*f {
+		inst.delivered = true
+		bb.deliver(systemId, d.GetSender(), inst.messages[ready])
+	}
+}
+
+func (bb *ByzantineBroadcast) deliver(systemId string, sender *pb.ProcessId, message *pb.Message) {
+	m := &pb.Message{
+		Type:              pb.Message_BCB_DELIVER,
+		FromAbstractionId: bb.id,
+		ToAbstractionId:   bb.parentId,
+		SystemId:          systemId,
+		BcbDeliver:        &pb.BcbDeliver{Message: message, Sender: sender},
+	}
+	if bb.reliable {
+		m.Type, m.BcbDeliver = pb.Message_BRB_DELIVER, nil
+		m.BrbDeliver = &pb.BrbDeliver{Message: message, Sender: sender}
+	}
+
+	bb.msgQueue <- m
+}
+
+func (bb *ByzantineBroadcast) instance(d byzantineData) *byzantineInstance {
+	key := utils.GetProcessKey(d.GetSender()) + "/" + utils.Int32ToString(d.GetSequenceNumber())
+	inst, ok := bb.instances[key]
+	if !ok {
+		inst = &byzantineInstance{
+			echoes:   make(map[string]string),
+			readies:  make(map[string]string),
+			messages: make(map[string]*pb.Message),
+		}
+		bb.instances[key] = inst
+	}
+
+	return inst
+}
+
+// sendAll sends the message to every process, this one included
+func (bb *ByzantineBroadcast) sendAll(systemId string, m *pb.Message) {
+	m.FromAbstractionId = bb.id
+	m.ToAbstractionId = bb.id
+	m.SystemId = systemId
+
+	for _, p := range bb.processes {
+		bb.msgQueue <- &pb.Message{
+			Type:              pb.Message_PL_SEND,
+			FromAbstractionId: bb.id,
+			ToAbstractionId:   bb.id + ".pl",
+			SystemId:          systemId,
+			PlSend: &pb.PlSend{
+				Destination: p,
+				Message:     m,
+			},
+		}
+	}
+}
+
+// keep returns the key of the message, its deterministic encoding, so that the
+// messages are compared byte for byte
+func (inst *byzantineInstance) keep(m *pb.Message) string {
+	data, _ := proto.MarshalOptions{Deterministic: true}.Marshal(m)
+	key := string(data)
+	if _, ok := inst.messages[key]; !ok {
+		inst.messages[key] = m
+	}
+
+	return key
+}
+
+// mostFrequent returns the message sent by the most processes and how many
+func mostFrequent(byProcess map[string]string) (string, int) {
+	counts := make(map[string]int)
+	best, most := "", 0
+	for _, key := range byProcess {
+		counts[key]++
+		if counts[key] > most {
+			best, most = key, counts[key]
+		}
+	}
+
+	return best, most
+}
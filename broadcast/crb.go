@@ -0,0 +1,247 @@
+package broadcast
+
+import (
+	"amcds/pb"
+	"amcds/utils"
+	"amcds/utils/abstraction"
+	"amcds/utils/log"
+	"errors"
+)
+
+// NoWaitingCausalBroadcast sends along with every message all the messages
+// delivered before it (its causal past), delivering them first when they were
+// missed. Nothing is ever held back, but the past list grows forever
+type NoWaitingCausalBroadcast struct {
+	id        string
+	parentId  string
+	msgQueue  chan *pb.Message
+	self      *pb.ProcessId
+	seq       int
+	delivered map[string]bool
+	past      []*pb.CrbPastEntry
+	inPast    map[string]bool
+}
+
+func CreateNoWaitingCrb(parentAbstraction, abstractionId string, mQ chan *pb.Message, self *pb.ProcessId) *NoWaitingCausalBroadcast {
+	return &NoWaitingCausalBroadcast{
+		id:        abstractionId,
+		parentId:  parentAbstraction,
+		msgQueue:  mQ,
+		self:      self,
+		delivered: make(map[string]bool),
+		past:      make([]*pb.CrbPastEntry, 0),
+		inPast:    make(map[string]bool),
+	}
+}
+
+func (crb *NoWaitingCausalBroadcast) Handle(m *pb.Message) error {
+	switch m.Type {
+	case pb.Message_CRB_BROADCAST:
+		crb.seq++
+		entry := &pb.CrbPastEntry{
+			MessageId: messageId(crb.self, crb.seq),
+			Sender:    crb.self,
+			Message:   m.CrbBroadcast.Message,
+		}
+
+		past := make([]*pb.CrbPastEntry, len(crb.past))
+		copy(past, crb.past)
+		crbBroadcast(crb.msgQueue, crb.id, m.SystemId, &pb.CrbInternalData{
+			MessageId: entry.MessageId,
+			Past:      past,
+			Message:   entry.Message,
+		})
+		crb.addPast(entry)
+	case pb.Message_RB_DELIVER:
+		data := m.RbDeliver.Message.CrbInternalData
+		if data == nil {
+			return errors.New("crb rb deliver message type not supported")
+		}
+
+		if crb.delivered[data.MessageId] {
+			return nil
+		}
+
+		for _, e := range data.Past {
+			if !crb.delivered[e.MessageId] {
+				crb.deliver(m.SystemId, e)
+			}
+		}
+		crb.deliver(m.SystemId, &pb.CrbPastEntry{
+			MessageId: data.MessageId,
+			Sender:    m.RbDeliver.Sender,
+			Message:   data.Message,
+		})
+	default:
+		return errors.New("crb message type not supported")
+	}
+
+	return nil
+}
+
+func (crb *NoWaitingCausalBroadcast) Destroy() {}
+
+func (crb *NoWaitingCausalBroadcast) Buffered() abstraction.BufferStats {
+	return abstraction.BufferStats{}
+}
+
+func (crb *NoWaitingCausalBroadcast) deliver(systemId string, e *pb.CrbPastEntry) {
+	crb.delivered[e.MessageId] = true
+	crb.addPast(e)
+
+	crb.msgQueue <- &pb.Message{
+		Type:              pb.Message_CRB_DELIVER,
+		FromAbstractionId: crb.id,
+		ToAbstractionId:   crb.parentId,
+		SystemId:          systemId,
+		CrbDeliver: &pb.CrbDeliver{
+			Sender:  e.Sender,
+			Message: e.Message,
+		},
+	}
+}
+
+func (crb *NoWaitingCausalBroadcast) addPast(e *pb.CrbPastEntry) {
+	if !crb.inPast[e.MessageId] {
+		crb.inPast[e.MessageId] = true
+		crb.past = append(crb.past, e)
+	}
+}
+
+// WaitingCausalBroadcast tags every message with a vector clock counting the
+// messages of each sender delivered before it, and holds it back until the
+// same messages were delivered locally
+type WaitingCausalBroadcast struct {
+	id       string
+	parentId string
+	msgQueue chan *pb.Message
+	self     *pb.ProcessId
+	lsn      int32
+	// position of every process by rank, from 1, the ranks possibly having gaps
+	positions map[string]int32
+	// delivered messages by position - 1
+	v       []int32
+	pending []*crbPending
+	stats   abstraction.BufferStats
+}
+
+type crbPending struct {
+	sender *pb.ProcessId
+	data   *pb.CrbInternalData
+}
+
+func CreateWaitingCrb(parentAbstraction, abstractionId string, mQ chan *pb.Message, processes []*pb.ProcessId, self *pb.ProcessId) *WaitingCausalBroadcast {
+	return &WaitingCausalBroadcast{
+		id:        abstractionId,
+		parentId:  parentAbstraction,
+		msgQueue:  mQ,
+		self:      self,
+		positions: utils.RankPositions(processes),
+		v:         make([]int32, len(processes)),
+		pending:   make([]*crbPending, 0),
+	}
+}
+
+func (crb *WaitingCausalBroadcast) Handle(m *pb.Message) error {
+	switch m.Type {
+	case pb.Message_CRB_BROADCAST:
+		w := make([]int32, len(crb.v))
+		copy(w, crb.v)
+		w[crb.positions[utils.GetProcessKey(crb.self)]-1] = crb.lsn
+		crb.lsn++
+
+		crbBroadcast(crb.msgQueue, crb.id, m.SystemId, &pb.CrbInternalData{
+			MessageId:   messageId(crb.self, int(crb.lsn)),
+			VectorClock: w,
+			Message:     m.CrbBroadcast.Message,
+		})
+	case pb.Message_RB_DELIVER:
+		data := m.RbDeliver.Message.CrbInternalData
+		if data == nil {
+			return errors.New("crb rb deliver message type not supported")
+		}
+		if _, ok := crb.positions[utils.GetProcessKey(m.RbDeliver.Sender)]; !ok || len(data.VectorClock) != len(crb.v) {
+			return errors.New("crb vector clock does not match the system")
+		}
+
+		crb.pending = append(crb.pending, &crbPending{sender: m.RbDeliver.Sender, data: data})
+		crb.deliverPending(m.SystemId)
+	default:
+		return errors.New("crb message type not supported")
+	}
+
+	return nil
+}
+
+func (crb *WaitingCausalBroadcast) Destroy() {}
+
+func (crb *WaitingCausalBroadcast) Buffered() abstraction.BufferStats {
+	return crb.stats
+}
+
+// deliverPending delivers the messages whose causal past was delivered, as long
+// as there is one
+func (crb *WaitingCausalBroadcast) deliverPending(systemId string) {
+	for found := true; found; {
+		found = false
+
+		for i, p := range crb.pending {
+			if !crb.covers(p.data.VectorClock) {
+				continue
+			}
+
+			crb.v[crb.positions[utils.GetProcessKey(p.sender)]-1]++
+			crb.pending = append(crb.pending[:i], crb.pending[i+1:]...)
+			crb.msgQueue <- &pb.Message{
+				Type:              pb.Message_CRB_DELIVER,
+				FromAbstractionId: crb.id,
+				ToAbstractionId:   crb.parentId,
+				SystemId:          systemId,
+				CrbDeliver: &pb.CrbDeliver{
+					Sender:  p.sender,
+					Message: p.data.Message,
+				},
+			}
+			found = true
+			break
+		}
+	}
+
+	crb.stats.Current = len(crb.pending)
+	if crb.stats.Current > crb.stats.Max {
+		crb.stats.Max = crb.stats.Current
+	}
+	if crb.stats.Current > 0 {
+		log.Debug("[%v] holding back %v messages", crb.id, crb.stats.Current)
+	}
+}
+
+// covers checks w <= v, meaning that all the messages a message tagged with w
+// depends on were delivered
+func (crb *WaitingCausalBroadcast) covers(w []int32) bool {
+	for i := range w {
+		if w[i] > crb.v[i] {
+			return false
+		}
+	}
+
+	return true
+}
+
+func crbBroadcast(mQ chan *pb.Message, id, systemId string, data *pb.CrbInternalData) {
+	mQ <- &pb.Message{
+		Type:              pb.Message_RB_BROADCAST,
+		FromAbstractionId: id,
+		ToAbstractionId:   id + ".rb",
+		SystemId:          systemId,
+		RbBroadcast: &pb.RbBroadcast{
+			Message: &pb.Message{
+				Type:              pb.Message_CRB_INTERNAL_DATA,
+				FromAbstractionId: id,
+				ToAbstractionId:   id,
+				SystemId:          systemId,
+				CrbInternalData:   data,
+			},
+		},
+	}
+}
@@ -0,0 +1,120 @@
+package broadcast
+
+import (
+	"amcds/pb"
+	"amcds/utils"
+	"amcds/utils/abstraction"
+	"amcds/utils/log"
+	"errors"
+)
+
+// FifoReliableBroadcast numbers the messages of every sender and holds back
+// the ones rb delivers before their predecessors
+type FifoReliableBroadcast struct {
+	id       string
+	parentId string
+	msgQueue chan *pb.Message
+	lsn      int32
+	// next sequence number to deliver, by sender
+	next    map[string]int32
+	pending []*frbPending
+	stats   abstraction.BufferStats
+}
+
+type frbPending struct {
+	sender *pb.ProcessId
+	data   *pb.FrbInternalData
+}
+
+func CreateFrb(parentAbstraction, abstractionId string, mQ chan *pb.Message) *FifoReliableBroadcast {
+	return &FifoReliableBroadcast{
+		id:       abstractionId,
+		parentId: parentAbstraction,
+		msgQueue: mQ,
+		next:     make(map[string]int32),
+		pending:  make([]*frbPending, 0),
+	}
+}
+
+func (frb *FifoReliableBroadcast) Handle(m *pb.Message) error {
+	switch m.Type {
+	case pb.Message_FRB_BROADCAST:
+		frb.lsn++
+		frb.msgQueue <- &pb.Message{
+			Type:              pb.Message_RB_BROADCAST,
+			FromAbstractionId: frb.id,
+			ToAbstractionId:   frb.id + ".rb",
+			SystemId:          m.SystemId,
+			RbBroadcast: &pb.RbBroadcast{
+				Message: &pb.Message{
+					Type:              pb.Message_FRB_INTERNAL_DATA,
+					FromAbstractionId: frb.id,
+					ToAbstractionId:   frb.id,
+					SystemId:          m.SystemId,
+					FrbInternalData: &pb.FrbInternalData{
+						SequenceNumber: frb.lsn,
+						Message:        m.FrbBroadcast.Message,
+					},
+				},
+			},
+		}
+	case pb.Message_RB_DELIVER:
+		data := m.RbDeliver.Message.FrbInternalData
+		if data == nil {
+			return errors.New("frb rb deliver message type not supported")
+		}
+
+		frb.pending = append(frb.pending, &frbPending{sender: m.RbDeliver.Sender, data: data})
+		frb.deliverPending(m.SystemId)
+	default:
+		return errors.New("frb message type not supported")
+	}
+
+	return nil
+}
+
+func (frb *FifoReliableBroadcast) Destroy() {}
+
+func (frb *FifoReliableBroadcast) Buffered() abstraction.BufferStats {
+	return frb.stats
+}
+
+// deliverPending delivers the next message of a sender as long as there is one
+func (frb *FifoReliableBroadcast) deliverPending(systemId string) {
+	for found := true; found; {
+		found = false
+
+		for i, p := range frb.pending {
+			key := utils.GetProcessKey(p.sender)
+			if _, ok := frb.next[key]; !ok {
+				frb.next[key] = 1
+			}
+			if p.data.SequenceNumber != frb.next[key] {
+				continue
+			}
+
+			frb.next[key]++
+			frb.pending = append(frb.pending[:i], frb.pending[i+1:]...)
+			frb.msgQueue <- &pb.Message{
+				Type:              pb.Message_FRB_DELIVER,
+				FromAbstractionId: frb.id,
+				ToAbstractionId:   frb.parentId,
+				SystemId:          systemId,
+				FrbDeliver: &pb.FrbDeliver{
+					Sender:  p.sender,
+					Message: p.data.Message,
+				},
+			}
+			found = true
+			break
+		}
+	}
+
+	frb.stats.Current = len(frb.pending)
+	if frb.stats.Current > frb.stats.Max {
+		frb.stats.Max = frb.stats.Current
+	}
+	if frb.stats.Current > 0 {
+		log.Debug("[%v] holding back %v messages", frb.id, frb.stats.Current)
+	}
+}
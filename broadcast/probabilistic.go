@@ -0,0 +1,322 @@
+package broadcast
+
+import (
+	"amcds/pb"
+	"amcds/utils"
+	"amcds/utils/clock"
+	"errors"
+	"math/rand"
+	"time"
+)
+
+// GossipConfig holds the parameters of the probabilistic broadcast
+type GossipConfig struct {
+	Lazy bool
+	// number of random processes a message is relayed to, and for how many rounds
+	Fanout int
+	Rounds int32
+	// probability that a process stores a message to answer the retransmission
+	// requests, and how long a missing message is waited for (lazy only)
+	Store   float64
+	Timeout time.Duration
+	Seed    int64
+}
+
+// ProbabilisticBroadcast gossips every message to a few random processes,
+// which relay it the first time they see it, for a limited number of rounds.
+// Most processes deliver it with a fraction of the messages of beb.
+//
+// The lazy variant numbers the messages of every sender and delivers them in
+// order. A gap makes it ask around for the missing message, which only some of
+// the processes stored, and skip it after a timeout
+type ProbabilisticBroadcast struct {
+	id        string
+	parentId  string
+	msgQueue  chan *pb.Message
+	processes []*pb.ProcessId
+	self      *pb.ProcessId
+	cfg       GossipConfig
+	rng       *rand.Rand
+	clock     clock.Clock
+	// lazy only: timeout of every missing message, by message id
+	timers map[string]clock.Timer
+
+	lsn  int32
+	seen map[string]bool
+
+	// lazy only: next sequence number to deliver and early messages, by sender
+	next    map[string]int32
+	pending map[string]map[int32]*pb.PbInternalGossip
+	stored  map[string]*pb.PbInternalGossip
+}
+
+func CreatePb(parentAbstraction, abstractionId string, mQ chan *pb.Message, processes []*pb.ProcessId, self *pb.ProcessId, cfg GossipConfig, c clock.Clock) *ProbabilisticBroadcast {
+	if cfg.Fanout > len(processes)-1 {
+		cfg.Fanout = len(processes) - 1
+	}
+
+	return &ProbabilisticBroadcast{
+		id:        abstractionId,
+		parentId:  parentAbstraction,
+		msgQueue:  mQ,
+		processes: processes,
+		self:      self,
+		cfg:       cfg,
+		rng:       rand.New(rand.NewSource(cfg.Seed + int64(self.Rank))),
+		clock:     c,
+		timers:    make(map[string]clock.Timer),
+		seen:      make(map[string]bool),
+		next:      make(map[string]int32),
+		pending:   make(map[string]map[int32]*pb.PbInternalGossip),
+		stored:    make(map[string]*pb.PbInternalGossip),
+	}
+}
+
+func (pbc *ProbabilisticBroadcast) Handle(m *pb.Message) error {
+	switch m.Type {
+	case pb.Message_PB_BROADCAST:
+		pbc.lsn++
+		g := &pb.PbInternalGossip{
+			Sender:         pbc.self,
+			SequenceNumber: pbc.lsn,
+			Rounds:         pbc.cfg.Rounds,
+			Message:        m.PbBroadcast.Message,
+		}
+
+		pbc.seen[messageId(pbc.self, int(pbc.lsn))] = true
+		if pbc.cfg.Lazy {
+			pbc.stored[messageId(pbc.self, int(pbc.lsn))] = g
+			pbc.next[utils.GetProcessKey(pbc.self)] = pbc.lsn + 1
+		}
+		pbc.deliver(m.SystemId, g.Sender, g.Message)
+		pbc.gossip(m.SystemId, g)
+	case pb.Message_PB_TIMEOUT:
+		delete(pbc.timers, messageId(m.PbTimeout.Sender, int(m.PbTimeout.SequenceNumber-1)))
+		key := utils.GetProcessKey(m.PbTimeout.Sender)
+		if m.PbTimeout.SequenceNumber > pbc.nextOf(key) {
+			pbc.next[key] = m.PbTimeout.SequenceNumber
+			pbc.deliverPending(m.SystemId, key)
+		}
+	case pb.Message_PL_DELIVER:
+		inner := m.PlDeliver.Message
+		switch inner.Type {
+		case pb.Message_PB_INTERNAL_GOSSIP:
+			if pbc.seen[inner.MessageUuid] {
+				return nil
+			}
+			pbc.seen[inner.MessageUuid] = true
+
+			g := inner.PbInternalGossip
+			if pbc.cfg.Lazy {
+				pbc.receive(m.SystemId, g)
+			} else {
+				pbc.deliver(m.SystemId, g.Sender, g.Message)
+			}
+
+			if g.Rounds > 1 {
+				pbc.gossip(m.SystemId, &pb.PbInternalGossip{
+					Sender:         g.Sender,
+					SequenceNumber: g.SequenceNumber,
+					Rounds:         g.Rounds - 1,
+					Message:        g.Message,
+				})
+			}
+		case pb.Message_PB_INTERNAL_REQUEST:
+			r := inner.PbInternalRequest
+			if g, ok := pbc.stored[messageId(r.Sender, int(r.SequenceNumber))]; ok {
+				pbc.send(m.SystemId, r.Requester, &pb.Message{
+					Type:              pb.Message_PB_INTERNAL_DATA,
+					FromAbstractionId: pbc.id,
+					ToAbstractionId:   pbc.id,
+					SystemId:          m.SystemId,
+					PbInternalData: &pb.PbInternalData{
+						Sender:         g.Sender,
+						SequenceNumber: g.SequenceNumber,
+						Message:        g.Message,
+					},
+				})
+			} else if r.Rounds > 0 {
+				pbc.request(m.SystemId, r.Requester, r.Sender, r.SequenceNumber, r.Rounds-1)
+			}
+		case pb.Message_PB_INTERNAL_DATA:
+			d := inner.PbInternalData
+			id := messageId(d.Sender, int(d.SequenceNumber))
+			if pbc.seen[id] {
+				return nil
+			}
+			pbc.seen[id] = true
+
+			pbc.receive(m.SystemId, &pb.PbInternalGossip{
+				Sender:         d.Sender,
+				SequenceNumber: d.SequenceNumber,
+				Message:        d.Message,
+			})
+		default:
+			return errors.New("pb pl deliver message type not supported")
+		}
+	default:
+		return errors.New("pb message type not supported")
+	}
+
+	return nil
+}
+
+func (pbc *ProbabilisticBroadcast) Destroy() {
+	for _, t := range pbc.timers {
+		t.Stop()
+	}
+}
+
+// receive handles a message of the lazy variant, delivering it if it is the
+// next one of its sender, otherwise asking for the missing ones
+func (pbc *ProbabilisticBroadcast) receive(systemId string, g *pb.PbInternalGossip) {
+	id := messageId(g.Sender, int(g.SequenceNumber))
+	if t, ok := pbc.timers[id]; ok {
+		t.Stop()
+		delete(pbc.timers, id)
+	}
+	if pbc.rng.Float64() < pbc.cfg.Store {
+		pbc.stored[id] = g
+	}
+
+	key := utils.GetProcessKey(g.Sender)
+	next := pbc.nextOf(key)
+	if g.SequenceNumber < next {
+		return
+	}
+
+	if _, ok := pbc.pending[key]; !ok {
+		pbc.pending[key] = make(map[int32]*pb.PbInternalGossip)
+	}
+	pbc.pending[key][g.SequenceNumber] = g
+
+	for sn := next; sn < g.SequenceNumber; sn++ {
+		if _, ok := pbc.pending[key][sn]; !ok {
+			pbc.request(systemId, pbc.self, g.Sender, sn, pbc.cfg.Rounds-1)
+			pbc.await(systemId, g.Sender, sn)
+		}
+	}
+
+	pbc.deliverPending(systemId, key)
+}
+
+// await gives up on the missing message after the timeout, unless it arrives
+// first or is already waited for
+func (pbc *ProbabilisticBroadcast) await(systemId string, sender *pb.ProcessId, sn int32) {
+	id := messageId(sender, int(sn))
+	if _, ok := pbc.timers[id]; ok {
+		return
+	}
+
+	pbc.timers[id] = pbc.clock.AfterFunc(pbc.cfg.Timeout, func() {
+		pbc.msgQueue <- &pb.Message{
+			Type:              pb.Message_PB_TIMEOUT,
+			FromAbstractionId: pbc.id,
+			ToAbstractionId:   pbc.id,
+			SystemId:          systemId,
+			PbTimeout: &pb.PbTimeout{
+				Sender:         sender,
+				SequenceNumber: sn + 1,
+			},
+		}
+	})
+}
+
+func (pbc *ProbabilisticBroadcast) deliverPending(systemId, key string) {
+	for {
+		next := pbc.nextOf(key)
+		g, ok := pbc.pending[key][next]
+		if !ok {
+			break
+		}
+
+		delete(pbc.pending[key], next)
+		pbc.next[key] = next + 1
+		pbc.deliver(systemId, g.Sender, g.Message)
+	}
+
+	// messages before next were given up on
+	for sn := range pbc.pending[key] {
+		if sn < pbc.nextOf(key) {
+			delete(pbc.pending[key], sn)
+		}
+	}
+}
+
+func (pbc *ProbabilisticBroadcast) nextOf(key string) int32 {
+	if next, ok := pbc.next[key]; ok {
+		return next
+	}
+
+	return 1
+}
+
+func (pbc *ProbabilisticBroadcast) deliver(systemId string, sender *pb.ProcessId, m *pb.Message) {
+	pbc.msgQueue <- &pb.Message{
+		Type:              pb.Message_PB_DELIVER,
+		FromAbstractionId: pbc.id,
+		ToAbstractionId:   pbc.parentId,
+		SystemId:          systemId,
+		PbDeliver: &pb.PbDeliver{
+			Sender:  sender,
+			Message: m,
+		},
+	}
+}
+
+func (pbc *ProbabilisticBroadcast) gossip(systemId string, g *pb.PbInternalGossip) {
+	for _, p := range pbc.pickTargets() {
+		pbc.send(systemId, p, &pb.Message{
+			Type:              pb.Message_PB_INTERNAL_GOSSIP,
+			MessageUuid:       messageId(g.Sender, int(g.SequenceNumber)),
+			FromAbstractionId: pbc.id,
+			ToAbstractionId:   pbc.id,
+			SystemId:          systemId,
+			PbInternalGossip:  g,
+		})
+	}
+}
+
+func (pbc *ProbabilisticBroadcast) request(systemId string, requester, sender *pb.ProcessId, sn, rounds int32) {
+	for _, p := range pbc.pickTargets() {
+		pbc.send(systemId, p, &pb.Message{
+			Type:              pb.Message_PB_INTERNAL_REQUEST,
+			FromAbstractionId: pbc.id,
+			ToAbstractionId:   pbc.id,
+			SystemId:          systemId,
+			PbInternalRequest: &pb.PbInternalRequest{
+				Requester:      requester,
+				Sender:         sender,
+				SequenceNumber: sn,
+				Rounds:         rounds,
+			},
+		})
+	}
+}
+
+// pickTargets chooses fanout distinct processes other than self
+func (pbc *ProbabilisticBroadcast) pickTargets() []*pb.ProcessId {
+	others := make([]*pb.ProcessId, 0, len(pbc.processes))
+	for _, p := range pbc.processes {
+		if utils.GetProcessKey(p) != utils.GetProcessKey(pbc.self) {
+			others = append(others, p)
+		}
+	}
+
+	pbc.rng.Shuffle(len(others), func(i, j int) { others[i], others[j] = others[j], others[i] })
+
+	return others[:pbc.cfg.Fanout]
+}
+
+func (pbc *ProbabilisticBroadcast) send(systemId string, to *pb.ProcessId, m *pb.Message) {
+	pbc.msgQueue <- &pb.Message{
+		Type:              pb.Message_PL_SEND,
+		FromAbstractionId: pbc.id,
+		ToAbstractionId:   pbc.id + ".pl",
+		SystemId:          systemId,
+		PlSend: &pb.PlSend{
+			Destination: to,
+			Message:     m,
+		},
+	}
+}
@@ -0,0 +1,172 @@
+package broadcast
+
+import (
+	"amcds/pb"
+	"amcds/utils"
+	"errors"
+	"fmt"
+)
+
+// EagerReliableBroadcast relays every message the first time it delivers it,
+// so a message delivered by a correct process reaches all of them even if its
+// sender crashed half way through the beb broadcast
+type EagerReliableBroadcast struct {
+	id        string
+	parentId  string
+	msgQueue  chan *pb.Message
+	self      *pb.ProcessId
+	seq       int
+	delivered map[string]bool
+}
+
+func CreateEagerRb(parentAbstraction, abstractionId string, mQ chan *pb.Message, self *pb.ProcessId) *EagerReliableBroadcast {
+	return &EagerReliableBroadcast{
+		id:        abstractionId,
+		parentId:  parentAbstraction,
+		msgQueue:  mQ,
+		self:      self,
+		delivered: make(map[string]bool),
+	}
+}
+
+func (rb *EagerReliableBroadcast) Handle(m *pb.Message) error {
+	switch m.Type {
+	case pb.Message_RB_BROADCAST:
+		rb.seq++
+		rbBroadcast(rb.msgQueue, rb.id, m.SystemId, &pb.RbInternalData{
+			MessageId: messageId(rb.self, rb.seq),
+			Sender:    rb.self,
+			Message:   m.RbBroadcast.Message,
+		})
+	case pb.Message_BEB_DELIVER:
+		data := m.BebDeliver.Message.RbInternalData
+		if data == nil {
+			return errors.New("rb beb deliver message type not supported")
+		}
+
+		if rb.delivered[data.MessageId] {
+			return nil
+		}
+		rb.delivered[data.MessageId] = true
+
+		rbDeliver(rb.msgQueue, rb.id, rb.parentId, m.SystemId, data)
+		rbBroadcast(rb.msgQueue, rb.id, m.SystemId, data)
+	default:
+		return errors.New("rb message type not supported")
+	}
+
+	return nil
+}
+
+func (rb *EagerReliableBroadcast) Destroy() {}
+
+// LazyReliableBroadcast only relays the messages of the senders detected as
+// crashed by the perfect failure detector
+type LazyReliableBroadcast struct {
+	id       string
+	parentId string
+	msgQueue chan *pb.Message
+	self     *pb.ProcessId
+	seq      int
+	correct  utils.ProcessMap
+	// delivered messages, by sender, in delivery order
+	from      map[string][]*pb.RbInternalData
+	delivered map[string]bool
+}
+
+func CreateLazyRb(parentAbstraction, abstractionId string, mQ chan *pb.Message, processes []*pb.ProcessId, self *pb.ProcessId) *LazyReliableBroadcast {
+	rb := &LazyReliableBroadcast{
+		id:        abstractionId,
+		parentId:  parentAbstraction,
+		msgQueue:  mQ,
+		self:      self,
+		correct:   make(utils.ProcessMap),
+		from:      make(map[string][]*pb.RbInternalData),
+		delivered: make(map[string]bool),
+	}
+
+	for _, p := range processes {
+		rb.correct[utils.GetProcessKey(p)] = p
+	}
+
+	return rb
+}
+
+func (rb *LazyReliableBroadcast) Handle(m *pb.Message) error {
+	switch m.Type {
+	case pb.Message_RB_BROADCAST:
+		rb.seq++
+		rbBroadcast(rb.msgQueue, rb.id, m.SystemId, &pb.RbInternalData{
+			MessageId: messageId(rb.self, rb.seq),
+			Sender:    rb.self,
+			Message:   m.RbBroadcast.Message,
+		})
+	case pb.Message_BEB_DELIVER:
+		data := m.BebDeliver.Message.RbInternalData
+		if data == nil {
+			return errors.New("rb beb deliver message type not supported")
+		}
+
+		if rb.delivered[data.MessageId] {
+			return nil
+		}
+		rb.delivered[data.MessageId] = true
+
+		key := utils.GetProcessKey(data.Sender)
+		rb.from[key] = append(rb.from[key], data)
+		rbDeliver(rb.msgQueue, rb.id, rb.parentId, m.SystemId, data)
+
+		if _, ok := rb.correct[key]; !ok {
+			rbBroadcast(rb.msgQueue, rb.id, m.SystemId, data)
+		}
+	case pb.Message_PFD_CRASH:
+		key := utils.GetProcessKey(m.PfdCrash.Process)
+		delete(rb.correct, key)
+
+		for _, data := range rb.from[key] {
+			rbBroadcast(rb.msgQueue, rb.id, m.SystemId, data)
+		}
+	default:
+		return errors.New("rb message type not supported")
+	}
+
+	return nil
+}
+
+func (rb *LazyReliableBroadcast) Destroy() {}
+
+// messageId identifies the seq-th message broadcast by the process
+func messageId(p *pb.ProcessId, seq int) string {
+	return fmt.Sprintf("%v/%v", utils.GetProcessKey(p), seq)
+}
+
+func rbBroadcast(mQ chan *pb.Message, id, systemId string, data *pb.RbInternalData) {
+	mQ <- &pb.Message{
+		Type:              pb.Message_BEB_BROADCAST,
+		FromAbstractionId: id,
+		ToAbstractionId:   id + ".beb",
+		SystemId:          systemId,
+		BebBroadcast: &pb.BebBroadcast{
+			Message: &pb.Message{
+				Type:              pb.Message_RB_INTERNAL_DATA,
+				FromAbstractionId: id,
+				ToAbstractionId:   id,
+				SystemId:          systemId,
+				RbInternalData:    data,
+			},
+		},
+	}
+}
+
+func rbDeliver(mQ chan *pb.Message, id, parentId, systemId string, data *pb.RbInternalData) {
+	mQ <- &pb.Message{
+		Type:              pb.Message_RB_DELIVER,
+		FromAbstractionId: id,
+		ToAbstractionId:   parentId,
+		SystemId:          systemId,
+		RbDeliver: &pb.RbDeliver{
+			Sender:  data.Sender,
+			Message: data.Message,
+		},
+	}
+}
@@ -0,0 +1,161 @@
+package broadcast
+
+import (
+	"amcds/pb"
+	"amcds/utils"
+	"errors"
+)
+
+// UniformReliableBroadcast delivers a message only once enough processes have
+// relayed it, so that whatever a process delivers, even one which crashes
+// right after, is delivered by all the correct processes. With all-ack these
+// are all the processes not detected as crashed by the perfect failure
+// detector, with majority-ack any majority (assuming a majority is correct)
+type UniformReliableBroadcast struct {
+	id        string
+	parentId  string
+	msgQueue  chan *pb.Message
+	processes []*pb.ProcessId
+	self      *pb.ProcessId
+	seq       int
+	majority  bool
+
+	correct   utils.ProcessMap
+	delivered map[string]bool
+	// relayed but not delivered messages, in arrival order
+	pending []*pb.UrbInternalData
+	relayed map[string]bool
+	// processes which relayed each message
+	ack map[string]map[string]bool
+}
+
+func CreateAllAckUrb(parentAbstraction, abstractionId string, mQ chan *pb.Message, processes []*pb.ProcessId, self *pb.ProcessId) *UniformReliableBroadcast {
+	return createUrb(parentAbstraction, abstractionId, mQ, processes, self, false)
+}
+
+func CreateMajorityAckUrb(parentAbstraction, abstractionId string, mQ chan *pb.Message, processes []*pb.ProcessId, self *pb.ProcessId) *UniformReliableBroadcast {
+	return createUrb(parentAbstraction, abstractionId, mQ, processes, self, true)
+}
+
+func createUrb(parentAbstraction, abstractionId string, mQ chan *pb.Message, processes []*pb.ProcessId, self *pb.ProcessId, majority bool) *UniformReliableBroadcast {
+	urb := &UniformReliableBroadcast{
+		id:        abstractionId,
+		parentId:  parentAbstraction,
+		msgQueue:  mQ,
+		processes: processes,
+		self:      self,
+		majority:  majority,
+		correct:   make(utils.ProcessMap),
+		delivered: make(map[string]bool),
+		pending:   make([]*pb.UrbInternalData, 0),
+		relayed:   make(map[string]bool),
+		ack:       make(map[string]map[string]bool),
+	}
+
+	for _, p := range processes {
+		urb.correct[utils.GetProcessKey(p)] = p
+	}
+
+	return urb
+}
+
+func (urb *UniformReliableBroadcast) Handle(m *pb.Message) error {
+	switch m.Type {
+	case pb.Message_URB_BROADCAST:
+		urb.seq++
+		urb.relay(m.SystemId, &pb.UrbInternalData{
+			MessageId: messageId(urb.self, urb.seq),
+			Sender:    urb.self,
+			Message:   m.UrbBroadcast.Message,
+		})
+	case pb.Message_BEB_DELIVER:
+		data := m.BebDeliver.Message.UrbInternalData
+		if data == nil {
+			return errors.New("urb beb deliver message type not supported")
+		}
+
+		if _, ok := urb.ack[data.MessageId]; !ok {
+			urb.ack[data.MessageId] = make(map[string]bool)
+		}
+		urb.ack[data.MessageId][utils.GetProcessKey(m.BebDeliver.Sender)] = true
+
+		if !urb.relayed[data.MessageId] {
+			urb.relay(m.SystemId, data)
+		}
+	case pb.Message_PFD_CRASH:
+		delete(urb.correct, utils.GetProcessKey(m.PfdCrash.Process))
+	default:
+		return errors.New("urb message type not supported")
+	}
+
+	urb.deliverPending(m.SystemId)
+
+	return nil
+}
+
+func (urb *UniformReliableBroadcast) Destroy() {}
+
+func (urb *UniformReliableBroadcast) relay(systemId string, data *pb.UrbInternalData) {
+	urb.relayed[data.MessageId] = true
+	urb.pending = append(urb.pending, data)
+
+	urb.msgQueue <- &pb.Message{
+		Type:              pb.Message_BEB_BROADCAST,
+		FromAbstractionId: urb.id,
+		ToAbstractionId:   urb.id + ".beb",
+		SystemId:          systemId,
+		BebBroadcast: &pb.BebBroadcast{
+			Message: &pb.Message{
+				Type:              pb.Message_URB_INTERNAL_DATA,
+				FromAbstractionId: urb.id,
+				ToAbstractionId:   urb.id,
+				SystemId:          systemId,
+				UrbInternalData:   data,
+			},
+		},
+	}
+}
+
+func (urb *UniformReliableBroadcast) deliverPending(systemId string) {
+	pending := make([]*pb.UrbInternalData, 0, len(urb.pending))
+
+	for _, data := range urb.pending {
+		if urb.delivered[data.MessageId] {
+			continue
+		}
+		if !urb.canDeliver(data.MessageId) {
+			pending = append(pending, data)
+			continue
+		}
+
+		urb.delivered[data.MessageId] = true
+		urb.msgQueue <- &pb.Message{
+			Type:              pb.Message_URB_DELIVER,
+			FromAbstractionId: urb.id,
+			ToAbstractionId:   urb.parentId,
+			SystemId:          systemId,
+			UrbDeliver: &pb.UrbDeliver{
+				Sender:  data.Sender,
+				Message: data.Message,
+			},
+		}
+	}
+
+	urb.pending = pending
+}
+
+func (urb *UniformReliableBroadcast) canDeliver(id string) bool {
+	acks := urb.ack[id]
+
+	if urb.majority {
+		return len(acks) > len(urb.processes)/2
+	}
+
+	for key := range urb.correct {
+		if !acks[key] {
+			return false
+		}
+	}
+
+	return true
+}
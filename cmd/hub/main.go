@@ -0,0 +1,340 @@
+package main
+
+import (
+	"amcds/hub"
+	"amcds/lin"
+	"amcds/tcp"
+	"amcds/utils"
+	"amcds/utils/log"
+	"bufio"
+	"errors"
+	"flag"
+	"fmt"
+	"net"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/joho/godotenv"
+)
+
+const usage = `Commands:
+    log [info|debug|trace]        - set logging level
+    quit                          - quit the program
+    help                          - show usage
+    list                          - list the nodes
+    system owner1 ... [key=value] - initialize system with owners nodes, overriding
+                                    their settings (e.g. rb_algorithm=lazy)
+    broadcast process value       - best-effort broadcast value from process
+    rbroadcast process value      - reliable broadcast value from process
+    ubroadcast process value      - uniform reliable broadcast value from process
+    fbroadcast process value      - fifo reliable broadcast value from process
+    cbroadcast process value      - causal broadcast value from process
+    pbroadcast process value      - probabilistic broadcast value from process
+    ratio value                   - how many processes delivered value
+    bcbroadcast process value     - byzantine consistent broadcast value from process
+    brbroadcast process value     - byzantine reliable broadcast value from process
+    tbroadcast process value      - total-order broadcast value from process
+    vbroadcast process value      - view-synchronous broadcast value from process
+    order                         - check that the processes delivered the same total order
+    append process value          - append value (typed like for write) to the replicated log from process
+    write register value [procs]  - write value in register from procs (all if none), the value being
+                                    an int32, an int64 (e.g. 7L), bytes (e.g. 0x0aff) or a string
+    read register [procs]         - read register from procs (all if none)
+    owrite register value [procs] - write value (typed like for write) in regular register from procs (all if none)
+    oread register [procs]        - read regular register from procs (all if none)
+    swrite register value [procs] - write value (typed like for write) in single-writer atomic register from procs (all if none)
+    sread register [procs]        - read single-writer atomic register from procs (all if none)
+    storm register                - a lot of reads and writes involving all processes
+    consensus topic               - test consensus on topic
+    propose topic value [procs]   - propose value (typed like for write) on topic from procs (all if none)
+    rpropose topic value [procs]  - propose like propose, to the randomized consensus of topic
+    elect [procs]                 - start the leader election on procs (all if none)
+    trb topic process value       - terminating reliable broadcast of value from process
+    nbac topic [procs]            - atomic commit on topic, procs voting abort (none if empty)
+    2pc|3pc topic process [procs] - two or three phase commit of topic coordinated by process
+    lin register                  - check that the operations on register are linearizable
+    reconfigure +proc|-proc ...   - add processes to or remove them from the running system
+    wait N                        - wait N seconds`
+
+func main() {
+	host := flag.String("host", "127.0.0.1", "Host on which the hub listens")
+	port := flag.Int("port", 5000, "Port on which the hub listens")
+	seed := flag.Int64("seed", time.Now().UnixNano(), "Seed for ranks and random values")
+	history := flag.String("history", "", "File in which register operations are recorded")
+	flag.Parse()
+
+	godotenv.Load()
+
+	log.Instantiate()
+	log.SetLevel("info")
+
+	h := hub.Create(*host, int32(*port), *seed)
+	if *history != "" {
+		r, err := lin.CreateFileRecorder(*history)
+		if err != nil {
+			log.Fatal("Failed to open history file: %v", err)
+		}
+		defer r.Close()
+		h.SetRecorder(r)
+	}
+
+	address := net.JoinHostPort(*host, fmt.Sprint(*port))
+	l, err := tcp.Listen(address, h.Handle)
+	if err != nil {
+		log.Fatal("Failed to setup hub listener: %v", err)
+	}
+	defer l.Close()
+	log.Info("Hub listening on %v", address)
+
+	scanner := bufio.NewScanner(os.Stdin)
+	fmt.Print("dalgs> ")
+	for scanner.Scan() {
+		quit, err := execute(h, strings.Fields(scanner.Text()))
+		if err != nil {
+			fmt.Println("ERROR:", err)
+		}
+		if quit {
+			break
+		}
+		fmt.Print("dalgs> ")
+	}
+
+	h.Destroy()
+	log.Info("Stopping hub ...")
+}
+
+func execute(h *hub.Hub, args []string) (bool, error) {
+	if len(args) == 0 {
+		return false, nil
+	}
+
+	switch args[0] {
+	case "help":
+		fmt.Println(usage)
+	case "quit":
+		return true, nil
+	case "log":
+		if len(args) != 2 {
+			return false, errors.New("usage: log [info|debug|trace]")
+		}
+		return false, log.SetLevel(args[1])
+	case "list":
+		fmt.Print(h.List())
+	case "system":
+		owners := make([]string, 0)
+		settings := make(map[string]string)
+		for _, arg := range args[1:] {
+			if key, value, ok := strings.Cut(arg, "="); ok {
+				settings[key] = value
+			} else {
+				owners = append(owners, arg)
+			}
+		}
+		return false, h.System(owners, settings)
+	case "broadcast", "rbroadcast", "ubroadcast", "fbroadcast", "cbroadcast", "pbroadcast", "bcbroadcast", "brbroadcast", "tbroadcast", "vbroadcast":
+		if len(args) != 3 {
+			return false, fmt.Errorf("usage: %v process value", args[0])
+		}
+		v, err := parseValue(args[2])
+		if err != nil {
+			return false, err
+		}
+		switch args[0] {
+		case "rbroadcast":
+			return false, h.ReliableBroadcast(args[1], v)
+		case "ubroadcast":
+			return false, h.UniformBroadcast(args[1], v)
+		case "fbroadcast":
+			return false, h.FifoBroadcast(args[1], v)
+		case "cbroadcast":
+			return false, h.CausalBroadcast(args[1], v)
+		case "pbroadcast":
+			return false, h.ProbabilisticBroadcast(args[1], v)
+		case "bcbroadcast":
+			return false, h.BcbBroadcast(args[1], v)
+		case "brbroadcast":
+			return false, h.BrbBroadcast(args[1], v)
+		case "tbroadcast":
+			return false, h.TobBroadcast(args[1], v)
+		case "vbroadcast":
+			return false, h.VsBroadcast(args[1], v)
+		}
+		return false, h.Broadcast(args[1], v)
+	case "ratio":
+		if len(args) != 2 {
+			return false, errors.New("usage: ratio value")
+		}
+		v, err := parseValue(args[1])
+		if err != nil {
+			return false, err
+		}
+		delivered, total, err := h.DeliveryRatio(v)
+		if err != nil {
+			return false, err
+		}
+		fmt.Printf("%v delivered by %v/%v processes (%.0f%%)\n", v, delivered, total, 100*float64(delivered)/float64(total))
+	case "write":
+		if len(args) < 3 {
+			return false, errors.New("usage: write register value [procs]")
+		}
+		v, err := utils.ParseValue(args[2])
+		if err != nil {
+			return false, err
+		}
+		warnAllProcesses(args[3:])
+		return false, h.WriteValue(args[1], v, args[3:])
+	case "read":
+		if len(args) < 2 {
+			return false, errors.New("usage: read register [procs]")
+		}
+		warnAllProcesses(args[2:])
+		return false, h.Read(args[1], args[2:])
+	case "owrite":
+		if len(args) < 3 {
+			return false, errors.New("usage: owrite register value [procs]")
+		}
+		v, err := utils.ParseValue(args[2])
+		if err != nil {
+			return false, err
+		}
+		warnAllProcesses(args[3:])
+		return false, h.RegularWriteValue(args[1], v, args[3:])
+	case "oread":
+		if len(args) < 2 {
+			return false, errors.New("usage: oread register [procs]")
+		}
+		warnAllProcesses(args[2:])
+		return false, h.RegularRead(args[1], args[2:])
+	case "swrite":
+		if len(args) < 3 {
+			return false, errors.New("usage: swrite register value [procs]")
+		}
+		v, err := utils.ParseValue(args[2])
+		if err != nil {
+			return false, err
+		}
+		warnAllProcesses(args[3:])
+		return false, h.SingleWriterWriteValue(args[1], v, args[3:])
+	case "sread":
+		if len(args) < 2 {
+			return false, errors.New("usage: sread register [procs]")
+		}
+		warnAllProcesses(args[2:])
+		return false, h.SingleWriterRead(args[1], args[2:])
+	case "storm":
+		if len(args) != 2 {
+			return false, errors.New("usage: storm register")
+		}
+		return false, h.Storm(args[1])
+	case "consensus":
+		if len(args) != 2 {
+			return false, errors.New("usage: consensus topic")
+		}
+		return false, h.Consensus(args[1])
+	case "propose":
+		if len(args) < 3 {
+			return false, errors.New("usage: propose topic value [procs]")
+		}
+		v, err := utils.ParseValue(args[2])
+		if err != nil {
+			return false, err
+		}
+		warnAllProcesses(args[3:])
+		return false, h.Propose(args[1], v, args[3:])
+	case "rpropose":
+		if len(args) < 3 {
+			return false, errors.New("usage: rpropose topic value [procs]")
+		}
+		v, err := utils.ParseValue(args[2])
+		if err != nil {
+			return false, err
+		}
+		warnAllProcesses(args[3:])
+		return false, h.RcPropose(args[1], v, args[3:])
+	case "elect":
+		warnAllProcesses(args[1:])
+		return false, h.Elect(args[1:])
+	case "trb":
+		if len(args) != 4 {
+			return false, errors.New("usage: trb topic process value")
+		}
+		v, err := parseValue(args[3])
+		if err != nil {
+			return false, err
+		}
+		return false, h.Trb(args[1], args[2], v)
+	case "nbac":
+		if len(args) < 2 {
+			return false, errors.New("usage: nbac topic [procs]")
+		}
+		return false, h.Nbac(args[1], args[2:])
+	case "2pc", "3pc":
+		if len(args) < 3 {
+			return false, errors.New("usage: " + args[0] + " topic process [procs]")
+		}
+		return false, h.Commit(args[0], args[1], args[2], args[3:])
+	case "reconfigure":
+		added, removed := make([]string, 0), make([]string, 0)
+		for _, arg := range args[1:] {
+			switch {
+			case strings.HasPrefix(arg, "+"):
+				added = append(added, arg[1:])
+			case strings.HasPrefix(arg, "-"):
+				removed = append(removed, arg[1:])
+			default:
+				return false, errors.New("usage: reconfigure +proc|-proc ...")
+			}
+		}
+		return false, h.Reconfigure(added, removed)
+	case "append":
+		if len(args) != 3 {
+			return false, errors.New("usage: append process value")
+		}
+		v, err := utils.ParseValue(args[2])
+		if err != nil {
+			return false, err
+		}
+		return false, h.LogAppend(args[1], v)
+	case "order":
+		out, err := h.Order()
+		fmt.Print(out)
+		return false, err
+	case "lin":
+		if len(args) != 2 {
+			return false, errors.New("usage: lin register")
+		}
+		out, err := h.Lin(args[1])
+		fmt.Print(out)
+		return false, err
+	case "wait":
+		if len(args) != 2 {
+			return false, errors.New("usage: wait N")
+		}
+		n, err := strconv.Atoi(args[1])
+		if err != nil {
+			return false, err
+		}
+		time.Sleep(time.Duration(n) * time.Second)
+	default:
+		return false, fmt.Errorf("unknown command %v, type help for usage", args[0])
+	}
+
+	return false, nil
+}
+
+func parseValue(s string) (int32, error) {
+	v, err := strconv.ParseInt(s, 10, 32)
+	if err != nil {
+		return 0, fmt.Errorf("invalid value %v", s)
+	}
+
+	return int32(v), nil
+}
+
+func warnAllProcesses(names []string) {
+	if len(names) == 0 {
+		fmt.Println("INFO: No process name(s) provided. Triggering all processes")
+	}
+}
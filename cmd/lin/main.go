@@ -0,0 +1,78 @@
+package main
+
+import (
+	"amcds/lin"
+	"flag"
+	"fmt"
+	"os"
+	"sort"
+)
+
+// Checks recorded register histories offline. Every argument is a history
+// file written by the hub (-history) or by a process (HISTORY_DIR); files of
+// different processes of the same system are merged before checking. Both the
+// hub and the processes record the operations asked by the hub, so the
+// history of a system is either the hub file or the files of its processes,
+// mixing them being rejected
+func main() {
+	register := flag.String("register", "", "Only check this register (system/register)")
+	timeline := flag.Bool("timeline", false, "Draw the timeline of every checked register")
+	flag.Parse()
+
+	if flag.NArg() == 0 {
+		fmt.Fprintln(os.Stderr, "usage: lin [-register system/register] [-timeline] history.jsonl ...")
+		os.Exit(2)
+	}
+
+	events := make([]lin.Event, 0)
+	for _, path := range flag.Args() {
+		f, err := os.Open(path)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(2)
+		}
+		e, err := lin.ReadEvents(f)
+		f.Close()
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "%v: %v\n", path, err)
+			os.Exit(2)
+		}
+		events = append(events, e...)
+	}
+
+	ops, err := lin.Operations(events)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(2)
+	}
+
+	registers := make([]string, 0, len(ops))
+	for r := range ops {
+		if *register == "" || r == *register {
+			registers = append(registers, r)
+		}
+	}
+	sort.Strings(registers)
+
+	atomic := true
+	for _, r := range registers {
+		fmt.Printf("== %v (%v operations)\n", r, len(ops[r]))
+		if *timeline {
+			fmt.Print(lin.Render(ops[r]))
+		}
+
+		res := lin.Check(ops[r])
+		if res.Linearizable {
+			fmt.Println("The execution is atomic, witness order:")
+			fmt.Print(lin.Format(res.Witness))
+		} else {
+			atomic = false
+			fmt.Println("The execution is not atomic, minimal violating subhistory:")
+			fmt.Print(lin.Format(res.Violation))
+		}
+	}
+
+	if !atomic {
+		os.Exit(1)
+	}
+}
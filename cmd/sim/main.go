@@ -0,0 +1,63 @@
+package main
+
+import (
+	"amcds/sim"
+	"amcds/utils/log"
+	"flag"
+	"fmt"
+	"os"
+	"time"
+)
+
+// Runs the simulation scenarios for a range of seeds. A failing seed can be
+// replayed exactly with -seed N -runs 1
+func main() {
+	scenario := flag.String("scenario", "", "Scenario to run (all if empty)")
+	seed := flag.Int64("seed", 1, "Seed of the first run")
+	runs := flag.Int("runs", 20, "Number of runs, with consecutive seeds")
+	minDelay := flag.Duration("min-delay", time.Millisecond, "Minimum network delay")
+	maxDelay := flag.Duration("max-delay", 10*time.Millisecond, "Maximum network delay")
+	level := flag.String("log", "error", "Log level [debug|info|warn|error]")
+	list := flag.Bool("list", false, "List the scenarios")
+	flag.Parse()
+
+	log.Instantiate()
+	log.SetLevel(*level)
+
+	if *list {
+		for _, sc := range sim.Scenarios {
+			fmt.Printf("%-12v %v\n", sc.Name, sc.Description)
+		}
+		return
+	}
+
+	scenarios := sim.Scenarios
+	if *scenario != "" {
+		sc, ok := sim.FindScenario(*scenario)
+		if !ok {
+			fmt.Fprintf(os.Stderr, "unknown scenario %v\n", *scenario)
+			os.Exit(2)
+		}
+		scenarios = []sim.Scenario{sc}
+	}
+
+	failed := false
+	for _, sc := range scenarios {
+		passed := 0
+		for s := *seed; s < *seed+int64(*runs); s++ {
+			simulation := sim.Create(sim.Config{Seed: s, MinDelay: *minDelay, MaxDelay: *maxDelay})
+			if err := sc.Run(simulation); err != nil {
+				failed = true
+				fmt.Printf("FAIL %v seed=%v at %v: %v\n", sc.Name, s, simulation.Elapsed(), err)
+				fmt.Printf("     replay with: -scenario %v -seed %v -runs 1 -log debug\n", sc.Name, s)
+				continue
+			}
+			passed++
+		}
+		fmt.Printf("%-16v %v/%v runs passed\n", sc.Name, passed, *runs)
+	}
+
+	if failed {
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,152 @@
+package commit
+
+import (
+	"amcds/pb"
+	"amcds/utils"
+	"amcds/utils/clock"
+	"time"
+)
+
+// transaction is what both protocols keep about a transaction, as a
+// participant and, on one process, as its coordinator. Every process votes,
+// the coordinator included
+type transaction struct {
+	id        string
+	parentId  string
+	msgQueue  chan *pb.Message
+	processes []*pb.ProcessId
+	self      *pb.ProcessId
+	clock     clock.Clock
+	start     time.Time
+
+	// learned from CommitStart or from the prepare of the coordinator
+	coordinator *pb.ProcessId
+	started     bool
+	vote        bool
+	prepared    bool
+	voted       bool
+	decided     bool
+	committed   bool
+	suspected   map[string]bool
+
+	// coordinator only: processes which voted commit
+	votes        map[string]bool
+	decisionSent bool
+}
+
+func createTransaction(parentAbstraction, abstractionId string, mQ chan *pb.Message, processes []*pb.ProcessId, self *pb.ProcessId, c clock.Clock) transaction {
+	return transaction{
+		id:        abstractionId,
+		parentId:  parentAbstraction,
+		msgQueue:  mQ,
+		processes: processes,
+		self:      self,
+		clock:     c,
+		start:     c.Now(),
+		suspected: make(map[string]bool),
+		votes:     make(map[string]bool),
+	}
+}
+
+func (t *transaction) isCoordinator() bool {
+	return t.coordinator != nil && utils.GetProcessKey(t.coordinator) == utils.GetProcessKey(t.self)
+}
+
+func (t *transaction) isCoordinatorSuspected() bool {
+	return t.coordinator != nil && t.suspected[utils.GetProcessKey(t.coordinator)]
+}
+
+// begin handles the CommitStart of the process, the coordinator asking every
+// process for its vote
+func (t *transaction) begin(m *pb.CommitStart) {
+	t.coordinator = m.Coordinator
+	t.vote = m.Commit
+	t.started = true
+
+	if t.isCoordinator() {
+		t.sendAll(&pb.Message{
+			Type:                  pb.Message_COMMIT_INTERNAL_PREPARE,
+			CommitInternalPrepare: &pb.CommitInternalPrepare{},
+		})
+	}
+	t.sendVote()
+}
+
+// sendVote answers the prepare of the coordinator once both it and the vote
+// are known. A process voting abort aborts right away
+func (t *transaction) sendVote() {
+	if !t.started || !t.prepared || t.voted {
+		return
+	}
+	t.voted = true
+
+	t.send(t.coordinator, &pb.Message{
+		Type:               pb.Message_COMMIT_INTERNAL_VOTE,
+		CommitInternalVote: &pb.CommitInternalVote{Commit: t.vote},
+	})
+	if !t.vote {
+		t.decide(false)
+	}
+}
+
+// allVoted checks whether the coordinator got a commit vote from every process
+func (t *transaction) allVoted() bool {
+	return len(t.votes) == len(t.processes)
+}
+
+// sendDecision makes the coordinator send the outcome to every process, once
+func (t *transaction) sendDecision(commit bool) {
+	if t.decisionSent {
+		return
+	}
+	t.decisionSent = true
+
+	t.sendAll(&pb.Message{
+		Type:                   pb.Message_COMMIT_INTERNAL_DECISION,
+		CommitInternalDecision: &pb.CommitInternalDecision{Commit: commit},
+	})
+}
+
+func (t *transaction) decide(commit bool) {
+	if t.decided {
+		return
+	}
+	t.decided = true
+	t.committed = commit
+
+	t.report(commit, false)
+}
+
+func (t *transaction) report(committed, blocked bool) {
+	t.msgQueue <- &pb.Message{
+		Type:              pb.Message_COMMIT_OUTCOME,
+		FromAbstractionId: t.id,
+		ToAbstractionId:   t.parentId,
+		CommitOutcome: &pb.CommitOutcome{
+			Committed: committed,
+			Blocked:   blocked,
+			Latency:   int64(t.clock.Now().Sub(t.start)),
+		},
+	}
+}
+
+func (t *transaction) sendAll(m *pb.Message) {
+	for _, p := range t.processes {
+		t.send(p, m)
+	}
+}
+
+func (t *transaction) send(to *pb.ProcessId, m *pb.Message) {
+	m.FromAbstractionId = t.id
+	m.ToAbstractionId = t.id
+
+	t.msgQueue <- &pb.Message{
+		Type:              pb.Message_PL_SEND,
+		FromAbstractionId: t.id,
+		ToAbstractionId:   t.id + ".pl",
+		PlSend: &pb.PlSend{
+			Destination: to,
+			Message:     m,
+		},
+	}
+}
@@ -0,0 +1,179 @@
+package commit
+
+import (
+	"amcds/pb"
+	"amcds/utils"
+	"amcds/utils/clock"
+	"errors"
+)
+
+// ThreePhaseCommit adds a precommit phase to two phase commit: the coordinator
+// only commits once every process not suspected acknowledged that everybody
+// voted commit. When the coordinator is suspected the processes send their
+// state to the highest ranked process they do not suspect, which commits if
+// one of them precommitted and aborts otherwise, so they never block
+type ThreePhaseCommit struct {
+	transaction
+	precommitted bool
+
+	// coordinator only
+	precommitSent bool
+	acks          map[string]bool
+
+	// termination, once the coordinator is suspected: process collecting the
+	// states and, on that process, the states it got
+	terminator *pb.ProcessId
+	states     map[string]*pb.CommitInternalState
+}
+
+func CreateThreePhaseCommit(parentAbstraction, abstractionId string, mQ chan *pb.Message, processes []*pb.ProcessId, self *pb.ProcessId, c clock.Clock) *ThreePhaseCommit {
+	return &ThreePhaseCommit{
+		transaction: createTransaction(parentAbstraction, abstractionId, mQ, processes, self, c),
+		acks:        make(map[string]bool),
+		states:      make(map[string]*pb.CommitInternalState),
+	}
+}
+
+func (tpc *ThreePhaseCommit) Handle(m *pb.Message) error {
+	switch m.Type {
+	case pb.Message_COMMIT_START:
+		tpc.begin(m.CommitStart)
+	case pb.Message_EPFD_SUSPECT:
+		tpc.suspected[utils.GetProcessKey(m.EpfdSuspect.Process)] = true
+	case pb.Message_EPFD_RESTORE:
+		delete(tpc.suspected, utils.GetProcessKey(m.EpfdRestore.Process))
+	case pb.Message_PL_DELIVER:
+		inner := m.PlDeliver.Message
+		sender := utils.GetProcessKey(m.PlDeliver.Sender)
+		switch inner.Type {
+		case pb.Message_COMMIT_INTERNAL_PREPARE:
+			tpc.coordinator = m.PlDeliver.Sender
+			tpc.prepared = true
+			tpc.sendVote()
+		case pb.Message_COMMIT_INTERNAL_VOTE:
+			if !inner.CommitInternalVote.Commit {
+				tpc.sendDecision(false)
+				break
+			}
+
+			tpc.votes[sender] = true
+			if tpc.allVoted() && !tpc.precommitSent {
+				tpc.precommitSent = true
+				tpc.sendAll(&pb.Message{
+					Type:                    pb.Message_COMMIT_INTERNAL_PRECOMMIT,
+					CommitInternalPrecommit: &pb.CommitInternalPrecommit{},
+				})
+			}
+		case pb.Message_COMMIT_INTERNAL_PRECOMMIT:
+			// the state was already sent to a new coordinator
+			if tpc.terminator != nil {
+				break
+			}
+
+			tpc.precommitted = true
+			tpc.send(m.PlDeliver.Sender, &pb.Message{
+				Type:              pb.Message_COMMIT_INTERNAL_ACK,
+				CommitInternalAck: &pb.CommitInternalAck{},
+			})
+		case pb.Message_COMMIT_INTERNAL_ACK:
+			tpc.acks[sender] = true
+		case pb.Message_COMMIT_INTERNAL_DECISION:
+			tpc.decide(inner.CommitInternalDecision.Commit)
+		case pb.Message_COMMIT_INTERNAL_STATE:
+			tpc.states[sender] = inner.CommitInternalState
+		default:
+			return errors.New("3pc pl deliver message type not supported")
+		}
+	default:
+		return errors.New("3pc message type not supported")
+	}
+
+	tpc.checkCoordinator()
+	tpc.checkTermination()
+
+	return nil
+}
+
+func (tpc *ThreePhaseCommit) Destroy() {}
+
+// checkCoordinator makes the coordinator abort when a process it suspects did
+// not vote, and commit once every process acknowledged the precommit or is
+// suspected
+func (tpc *ThreePhaseCommit) checkCoordinator() {
+	if !tpc.isCoordinator() || tpc.decisionSent {
+		return
+	}
+
+	if !tpc.precommitSent {
+		for key := range tpc.suspected {
+			if !tpc.votes[key] {
+				tpc.sendDecision(false)
+				return
+			}
+		}
+		return
+	}
+
+	for _, p := range tpc.processes {
+		key := utils.GetProcessKey(p)
+		if !tpc.acks[key] && !tpc.suspected[key] {
+			return
+		}
+	}
+	tpc.sendDecision(true)
+}
+
+// checkTermination sends the state of the process to the highest ranked one it
+// does not suspect once the coordinator is suspected, again whenever that one
+// changes. The process it lands on decides once it got the state of every
+// process it does not suspect
+func (tpc *ThreePhaseCommit) checkTermination() {
+	if !tpc.isCoordinatorSuspected() {
+		return
+	}
+	if !tpc.voted {
+		tpc.voted = true
+		tpc.decide(false)
+	}
+
+	alive := make(utils.ProcessMap)
+	for _, p := range tpc.processes {
+		if !tpc.suspected[utils.GetProcessKey(p)] {
+			alive[utils.GetProcessKey(p)] = p
+		}
+	}
+	terminator := utils.GetMaxRank(alive)
+	if terminator == nil {
+		return
+	}
+
+	if tpc.terminator == nil || utils.GetProcessKey(tpc.terminator) != utils.GetProcessKey(terminator) {
+		tpc.terminator = terminator
+		tpc.send(terminator, &pb.Message{
+			Type: pb.Message_COMMIT_INTERNAL_STATE,
+			CommitInternalState: &pb.CommitInternalState{
+				Precommitted: tpc.precommitted,
+				Decided:      tpc.decided,
+				Commit:       tpc.committed,
+			},
+		})
+	}
+
+	if utils.GetProcessKey(terminator) != utils.GetProcessKey(tpc.self) || tpc.decisionSent {
+		return
+	}
+
+	commit := false
+	for key := range alive {
+		state, ok := tpc.states[key]
+		if !ok {
+			return
+		}
+		if state.Decided {
+			commit = state.Commit
+			break
+		}
+		commit = commit || state.Precommitted
+	}
+	tpc.sendDecision(commit)
+}
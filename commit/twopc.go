@@ -0,0 +1,88 @@
+package commit
+
+import (
+	"amcds/pb"
+	"amcds/utils"
+	"amcds/utils/clock"
+	"errors"
+)
+
+// TwoPhaseCommit is the classic two phase commit: the coordinator collects the
+// votes and sends the outcome. A process which voted commit cannot decide on
+// its own, so it blocks if the coordinator crashes before the outcome reaches
+// it, which it reports once
+type TwoPhaseCommit struct {
+	transaction
+	blocked bool
+}
+
+func CreateTwoPhaseCommit(parentAbstraction, abstractionId string, mQ chan *pb.Message, processes []*pb.ProcessId, self *pb.ProcessId, c clock.Clock) *TwoPhaseCommit {
+	return &TwoPhaseCommit{
+		transaction: createTransaction(parentAbstraction, abstractionId, mQ, processes, self, c),
+	}
+}
+
+func (tpc *TwoPhaseCommit) Handle(m *pb.Message) error {
+	switch m.Type {
+	case pb.Message_COMMIT_START:
+		tpc.begin(m.CommitStart)
+	case pb.Message_EPFD_SUSPECT:
+		tpc.suspected[utils.GetProcessKey(m.EpfdSuspect.Process)] = true
+	case pb.Message_EPFD_RESTORE:
+		delete(tpc.suspected, utils.GetProcessKey(m.EpfdRestore.Process))
+	case pb.Message_PL_DELIVER:
+		inner := m.PlDeliver.Message
+		switch inner.Type {
+		case pb.Message_COMMIT_INTERNAL_PREPARE:
+			tpc.coordinator = m.PlDeliver.Sender
+			tpc.prepared = true
+			tpc.sendVote()
+		case pb.Message_COMMIT_INTERNAL_VOTE:
+			if !inner.CommitInternalVote.Commit {
+				tpc.sendDecision(false)
+				break
+			}
+
+			tpc.votes[utils.GetProcessKey(m.PlDeliver.Sender)] = true
+			if tpc.allVoted() {
+				tpc.sendDecision(true)
+			}
+		case pb.Message_COMMIT_INTERNAL_DECISION:
+			tpc.decide(inner.CommitInternalDecision.Commit)
+		default:
+			return errors.New("2pc pl deliver message type not supported")
+		}
+	default:
+		return errors.New("2pc message type not supported")
+	}
+
+	tpc.checkTimeouts()
+
+	return nil
+}
+
+func (tpc *TwoPhaseCommit) Destroy() {}
+
+// checkTimeouts reacts to the suspected processes: the coordinator aborts when
+// one of them did not vote, a process which did not vote yet aborts when the
+// coordinator is suspected, the others block
+func (tpc *TwoPhaseCommit) checkTimeouts() {
+	if tpc.isCoordinator() {
+		for key := range tpc.suspected {
+			if !tpc.votes[key] {
+				tpc.sendDecision(false)
+			}
+		}
+	}
+
+	if tpc.decided || !tpc.isCoordinatorSuspected() {
+		return
+	}
+	if !tpc.voted {
+		tpc.voted = true
+		tpc.decide(false)
+	} else if !tpc.blocked {
+		tpc.blocked = true
+		tpc.report(false, true)
+	}
+}
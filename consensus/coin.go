@@ -0,0 +1,48 @@
+package consensus
+
+import (
+	"amcds/pb"
+	"encoding/binary"
+	"hash/fnv"
+	"math/rand"
+)
+
+// Coin gives a random bit, 0 or 1, for every round of a binary randomized
+// consensus instance
+type Coin interface {
+	Toss(round int32) int32
+}
+
+// CommonCoin gives every process the same bit for the same instance and round,
+// hashing the seed shared by the processes with them
+type CommonCoin struct {
+	seed int64
+	key  string
+}
+
+func CreateCommonCoin(seed int64, key string) *CommonCoin {
+	return &CommonCoin{seed: seed, key: key}
+}
+
+func (c *CommonCoin) Toss(round int32) int32 {
+	h := fnv.New64a()
+	binary.Write(h, binary.BigEndian, c.seed)
+	h.Write([]byte(c.key))
+	binary.Write(h, binary.BigEndian, round)
+
+	return int32(h.Sum64() >> 63)
+}
+
+// LocalCoin tosses a coin of its own on every process, the processes agreeing
+// by chance only, so the expected number of rounds grows with their number
+type LocalCoin struct {
+	rng *rand.Rand
+}
+
+func CreateLocalCoin(seed int64, self *pb.ProcessId) *LocalCoin {
+	return &LocalCoin{rng: rand.New(rand.NewSource(seed + int64(self.Rank)))}
+}
+
+func (c *LocalCoin) Toss(round int32) int32 {
+	return int32(c.rng.Intn(2))
+}
@@ -13,8 +13,11 @@ type Ec struct {
 	msgQueue  chan *pb.Message
 	processes []*pb.ProcessId
 	trusted   *pb.ProcessId
-	lastTs    int32
-	ts        int32
+	// last epoch started, or newer one reported by a NACK
+	lastTs int32
+	// leader of the last epoch started
+	lastL *pb.ProcessId
+	ts    int32
 }
 
 func CreateEc(parentAbstraction, abstractionId string, ownProcess *pb.ProcessId, mQ chan *pb.Message, processes []*pb.ProcessId) *Ec {
@@ -26,7 +29,8 @@ func CreateEc(parentAbstraction, abstractionId string, ownProcess *pb.ProcessId,
 		processes: processes,
 		trusted:   utils.GetMaxRankSlice(processes),
 		lastTs:    0,
-		ts:        ownProcess.Rank,
+		lastL:     utils.GetMaxRankSlice(processes),
+		ts:        utils.RankPositions(processes)[utils.GetProcessKey(ownProcess)],
 	}
 }
 
@@ -34,11 +38,26 @@ func (ec *Ec) Handle(m *pb.Message) error {
 	switch m.Type {
 	case pb.Message_ELD_TRUST:
 		ec.trusted = m.EldTrust.Process
+		// the new leader may be done with its epochs while this process
+		// started a newer one of another leader, which it has to overtake
+		self := utils.GetProcessKey(ec.self)
+		trusted := utils.GetProcessKey(ec.trusted)
+		if trusted != self && trusted != utils.GetProcessKey(ec.lastL) {
+			ec.nack(ec.trusted, ec.lastTs)
+		}
 		ec.handleSelfTrust()
 	case pb.Message_PL_DELIVER:
 		switch m.PlDeliver.Message.Type {
 		case pb.Message_EC_INTERNAL_NACK:
-			ec.handleSelfTrust()
+			// every process rejecting an epoch answers, only the first NACK
+			// starts a new one. A NACK of a newer epoch comes from a process
+			// which started it before trusting this one, the next epoch has
+			// to overtake it
+			nackTs := m.PlDeliver.Message.EcInternalNack.Timestamp
+			if nackTs >= ec.ts {
+				ec.lastTs = max(ec.lastTs, nackTs)
+				ec.handleSelfTrust()
+			}
 		}
 	case pb.Message_BEB_DELIVER:
 		switch m.BebDeliver.Message.Type {
@@ -49,6 +68,7 @@ func (ec *Ec) Handle(m *pb.Message) error {
 
 			if l == trusted && newTs > ec.lastTs {
 				ec.lastTs = newTs
+				ec.lastL = m.BebDeliver.Sender
 				ec.msgQueue <- &pb.Message{
 					Type:              pb.Message_EC_START_EPOCH,
 					FromAbstractionId: ec.id,
@@ -59,19 +79,7 @@ func (ec *Ec) Handle(m *pb.Message) error {
 					},
 				}
 			} else {
-				ec.msgQueue <- &pb.Message{
-					Type:              pb.Message_PL_SEND,
-					FromAbstractionId: ec.id,
-					ToAbstractionId:   ec.id + ".pl",
-					PlSend: &pb.PlSend{
-						Message: &pb.Message{
-							Type:              pb.Message_EC_INTERNAL_NACK,
-							FromAbstractionId: ec.id,
-							ToAbstractionId:   ec.id,
-							EcInternalNack:    &pb.EcInternalNack{},
-						},
-					},
-				}
+				ec.nack(m.BebDeliver.Sender, max(newTs, ec.lastTs))
 			}
 		default:
 			return errors.New("ec unknown beb deliver message type")
@@ -85,10 +93,33 @@ func (ec *Ec) Handle(m *pb.Message) error {
 
 func (ec *Ec) Destroy() {}
 
+// nack rejects the epochs of the leader l up to ts
+func (ec *Ec) nack(l *pb.ProcessId, ts int32) {
+	ec.msgQueue <- &pb.Message{
+		Type:              pb.Message_PL_SEND,
+		FromAbstractionId: ec.id,
+		ToAbstractionId:   ec.id + ".pl",
+		PlSend: &pb.PlSend{
+			Destination: l,
+			Message: &pb.Message{
+				Type:              pb.Message_EC_INTERNAL_NACK,
+				FromAbstractionId: ec.id,
+				ToAbstractionId:   ec.id,
+				EcInternalNack: &pb.EcInternalNack{
+					Timestamp: ts,
+				},
+			},
+		},
+	}
+}
+
 func (ec *Ec) handleSelfTrust() {
 	if utils.GetProcessKey(ec.self) == utils.GetProcessKey(ec.trusted) {
-		// increment timestamp with all present processes
-		ec.ts = ec.lastTs + int32(len(ec.processes))
+		// increment timestamp with all present processes, in one step past
+		// the last epoch started. It stays the rank position of this process
+		// modulo N, so no other leader starts an epoch with the same timestamp
+		n := int32(len(ec.processes))
+		ec.ts += n * ((max(ec.ts, ec.lastTs)-ec.ts)/n + 1)
 
 		// broadcast new epoch to all processes
 		ec.msgQueue <- &pb.Message{
@@ -37,7 +37,7 @@ func (eld *Eld) Handle(m *pb.Message) error {
 	switch m.Type {
 	case pb.Message_EPFD_SUSPECT:
 		key := utils.GetProcessKey(m.EpfdSuspect.Process)
-		if _, isAlive := eld.alive[key]; !isAlive {
+		if _, isAlive := eld.alive[key]; isAlive {
 			delete(eld.alive, key)
 		}
 	case pb.Message_EPFD_RESTORE:
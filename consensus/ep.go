@@ -117,7 +117,7 @@ func (ep *Ep) Handle(m *pb.Message) error {
 				ToAbstractionId:   ep.parentId,
 				EpDecide: &pb.EpDecide{
 					Ets:   ep.ets,
-					Value: ep.state.Value,
+					Value: m.BebDeliver.Message.EpInternalDecided.Value,
 				},
 			}
 		default:
@@ -3,6 +3,7 @@ package consensus
 import (
 	"amcds/pb"
 	"amcds/utils"
+	"amcds/utils/clock"
 	"amcds/utils/log"
 	"errors"
 	"time"
@@ -17,12 +18,13 @@ type EpfdIncreaseTimeout struct {
 	alive     utils.ProcessMap
 	suspected utils.ProcessMap
 	delay     time.Duration
-	timer     *time.Timer
+	clock     clock.Clock
+	timer     clock.Timer
 }
 
 const delta = 100 * time.Millisecond
 
-func CreateEpfd(parentAbstraction, abstractionId string, mQ chan *pb.Message, processes []*pb.ProcessId) *EpfdIncreaseTimeout {
+func CreateEpfd(parentAbstraction, abstractionId string, mQ chan *pb.Message, processes []*pb.ProcessId, c clock.Clock) *EpfdIncreaseTimeout {
 	epfd := &EpfdIncreaseTimeout{
 		id:        abstractionId,
 		parentId:  parentAbstraction,
@@ -32,6 +34,7 @@ func CreateEpfd(parentAbstraction, abstractionId string, mQ chan *pb.Message, pr
 		alive:     make(utils.ProcessMap),
 		suspected: make(utils.ProcessMap),
 		delay:     delta,
+		clock:     c,
 	}
 
 	// Set all processes as alive
@@ -45,13 +48,10 @@ func CreateEpfd(parentAbstraction, abstractionId string, mQ chan *pb.Message, pr
 }
 
 func (epfd *EpfdIncreaseTimeout) startTimer(delay time.Duration) {
-	epfd.timer = time.NewTimer(delay)
-
-	go timerCallBack(epfd)
+	epfd.timer = epfd.clock.AfterFunc(delay, epfd.timerCallBack)
 }
 
-func timerCallBack(epfd *EpfdIncreaseTimeout) {
-	<-epfd.timer.C
+func (epfd *EpfdIncreaseTimeout) timerCallBack() {
 	msgToSend := &pb.Message{
 		Type:              pb.Message_EPFD_TIMEOUT,
 		FromAbstractionId: epfd.id,
@@ -75,6 +75,7 @@ func (epfd *EpfdIncreaseTimeout) Handle(m *pb.Message) error {
 				FromAbstractionId: epfd.id,
 				ToAbstractionId:   epfd.id + ".pl",
 				PlSend: &pb.PlSend{
+					Destination: m.PlDeliver.Sender,
 					Message: &pb.Message{
 						Type:                       pb.Message_EPFD_INTERNAL_HEARTBEAT_REPLY,
 						FromAbstractionId:          epfd.id,
@@ -140,10 +141,18 @@ func (epfd *EpfdIncreaseTimeout) handleTimeout() {
 
 		// send heartbeat request
 		epfd.msgQueue <- &pb.Message{
-			Type:                         pb.Message_EPFD_INTERNAL_HEARTBEAT_REQUEST,
-			FromAbstractionId:            epfd.id,
-			ToAbstractionId:              epfd.id,
-			EpfdInternalHeartbeatRequest: &pb.EpfdInternalHeartbeatRequest{},
+			Type:              pb.Message_PL_SEND,
+			FromAbstractionId: epfd.id,
+			ToAbstractionId:   epfd.id + ".pl",
+			PlSend: &pb.PlSend{
+				Destination: p,
+				Message: &pb.Message{
+					Type:                         pb.Message_EPFD_INTERNAL_HEARTBEAT_REQUEST,
+					FromAbstractionId:            epfd.id,
+					ToAbstractionId:              epfd.id,
+					EpfdInternalHeartbeatRequest: &pb.EpfdInternalHeartbeatRequest{},
+				},
+			},
 		}
 	}
 
@@ -0,0 +1,312 @@
+package consensus
+
+import (
+	"amcds/pb"
+	"amcds/utils"
+	"errors"
+	"slices"
+)
+
+// FloodingConsensus is the flooding consensus of a fail-stop system (algorithm
+// 5.1). Every round the processes beb-broadcast the proposals they know and
+// wait for the ones not detected as crashed by the perfect failure detector.
+// A round heard from the same processes as the previous one brought every
+// proposal, so the process decides the smallest and broadcasts its decision,
+// which the others adopt. Every process must propose
+type FloodingConsensus struct {
+	id        string
+	parentId  string
+	msgQueue  chan *pb.Message
+	processes []*pb.ProcessId
+
+	correct  utils.ProcessMap
+	round    int32
+	proposed bool
+	decided  bool
+	// senders and proposals by round, round 0 being heard from every process
+	receivedFrom map[int32]map[string]bool
+	proposals    map[int32]map[string]*pb.Value
+}
+
+func CreateFloodingConsensus(parentAbstraction, abstractionId string, mQ chan *pb.Message, processes []*pb.ProcessId) *FloodingConsensus {
+	fc := &FloodingConsensus{
+		id:           abstractionId,
+		parentId:     parentAbstraction,
+		msgQueue:     mQ,
+		processes:    processes,
+		correct:      make(utils.ProcessMap),
+		round:        1,
+		receivedFrom: map[int32]map[string]bool{0: {}},
+		proposals:    make(map[int32]map[string]*pb.Value),
+	}
+
+	for _, p := range processes {
+		fc.correct[utils.GetProcessKey(p)] = p
+		fc.receivedFrom[0][utils.GetProcessKey(p)] = true
+	}
+
+	return fc
+}
+
+func (fc *FloodingConsensus) Handle(m *pb.Message) error {
+	switch m.Type {
+	case pb.Message_UC_PROPOSE:
+		if fc.proposed {
+			return nil
+		}
+		fc.proposed = true
+		addValues(fc.proposalsOf(1), m.UcPropose.Value)
+		fc.broadcastProposals(1, fc.proposals[1])
+	case pb.Message_PFD_CRASH:
+		delete(fc.correct, utils.GetProcessKey(m.PfdCrash.Process))
+	case pb.Message_BEB_DELIVER:
+		sender := utils.GetProcessKey(m.BebDeliver.Sender)
+		switch m.BebDeliver.Message.Type {
+		case pb.Message_FLOODING_INTERNAL_PROPOSAL:
+			p := m.BebDeliver.Message.FloodingInternalProposal
+			if _, ok := fc.receivedFrom[p.Round]; !ok {
+				fc.receivedFrom[p.Round] = make(map[string]bool)
+			}
+			fc.receivedFrom[p.Round][sender] = true
+			addValues(fc.proposalsOf(p.Round), p.Proposals...)
+		case pb.Message_FLOODING_INTERNAL_DECIDED:
+			if _, ok := fc.correct[sender]; ok && !fc.decided {
+				fc.decide(m.BebDeliver.Message.FloodingInternalDecided.Value)
+			}
+		default:
+			return errors.New("flooding consensus beb deliver message type not supported")
+		}
+	default:
+		return errors.New("flooding consensus message type not supported")
+	}
+
+	fc.completeRounds()
+
+	return nil
+}
+
+func (fc *FloodingConsensus) Destroy() {}
+
+// completeRounds moves on once the round heard from every correct process
+func (fc *FloodingConsensus) completeRounds() {
+	for !fc.decided && heardFromAll(fc.correct, fc.receivedFrom[fc.round]) {
+		if sameKeys(fc.receivedFrom[fc.round], fc.receivedFrom[fc.round-1]) {
+			fc.decide(minValue(fc.proposals[fc.round]))
+			return
+		}
+
+		fc.round++
+		fc.broadcastProposals(fc.round, fc.proposals[fc.round-1])
+	}
+}
+
+func (fc *FloodingConsensus) decide(v *pb.Value) {
+	fc.decided = true
+	fc.msgQueue <- &pb.Message{
+		Type:              pb.Message_BEB_BROADCAST,
+		FromAbstractionId: fc.id,
+		ToAbstractionId:   fc.id + ".beb",
+		BebBroadcast: &pb.BebBroadcast{
+			Message: &pb.Message{
+				Type:                    pb.Message_FLOODING_INTERNAL_DECIDED,
+				FromAbstractionId:       fc.id,
+				ToAbstractionId:         fc.id,
+				FloodingInternalDecided: &pb.FloodingInternalDecided{Value: v},
+			},
+		},
+	}
+	fc.msgQueue <- &pb.Message{
+		Type:              pb.Message_UC_DECIDE,
+		FromAbstractionId: fc.id,
+		ToAbstractionId:   fc.parentId,
+		UcDecide: &pb.UcDecide{
+			Value: v,
+		},
+	}
+}
+
+func (fc *FloodingConsensus) proposalsOf(round int32) map[string]*pb.Value {
+	if _, ok := fc.proposals[round]; !ok {
+		fc.proposals[round] = make(map[string]*pb.Value)
+	}
+
+	return fc.proposals[round]
+}
+
+func (fc *FloodingConsensus) broadcastProposals(round int32, proposals map[string]*pb.Value) {
+	broadcastProposals(fc.msgQueue, fc.id, round, proposals)
+}
+
+// FloodingUniformConsensus is the flooding uniform consensus of a fail-stop
+// system (algorithm 5.3). The processes flood the proposals they know for N
+// rounds, waiting every round for the ones not detected as crashed, so that
+// the correct processes end up with the same proposals, the smallest of which
+// they decide. A process deciding never decided differently from the others,
+// even if it crashes right after
+type FloodingUniformConsensus struct {
+	id        string
+	parentId  string
+	msgQueue  chan *pb.Message
+	processes []*pb.ProcessId
+
+	correct     utils.ProcessMap
+	round       int32
+	proposed    bool
+	decided     bool
+	proposalSet map[string]*pb.Value
+	// proposals by round and sender, those of the later rounds being kept
+	// until this process gets there
+	received map[int32]map[string][]*pb.Value
+}
+
+func CreateFloodingUniformConsensus(parentAbstraction, abstractionId string, mQ chan *pb.Message, processes []*pb.ProcessId) *FloodingUniformConsensus {
+	fc := &FloodingUniformConsensus{
+		id:          abstractionId,
+		parentId:    parentAbstraction,
+		msgQueue:    mQ,
+		processes:   processes,
+		correct:     make(utils.ProcessMap),
+		round:       1,
+		proposalSet: make(map[string]*pb.Value),
+		received:    make(map[int32]map[string][]*pb.Value),
+	}
+
+	for _, p := range processes {
+		fc.correct[utils.GetProcessKey(p)] = p
+	}
+
+	return fc
+}
+
+func (fc *FloodingUniformConsensus) Handle(m *pb.Message) error {
+	switch m.Type {
+	case pb.Message_UC_PROPOSE:
+		if fc.proposed {
+			return nil
+		}
+		fc.proposed = true
+		addValues(fc.proposalSet, m.UcPropose.Value)
+		broadcastProposals(fc.msgQueue, fc.id, 1, fc.proposalSet)
+	case pb.Message_PFD_CRASH:
+		delete(fc.correct, utils.GetProcessKey(m.PfdCrash.Process))
+	case pb.Message_BEB_DELIVER:
+		p := m.BebDeliver.Message.FloodingInternalProposal
+		if p == nil {
+			return errors.New("flooding uniform consensus beb deliver message type not supported")
+		}
+		if p.Round < fc.round {
+			return nil
+		}
+		if _, ok := fc.received[p.Round]; !ok {
+			fc.received[p.Round] = make(map[string][]*pb.Value)
+		}
+		fc.received[p.Round][utils.GetProcessKey(m.BebDeliver.Sender)] = p.Proposals
+	default:
+		return errors.New("flooding uniform consensus message type not supported")
+	}
+
+	fc.completeRounds()
+
+	return nil
+}
+
+func (fc *FloodingUniformConsensus) Destroy() {}
+
+func (fc *FloodingUniformConsensus) completeRounds() {
+	for !fc.decided {
+		received := fc.received[fc.round]
+		senders := make(map[string]bool)
+		for sender := range received {
+			senders[sender] = true
+		}
+		if !heardFromAll(fc.correct, senders) {
+			return
+		}
+
+		for _, proposals := range received {
+			addValues(fc.proposalSet, proposals...)
+		}
+		delete(fc.received, fc.round)
+
+		if fc.round == int32(len(fc.processes)) {
+			fc.decided = true
+			fc.msgQueue <- &pb.Message{
+				Type:              pb.Message_UC_DECIDE,
+				FromAbstractionId: fc.id,
+				ToAbstractionId:   fc.parentId,
+				UcDecide: &pb.UcDecide{
+					Value: minValue(fc.proposalSet),
+				},
+			}
+			return
+		}
+
+		fc.round++
+		broadcastProposals(fc.msgQueue, fc.id, fc.round, fc.proposalSet)
+	}
+}
+
+func broadcastProposals(mQ chan *pb.Message, id string, round int32, proposals map[string]*pb.Value) {
+	values := make([]*pb.Value, 0, len(proposals))
+	for _, v := range proposals {
+		values = append(values, v)
+	}
+
+	mQ <- &pb.Message{
+		Type:              pb.Message_BEB_BROADCAST,
+		FromAbstractionId: id,
+		ToAbstractionId:   id + ".beb",
+		BebBroadcast: &pb.BebBroadcast{
+			Message: &pb.Message{
+				Type:              pb.Message_FLOODING_INTERNAL_PROPOSAL,
+				FromAbstractionId: id,
+				ToAbstractionId:   id,
+				FloodingInternalProposal: &pb.FloodingInternalProposal{
+					Round:     round,
+					Proposals: values,
+				},
+			},
+		},
+	}
+}
+
+// addValues adds the values to the set, keyed so that equal values of
+// different messages are kept once
+func addValues(set map[string]*pb.Value, values ...*pb.Value) {
+	for _, v := range values {
+		set[utils.ValueString(v)] = v
+	}
+}
+
+func minValue(set map[string]*pb.Value) *pb.Value {
+	values := make([]*pb.Value, 0, len(set))
+	for _, v := range set {
+		values = append(values, v)
+	}
+
+	return slices.MinFunc(values, utils.CompareValues)
+}
+
+// heardFromAll checks that every correct process is among the senders
+func heardFromAll(correct utils.ProcessMap, senders map[string]bool) bool {
+	for key := range correct {
+		if !senders[key] {
+			return false
+		}
+	}
+
+	return true
+}
+
+func sameKeys(a, b map[string]bool) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for key := range a {
+		if !b[key] {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,178 @@
+package consensus
+
+import (
+	"amcds/pb"
+	"amcds/utils"
+	"errors"
+	"strconv"
+)
+
+// GroupMembership installs a new view whenever members of the current one
+// crash (algorithm 6.5) or a reconfiguration asks for other members. The next
+// view is agreed on through one uc instance per view id, so every process
+// installs the same views in the same order. Besides its parent, the
+// abstractions subscribed to it get the views
+type GroupMembership struct {
+	id          string
+	parentId    string
+	msgQueue    chan *pb.Message
+	self        *pb.ProcessId
+	view        *pb.GmView
+	crashed     utils.ProcessMap
+	wait        bool
+	subscribers []string
+	// members asked by a reconfiguration until a view has them
+	requested []*pb.ProcessId
+	// views decided for ids not reached yet
+	decided map[int32]*pb.GmView
+}
+
+func CreateGm(parentAbstraction, abstractionId string, mQ chan *pb.Message, processes []*pb.ProcessId, self *pb.ProcessId) *GroupMembership {
+	return &GroupMembership{
+		id:       abstractionId,
+		parentId: parentAbstraction,
+		msgQueue: mQ,
+		self:     self,
+		view:     &pb.GmView{Id: 0, Members: processes},
+		crashed:  make(utils.ProcessMap),
+		decided:  make(map[int32]*pb.GmView),
+	}
+}
+
+// Subscribe makes the abstraction get a GmView with the current view right
+// away, then with every view installed afterwards
+func (gm *GroupMembership) Subscribe(abstractionId string) {
+	gm.subscribers = append(gm.subscribers, abstractionId)
+	gm.send(abstractionId, "")
+}
+
+// View returns the current view
+func (gm *GroupMembership) View() *pb.GmView {
+	return gm.view
+}
+
+func (gm *GroupMembership) Handle(m *pb.Message) error {
+	switch m.Type {
+	case pb.Message_PFD_CRASH:
+		gm.crashed[utils.GetProcessKey(m.PfdCrash.Process)] = m.PfdCrash.Process
+	case pb.Message_GM_RECONFIGURE:
+		gm.requested = m.GmReconfigure.Members
+	case pb.Message_GM_VIEW:
+		// a process joining the system gets the view adding it from the
+		// members of the previous one
+		if m.GmView.Id <= gm.view.Id {
+			break
+		}
+		for id := range gm.decided {
+			if id <= m.GmView.Id {
+				delete(gm.decided, id)
+			}
+		}
+		gm.view = m.GmView
+		gm.wait = false
+		gm.notify(m.SystemId)
+		gm.installDecided(m.SystemId)
+	case pb.Message_UC_DECIDE:
+		id, err := strconv.Atoi(utils.GetRegisterId(m.FromAbstractionId))
+		if err != nil {
+			return errors.New("gm decision from unknown uc instance " + m.FromAbstractionId)
+		}
+
+		view := &pb.GmView{}
+		if err := utils.ReadPayload(m.UcDecide.Value, view); err != nil {
+			return err
+		}
+
+		gm.decided[int32(id)] = view
+		gm.installDecided(m.SystemId)
+	default:
+		return errors.New("gm message type not supported")
+	}
+
+	return gm.propose(m.SystemId)
+}
+
+func (gm *GroupMembership) Destroy() {}
+
+// installDecided installs the views decided so far, in order
+func (gm *GroupMembership) installDecided(systemId string) {
+	for v, ok := gm.decided[gm.view.Id+1]; ok; v, ok = gm.decided[gm.view.Id+1] {
+		delete(gm.decided, v.Id)
+		gm.view = v
+		gm.wait = false
+		gm.notify(systemId)
+	}
+}
+
+func (gm *GroupMembership) notify(systemId string) {
+	gm.send(gm.parentId, systemId)
+	for _, id := range gm.subscribers {
+		gm.send(id, systemId)
+	}
+}
+
+// propose starts the uc instance of the next view when members of the current
+// one crashed or a reconfiguration asked for other members, unless it was
+// already started. A process which left the group does not propose anymore
+func (gm *GroupMembership) propose(systemId string) error {
+	if gm.wait || !utils.ContainsProcess(gm.view.Members, gm.self) {
+		return nil
+	}
+
+	wanted := gm.view.Members
+	if gm.requested != nil {
+		wanted = gm.requested
+	}
+	members := make([]*pb.ProcessId, 0, len(wanted))
+	for _, p := range wanted {
+		if _, ok := gm.crashed[utils.GetProcessKey(p)]; !ok {
+			members = append(members, p)
+		}
+	}
+	if len(members) == len(gm.view.Members) && isSubset(members, gm.view.Members) {
+		gm.requested = nil
+		return nil
+	}
+	if len(members) == 0 {
+		return nil
+	}
+
+	next := gm.view.Id + 1
+	value, err := utils.PayloadValue(&pb.GmView{Id: next, Members: members})
+	if err != nil {
+		return err
+	}
+
+	gm.wait = true
+	gm.msgQueue <- &pb.Message{
+		Type:              pb.Message_UC_PROPOSE,
+		FromAbstractionId: gm.id,
+		ToAbstractionId:   gm.id + ".uc[" + utils.Int32ToString(next) + "]",
+		SystemId:          systemId,
+		UcPropose: &pb.UcPropose{
+			Value: value,
+		},
+	}
+
+	return nil
+}
+
+func isSubset(processes, of []*pb.ProcessId) bool {
+	for _, p := range processes {
+		if !utils.ContainsProcess(of, p) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (gm *GroupMembership) send(to, systemId string) {
+	gm.msgQueue <- &pb.Message{
+		Type:              pb.Message_GM_VIEW,
+		FromAbstractionId: gm.id,
+		ToAbstractionId:   to,
+		SystemId:          systemId,
+		GmView:            gm.view,
+	}
+}
@@ -0,0 +1,253 @@
+package consensus
+
+import (
+	"amcds/pb"
+	"amcds/utils"
+	"errors"
+)
+
+// HierarchicalConsensus is the hierarchical consensus of a fail-stop system
+// (algorithm 5.2). The processes lead one round each, in the order of their
+// ranks. The leader decides its proposal and beb-broadcasts it, the processes
+// after it adopting the proposal of the last leader they heard from. A round
+// ends when its leader was heard from or detected as crashed
+type HierarchicalConsensus struct {
+	id       string
+	parentId string
+	msgQueue chan *pb.Message
+	// position of every process by rank, from 1, and the one of this process
+	ranks map[string]int32
+	rank  int32
+
+	detected    map[int32]bool
+	delivered   map[int32]bool
+	round       int32
+	proposal    *pb.Value
+	proposer    int32
+	broadcasted bool
+}
+
+func CreateHierarchicalConsensus(parentAbstraction, abstractionId string, mQ chan *pb.Message, processes []*pb.ProcessId, self *pb.ProcessId) *HierarchicalConsensus {
+	ranks := utils.RankPositions(processes)
+
+	return &HierarchicalConsensus{
+		id:        abstractionId,
+		parentId:  parentAbstraction,
+		msgQueue:  mQ,
+		ranks:     ranks,
+		rank:      ranks[utils.GetProcessKey(self)],
+		detected:  make(map[int32]bool),
+		delivered: make(map[int32]bool),
+		round:     1,
+		proposal:  &pb.Value{},
+	}
+}
+
+func (hc *HierarchicalConsensus) Handle(m *pb.Message) error {
+	switch m.Type {
+	case pb.Message_UC_PROPOSE:
+		if !hc.proposal.Defined {
+			hc.proposal = m.UcPropose.Value
+		}
+	case pb.Message_PFD_CRASH:
+		hc.detected[hc.ranks[utils.GetProcessKey(m.PfdCrash.Process)]] = true
+	case pb.Message_BEB_DELIVER:
+		d := m.BebDeliver.Message.HierarchicalInternalDecided
+		if d == nil {
+			return errors.New("hierarchical consensus beb deliver message type not supported")
+		}
+		r := hc.ranks[utils.GetProcessKey(m.BebDeliver.Sender)]
+		if r < hc.rank && r > hc.proposer {
+			hc.proposal = d.Value
+			hc.proposer = r
+		}
+		hc.delivered[r] = true
+	default:
+		return errors.New("hierarchical consensus message type not supported")
+	}
+
+	for hc.detected[hc.round] || hc.delivered[hc.round] {
+		hc.round++
+	}
+
+	if hc.round == hc.rank && !hc.broadcasted && hc.proposal.Defined {
+		hc.broadcasted = true
+		hc.msgQueue <- &pb.Message{
+			Type:              pb.Message_BEB_BROADCAST,
+			FromAbstractionId: hc.id,
+			ToAbstractionId:   hc.id + ".beb",
+			BebBroadcast: &pb.BebBroadcast{
+				Message: &pb.Message{
+					Type:                        pb.Message_HIERARCHICAL_INTERNAL_DECIDED,
+					FromAbstractionId:           hc.id,
+					ToAbstractionId:             hc.id,
+					HierarchicalInternalDecided: &pb.HierarchicalInternalDecided{Value: hc.proposal},
+				},
+			},
+		}
+		hc.msgQueue <- &pb.Message{
+			Type:              pb.Message_UC_DECIDE,
+			FromAbstractionId: hc.id,
+			ToAbstractionId:   hc.parentId,
+			UcDecide: &pb.UcDecide{
+				Value: hc.proposal,
+			},
+		}
+	}
+
+	return nil
+}
+
+func (hc *HierarchicalConsensus) Destroy() {}
+
+// HierarchicalUniformConsensus is the hierarchical uniform consensus of a
+// fail-stop system (algorithm 5.4). The leader of a round beb-broadcasts its
+// proposal and waits for every process not detected as crashed to acknowledge
+// it before rb-broadcasting the decision, so a process only decides a value
+// all the correct ones adopted. The others move to the next round when the
+// leader is detected as crashed, adopting its proposal if they got it
+type HierarchicalUniformConsensus struct {
+	id       string
+	parentId string
+	msgQueue chan *pb.Message
+	ranks    map[string]int32
+	rank     int32
+
+	detected    map[int32]bool
+	acks        map[int32]bool
+	round       int32
+	proposal    *pb.Value
+	proposed    map[int32]*pb.Value
+	broadcasted bool
+	announced   bool
+	decided     bool
+}
+
+func CreateHierarchicalUniformConsensus(parentAbstraction, abstractionId string, mQ chan *pb.Message, processes []*pb.ProcessId, self *pb.ProcessId) *HierarchicalUniformConsensus {
+	ranks := utils.RankPositions(processes)
+
+	return &HierarchicalUniformConsensus{
+		id:       abstractionId,
+		parentId: parentAbstraction,
+		msgQueue: mQ,
+		ranks:    ranks,
+		rank:     ranks[utils.GetProcessKey(self)],
+		detected: make(map[int32]bool),
+		acks:     make(map[int32]bool),
+		round:    1,
+		proposal: &pb.Value{},
+		proposed: make(map[int32]*pb.Value),
+	}
+}
+
+func (hc *HierarchicalUniformConsensus) Handle(m *pb.Message) error {
+	switch m.Type {
+	case pb.Message_UC_PROPOSE:
+		if !hc.proposal.Defined {
+			hc.proposal = m.UcPropose.Value
+		}
+	case pb.Message_PFD_CRASH:
+		hc.detected[hc.ranks[utils.GetProcessKey(m.PfdCrash.Process)]] = true
+	case pb.Message_BEB_DELIVER:
+		p := m.BebDeliver.Message.HierarchicalInternalProposal
+		if p == nil {
+			return errors.New("hierarchical uniform consensus beb deliver message type not supported")
+		}
+		r := hc.ranks[utils.GetProcessKey(m.BebDeliver.Sender)]
+		hc.proposed[r] = p.Value
+		if r >= hc.round {
+			hc.msgQueue <- &pb.Message{
+				Type:              pb.Message_PL_SEND,
+				FromAbstractionId: hc.id,
+				ToAbstractionId:   hc.id + ".pl",
+				PlSend: &pb.PlSend{
+					Destination: m.BebDeliver.Sender,
+					Message: &pb.Message{
+						Type:                    pb.Message_HIERARCHICAL_INTERNAL_ACK,
+						FromAbstractionId:       hc.id,
+						ToAbstractionId:         hc.id,
+						HierarchicalInternalAck: &pb.HierarchicalInternalAck{},
+					},
+				},
+			}
+		}
+	case pb.Message_PL_DELIVER:
+		if m.PlDeliver.Message.Type != pb.Message_HIERARCHICAL_INTERNAL_ACK {
+			return errors.New("hierarchical uniform consensus pl deliver message type not supported")
+		}
+		hc.acks[hc.ranks[utils.GetProcessKey(m.PlDeliver.Sender)]] = true
+	case pb.Message_RB_DELIVER:
+		d := m.RbDeliver.Message.HierarchicalInternalDecided
+		if d == nil {
+			return errors.New("hierarchical uniform consensus rb deliver message type not supported")
+		}
+		if !hc.decided {
+			hc.decided = true
+			hc.msgQueue <- &pb.Message{
+				Type:              pb.Message_UC_DECIDE,
+				FromAbstractionId: hc.id,
+				ToAbstractionId:   hc.parentId,
+				UcDecide: &pb.UcDecide{
+					Value: d.Value,
+				},
+			}
+		}
+	default:
+		return errors.New("hierarchical uniform consensus message type not supported")
+	}
+
+	for hc.detected[hc.round] {
+		if v, ok := hc.proposed[hc.round]; ok {
+			hc.proposal = v
+		}
+		hc.round++
+	}
+
+	if hc.round == hc.rank && !hc.broadcasted && hc.proposal.Defined && !hc.decided {
+		hc.broadcasted = true
+		hc.msgQueue <- &pb.Message{
+			Type:              pb.Message_BEB_BROADCAST,
+			FromAbstractionId: hc.id,
+			ToAbstractionId:   hc.id + ".beb",
+			BebBroadcast: &pb.BebBroadcast{
+				Message: &pb.Message{
+					Type:                         pb.Message_HIERARCHICAL_INTERNAL_PROPOSAL,
+					FromAbstractionId:            hc.id,
+					ToAbstractionId:              hc.id,
+					HierarchicalInternalProposal: &pb.HierarchicalInternalProposal{Value: hc.proposal},
+				},
+			},
+		}
+	}
+
+	if hc.broadcasted && !hc.announced && hc.allAckedOrDetected() {
+		hc.announced = true
+		hc.msgQueue <- &pb.Message{
+			Type:              pb.Message_RB_BROADCAST,
+			FromAbstractionId: hc.id,
+			ToAbstractionId:   hc.id + ".rb",
+			RbBroadcast: &pb.RbBroadcast{
+				Message: &pb.Message{
+					Type:                        pb.Message_HIERARCHICAL_INTERNAL_DECIDED,
+					FromAbstractionId:           hc.id,
+					ToAbstractionId:             hc.id,
+					HierarchicalInternalDecided: &pb.HierarchicalInternalDecided{Value: hc.proposal},
+				},
+			},
+		}
+	}
+
+	return nil
+}
+
+func (hc *HierarchicalUniformConsensus) Destroy() {}
+
+func (hc *HierarchicalUniformConsensus) allAckedOrDetected() bool {
+	for r := int32(1); r <= int32(len(hc.ranks)); r++ {
+		if !hc.acks[r] && !hc.detected[r] {
+			return false
+		}
+	}
+
+	return true
+}
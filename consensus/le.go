@@ -0,0 +1,82 @@
+package consensus
+
+import (
+	"amcds/pb"
+	"amcds/utils"
+	"errors"
+)
+
+// LeaderElection is the monarchical leader election: relying on the perfect
+// failure detector, the leader is always the max-rank process which was not
+// detected as crashed, so it only changes when the current one crashes
+type LeaderElection struct {
+	id        string
+	parentId  string
+	msgQueue  chan *pb.Message
+	processes []*pb.ProcessId
+	alive     utils.ProcessMap
+	leader    *pb.ProcessId
+}
+
+func CreateLe(parentAbstraction, abstractionId string, mQ chan *pb.Message, processes []*pb.ProcessId) *LeaderElection {
+	le := &LeaderElection{
+		id:        abstractionId,
+		parentId:  parentAbstraction,
+		msgQueue:  mQ,
+		processes: processes,
+		alive:     make(utils.ProcessMap),
+		leader:    nil,
+	}
+
+	for _, p := range processes {
+		le.alive[utils.GetProcessKey(p)] = p
+	}
+
+	// the initial leader is announced right away
+	le.updateLeader()
+
+	return le
+}
+
+func (le *LeaderElection) Handle(m *pb.Message) error {
+	switch m.Type {
+	case pb.Message_LE_START:
+		// the leader was announced when le was created
+		return nil
+	case pb.Message_PFD_CRASH:
+		delete(le.alive, utils.GetProcessKey(m.PfdCrash.Process))
+	default:
+		return errors.New("le message type not supported")
+	}
+
+	return le.updateLeader()
+}
+
+func (le *LeaderElection) Destroy() {}
+
+// Leader returns the current leader, nil once every process crashed
+func (le *LeaderElection) Leader() *pb.ProcessId {
+	return le.leader
+}
+
+func (le *LeaderElection) updateLeader() error {
+	max := utils.GetMaxRank(le.alive)
+
+	if max == nil {
+		return errors.New("every process crashed, no leader left")
+	}
+
+	if le.leader == nil || utils.GetProcessKey(le.leader) != utils.GetProcessKey(max) {
+		le.leader = max
+		le.msgQueue <- &pb.Message{
+			Type:              pb.Message_LE_LEADER,
+			FromAbstractionId: le.id,
+			ToAbstractionId:   le.parentId,
+			LeLeader: &pb.LeLeader{
+				Process: le.leader,
+			},
+		}
+	}
+
+	return nil
+}
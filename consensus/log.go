@@ -0,0 +1,475 @@
+package consensus
+
+import (
+	"amcds/pb"
+	"amcds/utils"
+	"amcds/utils/log"
+	"errors"
+	"sort"
+	"strconv"
+)
+
+// ballot orders the leaders: the epoch timestamp of ec, then the rank of the
+// leader, since two leaders may pick the same timestamp
+type ballot struct {
+	epoch int32
+	rank  int32
+}
+
+func (b ballot) less(other ballot) bool {
+	return b.epoch < other.epoch || (b.epoch == other.epoch && b.rank < other.rank)
+}
+
+// ReplicatedLog decides an unbounded sequence of slots (Multi-Paxos). Unlike
+// one uc instance per decision, the leader keeps its epoch across the slots:
+// it prepares once when ec starts its epoch, learning from a majority the
+// entries they accepted, then decides every value appended with a single
+// accept round trip. ec only runs again when eld trusts another process. The
+// max-rank process leads epoch 0 without preparing, as nothing was accepted
+// before. The values appended on the others are forwarded to the leader and
+// sent again when it changes, the leader leaving out the ones it already has
+type ReplicatedLog struct {
+	id        string
+	parentId  string
+	msgQueue  chan *pb.Message
+	processes []*pb.ProcessId
+	self      *pb.ProcessId
+
+	// leader of the current epoch of ec
+	epoch  int32
+	leader *pb.ProcessId
+	// highest ballot seen, the acceptor ignoring the older ones
+	promised ballot
+	accepted map[int32]*pb.LogSlot
+
+	// committed entries by slot, the ones before next being delivered
+	committed map[int32]*pb.LogEntry
+	next      int32
+	delivered map[string]bool
+
+	// values appended on this process and not committed yet
+	lsn     int
+	pending []*pb.LogEntry
+
+	// leader only: prepare phase, then the slots proposed and their acks
+	preparing bool
+	leading   bool
+	promises  map[string]*pb.LogInternalPromise
+	nextSlot  int32
+	assigned  map[string]bool
+	proposals map[int32]*pb.LogEntry
+	acks      map[int32]utils.ProcessMap
+	// values forwarded before this process leads, proposed once it does
+	waiting []*pb.LogEntry
+}
+
+func CreateLog(parentAbstraction, abstractionId string, mQ chan *pb.Message, processes []*pb.ProcessId, self *pb.ProcessId) *ReplicatedLog {
+	leader := utils.GetMaxRankSlice(processes)
+	lg := &ReplicatedLog{
+		id:        abstractionId,
+		parentId:  parentAbstraction,
+		msgQueue:  mQ,
+		processes: processes,
+		self:      self,
+		leader:    leader,
+		promised:  ballot{epoch: 0, rank: leader.Rank},
+		accepted:  make(map[int32]*pb.LogSlot),
+		committed: make(map[int32]*pb.LogEntry),
+		next:      1,
+		delivered: make(map[string]bool),
+		pending:   make([]*pb.LogEntry, 0),
+		nextSlot:  1,
+		assigned:  make(map[string]bool),
+		proposals: make(map[int32]*pb.LogEntry),
+		acks:      make(map[int32]utils.ProcessMap),
+	}
+	lg.leading = lg.isLeader()
+
+	return lg
+}
+
+func (lg *ReplicatedLog) Handle(m *pb.Message) error {
+	switch m.Type {
+	case pb.Message_LOG_APPEND:
+		lg.lsn++
+		e := &pb.LogEntry{
+			Id:    utils.GetProcessKey(lg.self) + "/" + strconv.Itoa(lg.lsn),
+			Value: m.LogAppend.Value,
+		}
+		lg.pending = append(lg.pending, e)
+		lg.forward(m.SystemId, []*pb.LogEntry{e})
+	case pb.Message_EC_START_EPOCH:
+		lg.startEpoch(m.SystemId, m.EcStartEpoch.NewTimestamp, m.EcStartEpoch.NewLeader)
+	case pb.Message_BEB_DELIVER:
+		inner := m.BebDeliver.Message
+		switch inner.Type {
+		case pb.Message_LOG_INTERNAL_PREPARE:
+			lg.prepare(m.SystemId, m.BebDeliver.Sender, inner.LogInternalPrepare)
+		case pb.Message_LOG_INTERNAL_ACCEPT:
+			lg.accept(m.SystemId, m.BebDeliver.Sender, inner.LogInternalAccept)
+		case pb.Message_LOG_INTERNAL_COMMIT:
+			lg.commit(m.SystemId, inner.LogInternalCommit.Slots)
+		default:
+			return errors.New("log beb deliver message type not supported")
+		}
+	case pb.Message_PL_DELIVER:
+		inner := m.PlDeliver.Message
+		switch inner.Type {
+		case pb.Message_LOG_INTERNAL_FORWARD:
+			lg.propose(m.SystemId, inner.LogInternalForward.Entries)
+		case pb.Message_LOG_INTERNAL_PROMISE:
+			lg.promise(m.SystemId, m.PlDeliver.Sender, inner.LogInternalPromise)
+		case pb.Message_LOG_INTERNAL_ACCEPTED:
+			lg.acknowledge(m.SystemId, m.PlDeliver.Sender, inner.LogInternalAccepted)
+		case pb.Message_LOG_INTERNAL_COMMIT:
+			lg.commit(m.SystemId, inner.LogInternalCommit.Slots)
+		default:
+			return errors.New("log pl deliver message type not supported")
+		}
+	default:
+		return errors.New("log message type not supported")
+	}
+
+	return nil
+}
+
+func (lg *ReplicatedLog) Destroy() {}
+
+// startEpoch follows the leader elected by ec. A new leader prepares its
+// ballot, the others forward it the values they did not see committed
+func (lg *ReplicatedLog) startEpoch(systemId string, ts int32, l *pb.ProcessId) {
+	log.Info("Log %v starts epoch %v led by %v-%v", lg.id, ts, l.Owner, l.Index)
+	lg.epoch = ts
+	lg.leader = l
+	lg.preparing = false
+	lg.leading = false
+	lg.assigned = make(map[string]bool)
+	lg.proposals = make(map[int32]*pb.LogEntry)
+	lg.acks = make(map[int32]utils.ProcessMap)
+
+	if !lg.isLeader() {
+		lg.waiting = nil
+		lg.forward(systemId, lg.pending)
+		return
+	}
+
+	lg.preparing = true
+	lg.promises = make(map[string]*pb.LogInternalPromise)
+	lg.broadcast(systemId, &pb.Message{
+		Type:              pb.Message_LOG_INTERNAL_PREPARE,
+		FromAbstractionId: lg.id,
+		ToAbstractionId:   lg.id,
+		SystemId:          systemId,
+		LogInternalPrepare: &pb.LogInternalPrepare{
+			Epoch:      lg.epoch,
+			LeaderRank: lg.self.Rank,
+			From:       lg.next,
+		},
+	})
+	lg.waiting = append(lg.waiting, lg.pending...)
+}
+
+// prepare promises the ballot unless a newer one was seen, answering with the
+// entries accepted from the slot the leader asks for
+func (lg *ReplicatedLog) prepare(systemId string, sender *pb.ProcessId, p *pb.LogInternalPrepare) {
+	b := ballot{epoch: p.Epoch, rank: p.LeaderRank}
+	if b.less(lg.promised) {
+		return
+	}
+	lg.promised = b
+
+	accepted := make([]*pb.LogSlot, 0)
+	for slot, s := range lg.accepted {
+		if slot >= p.From {
+			accepted = append(accepted, s)
+		}
+	}
+	sort.Slice(accepted, func(i, j int) bool { return accepted[i].Slot < accepted[j].Slot })
+
+	lg.send(systemId, sender, &pb.Message{
+		Type:              pb.Message_LOG_INTERNAL_PROMISE,
+		FromAbstractionId: lg.id,
+		ToAbstractionId:   lg.id,
+		SystemId:          systemId,
+		LogInternalPromise: &pb.LogInternalPromise{
+			Epoch:      p.Epoch,
+			LeaderRank: p.LeaderRank,
+			Next:       lg.next,
+			Accepted:   accepted,
+		},
+	})
+}
+
+// promise sends the process the committed slots it misses and, once a
+// majority promised, proposes again in every slot not committed yet the entry
+// accepted with the highest ballot, a no-op if none was
+func (lg *ReplicatedLog) promise(systemId string, sender *pb.ProcessId, p *pb.LogInternalPromise) {
+	if (!lg.preparing && !lg.leading) || p.Epoch != lg.epoch || p.LeaderRank != lg.self.Rank {
+		return
+	}
+
+	missed := make([]*pb.LogSlot, 0)
+	for slot := p.Next; slot < lg.next; slot++ {
+		missed = append(missed, &pb.LogSlot{Slot: slot, Entry: lg.committed[slot]})
+	}
+	if len(missed) > 0 {
+		lg.send(systemId, sender, &pb.Message{
+			Type:              pb.Message_LOG_INTERNAL_COMMIT,
+			FromAbstractionId: lg.id,
+			ToAbstractionId:   lg.id,
+			SystemId:          systemId,
+			LogInternalCommit: &pb.LogInternalCommit{Slots: missed},
+		})
+	}
+
+	if !lg.preparing {
+		return
+	}
+	lg.promises[utils.GetProcessKey(sender)] = p
+	if len(lg.promises) <= len(lg.processes)/2 {
+		return
+	}
+
+	highest := make(map[int32]*pb.LogSlot)
+	last := lg.next - 1
+	for _, promise := range lg.promises {
+		for _, s := range promise.Accepted {
+			h, ok := highest[s.Slot]
+			if !ok || (ballot{h.Epoch, h.LeaderRank}).less(ballot{s.Epoch, s.LeaderRank}) {
+				highest[s.Slot] = s
+			}
+			if s.Slot > last {
+				last = s.Slot
+			}
+		}
+	}
+
+	lg.preparing = false
+	lg.leading = true
+	lg.promises = nil
+	lg.nextSlot = last + 1
+
+	slots := make([]*pb.LogSlot, 0)
+	for slot := lg.next; slot <= last; slot++ {
+		e := &pb.LogEntry{}
+		if s, ok := highest[slot]; ok {
+			e = s.Entry
+		}
+		if e.GetId() != "" {
+			lg.assigned[e.Id] = true
+		}
+		slots = append(slots, lg.proposal(slot, e))
+	}
+	log.Info("Log %v leads epoch %v from slot %v, proposing %v slots again", lg.id, lg.epoch, lg.next, len(slots))
+	lg.sendAccept(systemId, slots)
+
+	waiting := lg.waiting
+	lg.waiting = nil
+	lg.propose(systemId, waiting)
+}
+
+// propose gives the next slots to the values the leader did not propose yet,
+// or keeps them until it leads
+func (lg *ReplicatedLog) propose(systemId string, entries []*pb.LogEntry) {
+	if !lg.leading {
+		lg.waiting = append(lg.waiting, entries...)
+		return
+	}
+
+	slots := make([]*pb.LogSlot, 0, len(entries))
+	for _, e := range entries {
+		if lg.assigned[e.GetId()] || lg.delivered[e.GetId()] {
+			continue
+		}
+		lg.assigned[e.Id] = true
+		slots = append(slots, lg.proposal(lg.nextSlot, e))
+		lg.nextSlot++
+	}
+	lg.sendAccept(systemId, slots)
+}
+
+func (lg *ReplicatedLog) proposal(slot int32, e *pb.LogEntry) *pb.LogSlot {
+	lg.proposals[slot] = e
+	lg.acks[slot] = make(utils.ProcessMap)
+
+	return &pb.LogSlot{Slot: slot, Entry: e}
+}
+
+func (lg *ReplicatedLog) sendAccept(systemId string, slots []*pb.LogSlot) {
+	if len(slots) == 0 {
+		return
+	}
+
+	lg.broadcast(systemId, &pb.Message{
+		Type:              pb.Message_LOG_INTERNAL_ACCEPT,
+		FromAbstractionId: lg.id,
+		ToAbstractionId:   lg.id,
+		SystemId:          systemId,
+		LogInternalAccept: &pb.LogInternalAccept{
+			Epoch:      lg.epoch,
+			LeaderRank: lg.self.Rank,
+			Slots:      slots,
+		},
+	})
+}
+
+// accept takes the entries of the ballot unless a newer one was seen,
+// notifying the parent of the values appended to the local log
+func (lg *ReplicatedLog) accept(systemId string, sender *pb.ProcessId, a *pb.LogInternalAccept) {
+	b := ballot{epoch: a.Epoch, rank: a.LeaderRank}
+	if b.less(lg.promised) {
+		return
+	}
+	lg.promised = b
+
+	slots := make([]int32, 0, len(a.Slots))
+	for _, s := range a.Slots {
+		previous, ok := lg.accepted[s.Slot]
+		lg.accepted[s.Slot] = &pb.LogSlot{Slot: s.Slot, Entry: s.Entry, Epoch: a.Epoch, LeaderRank: a.LeaderRank}
+		slots = append(slots, s.Slot)
+
+		if s.Entry.GetId() != "" && (!ok || previous.Entry.GetId() != s.Entry.GetId()) {
+			lg.msgQueue <- &pb.Message{
+				Type:              pb.Message_LOG_APPENDED,
+				FromAbstractionId: lg.id,
+				ToAbstractionId:   lg.parentId,
+				SystemId:          systemId,
+				LogAppended: &pb.LogAppended{
+					Slot:  s.Slot,
+					Value: s.Entry.Value,
+				},
+			}
+		}
+	}
+
+	lg.send(systemId, sender, &pb.Message{
+		Type:              pb.Message_LOG_INTERNAL_ACCEPTED,
+		FromAbstractionId: lg.id,
+		ToAbstractionId:   lg.id,
+		SystemId:          systemId,
+		LogInternalAccepted: &pb.LogInternalAccepted{
+			Epoch:      a.Epoch,
+			LeaderRank: a.LeaderRank,
+			Slots:      slots,
+		},
+	})
+}
+
+// acknowledge commits the slots a majority accepted in the ballot of the leader
+func (lg *ReplicatedLog) acknowledge(systemId string, sender *pb.ProcessId, a *pb.LogInternalAccepted) {
+	if !lg.leading || a.Epoch != lg.epoch || a.LeaderRank != lg.self.Rank {
+		return
+	}
+
+	slots := make([]*pb.LogSlot, 0)
+	for _, slot := range a.Slots {
+		acks, ok := lg.acks[slot]
+		if !ok {
+			continue
+		}
+
+		acks[utils.GetProcessKey(sender)] = sender
+		if len(acks) > len(lg.processes)/2 {
+			slots = append(slots, &pb.LogSlot{Slot: slot, Entry: lg.proposals[slot]})
+			delete(lg.acks, slot)
+			delete(lg.proposals, slot)
+		}
+	}
+	if len(slots) == 0 {
+		return
+	}
+
+	lg.broadcast(systemId, &pb.Message{
+		Type:              pb.Message_LOG_INTERNAL_COMMIT,
+		FromAbstractionId: lg.id,
+		ToAbstractionId:   lg.id,
+		SystemId:          systemId,
+		LogInternalCommit: &pb.LogInternalCommit{Slots: slots},
+	})
+}
+
+// commit keeps the decided entries and delivers the slots following the last
+// delivered one, leaving out the no-ops and the values already delivered
+func (lg *ReplicatedLog) commit(systemId string, slots []*pb.LogSlot) {
+	for _, s := range slots {
+		if _, ok := lg.committed[s.Slot]; !ok {
+			lg.committed[s.Slot] = s.Entry
+		}
+	}
+
+	for e, ok := lg.committed[lg.next]; ok; e, ok = lg.committed[lg.next] {
+		slot := lg.next
+		lg.next++
+		if e.GetId() == "" || lg.delivered[e.Id] {
+			continue
+		}
+
+		lg.delivered[e.Id] = true
+		for i, p := range lg.pending {
+			if p.Id == e.Id {
+				lg.pending = append(lg.pending[:i], lg.pending[i+1:]...)
+				break
+			}
+		}
+		lg.msgQueue <- &pb.Message{
+			Type:              pb.Message_LOG_COMMITTED,
+			FromAbstractionId: lg.id,
+			ToAbstractionId:   lg.parentId,
+			SystemId:          systemId,
+			LogCommitted: &pb.LogCommitted{
+				Slot:  slot,
+				Value: e.Value,
+			},
+		}
+	}
+}
+
+// forward hands the values to the leader, which may be this process
+func (lg *ReplicatedLog) forward(systemId string, entries []*pb.LogEntry) {
+	if len(entries) == 0 {
+		return
+	}
+	if lg.isLeader() {
+		lg.propose(systemId, entries)
+		return
+	}
+
+	lg.send(systemId, lg.leader, &pb.Message{
+		Type:              pb.Message_LOG_INTERNAL_FORWARD,
+		FromAbstractionId: lg.id,
+		ToAbstractionId:   lg.id,
+		SystemId:          systemId,
+		LogInternalForward: &pb.LogInternalForward{
+			Entries: entries,
+		},
+	})
+}
+
+func (lg *ReplicatedLog) isLeader() bool {
+	return utils.GetProcessKey(lg.leader) == utils.GetProcessKey(lg.self)
+}
+
+func (lg *ReplicatedLog) broadcast(systemId string, m *pb.Message) {
+	lg.msgQueue <- &pb.Message{
+		Type:              pb.Message_BEB_BROADCAST,
+		FromAbstractionId: lg.id,
+		ToAbstractionId:   lg.id + ".beb",
+		SystemId:          systemId,
+		BebBroadcast: &pb.BebBroadcast{
+			Message: m,
+		},
+	}
+}
+
+func (lg *ReplicatedLog) send(systemId string, to *pb.ProcessId, m *pb.Message) {
+	lg.msgQueue <- &pb.Message{
+		Type:              pb.Message_PL_SEND,
+		FromAbstractionId: lg.id,
+		ToAbstractionId:   lg.id + ".pl",
+		SystemId:          systemId,
+		PlSend: &pb.PlSend{
+			Destination: to,
+			Message:     m,
+		},
+	}
+}
@@ -0,0 +1,108 @@
+package consensus
+
+import (
+	"amcds/pb"
+	"amcds/utils"
+	"errors"
+)
+
+// NonBlockingAtomicCommit beb broadcasts the vote of every process and
+// proposes to uc to commit once all of them voted commit, or to abort as soon
+// as one votes abort or crashes. The processes which did not crash all decide
+// the same, even if the ones which voted crash afterwards
+type NonBlockingAtomicCommit struct {
+	id        string
+	parentId  string
+	msgQueue  chan *pb.Message
+	processes []*pb.ProcessId
+	voted     map[string]bool
+	proposed  bool
+}
+
+func CreateNbac(parentAbstraction, abstractionId string, mQ chan *pb.Message, processes []*pb.ProcessId) *NonBlockingAtomicCommit {
+	return &NonBlockingAtomicCommit{
+		id:        abstractionId,
+		parentId:  parentAbstraction,
+		msgQueue:  mQ,
+		processes: processes,
+		voted:     make(map[string]bool),
+	}
+}
+
+func (nbac *NonBlockingAtomicCommit) Handle(m *pb.Message) error {
+	switch m.Type {
+	case pb.Message_NBAC_PROPOSE:
+		nbac.msgQueue <- &pb.Message{
+			Type:              pb.Message_BEB_BROADCAST,
+			FromAbstractionId: nbac.id,
+			ToAbstractionId:   nbac.id + ".beb",
+			SystemId:          m.SystemId,
+			BebBroadcast: &pb.BebBroadcast{
+				Message: &pb.Message{
+					Type:              pb.Message_NBAC_INTERNAL_VOTE,
+					FromAbstractionId: nbac.id,
+					ToAbstractionId:   nbac.id,
+					SystemId:          m.SystemId,
+					NbacInternalVote: &pb.NbacInternalVote{
+						Commit: m.NbacPropose.Commit,
+					},
+				},
+			},
+		}
+	case pb.Message_BEB_DELIVER:
+		vote := m.BebDeliver.Message.NbacInternalVote
+		if vote == nil {
+			return errors.New("nbac beb deliver message type not supported")
+		}
+
+		if !vote.Commit {
+			nbac.propose(m.SystemId, false)
+			return nil
+		}
+
+		nbac.voted[utils.GetProcessKey(m.BebDeliver.Sender)] = true
+		if len(nbac.voted) == len(nbac.processes) {
+			nbac.propose(m.SystemId, true)
+		}
+	case pb.Message_PFD_CRASH:
+		nbac.propose(m.SystemId, false)
+	case pb.Message_UC_DECIDE:
+		nbac.msgQueue <- &pb.Message{
+			Type:              pb.Message_NBAC_DECIDE,
+			FromAbstractionId: nbac.id,
+			ToAbstractionId:   nbac.parentId,
+			SystemId:          m.SystemId,
+			NbacDecide: &pb.NbacDecide{
+				Commit: m.UcDecide.GetValue().GetV() == 1,
+			},
+		}
+	default:
+		return errors.New("nbac message type not supported")
+	}
+
+	return nil
+}
+
+func (nbac *NonBlockingAtomicCommit) Destroy() {}
+
+// propose hands commit (1) or abort (0) to uc, once
+func (nbac *NonBlockingAtomicCommit) propose(systemId string, commit bool) {
+	if nbac.proposed {
+		return
+	}
+	nbac.proposed = true
+
+	v := int32(0)
+	if commit {
+		v = 1
+	}
+	nbac.msgQueue <- &pb.Message{
+		Type:              pb.Message_UC_PROPOSE,
+		FromAbstractionId: nbac.id,
+		ToAbstractionId:   nbac.id + ".uc",
+		SystemId:          systemId,
+		UcPropose: &pb.UcPropose{
+			Value: &pb.Value{Defined: true, V: v},
+		},
+	}
+}
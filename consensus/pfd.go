@@ -0,0 +1,140 @@
+package consensus
+
+import (
+	"amcds/pb"
+	"amcds/utils"
+	"amcds/utils/clock"
+	"errors"
+	"time"
+)
+
+// PerfectFailureDetector is the exclude on timeout algorithm: a process which
+// did not answer the heartbeats in time is detected as crashed, for good
+type PerfectFailureDetector struct {
+	id        string
+	parentId  string
+	msgQueue  chan *pb.Message
+	processes []*pb.ProcessId
+
+	alive    utils.ProcessMap
+	detected utils.ProcessMap
+	timeout  time.Duration
+	clock    clock.Clock
+	timer    clock.Timer
+}
+
+func CreatePfd(parentAbstraction, abstractionId string, mQ chan *pb.Message, processes []*pb.ProcessId, timeout time.Duration, c clock.Clock) *PerfectFailureDetector {
+	pfd := &PerfectFailureDetector{
+		id:        abstractionId,
+		parentId:  parentAbstraction,
+		msgQueue:  mQ,
+		processes: processes,
+
+		alive:    make(utils.ProcessMap),
+		detected: make(utils.ProcessMap),
+		timeout:  timeout,
+		clock:    c,
+	}
+
+	for _, p := range processes {
+		pfd.alive[utils.GetProcessKey(p)] = p
+	}
+
+	pfd.startTimer()
+
+	return pfd
+}
+
+func (pfd *PerfectFailureDetector) startTimer() {
+	pfd.timer = pfd.clock.AfterFunc(pfd.timeout, func() {
+		pfd.msgQueue <- &pb.Message{
+			Type:              pb.Message_PFD_TIMEOUT,
+			FromAbstractionId: pfd.id,
+			ToAbstractionId:   pfd.id,
+			PfdTimeout:        &pb.PfdTimeout{},
+		}
+	})
+}
+
+func (pfd *PerfectFailureDetector) Handle(m *pb.Message) error {
+	switch m.Type {
+	case pb.Message_PFD_TIMEOUT:
+		pfd.handleTimeout()
+
+	case pb.Message_GM_VIEW:
+		// the processes joining are watched from the next timeout on
+		for _, p := range m.GmView.Members {
+			if !utils.ContainsProcess(pfd.processes, p) {
+				pfd.alive[utils.GetProcessKey(p)] = p
+			}
+		}
+		pfd.processes = m.GmView.Members
+
+	case pb.Message_PL_DELIVER:
+		switch m.PlDeliver.Message.Type {
+		case pb.Message_PFD_INTERNAL_HEARTBEAT_REQUEST:
+			pfd.send(m.PlDeliver.Sender, &pb.Message{
+				Type:                      pb.Message_PFD_INTERNAL_HEARTBEAT_REPLY,
+				FromAbstractionId:         pfd.id,
+				ToAbstractionId:           pfd.id,
+				PfdInternalHeartbeatReply: &pb.PfdInternalHeartbeatReply{},
+			})
+		case pb.Message_PFD_INTERNAL_HEARTBEAT_REPLY:
+			sender := m.PlDeliver.Sender
+			pfd.alive[utils.GetProcessKey(sender)] = sender
+		default:
+			return errors.New("pfd pl deliver message type not supported")
+		}
+	default:
+		return errors.New("pfd message type not supported")
+	}
+
+	return nil
+}
+
+func (pfd *PerfectFailureDetector) handleTimeout() {
+	for _, p := range pfd.processes {
+		key := utils.GetProcessKey(p)
+		_, isAlive := pfd.alive[key]
+		_, isDetected := pfd.detected[key]
+
+		if !isAlive && !isDetected {
+			pfd.detected[key] = p
+
+			pfd.msgQueue <- &pb.Message{
+				Type:              pb.Message_PFD_CRASH,
+				FromAbstractionId: pfd.id,
+				ToAbstractionId:   pfd.parentId,
+				PfdCrash: &pb.PfdCrash{
+					Process: p,
+				},
+			}
+		}
+
+		pfd.send(p, &pb.Message{
+			Type:                        pb.Message_PFD_INTERNAL_HEARTBEAT_REQUEST,
+			FromAbstractionId:           pfd.id,
+			ToAbstractionId:             pfd.id,
+			PfdInternalHeartbeatRequest: &pb.PfdInternalHeartbeatRequest{},
+		})
+	}
+
+	pfd.alive = make(utils.ProcessMap)
+	pfd.startTimer()
+}
+
+func (pfd *PerfectFailureDetector) send(to *pb.ProcessId, m *pb.Message) {
+	pfd.msgQueue <- &pb.Message{
+		Type:              pb.Message_PL_SEND,
+		FromAbstractionId: pfd.id,
+		ToAbstractionId:   pfd.id + ".pl",
+		PlSend: &pb.PlSend{
+			Destination: to,
+			Message:     m,
+		},
+	}
+}
+
+func (pfd *PerfectFailureDetector) Destroy() {
+	pfd.timer.Stop()
+}
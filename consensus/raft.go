@@ -0,0 +1,461 @@
+package consensus
+
+import (
+	"amcds/pb"
+	"amcds/utils"
+	"amcds/utils/clock"
+	"amcds/utils/log"
+	"errors"
+	"math/rand"
+	"time"
+)
+
+// RaftConfig holds the timing of raft
+type RaftConfig struct {
+	// a follower which did not hear from a leader for a random duration between
+	// ElectionTimeout and twice it becomes a candidate
+	ElectionTimeout time.Duration
+	Heartbeat       time.Duration
+	Seed            int64
+}
+
+type raftRole int
+
+const (
+	follower raftRole = iota
+	candidate
+	leader
+)
+
+// Raft is a uniform consensus instance taking the place of Uc: it answers the
+// same UcPropose with a UcDecide. A leader is elected for every term by a
+// majority, a process voting once per term and only for a candidate whose log
+// is at least as up to date as its own. The leader starts its term with a
+// no-op entry, appends the proposals forwarded to it and replicates its log,
+// an entry of its term being committed once a majority stored it. Every
+// process decides the first committed entry with a defined value.
+//
+// The state is kept in memory only, as the processes crash and stop
+type Raft struct {
+	id        string
+	parentId  string
+	msgQueue  chan *pb.Message
+	processes []*pb.ProcessId
+	self      *pb.ProcessId
+	cfg       RaftConfig
+	rng       *rand.Rand
+	clock     clock.Clock
+	timer     clock.Timer
+	// generation of the last timer started, the older ones being ignored
+	timerGen int32
+
+	role        raftRole
+	currentTerm int32
+	votedFor    string
+	votes       map[string]bool
+	leader      *pb.ProcessId
+	// log[0] is a sentinel so that the entries are indexed from 1
+	log         []*pb.RaftEntry
+	commitIndex int32
+	// leader only, by process key
+	nextIndex  map[string]int32
+	matchIndex map[string]int32
+
+	val *pb.Value
+	// leader the proposal was last forwarded to
+	forwardedTo string
+	decided     bool
+}
+
+func CreateRaft(parentAbstraction, abstractionId string, mQ chan *pb.Message, processes []*pb.ProcessId, self *pb.ProcessId, cfg RaftConfig, c clock.Clock) *Raft {
+	r := &Raft{
+		id:        abstractionId,
+		parentId:  parentAbstraction,
+		msgQueue:  mQ,
+		processes: processes,
+		self:      self,
+		cfg:       cfg,
+		rng:       rand.New(rand.NewSource(cfg.Seed + int64(self.Rank))),
+		clock:     c,
+
+		role:       follower,
+		votes:      make(map[string]bool),
+		log:        []*pb.RaftEntry{{Value: &pb.Value{}}},
+		nextIndex:  make(map[string]int32),
+		matchIndex: make(map[string]int32),
+		val:        &pb.Value{},
+	}
+
+	r.startElectionTimer()
+
+	return r
+}
+
+func (r *Raft) Handle(m *pb.Message) error {
+	switch m.Type {
+	case pb.Message_UC_PROPOSE:
+		r.val = m.UcPropose.Value
+		if r.role == leader {
+			r.appendEntry(r.val)
+		} else {
+			r.forward()
+		}
+	case pb.Message_RAFT_TIMEOUT:
+		if m.RaftTimeout.Timer != r.timerGen {
+			return nil
+		}
+		if r.role == leader {
+			r.replicate()
+			r.startHeartbeatTimer()
+		} else {
+			r.startElection()
+		}
+	case pb.Message_PL_DELIVER:
+		return r.handleDeliver(m.PlDeliver.Sender, m.PlDeliver.Message)
+	default:
+		return errors.New("raft message type not supported")
+	}
+
+	return nil
+}
+
+func (r *Raft) handleDeliver(sender *pb.ProcessId, m *pb.Message) error {
+	switch m.Type {
+	case pb.Message_RAFT_INTERNAL_REQUEST_VOTE:
+		rv := m.RaftInternalRequestVote
+		r.observeTerm(rv.Term)
+
+		key := utils.GetProcessKey(sender)
+		lastIndex, lastTerm := r.lastLog()
+		upToDate := rv.LastLogTerm > lastTerm || (rv.LastLogTerm == lastTerm && rv.LastLogIndex >= lastIndex)
+		granted := rv.Term == r.currentTerm && (r.votedFor == "" || r.votedFor == key) && upToDate
+		if granted {
+			r.votedFor = key
+			r.startElectionTimer()
+		}
+
+		r.send(sender, &pb.Message{
+			Type:             pb.Message_RAFT_INTERNAL_VOTE,
+			RaftInternalVote: &pb.RaftInternalVote{Term: r.currentTerm, Granted: granted},
+		})
+
+	case pb.Message_RAFT_INTERNAL_VOTE:
+		v := m.RaftInternalVote
+		r.observeTerm(v.Term)
+
+		if r.role == candidate && v.Term == r.currentTerm && v.Granted {
+			r.votes[utils.GetProcessKey(sender)] = true
+			if len(r.votes) > len(r.processes)/2 {
+				r.becomeLeader()
+			}
+		}
+
+	case pb.Message_RAFT_INTERNAL_APPEND_ENTRIES:
+		r.handleAppendEntries(sender, m.RaftInternalAppendEntries)
+
+	case pb.Message_RAFT_INTERNAL_APPEND_RESULT:
+		res := m.RaftInternalAppendResult
+		r.observeTerm(res.Term)
+		if r.role != leader || res.Term != r.currentTerm {
+			return nil
+		}
+
+		key := utils.GetProcessKey(sender)
+		if res.Success {
+			r.matchIndex[key] = max(r.matchIndex[key], res.MatchIndex)
+			r.nextIndex[key] = r.matchIndex[key] + 1
+			r.advanceCommit()
+		} else {
+			// back off to the end of the log of the follower at most
+			r.nextIndex[key] = max(1, min(r.nextIndex[key]-1, res.MatchIndex+1))
+		}
+		if r.nextIndex[key] <= int32(len(r.log)-1) {
+			r.sendEntries(sender)
+		}
+
+	case pb.Message_RAFT_INTERNAL_FORWARD:
+		// a process which is not the leader anymore drops it, the proposer
+		// forwarding it again to the next leader
+		if r.role == leader {
+			r.appendEntry(m.RaftInternalForward.Value)
+		}
+
+	default:
+		return errors.New("raft pl deliver message type not supported")
+	}
+
+	return nil
+}
+
+func (r *Raft) handleAppendEntries(sender *pb.ProcessId, ae *pb.RaftInternalAppendEntries) {
+	r.observeTerm(ae.Term)
+
+	result := &pb.RaftInternalAppendResult{Term: r.currentTerm}
+	reply := func() {
+		r.send(sender, &pb.Message{
+			Type:                     pb.Message_RAFT_INTERNAL_APPEND_RESULT,
+			RaftInternalAppendResult: result,
+		})
+	}
+
+	if ae.Term < r.currentTerm {
+		result.MatchIndex = int32(len(r.log) - 1)
+		reply()
+		return
+	}
+
+	// the sender is the leader of the current term
+	r.role = follower
+	r.leader = sender
+	r.startElectionTimer()
+	r.forward()
+
+	if ae.PrevLogIndex >= int32(len(r.log)) || r.log[ae.PrevLogIndex].Term != ae.PrevLogTerm {
+		result.MatchIndex = min(ae.PrevLogIndex-1, int32(len(r.log)-1))
+		reply()
+		return
+	}
+
+	for i, e := range ae.Entries {
+		index := ae.PrevLogIndex + 1 + int32(i)
+		if index < int32(len(r.log)) {
+			if r.log[index].Term == e.Term {
+				continue
+			}
+			// the conflicting entries were never committed
+			r.log = r.log[:index]
+		}
+		r.log = append(r.log, e)
+	}
+
+	result.Success = true
+	result.MatchIndex = ae.PrevLogIndex + int32(len(ae.Entries))
+	if ae.LeaderCommit > r.commitIndex {
+		r.commitIndex = max(r.commitIndex, min(ae.LeaderCommit, result.MatchIndex))
+		r.decide()
+	}
+	reply()
+}
+
+// observeTerm moves to a newer term, as a follower which did not vote yet
+func (r *Raft) observeTerm(term int32) {
+	if term <= r.currentTerm {
+		return
+	}
+
+	wasLeader := r.role == leader
+	r.currentTerm = term
+	r.role = follower
+	r.votedFor = ""
+	r.leader = nil
+	if wasLeader {
+		r.startElectionTimer()
+	}
+}
+
+func (r *Raft) startElection() {
+	r.currentTerm++
+	r.role = candidate
+	r.votedFor = utils.GetProcessKey(r.self)
+	r.votes = map[string]bool{r.votedFor: true}
+	r.leader = nil
+	log.Debug("%v starting the election of term %v", r.id, r.currentTerm)
+
+	if len(r.votes) > len(r.processes)/2 {
+		r.becomeLeader()
+		return
+	}
+
+	r.startElectionTimer()
+	lastIndex, lastTerm := r.lastLog()
+	for _, p := range r.others() {
+		r.send(p, &pb.Message{
+			Type: pb.Message_RAFT_INTERNAL_REQUEST_VOTE,
+			RaftInternalRequestVote: &pb.RaftInternalRequestVote{
+				Term:         r.currentTerm,
+				LastLogIndex: lastIndex,
+				LastLogTerm:  lastTerm,
+			},
+		})
+	}
+}
+
+func (r *Raft) becomeLeader() {
+	log.Debug("%v elected leader of term %v", r.id, r.currentTerm)
+	r.role = leader
+	r.leader = r.self
+
+	lastIndex, _ := r.lastLog()
+	for _, p := range r.processes {
+		key := utils.GetProcessKey(p)
+		r.nextIndex[key] = lastIndex + 1
+		r.matchIndex[key] = 0
+	}
+
+	// committing the no-op commits the entries of the previous terms too
+	r.log = append(r.log, &pb.RaftEntry{Term: r.currentTerm, Value: &pb.Value{}})
+	if r.val.Defined && !r.decided {
+		r.log = append(r.log, &pb.RaftEntry{Term: r.currentTerm, Value: r.val})
+	}
+	r.matchIndex[utils.GetProcessKey(r.self)] = int32(len(r.log) - 1)
+	r.forwardedTo = utils.GetProcessKey(r.self)
+
+	r.advanceCommit()
+	r.replicate()
+	r.startHeartbeatTimer()
+}
+
+// appendEntry adds a proposal to the log of the leader, unless it already
+// decided, and replicates it right away
+func (r *Raft) appendEntry(v *pb.Value) {
+	if r.decided || !v.GetDefined() {
+		return
+	}
+
+	r.log = append(r.log, &pb.RaftEntry{Term: r.currentTerm, Value: v})
+	r.matchIndex[utils.GetProcessKey(r.self)] = int32(len(r.log) - 1)
+	r.advanceCommit()
+	r.replicate()
+}
+
+// forward sends the proposal to the leader, once per leader until deciding
+func (r *Raft) forward() {
+	if r.decided || !r.val.Defined || r.leader == nil {
+		return
+	}
+	key := utils.GetProcessKey(r.leader)
+	if key == r.forwardedTo {
+		return
+	}
+
+	r.forwardedTo = key
+	r.send(r.leader, &pb.Message{
+		Type:                pb.Message_RAFT_INTERNAL_FORWARD,
+		RaftInternalForward: &pb.RaftInternalForward{Value: r.val},
+	})
+}
+
+// advanceCommit commits the last entry of the current term stored by a
+// majority, the leader never counting replicas of the older terms
+func (r *Raft) advanceCommit() {
+	for n := int32(len(r.log) - 1); n > r.commitIndex && r.log[n].Term == r.currentTerm; n-- {
+		count := 0
+		for _, p := range r.processes {
+			if r.matchIndex[utils.GetProcessKey(p)] >= n {
+				count++
+			}
+		}
+		if count > len(r.processes)/2 {
+			r.commitIndex = n
+			r.decide()
+			return
+		}
+	}
+}
+
+func (r *Raft) decide() {
+	if r.decided {
+		return
+	}
+
+	for _, e := range r.log[1 : r.commitIndex+1] {
+		if e.Value.GetDefined() {
+			r.decided = true
+			r.msgQueue <- &pb.Message{
+				Type:              pb.Message_UC_DECIDE,
+				FromAbstractionId: r.id,
+				ToAbstractionId:   r.parentId,
+				UcDecide: &pb.UcDecide{
+					Value: e.Value,
+				},
+			}
+			return
+		}
+	}
+}
+
+// replicate sends every follower the entries it is missing, an empty append
+// being the heartbeat
+func (r *Raft) replicate() {
+	for _, p := range r.others() {
+		r.sendEntries(p)
+	}
+}
+
+func (r *Raft) sendEntries(to *pb.ProcessId) {
+	next := r.nextIndex[utils.GetProcessKey(to)]
+	r.send(to, &pb.Message{
+		Type: pb.Message_RAFT_INTERNAL_APPEND_ENTRIES,
+		RaftInternalAppendEntries: &pb.RaftInternalAppendEntries{
+			Term:         r.currentTerm,
+			PrevLogIndex: next - 1,
+			PrevLogTerm:  r.log[next-1].Term,
+			Entries:      append([]*pb.RaftEntry(nil), r.log[next:]...),
+			LeaderCommit: r.commitIndex,
+		},
+	})
+}
+
+func (r *Raft) lastLog() (int32, int32) {
+	last := len(r.log) - 1
+	return int32(last), r.log[last].Term
+}
+
+func (r *Raft) others() []*pb.ProcessId {
+	others := make([]*pb.ProcessId, 0, len(r.processes))
+	for _, p := range r.processes {
+		if utils.GetProcessKey(p) != utils.GetProcessKey(r.self) {
+			others = append(others, p)
+		}
+	}
+
+	return others
+}
+
+// startElectionTimer (re)starts the randomized election timeout, which spreads
+// the candidates so that a split vote is rarely repeated
+func (r *Raft) startElectionTimer() {
+	d := r.cfg.ElectionTimeout + time.Duration(r.rng.Int63n(int64(r.cfg.ElectionTimeout)))
+	r.startTimer(d)
+}
+
+func (r *Raft) startHeartbeatTimer() {
+	r.startTimer(r.cfg.Heartbeat)
+}
+
+func (r *Raft) startTimer(d time.Duration) {
+	if r.timer != nil {
+		r.timer.Stop()
+	}
+
+	r.timerGen++
+	gen := r.timerGen
+	r.timer = r.clock.AfterFunc(d, func() {
+		r.msgQueue <- &pb.Message{
+			Type:              pb.Message_RAFT_TIMEOUT,
+			FromAbstractionId: r.id,
+			ToAbstractionId:   r.id,
+			RaftTimeout:       &pb.RaftTimeout{Timer: gen},
+		}
+	})
+}
+
+func (r *Raft) send(to *pb.ProcessId, m *pb.Message) {
+	m.FromAbstractionId = r.id
+	m.ToAbstractionId = r.id
+	r.msgQueue <- &pb.Message{
+		Type:              pb.Message_PL_SEND,
+		FromAbstractionId: r.id,
+		ToAbstractionId:   r.id + ".pl",
+		PlSend: &pb.PlSend{
+			Destination: to,
+			Message:     m,
+		},
+	}
+}
+
+func (r *Raft) Destroy() {
+	if r.timer != nil {
+		r.timer.Stop()
+	}
+}
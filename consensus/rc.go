@@ -0,0 +1,352 @@
+package consensus
+
+import (
+	"amcds/pb"
+	"amcds/utils"
+	"errors"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// RandomizedConsensus decides one of the proposed values without relying on
+// any timeout, as long as a majority of the processes is correct. Every
+// process rb-broadcasts its proposal, then the processes run the binary
+// instances bc[0], bc[1], ... in turn, instance k deciding whether to take the
+// proposal of the process k modulo N by rank. A process proposes 1 when it
+// delivered that proposal, so an instance deciding 1 had a correct process
+// deliver it and every process eventually does. Once every proposal of a
+// correct process reached all of them, the instances of those processes
+// decide 1, so the processes decide with probability 1
+type RandomizedConsensus struct {
+	id       string
+	parentId string
+	msgQueue chan *pb.Message
+	// processes by rank, the order in which their proposals are considered
+	order []*pb.ProcessId
+
+	proposed  bool
+	started   bool
+	proposals map[string]*pb.Value
+	// current binary instance and the decisions of the ones not handled yet
+	instance  int32
+	decisions map[int32]int32
+	// process whose proposal is decided, possibly not delivered yet
+	chosen  *pb.ProcessId
+	decided bool
+}
+
+func CreateRc(parentAbstraction, abstractionId string, mQ chan *pb.Message, processes []*pb.ProcessId) *RandomizedConsensus {
+	order := append([]*pb.ProcessId(nil), processes...)
+	sort.Slice(order, func(i, j int) bool { return order[i].Rank < order[j].Rank })
+
+	return &RandomizedConsensus{
+		id:        abstractionId,
+		parentId:  parentAbstraction,
+		msgQueue:  mQ,
+		order:     order,
+		proposals: make(map[string]*pb.Value),
+		decisions: make(map[int32]int32),
+	}
+}
+
+func (rc *RandomizedConsensus) Handle(m *pb.Message) error {
+	switch m.Type {
+	case pb.Message_UC_PROPOSE:
+		if rc.proposed {
+			return nil
+		}
+		rc.proposed = true
+
+		rc.msgQueue <- &pb.Message{
+			Type:              pb.Message_RB_BROADCAST,
+			FromAbstractionId: rc.id,
+			ToAbstractionId:   rc.id + ".rb",
+			SystemId:          m.SystemId,
+			RbBroadcast: &pb.RbBroadcast{
+				Message: &pb.Message{
+					Type:              pb.Message_RC_INTERNAL_PROPOSAL,
+					FromAbstractionId: rc.id,
+					ToAbstractionId:   rc.id,
+					SystemId:          m.SystemId,
+					RcInternalProposal: &pb.RcInternalProposal{
+						Value: m.UcPropose.Value,
+					},
+				},
+			},
+		}
+	case pb.Message_RB_DELIVER:
+		if m.RbDeliver.Message.Type != pb.Message_RC_INTERNAL_PROPOSAL {
+			return errors.New("rc rb deliver message type not supported")
+		}
+		rc.proposals[utils.GetProcessKey(m.RbDeliver.Sender)] = m.RbDeliver.Message.RcInternalProposal.Value
+
+		// a process which did not propose takes part too, the binary instances
+		// needing a majority
+		if !rc.started {
+			rc.started = true
+			rc.propose()
+			rc.advance()
+		}
+		rc.decide()
+	case pb.Message_UC_DECIDE:
+		rc.decisions[instanceOf(m.FromAbstractionId)] = m.UcDecide.GetValue().GetV()
+		rc.advance()
+	default:
+		return errors.New("rc message type not supported")
+	}
+
+	return nil
+}
+
+func (rc *RandomizedConsensus) Destroy() {}
+
+// propose starts the current binary instance, voting for the proposal it is
+// about if it was delivered
+func (rc *RandomizedConsensus) propose() {
+	var bit int32
+	if _, ok := rc.proposals[utils.GetProcessKey(rc.candidate())]; ok {
+		bit = 1
+	}
+
+	rc.msgQueue <- &pb.Message{
+		Type:              pb.Message_UC_PROPOSE,
+		FromAbstractionId: rc.id,
+		ToAbstractionId:   rc.id + ".bc[" + utils.Int32ToString(rc.instance) + "]",
+		UcPropose: &pb.UcPropose{
+			Value: utils.Int32Value(bit),
+		},
+	}
+}
+
+// advance handles the decisions of the binary instances in order, the ones of
+// later instances waiting for this process to get there
+func (rc *RandomizedConsensus) advance() {
+	for rc.started && rc.chosen == nil {
+		bit, ok := rc.decisions[rc.instance]
+		if !ok {
+			return
+		}
+		delete(rc.decisions, rc.instance)
+
+		if bit == 1 {
+			rc.chosen = rc.candidate()
+			rc.decide()
+			return
+		}
+		rc.instance++
+		rc.propose()
+	}
+}
+
+// decide once the proposal of the chosen process was delivered
+func (rc *RandomizedConsensus) decide() {
+	if rc.chosen == nil || rc.decided {
+		return
+	}
+	v, ok := rc.proposals[utils.GetProcessKey(rc.chosen)]
+	if !ok {
+		return
+	}
+
+	rc.decided = true
+	rc.msgQueue <- &pb.Message{
+		Type:              pb.Message_UC_DECIDE,
+		FromAbstractionId: rc.id,
+		ToAbstractionId:   rc.parentId,
+		UcDecide: &pb.UcDecide{
+			Value: v,
+		},
+	}
+}
+
+// candidate returns the process whose proposal the current instance is about
+func (rc *RandomizedConsensus) candidate() *pb.ProcessId {
+	return rc.order[int(rc.instance)%len(rc.order)]
+}
+
+// instanceOf returns k from an id such as app.rc[topic].bc[k]
+func instanceOf(id string) int32 {
+	_, after, _ := strings.Cut(id, ".bc[")
+	k, _, _ := strings.Cut(after, "]")
+	n, _ := strconv.Atoi(k)
+
+	return int32(n)
+}
+
+// BinaryConsensus is the randomized binary consensus run by RandomizedConsensus
+// (one of its bc[k] instances), deciding 0 or 1. With N processes of which f
+// may crash, f < N/2, every round has two phases, each waiting for the values
+// of N-f processes over beb. The first one keeps a value proposed by a
+// majority, if any, so that the second one shows at most one value. A process
+// seeing it more than f times decides it, and any N-f processes include one of
+// those, so all of them adopt it for the next round. A process seeing no value
+// takes the output of the coin instead, the common coin making all of them
+// agree with probability 1/2 in every round. The decision is rb-broadcast, the
+// processes deciding when they deliver it
+type BinaryConsensus struct {
+	id        string
+	parentId  string
+	msgQueue  chan *pb.Message
+	processes []*pb.ProcessId
+	coin      Coin
+
+	round    int32
+	phase    int32
+	proposal *pb.Value
+	// values by sender for the phases of the current and later rounds
+	received  map[phaseKey]map[string]*pb.Value
+	announced bool
+	decided   bool
+}
+
+type phaseKey struct {
+	round int32
+	phase int32
+}
+
+func CreateBc(parentAbstraction, abstractionId string, mQ chan *pb.Message, processes []*pb.ProcessId, coin Coin) *BinaryConsensus {
+	return &BinaryConsensus{
+		id:        abstractionId,
+		parentId:  parentAbstraction,
+		msgQueue:  mQ,
+		processes: processes,
+		coin:      coin,
+		received:  make(map[phaseKey]map[string]*pb.Value),
+	}
+}
+
+func (bc *BinaryConsensus) Handle(m *pb.Message) error {
+	switch m.Type {
+	case pb.Message_UC_PROPOSE:
+		if bc.round > 0 || bc.decided {
+			return nil
+		}
+		bc.round, bc.phase = 1, 1
+		bc.proposal = m.UcPropose.Value
+		bc.broadcastPhase()
+		bc.progress()
+	case pb.Message_BEB_DELIVER:
+		if m.BebDeliver.Message.Type != pb.Message_BC_INTERNAL_PHASE {
+			return errors.New("bc beb deliver message type not supported")
+		}
+		p := m.BebDeliver.Message.BcInternalPhase
+		key := phaseKey{p.Round, p.Phase}
+		if bc.decided || p.Round < bc.round || (p.Round == bc.round && p.Phase < bc.phase) {
+			return nil
+		}
+		if _, ok := bc.received[key]; !ok {
+			bc.received[key] = make(map[string]*pb.Value)
+		}
+		bc.received[key][utils.GetProcessKey(m.BebDeliver.Sender)] = p.Value
+		bc.progress()
+	case pb.Message_RB_DELIVER:
+		if m.RbDeliver.Message.Type != pb.Message_BC_INTERNAL_DECIDED {
+			return errors.New("bc rb deliver message type not supported")
+		}
+		if bc.decided {
+			return nil
+		}
+		bc.decided = true
+		bc.received = nil
+
+		bc.msgQueue <- &pb.Message{
+			Type:              pb.Message_UC_DECIDE,
+			FromAbstractionId: bc.id,
+			ToAbstractionId:   bc.parentId,
+			UcDecide: &pb.UcDecide{
+				Value: m.RbDeliver.Message.BcInternalDecided.Value,
+			},
+		}
+	default:
+		return errors.New("bc message type not supported")
+	}
+
+	return nil
+}
+
+func (bc *BinaryConsensus) Destroy() {}
+
+// progress completes the phases for which N-f values arrived
+func (bc *BinaryConsensus) progress() {
+	f := (len(bc.processes) - 1) / 2
+	for !bc.decided && bc.round > 0 {
+		key := phaseKey{bc.round, bc.phase}
+		values := bc.received[key]
+		if len(values) < len(bc.processes)-f {
+			return
+		}
+		delete(bc.received, key)
+
+		counts := make(map[int32]int)
+		for _, v := range values {
+			if v.GetDefined() {
+				counts[v.V]++
+			}
+		}
+
+		if bc.phase == 1 {
+			bc.proposal = &pb.Value{}
+			for b, n := range counts {
+				if n > len(bc.processes)/2 {
+					bc.proposal = utils.Int32Value(b)
+				}
+			}
+			bc.phase = 2
+			bc.broadcastPhase()
+			continue
+		}
+
+		if len(counts) == 0 {
+			bc.proposal = utils.Int32Value(bc.coin.Toss(bc.round))
+		}
+		// the first phase let one value through at most
+		for b, n := range counts {
+			bc.proposal = utils.Int32Value(b)
+			if n > f && !bc.announced {
+				bc.announced = true
+				bc.announce(b)
+			}
+		}
+		bc.round, bc.phase = bc.round+1, 1
+		bc.broadcastPhase()
+	}
+}
+
+func (bc *BinaryConsensus) broadcastPhase() {
+	bc.msgQueue <- &pb.Message{
+		Type:              pb.Message_BEB_BROADCAST,
+		FromAbstractionId: bc.id,
+		ToAbstractionId:   bc.id + ".beb",
+		BebBroadcast: &pb.BebBroadcast{
+			Message: &pb.Message{
+				Type:              pb.Message_BC_INTERNAL_PHASE,
+				FromAbstractionId: bc.id,
+				ToAbstractionId:   bc.id,
+				BcInternalPhase: &pb.BcInternalPhase{
+					Round: bc.round,
+					Phase: bc.phase,
+					Value: bc.proposal,
+				},
+			},
+		},
+	}
+}
+
+func (bc *BinaryConsensus) announce(b int32) {
+	bc.msgQueue <- &pb.Message{
+		Type:              pb.Message_RB_BROADCAST,
+		FromAbstractionId: bc.id,
+		ToAbstractionId:   bc.id + ".rb",
+		RbBroadcast: &pb.RbBroadcast{
+			Message: &pb.Message{
+				Type:              pb.Message_BC_INTERNAL_DECIDED,
+				FromAbstractionId: bc.id,
+				ToAbstractionId:   bc.id,
+				BcInternalDecided: &pb.BcInternalDecided{
+					Value: utils.Int32Value(b),
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,190 @@
+package consensus
+
+import (
+	"amcds/pb"
+	"amcds/utils"
+	"errors"
+	"strconv"
+)
+
+// TotalOrderBroadcast rb broadcasts the messages, then agrees with the other
+// processes on the batch of messages to deliver next, running one uc instance
+// per round. A process proposes the messages it got and did not deliver yet,
+// all of them deliver the decided batch in the order of the proposal
+type TotalOrderBroadcast struct {
+	id       string
+	parentId string
+	msgQueue chan *pb.Message
+	self     *pb.ProcessId
+	lsn      int
+	// messages delivered, or waiting to be ordered, by id
+	delivered map[string]bool
+	unordered []*pb.TobEntry
+	// current round and whether its uc instance got a proposal
+	round int32
+	wait  bool
+	// batches decided in rounds not reached yet
+	decided map[int32][]*pb.TobEntry
+	sn      int32
+}
+
+func CreateTob(parentAbstraction, abstractionId string, mQ chan *pb.Message, self *pb.ProcessId) *TotalOrderBroadcast {
+	return &TotalOrderBroadcast{
+		id:        abstractionId,
+		parentId:  parentAbstraction,
+		msgQueue:  mQ,
+		self:      self,
+		delivered: make(map[string]bool),
+		unordered: make([]*pb.TobEntry, 0),
+		round:     1,
+		decided:   make(map[int32][]*pb.TobEntry),
+	}
+}
+
+func (tob *TotalOrderBroadcast) Handle(m *pb.Message) error {
+	switch m.Type {
+	case pb.Message_TOB_BROADCAST:
+		tob.lsn++
+		tob.msgQueue <- &pb.Message{
+			Type:              pb.Message_RB_BROADCAST,
+			FromAbstractionId: tob.id,
+			ToAbstractionId:   tob.id + ".rb",
+			SystemId:          m.SystemId,
+			RbBroadcast: &pb.RbBroadcast{
+				Message: &pb.Message{
+					Type:              pb.Message_TOB_INTERNAL_DATA,
+					FromAbstractionId: tob.id,
+					ToAbstractionId:   tob.id,
+					SystemId:          m.SystemId,
+					TobInternalData: &pb.TobInternalData{
+						MessageId: utils.GetProcessKey(tob.self) + "/" + strconv.Itoa(tob.lsn),
+						Message:   m.TobBroadcast.Message,
+					},
+				},
+			},
+		}
+	case pb.Message_RB_DELIVER:
+		data := m.RbDeliver.Message.TobInternalData
+		if data == nil {
+			return errors.New("tob rb deliver message type not supported")
+		}
+
+		if !tob.delivered[data.MessageId] && !tob.isUnordered(data.MessageId) {
+			tob.unordered = append(tob.unordered, &pb.TobEntry{
+				MessageId: data.MessageId,
+				Sender:    m.RbDeliver.Sender,
+				Message:   data.Message,
+			})
+		}
+	case pb.Message_UC_DECIDE:
+		round, err := strconv.Atoi(utils.GetRegisterId(m.FromAbstractionId))
+		if err != nil {
+			return errors.New("tob decision from unknown uc instance " + m.FromAbstractionId)
+		}
+
+		batch, err := batchOf(m.UcDecide.Value)
+		if err != nil {
+			return err
+		}
+
+		tob.decided[int32(round)] = batch
+		tob.deliverDecided(m.SystemId)
+	default:
+		return errors.New("tob message type not supported")
+	}
+
+	return tob.propose(m.SystemId)
+}
+
+func (tob *TotalOrderBroadcast) Destroy() {}
+
+// deliverDecided delivers the batches of the rounds decided so far, in order
+func (tob *TotalOrderBroadcast) deliverDecided(systemId string) {
+	for batch, ok := tob.decided[tob.round]; ok; batch, ok = tob.decided[tob.round] {
+		for _, e := range batch {
+			if tob.delivered[e.MessageId] {
+				continue
+			}
+
+			tob.delivered[e.MessageId] = true
+			tob.sn++
+			tob.msgQueue <- &pb.Message{
+				Type:              pb.Message_TOB_DELIVER,
+				FromAbstractionId: tob.id,
+				ToAbstractionId:   tob.parentId,
+				SystemId:          systemId,
+				TobDeliver: &pb.TobDeliver{
+					Sender:         e.Sender,
+					Message:        e.Message,
+					SequenceNumber: tob.sn,
+				},
+			}
+		}
+
+		unordered := make([]*pb.TobEntry, 0, len(tob.unordered))
+		for _, e := range tob.unordered {
+			if !tob.delivered[e.MessageId] {
+				unordered = append(unordered, e)
+			}
+		}
+		tob.unordered = unordered
+
+		delete(tob.decided, tob.round)
+		tob.round++
+		tob.wait = false
+	}
+}
+
+// propose starts the uc instance of the current round with the messages which
+// are not ordered yet, unless it was already started
+func (tob *TotalOrderBroadcast) propose(systemId string) error {
+	if tob.wait || len(tob.unordered) == 0 {
+		return nil
+	}
+
+	batch := make([]*pb.TobEntry, len(tob.unordered))
+	copy(batch, tob.unordered)
+	value, err := batchValue(batch)
+	if err != nil {
+		return err
+	}
+
+	tob.wait = true
+	tob.msgQueue <- &pb.Message{
+		Type:              pb.Message_UC_PROPOSE,
+		FromAbstractionId: tob.id,
+		ToAbstractionId:   tob.id + ".uc[" + utils.Int32ToString(tob.round) + "]",
+		SystemId:          systemId,
+		UcPropose: &pb.UcPropose{
+			Value: value,
+		},
+	}
+
+	return nil
+}
+
+// batchValue makes the value proposed to uc out of a batch, carried as a
+// TobBatch
+func batchValue(batch []*pb.TobEntry) (*pb.Value, error) {
+	return utils.PayloadValue(&pb.TobBatch{Entries: batch})
+}
+
+// batchOf reads the batch of a value decided by uc
+func batchOf(v *pb.Value) ([]*pb.TobEntry, error) {
+	batch := &pb.TobBatch{}
+	if err := utils.ReadPayload(v, batch); err != nil {
+		return nil, err
+	}
+
+	return batch.Entries, nil
+}
+
+func (tob *TotalOrderBroadcast) isUnordered(messageId string) bool {
+	for _, e := range tob.unordered {
+		if e.MessageId == messageId {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,135 @@
+package consensus
+
+import (
+	"amcds/pb"
+	"amcds/utils"
+	"errors"
+)
+
+// TerminatingReliableBroadcast makes every correct process deliver either the
+// message of a designated sender or the indication that it failed, the same
+// everywhere. A process proposes to uc whichever it learns first: the message
+// beb delivered by the sender or its crash, detected by pfd
+type TerminatingReliableBroadcast struct {
+	id       string
+	parentId string
+	msgQueue chan *pb.Message
+	self     *pb.ProcessId
+	// learned from TrbBroadcast or from its message, whichever comes first
+	sender   *pb.ProcessId
+	crashed  map[string]bool
+	proposal *pb.Value
+}
+
+func CreateTrb(parentAbstraction, abstractionId string, mQ chan *pb.Message, self *pb.ProcessId) *TerminatingReliableBroadcast {
+	return &TerminatingReliableBroadcast{
+		id:       abstractionId,
+		parentId: parentAbstraction,
+		msgQueue: mQ,
+		self:     self,
+		crashed:  make(map[string]bool),
+	}
+}
+
+func (trb *TerminatingReliableBroadcast) Handle(m *pb.Message) error {
+	switch m.Type {
+	case pb.Message_TRB_BROADCAST:
+		trb.sender = m.TrbBroadcast.Sender
+		if utils.GetProcessKey(trb.sender) == utils.GetProcessKey(trb.self) {
+			trb.msgQueue <- &pb.Message{
+				Type:              pb.Message_BEB_BROADCAST,
+				FromAbstractionId: trb.id,
+				ToAbstractionId:   trb.id + ".beb",
+				SystemId:          m.SystemId,
+				BebBroadcast: &pb.BebBroadcast{
+					Message: &pb.Message{
+						Type:              pb.Message_TRB_INTERNAL_DATA,
+						FromAbstractionId: trb.id,
+						ToAbstractionId:   trb.id,
+						SystemId:          m.SystemId,
+						TrbInternalData: &pb.TrbInternalData{
+							Message: m.TrbBroadcast.Message,
+						},
+					},
+				},
+			}
+		}
+
+		if trb.crashed[utils.GetProcessKey(trb.sender)] {
+			return trb.propose(m.SystemId, nil)
+		}
+	case pb.Message_BEB_DELIVER:
+		data := m.BebDeliver.Message.TrbInternalData
+		if data == nil {
+			return errors.New("trb beb deliver message type not supported")
+		}
+
+		if trb.sender == nil {
+			trb.sender = m.BebDeliver.Sender
+		} else if utils.GetProcessKey(trb.sender) != utils.GetProcessKey(m.BebDeliver.Sender) {
+			return errors.New("trb message from " + utils.GetProcessKey(m.BebDeliver.Sender) + " which is not the sender")
+		}
+
+		return trb.propose(m.SystemId, data.Message)
+	case pb.Message_PFD_CRASH:
+		key := utils.GetProcessKey(m.PfdCrash.Process)
+		trb.crashed[key] = true
+
+		if trb.sender != nil && key == utils.GetProcessKey(trb.sender) {
+			return trb.propose(m.SystemId, nil)
+		}
+	case pb.Message_UC_DECIDE:
+		proposal := &pb.TrbProposal{}
+		if err := utils.ReadPayload(m.UcDecide.Value, proposal); err != nil {
+			return err
+		}
+		deliver := &pb.TrbDeliver{
+			Sender:  proposal.Sender,
+			Message: proposal.Message,
+			Failed:  proposal.Failed,
+		}
+
+		trb.msgQueue <- &pb.Message{
+			Type:              pb.Message_TRB_DELIVER,
+			FromAbstractionId: trb.id,
+			ToAbstractionId:   trb.parentId,
+			SystemId:          m.SystemId,
+			TrbDeliver:        deliver,
+		}
+	default:
+		return errors.New("trb message type not supported")
+	}
+
+	return nil
+}
+
+func (trb *TerminatingReliableBroadcast) Destroy() {}
+
+// propose hands the message of the sender to uc, or its failure when there is
+// no message, unless something was proposed already
+func (trb *TerminatingReliableBroadcast) propose(systemId string, message *pb.Message) error {
+	if trb.proposal != nil {
+		return nil
+	}
+	proposal, err := utils.PayloadValue(&pb.TrbProposal{
+		Sender:  trb.sender,
+		Message: message,
+		Failed:  message == nil,
+	})
+	if err != nil {
+		return err
+	}
+	trb.proposal = proposal
+
+	trb.msgQueue <- &pb.Message{
+		Type:              pb.Message_UC_PROPOSE,
+		FromAbstractionId: trb.id,
+		ToAbstractionId:   trb.id + ".uc",
+		SystemId:          systemId,
+		UcPropose: &pb.UcPropose{
+			Value: trb.proposal,
+		},
+	}
+
+	return nil
+}
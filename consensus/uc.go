@@ -7,10 +7,13 @@ import (
 	"amcds/utils"
 	"amcds/utils/abstraction"
 	"errors"
+	"strconv"
+	"strings"
 )
 
 type Uc struct {
 	id           string
+	parentId     string
 	msgQueue     chan *pb.Message
 	abstractions abstraction.Registry
 	processes    []*pb.ProcessId
@@ -24,11 +27,14 @@ type Uc struct {
 	l        *pb.ProcessId
 	newTs    int32
 	newL     *pb.ProcessId
+	// messages for epochs which were not started yet
+	deferred []*pb.Message
 }
 
-func CreateUc(id string, mQ chan *pb.Message, abstractions abstraction.Registry, processes []*pb.ProcessId, ownProcess *pb.ProcessId, pl *pl.PerfectLink) *Uc {
+func CreateUc(parentAbstraction, id string, mQ chan *pb.Message, abstractions abstraction.Registry, processes []*pb.ProcessId, ownProcess *pb.ProcessId, pl *pl.PerfectLink) *Uc {
 	uc := &Uc{
 		id:           id,
+		parentId:     parentAbstraction,
 		msgQueue:     mQ,
 		abstractions: abstractions,
 		processes:    processes,
@@ -80,7 +86,7 @@ func (uc *Uc) Handle(m *pb.Message) error {
 				uc.msgQueue <- &pb.Message{
 					Type:              pb.Message_UC_DECIDE,
 					FromAbstractionId: uc.id,
-					ToAbstractionId:   "app",
+					ToAbstractionId:   uc.parentId,
 					UcDecide: &pb.UcDecide{
 						Value: m.EpDecide.Value,
 					},
@@ -95,12 +101,39 @@ func (uc *Uc) Handle(m *pb.Message) error {
 }
 func (uc *Uc) Destroy() {}
 
+// Defer keeps a message for an epoch of this instance which was not started
+// yet, as the other processes may start it before this one
+func (uc *Uc) Defer(m *pb.Message) {
+	uc.deferred = append(uc.deferred, m)
+}
+
 func (uc *Uc) addEpAbstractions(state *EpState) {
 	aId := uc.id + uc.getEpId()
 	uc.abstractions[aId] = CreateEp(uc.id, aId, uc.msgQueue, uc.processes, uc.ets, state)
 	uc.abstractions[aId+".beb"] = broadcast.Create(uc.msgQueue, uc.processes, aId+".beb")
 	uc.abstractions[aId+".pl"] = uc.pl.CreateCopyWithParentId(aId)
 	uc.abstractions[aId+".beb.pl"] = uc.pl.CreateCopyWithParentId(aId + ".beb")
+
+	// replay the messages of this epoch, the ones of older epochs will never be handled
+	deferred := make([]*pb.Message, 0)
+	for _, m := range uc.deferred {
+		ets := epochOf(m.ToAbstractionId)
+		if ets == uc.ets {
+			uc.msgQueue <- m
+		} else if ets > uc.ets {
+			deferred = append(deferred, m)
+		}
+	}
+	uc.deferred = deferred
+}
+
+// epochOf returns ets from an id such as app.uc[topic].ep[ets].pl
+func epochOf(id string) int32 {
+	_, after, _ := strings.Cut(id, ".ep[")
+	ets, _, _ := strings.Cut(after, "]")
+	n, _ := strconv.Atoi(ets)
+
+	return int32(n)
 }
 
 func (uc *Uc) getEpId() string {
@@ -0,0 +1,296 @@
+package consensus
+
+import (
+	"amcds/pb"
+	"amcds/utils"
+	"errors"
+	"strconv"
+)
+
+// ViewSynchronousBroadcast beb broadcasts messages in the current view and
+// moves to the views installed by gm in a way that every process going from a
+// view to the next one delivers the same messages in the view. When gm
+// installs a view, broadcasts are held back, the members exchange the messages
+// they delivered and agree through the uc instance of the current view on the
+// next view and on the messages to deliver before installing it
+type ViewSynchronousBroadcast struct {
+	id       string
+	parentId string
+	msgQueue chan *pb.Message
+	self     *pb.ProcessId
+	lsn      int
+
+	view *pb.VsView
+	// latest view of gm, the next view when it differs from the current one
+	next *pb.GmView
+	// messages delivered in the current view, by id, in delivery order
+	delivered map[string]bool
+	entries   []*pb.TobEntry
+	// flush of the current view: messages delivered by every member which
+	// sent them and whether the uc instance got a proposal
+	flushing bool
+	seen     map[string][]*pb.TobEntry
+	wait     bool
+	// broadcasts held back while flushing and messages of views not installed
+	// yet, both handled once the next view is installed
+	blocked []*pb.Message
+	future  []*pb.Message
+	// views decided for ids not reached yet
+	decided map[int32]*pb.VsProposal
+}
+
+// CreateVs starts in the current view of gm, which a process joining the
+// system got with the state of the others
+func CreateVs(parentAbstraction, abstractionId string, mQ chan *pb.Message, view *pb.GmView, self *pb.ProcessId) *ViewSynchronousBroadcast {
+	return &ViewSynchronousBroadcast{
+		id:        abstractionId,
+		parentId:  parentAbstraction,
+		msgQueue:  mQ,
+		self:      self,
+		view:      &pb.VsView{Id: view.Id, Members: view.Members},
+		next:      view,
+		delivered: make(map[string]bool),
+		seen:      make(map[string][]*pb.TobEntry),
+		decided:   make(map[int32]*pb.VsProposal),
+	}
+}
+
+// View returns the current view
+func (vs *ViewSynchronousBroadcast) View() *pb.VsView {
+	return vs.view
+}
+
+func (vs *ViewSynchronousBroadcast) Handle(m *pb.Message) error {
+	switch m.Type {
+	case pb.Message_VS_BROADCAST:
+		if vs.flushing {
+			vs.blocked = append(vs.blocked, m)
+			break
+		}
+		vs.broadcast(m)
+	case pb.Message_GM_VIEW:
+		if m.GmView.Id > vs.next.Id {
+			vs.next = m.GmView
+		}
+		vs.flush(m.SystemId)
+	case pb.Message_BEB_DELIVER:
+		inner := m.BebDeliver.Message
+		switch inner.Type {
+		case pb.Message_VS_INTERNAL_DATA:
+			data := inner.VsInternalData
+			if data.ViewId > vs.view.Id {
+				vs.future = append(vs.future, m)
+				break
+			}
+			// the messages of the view are only delivered through the
+			// decision once the process sent the ones it delivered
+			if data.ViewId < vs.view.Id || vs.flushing {
+				break
+			}
+
+			vs.deliver(&pb.TobEntry{
+				MessageId: data.MessageId,
+				Sender:    m.BebDeliver.Sender,
+				Message:   data.Message,
+			}, m.SystemId)
+		case pb.Message_VS_INTERNAL_PENDING:
+			pending := inner.VsInternalPending
+			if pending.ViewId > vs.view.Id {
+				vs.future = append(vs.future, m)
+				break
+			}
+			if pending.ViewId == vs.view.Id {
+				vs.seen[utils.GetProcessKey(m.BebDeliver.Sender)] = pending.Delivered
+			}
+		default:
+			return errors.New("vs beb deliver message type not supported")
+		}
+	case pb.Message_UC_DECIDE:
+		id, err := strconv.Atoi(utils.GetRegisterId(m.FromAbstractionId))
+		if err != nil {
+			return errors.New("vs decision from unknown uc instance " + m.FromAbstractionId)
+		}
+
+		proposal := &pb.VsProposal{}
+		if err := utils.ReadPayload(m.UcDecide.Value, proposal); err != nil {
+			return err
+		}
+
+		vs.decided[int32(id)] = proposal
+		vs.installDecided(m.SystemId)
+	default:
+		return errors.New("vs message type not supported")
+	}
+
+	return vs.propose(m.SystemId)
+}
+
+func (vs *ViewSynchronousBroadcast) Destroy() {}
+
+func (vs *ViewSynchronousBroadcast) broadcast(m *pb.Message) {
+	vs.lsn++
+	vs.msgQueue <- &pb.Message{
+		Type:              pb.Message_BEB_BROADCAST,
+		FromAbstractionId: vs.id,
+		ToAbstractionId:   vs.id + ".beb",
+		SystemId:          m.SystemId,
+		BebBroadcast: &pb.BebBroadcast{
+			Message: &pb.Message{
+				Type:              pb.Message_VS_INTERNAL_DATA,
+				FromAbstractionId: vs.id,
+				ToAbstractionId:   vs.id,
+				SystemId:          m.SystemId,
+				VsInternalData: &pb.VsInternalData{
+					ViewId:    vs.view.Id,
+					MessageId: utils.GetProcessKey(vs.self) + "/" + strconv.Itoa(vs.lsn),
+					Message:   m.VsBroadcast.Message,
+				},
+			},
+		},
+	}
+}
+
+func (vs *ViewSynchronousBroadcast) deliver(e *pb.TobEntry, systemId string) {
+	if vs.delivered[e.MessageId] {
+		return
+	}
+
+	vs.delivered[e.MessageId] = true
+	vs.entries = append(vs.entries, e)
+	vs.msgQueue <- &pb.Message{
+		Type:              pb.Message_VS_DELIVER,
+		FromAbstractionId: vs.id,
+		ToAbstractionId:   vs.parentId,
+		SystemId:          systemId,
+		VsDeliver: &pb.VsDeliver{
+			Sender:  e.Sender,
+			Message: e.Message,
+			ViewId:  vs.view.Id,
+		},
+	}
+}
+
+// flush starts moving to the latest view of gm, sending the messages
+// delivered in the current view to its members
+func (vs *ViewSynchronousBroadcast) flush(systemId string) {
+	if vs.flushing || vs.next.Id == vs.view.Id {
+		return
+	}
+	vs.flushing = true
+
+	delivered := make([]*pb.TobEntry, len(vs.entries))
+	copy(delivered, vs.entries)
+	vs.msgQueue <- &pb.Message{
+		Type:              pb.Message_BEB_BROADCAST,
+		FromAbstractionId: vs.id,
+		ToAbstractionId:   vs.id + ".beb",
+		SystemId:          systemId,
+		BebBroadcast: &pb.BebBroadcast{
+			Message: &pb.Message{
+				Type:              pb.Message_VS_INTERNAL_PENDING,
+				FromAbstractionId: vs.id,
+				ToAbstractionId:   vs.id,
+				SystemId:          systemId,
+				VsInternalPending: &pb.VsInternalPending{
+					ViewId:    vs.view.Id,
+					Delivered: delivered,
+				},
+			},
+		},
+	}
+}
+
+// propose starts the uc instance of the current view once every member of the
+// latest view of gm sent the messages it delivered, proposing that view with
+// all of them. A member crashing meanwhile is left out by a later view of gm.
+// The members joining delivered nothing in the current view
+func (vs *ViewSynchronousBroadcast) propose(systemId string) error {
+	if !vs.flushing || vs.wait {
+		return nil
+	}
+
+	current := make(map[string]bool)
+	for _, p := range vs.view.Members {
+		current[utils.GetProcessKey(p)] = true
+	}
+
+	batch := make([]*pb.TobEntry, 0)
+	ids := make(map[string]bool)
+	for _, p := range vs.next.Members {
+		if !current[utils.GetProcessKey(p)] {
+			continue
+		}
+		delivered, ok := vs.seen[utils.GetProcessKey(p)]
+		if !ok {
+			return nil
+		}
+
+		for _, e := range delivered {
+			if !ids[e.MessageId] {
+				ids[e.MessageId] = true
+				batch = append(batch, e)
+			}
+		}
+	}
+	value, err := utils.PayloadValue(&pb.VsProposal{
+		View:      &pb.VsView{Id: vs.next.Id, Members: vs.next.Members},
+		Delivered: batch,
+	})
+	if err != nil {
+		return err
+	}
+	vs.wait = true
+
+	vs.msgQueue <- &pb.Message{
+		Type:              pb.Message_UC_PROPOSE,
+		FromAbstractionId: vs.id,
+		ToAbstractionId:   vs.id + ".uc[" + utils.Int32ToString(vs.view.Id) + "]",
+		SystemId:          systemId,
+		UcPropose: &pb.UcPropose{
+			Value: value,
+		},
+	}
+
+	return nil
+}
+
+// installDecided delivers the messages decided for the current view which
+// were not delivered yet, then installs the decided view. It goes on with the
+// views decided for the next ones, if any
+func (vs *ViewSynchronousBroadcast) installDecided(systemId string) {
+	for v, ok := vs.decided[vs.view.Id]; ok; v, ok = vs.decided[vs.view.Id] {
+		delete(vs.decided, vs.view.Id)
+		for _, e := range v.Delivered {
+			vs.deliver(e, systemId)
+		}
+
+		vs.view = v.View
+		vs.delivered = make(map[string]bool)
+		vs.entries = nil
+		vs.flushing = false
+		vs.seen = make(map[string][]*pb.TobEntry)
+		vs.wait = false
+		vs.msgQueue <- &pb.Message{
+			Type:              pb.Message_VS_VIEW,
+			FromAbstractionId: vs.id,
+			ToAbstractionId:   vs.parentId,
+			SystemId:          systemId,
+			VsView:            vs.view,
+		}
+
+		// the decided view may be older than the latest one of gm
+		vs.flush(systemId)
+
+		future := vs.future
+		vs.future = nil
+		for _, m := range future {
+			vs.Handle(m)
+		}
+
+		blocked := vs.blocked
+		vs.blocked = nil
+		for _, m := range blocked {
+			vs.Handle(m)
+		}
+	}
+}
@@ -0,0 +1,1251 @@
+package hub
+
+import (
+	"amcds/lin"
+	"amcds/pb"
+	"amcds/pl"
+	"amcds/utils"
+	"amcds/utils/clock"
+	"amcds/utils/log"
+	"errors"
+	"fmt"
+	"math/rand"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// number of operations every process performs during a register storm
+const StormOps = 4
+
+type systemState struct {
+	id        string
+	processes []*pb.ProcessId
+	settings  map[string]string
+	// processes removed by a reconfiguration, still running the system
+	retired []*pb.ProcessId
+	pl      *pl.PerfectLink
+}
+
+type Hub struct {
+	mu   sync.Mutex
+	host string
+	port int32
+	rng  *rand.Rand
+
+	transport pl.Transport
+	clock     clock.Clock
+
+	processes   []*pb.ProcessId
+	systemCount int
+	system      *systemState
+
+	// remaining storm operations for every register, per process
+	storms map[string]map[string]int
+
+	recorder *lin.Recorder
+	// id of the operation in progress, per process and register
+	pending map[string]int
+
+	// processes which delivered each value, in the current system
+	delivered map[int32]map[string]bool
+	// values delivered by tob, by process and position in the total order
+	order map[string]map[int32]int32
+
+	// messages sent once mu is released, so that a slow process does not hold
+	// up the commands and the messages of the others
+	outbox []outgoing
+}
+
+type outgoing struct {
+	pl *pl.PerfectLink
+	to *pb.ProcessId
+	m  *pb.Message
+}
+
+func Create(host string, port int32, seed int64) *Hub {
+	return &Hub{
+		host:      host,
+		port:      port,
+		rng:       rand.New(rand.NewSource(seed)),
+		transport: pl.TcpTransport{},
+		clock:     clock.Real,
+		processes: make([]*pb.ProcessId, 0),
+		storms:    make(map[string]map[string]int),
+		recorder:  lin.CreateRecorder().CreateWithSource(lin.Hub),
+		pending:   make(map[string]int),
+		delivered: make(map[int32]map[string]bool),
+		order:     make(map[string]map[int32]int32),
+	}
+}
+
+// CreateWithRuntime replaces the network transport and the clock of the hub,
+// the clock being used to timestamp the recorded register operations
+func (h *Hub) CreateWithRuntime(t pl.Transport, c clock.Clock) *Hub {
+	h.transport = t
+	h.clock = c
+	h.recorder.CreateWithClock(c)
+
+	return h
+}
+
+// SetRecorder replaces the in-memory recorder of register operations, usually
+// with one that also saves the history to a file
+func (h *Hub) SetRecorder(r *lin.Recorder) {
+	h.mu.Lock()
+	defer h.unlock()
+
+	h.recorder = r.CreateWithClock(h.clock).CreateWithSource(lin.Hub)
+}
+
+// Recorder returns the history of the register operations triggered by the hub
+func (h *Hub) Recorder() *lin.Recorder {
+	h.mu.Lock()
+	defer h.unlock()
+
+	return h.recorder
+}
+
+// SystemId returns the id of the current system, empty when there is none
+func (h *Hub) SystemId() string {
+	h.mu.Lock()
+	defer h.unlock()
+
+	if h.system == nil {
+		return ""
+	}
+
+	return h.system.id
+}
+
+// Ranks returns the rank of every process of the current system, by name
+func (h *Hub) Ranks() map[string]int32 {
+	h.mu.Lock()
+	defer h.unlock()
+
+	ranks := make(map[string]int32)
+	if h.system == nil {
+		return ranks
+	}
+	for _, p := range h.system.processes {
+		ranks[processName(p)] = p.Rank
+	}
+
+	return ranks
+}
+
+// Handle parses a frame received on the hub listener and reacts to it
+func (h *Hub) Handle(data []byte) {
+	m := &pb.Message{}
+	if err := proto.Unmarshal(data, m); err != nil {
+		log.Warn("Failed to parse incoming message %v", err)
+		return
+	}
+
+	if m.Type != pb.Message_NETWORK_MESSAGE || m.NetworkMessage == nil || m.NetworkMessage.Message == nil {
+		log.Warn("Unexpected message type %v", m.Type)
+		return
+	}
+
+	h.mu.Lock()
+	defer h.unlock()
+
+	nm := m.NetworkMessage
+	inner := nm.Message
+
+	if inner.Type == pb.Message_PROC_REGISTRATION {
+		h.register(nm.SenderHost, nm.SenderListeningPort, inner.ProcRegistration)
+		return
+	}
+
+	sender := h.findProcess(nm.SenderHost, nm.SenderListeningPort)
+	if sender == nil {
+		log.Warn("Message %v from unregistered process %v:%v", inner.Type, nm.SenderHost, nm.SenderListeningPort)
+		return
+	}
+	name := processName(sender)
+
+	// the lines logged for the messages of the reference hub are the same as
+	// its own: "hub: " only on the register returns
+	switch inner.Type {
+	case pb.Message_APP_VALUE:
+		log.Info("%v/%v delivered %v", m.SystemId, name, valueString(inner.AppValue.Value))
+		if h.system != nil && m.SystemId == h.system.id && inner.AppValue.Value != nil {
+			v := inner.AppValue.Value.V
+			if _, ok := h.delivered[v]; !ok {
+				h.delivered[v] = make(map[string]bool)
+			}
+			h.delivered[v][name] = true
+		}
+	case pb.Message_APP_TOB_DELIVER:
+		d := inner.AppTobDeliver
+		log.Info("%v/%v delivered #%v %v from %v", m.SystemId, name, d.SequenceNumber, valueString(d.Value), processName(d.Sender))
+		if h.system != nil && m.SystemId == h.system.id && d.Value != nil {
+			if _, ok := h.order[name]; !ok {
+				h.order[name] = make(map[int32]int32)
+			}
+			h.order[name][d.SequenceNumber] = d.Value.V
+		}
+	case pb.Message_APP_LOG_APPENDED:
+		a := inner.AppLogAppended
+		log.Debug("%v/%v appended %v in slot %v", m.SystemId, name, valueString(a.Value), a.Slot)
+	case pb.Message_APP_LOG_COMMITTED:
+		c := inner.AppLogCommitted
+		log.Info("%v/%v committed %v in slot %v", m.SystemId, name, valueString(c.Value), c.Slot)
+	case pb.Message_APP_TRB_DELIVER:
+		d := inner.AppTrbDeliver
+		if d.Failed {
+			log.Info("%v/%v delivered on %v that %v failed", m.SystemId, name, d.Topic, processName(d.Sender))
+		} else {
+			log.Info("%v/%v delivered on %v %v from %v", m.SystemId, name, d.Topic, valueString(d.Value), processName(d.Sender))
+		}
+	case pb.Message_APP_NBAC_DECIDE:
+		log.Info("%v/%v decided to %v %v", m.SystemId, name, outcomeString(inner.AppNbacDecide.Commit), inner.AppNbacDecide.Topic)
+	case pb.Message_APP_VS_DELIVER:
+		d := inner.AppVsDeliver
+		log.Info("%v/%v delivered %v from %v in view %v", m.SystemId, name, valueString(d.Value), processName(d.Sender), d.ViewId)
+	case pb.Message_APP_VIEW:
+		v := inner.AppView
+		members := make([]string, 0, len(v.Members))
+		for _, p := range v.Members {
+			members = append(members, processName(p))
+		}
+		log.Info("%v/%v installed %v view %v with %v", m.SystemId, name, v.Source, v.Id, strings.Join(members, " "))
+	case pb.Message_APP_COMMIT_OUTCOME:
+		o := inner.AppCommitOutcome
+		if o.Blocked {
+			log.Info("%v/%v is blocked on %v %v after %v", m.SystemId, name, o.Protocol, o.Topic, time.Duration(o.Latency))
+		} else {
+			log.Info("%v/%v decided to %v %v %v after %v", m.SystemId, name, outcomeString(o.Committed), o.Protocol, o.Topic, time.Duration(o.Latency))
+		}
+	case pb.Message_APP_LEADER:
+		log.Info("%v/%v trusts %v as leader", m.SystemId, name, processName(inner.AppLeader.Leader))
+	case pb.Message_APP_DECIDE:
+		log.Info("%v/%v decided %v", m.SystemId, name, valueString(inner.AppDecide.Value))
+	case pb.Message_APP_RC_DECIDE:
+		log.Info("%v/%v decided %v on %v with randomized consensus", m.SystemId, name, valueString(inner.AppRcDecide.Value), inner.AppRcDecide.Topic)
+	case pb.Message_APP_READ_RETURN:
+		if inner.AppReadReturn.Kind == pb.RegisterKind_ONRR {
+			log.Info("hub: %v/%v read regular %v=%v", m.SystemId, name, inner.AppReadReturn.Register, valueString(inner.AppReadReturn.Value))
+			break
+		}
+		log.Info("hub: %v/%v read %v=%v", m.SystemId, name, inner.AppReadReturn.Register, valueString(inner.AppReadReturn.Value))
+		h.recordReturn(sender, inner.AppReadReturn.Register, lin.Read, inner.AppReadReturn.Value)
+		h.continueStorm(inner.AppReadReturn.Register, sender)
+	case pb.Message_APP_WRITE_RETURN:
+		if inner.AppWriteReturn.Kind == pb.RegisterKind_ONRR {
+			log.Info("hub: %v/%v finished writing regular %v", m.SystemId, name, inner.AppWriteReturn.Register)
+			break
+		}
+		if inner.AppWriteReturn.Error != "" {
+			// the write did not happen, so it is left out of the history
+			log.Warn("hub: %v/%v failed to write %v: %v", m.SystemId, name, inner.AppWriteReturn.Register, inner.AppWriteReturn.Error)
+			delete(h.pending, name+"/"+inner.AppWriteReturn.Register)
+			break
+		}
+		log.Info("hub: %v/%v finished writing %v", m.SystemId, name, inner.AppWriteReturn.Register)
+		h.recordReturn(sender, inner.AppWriteReturn.Register, lin.Write, nil)
+		h.continueStorm(inner.AppWriteReturn.Register, sender)
+	default:
+		log.Warn("Unexpected message type %v for system %v", inner.Type, m.SystemId)
+	}
+}
+
+func (h *Hub) register(host string, port int32, r *pb.ProcRegistration) {
+	for _, p := range h.processes {
+		if p.Owner == r.Owner && p.Index == r.Index {
+			p.Host = host
+			p.Port = port
+			log.Info("%v: re-registered on %v:%v", processName(p), host, port)
+			h.reinitialize(p)
+			return
+		}
+	}
+
+	p := &pb.ProcessId{
+		Host:  host,
+		Port:  port,
+		Owner: r.Owner,
+		Index: r.Index,
+	}
+	h.processes = append(h.processes, p)
+	log.Info("%v: listening on %v:%v", processName(p), host, port)
+}
+
+// reinitialize sends the current system again to a member which restarted, for
+// it to recover the state it logged in that system
+func (h *Hub) reinitialize(p *pb.ProcessId) {
+	if h.system == nil {
+		return
+	}
+
+	for _, member := range h.system.processes {
+		if member.Owner == p.Owner && member.Index == p.Index {
+			member.Host = p.Host
+			member.Port = p.Port
+			log.Info("Restarting system %v of process %v ...", h.system.id, processName(member))
+			h.send(member, &pb.Message{
+				Type: pb.Message_PROC_INITIALIZE_SYSTEM,
+				ProcInitializeSystem: &pb.ProcInitializeSystem{
+					Processes: h.system.processes,
+					Settings:  h.system.settings,
+				},
+			})
+		}
+	}
+}
+
+// List renders the registered processes grouped by owner
+func (h *Hub) List() string {
+	h.mu.Lock()
+	defer h.unlock()
+
+	owners := make([]string, 0)
+	byOwner := make(map[string][]*pb.ProcessId)
+	maxPorts := 0
+	for _, p := range h.processes {
+		if _, ok := byOwner[p.Owner]; !ok {
+			owners = append(owners, p.Owner)
+		}
+		byOwner[p.Owner] = append(byOwner[p.Owner], p)
+		if len(byOwner[p.Owner]) > maxPorts {
+			maxPorts = len(byOwner[p.Owner])
+		}
+	}
+
+	for _, ps := range byOwner {
+		sort.Slice(ps, func(i, j int) bool { return ps[i].Index < ps[j].Index })
+	}
+
+	header := []string{"#", "OWNER", "HOST"}
+	for i := 1; i <= maxPorts; i++ {
+		header = append(header, "PORT "+fmt.Sprint(i))
+	}
+	rows := [][]string{header}
+	for i, o := range owners {
+		row := []string{fmt.Sprint(i + 1), o, byOwner[o][0].Host}
+		for j := 0; j < maxPorts; j++ {
+			if j < len(byOwner[o]) {
+				row = append(row, utils.Int32ToString(byOwner[o][j].Port))
+			} else {
+				row = append(row, "")
+			}
+		}
+		rows = append(rows, row)
+	}
+
+	return renderTable(rows)
+}
+
+// System destroys the current system (if any) and initializes a new one made of
+// all the processes of the given owners. The settings (e.g. rb_algorithm=lazy)
+// override the ones of the processes for this system
+func (h *Hub) System(owners []string, settings map[string]string) error {
+	h.mu.Lock()
+	defer h.unlock()
+
+	if len(owners) == 0 {
+		return errors.New("no owners provided")
+	}
+
+	processes := make([]*pb.ProcessId, 0)
+	for _, o := range owners {
+		found := false
+		for _, p := range h.processes {
+			if p.Owner == o {
+				processes = append(processes, proto.Clone(p).(*pb.ProcessId))
+				found = true
+			}
+		}
+		if !found {
+			return fmt.Errorf("owner %v has no registered processes", o)
+		}
+	}
+
+	// every process gets a distinct rank, shared by all the members of the system
+	for i, r := range h.rng.Perm(len(processes)) {
+		processes[i].Rank = int32(r + 1)
+	}
+
+	if h.system != nil {
+		h.destroySystem()
+	}
+
+	h.systemCount++
+	h.system = &systemState{
+		id:        "sys-" + fmt.Sprint(h.systemCount),
+		processes: processes,
+		settings:  settings,
+	}
+	h.system.pl = pl.Create(h.host, h.port, "").CreateWithProps(h.system.id, nil, &h.system.processes).CreateWithTransport(h.transport).CreateCopyWithParentId("hub")
+	h.storms = make(map[string]map[string]int)
+	h.pending = make(map[string]int)
+	h.delivered = make(map[int32]map[string]bool)
+	h.order = make(map[string]map[int32]int32)
+
+	for _, p := range processes {
+		log.Info("Starting system %v of process %v ...", h.system.id, processName(p))
+		h.send(p, &pb.Message{
+			Type: pb.Message_PROC_INITIALIZE_SYSTEM,
+			ProcInitializeSystem: &pb.ProcInitializeSystem{
+				Processes: processes,
+				Settings:  settings,
+			},
+		})
+	}
+
+	return nil
+}
+
+// Destroy sends ProcDestroySystem to all the processes of the current system
+func (h *Hub) Destroy() {
+	h.mu.Lock()
+	defer h.unlock()
+
+	if h.system != nil {
+		h.destroySystem()
+		h.system = nil
+	}
+}
+
+// Reconfigure moves the current system to a configuration without the removed
+// processes and with the added ones, given by name. The processes added get
+// ranks above the ones of the current members. The members of both
+// configurations get ProcReconfigureSystem: the current ones agree on the next
+// configuration and transfer their state to the ones joining
+func (h *Hub) Reconfigure(added, removed []string) error {
+	h.mu.Lock()
+	defer h.unlock()
+
+	if h.system == nil {
+		return errors.New("no system initialized")
+	}
+	if len(added) == 0 && len(removed) == 0 {
+		return errors.New("no processes to add or remove")
+	}
+	leaving := make([]*pb.ProcessId, 0, len(removed))
+	if len(removed) > 0 {
+		var err error
+		if leaving, err = h.resolve(removed); err != nil {
+			return err
+		}
+	}
+
+	previous := h.system.processes
+	next := make([]*pb.ProcessId, 0, len(previous)+len(added))
+	rank := int32(0)
+	for _, p := range previous {
+		if p.Rank > rank {
+			rank = p.Rank
+		}
+		left := false
+		for _, l := range leaving {
+			left = left || l == p
+		}
+		if !left {
+			next = append(next, p)
+		}
+	}
+
+	for _, n := range added {
+		var joining *pb.ProcessId
+		for _, p := range h.processes {
+			if processName(p) == n {
+				joining = p
+			}
+		}
+		if joining == nil {
+			return fmt.Errorf("process %v is not registered", n)
+		}
+		for _, p := range next {
+			if processName(p) == n {
+				return fmt.Errorf("process %v is already part of %v", n, h.system.id)
+			}
+		}
+
+		rank++
+		joining = proto.Clone(joining).(*pb.ProcessId)
+		joining.Rank = rank
+		next = append(next, joining)
+	}
+	if len(next) == 0 {
+		return errors.New("no process would be left in the system")
+	}
+
+	h.system.processes = next
+	h.system.retired = append(h.system.retired, leaving...)
+
+	targets := append(append([]*pb.ProcessId{}, previous...), next[len(next)-len(added):]...)
+	for _, p := range targets {
+		log.Info("Reconfiguring system %v of process %v ...", h.system.id, processName(p))
+		h.send(p, &pb.Message{
+			Type: pb.Message_PROC_RECONFIGURE_SYSTEM,
+			ProcReconfigureSystem: &pb.ProcReconfigureSystem{
+				Processes: next,
+				Previous:  previous,
+				Settings:  h.system.settings,
+			},
+		})
+	}
+
+	return nil
+}
+
+func (h *Hub) destroySystem() {
+	for _, p := range append(append([]*pb.ProcessId{}, h.system.processes...), h.system.retired...) {
+		h.send(p, &pb.Message{
+			Type:              pb.Message_PROC_DESTROY_SYSTEM,
+			ProcDestroySystem: &pb.ProcDestroySystem{},
+		})
+	}
+}
+
+// Broadcast asks the given process to best-effort broadcast the value
+func (h *Hub) Broadcast(name string, v int32) error {
+	return h.broadcast(name, &pb.Message{
+		Type: pb.Message_APP_BROADCAST,
+		AppBroadcast: &pb.AppBroadcast{
+			Value: &pb.Value{Defined: true, V: v},
+		},
+	})
+}
+
+// ReliableBroadcast asks the given process to reliably broadcast the value
+func (h *Hub) ReliableBroadcast(name string, v int32) error {
+	return h.broadcast(name, &pb.Message{
+		Type: pb.Message_APP_RELIABLE_BROADCAST,
+		AppReliableBroadcast: &pb.AppReliableBroadcast{
+			Value: &pb.Value{Defined: true, V: v},
+		},
+	})
+}
+
+// UniformBroadcast asks the given process to uniformly reliably broadcast the value
+func (h *Hub) UniformBroadcast(name string, v int32) error {
+	return h.broadcast(name, &pb.Message{
+		Type: pb.Message_APP_UNIFORM_BROADCAST,
+		AppUniformBroadcast: &pb.AppUniformBroadcast{
+			Value: &pb.Value{Defined: true, V: v},
+		},
+	})
+}
+
+// FifoBroadcast asks the given process to broadcast the value in fifo order
+func (h *Hub) FifoBroadcast(name string, v int32) error {
+	return h.broadcast(name, &pb.Message{
+		Type: pb.Message_APP_FIFO_BROADCAST,
+		AppFifoBroadcast: &pb.AppFifoBroadcast{
+			Value: &pb.Value{Defined: true, V: v},
+		},
+	})
+}
+
+// CausalBroadcast asks the given process to broadcast the value in causal order
+func (h *Hub) CausalBroadcast(name string, v int32) error {
+	return h.broadcast(name, &pb.Message{
+		Type: pb.Message_APP_CAUSAL_BROADCAST,
+		AppCausalBroadcast: &pb.AppCausalBroadcast{
+			Value: &pb.Value{Defined: true, V: v},
+		},
+	})
+}
+
+// ProbabilisticBroadcast asks the given process to gossip the value
+func (h *Hub) ProbabilisticBroadcast(name string, v int32) error {
+	return h.broadcast(name, &pb.Message{
+		Type: pb.Message_APP_PROBABILISTIC_BROADCAST,
+		AppProbabilisticBroadcast: &pb.AppProbabilisticBroadcast{
+			Value: &pb.Value{Defined: true, V: v},
+		},
+	})
+}
+
+// BcbBroadcast asks the given process to broadcast the value with byzantine
+// consistent broadcast
+func (h *Hub) BcbBroadcast(name string, v int32) error {
+	return h.broadcast(name, &pb.Message{
+		Type: pb.Message_APP_BCB_BROADCAST,
+		AppBcbBroadcast: &pb.AppBcbBroadcast{
+			Value: &pb.Value{Defined: true, V: v},
+		},
+	})
+}
+
+// BrbBroadcast asks the given process to broadcast the value with byzantine
+// reliable broadcast
+func (h *Hub) BrbBroadcast(name string, v int32) error {
+	return h.broadcast(name, &pb.Message{
+		Type: pb.Message_APP_BRB_BROADCAST,
+		AppBrbBroadcast: &pb.AppBrbBroadcast{
+			Value: &pb.Value{Defined: true, V: v},
+		},
+	})
+}
+
+// DeliveryRatio returns how many processes of the current system delivered the
+// value, out of all of them
+func (h *Hub) DeliveryRatio(v int32) (int, int, error) {
+	h.mu.Lock()
+	defer h.unlock()
+
+	if h.system == nil {
+		return 0, 0, errors.New("no system initialized")
+	}
+
+	return len(h.delivered[v]), len(h.system.processes), nil
+}
+
+// TobBroadcast asks the given process to broadcast the value in total order
+func (h *Hub) TobBroadcast(name string, v int32) error {
+	return h.broadcast(name, &pb.Message{
+		Type: pb.Message_APP_TOB_BROADCAST,
+		AppTobBroadcast: &pb.AppTobBroadcast{
+			Value: &pb.Value{Defined: true, V: v},
+		},
+	})
+}
+
+// LogAppend asks the given process to append the value to the replicated log
+func (h *Hub) LogAppend(name string, v *pb.Value) error {
+	return h.broadcast(name, &pb.Message{
+		Type: pb.Message_APP_LOG_APPEND,
+		AppLogAppend: &pb.AppLogAppend{
+			Value: v,
+		},
+	})
+}
+
+// VsBroadcast asks the given process to broadcast the value in its current
+// view of the view-synchronous broadcast
+func (h *Hub) VsBroadcast(name string, v int32) error {
+	return h.broadcast(name, &pb.Message{
+		Type: pb.Message_APP_VS_BROADCAST,
+		AppVsBroadcast: &pb.AppVsBroadcast{
+			Value: &pb.Value{Defined: true, V: v},
+		},
+	})
+}
+
+// Trb starts a terminating reliable broadcast of the value from the given
+// process on the topic, every process of the system taking part
+func (h *Hub) Trb(topic, name string, v int32) error {
+	h.mu.Lock()
+	defer h.unlock()
+
+	senders, err := h.resolve([]string{name})
+	if err != nil {
+		return err
+	}
+	targets, err := h.resolve(nil)
+	if err != nil {
+		return err
+	}
+
+	for _, p := range targets {
+		h.send(p, &pb.Message{
+			Type: pb.Message_APP_TRB,
+			AppTrb: &pb.AppTrb{
+				Topic:  topic,
+				Sender: senders[0],
+				Value:  &pb.Value{Defined: true, V: v},
+			},
+		})
+	}
+
+	return nil
+}
+
+// Nbac makes every process of the system vote on committing the topic, the
+// given ones voting abort
+func (h *Hub) Nbac(topic string, aborting []string) error {
+	h.mu.Lock()
+	defer h.unlock()
+
+	if _, err := h.resolve(aborting); err != nil {
+		return err
+	}
+	targets, err := h.resolve(nil)
+	if err != nil {
+		return err
+	}
+
+	for _, p := range targets {
+		commit := true
+		for _, name := range aborting {
+			if name == processName(p) {
+				commit = false
+			}
+		}
+
+		log.Info("%v/%v will vote to %v %v", h.system.id, processName(p), outcomeString(commit), topic)
+		h.send(p, &pb.Message{
+			Type: pb.Message_APP_NBAC_PROPOSE,
+			AppNbacPropose: &pb.AppNbacPropose{
+				Topic:  topic,
+				Commit: commit,
+			},
+		})
+	}
+
+	return nil
+}
+
+// Commit runs the transaction topic with the 2pc or 3pc protocol, coordinated
+// by the given process. Every process of the system takes part, the given ones
+// voting abort
+func (h *Hub) Commit(protocol, topic, coordinator string, aborting []string) error {
+	h.mu.Lock()
+	defer h.unlock()
+
+	if protocol != "2pc" && protocol != "3pc" {
+		return errors.New("unknown commit protocol " + protocol)
+	}
+	coordinators, err := h.resolve([]string{coordinator})
+	if err != nil {
+		return err
+	}
+	if _, err := h.resolve(aborting); err != nil {
+		return err
+	}
+	targets, err := h.resolve(nil)
+	if err != nil {
+		return err
+	}
+
+	for _, p := range targets {
+		commit := true
+		for _, name := range aborting {
+			if name == processName(p) {
+				commit = false
+			}
+		}
+
+		log.Info("%v/%v will vote to %v %v %v", h.system.id, processName(p), outcomeString(commit), protocol, topic)
+		h.send(p, &pb.Message{
+			Type: pb.Message_APP_COMMIT,
+			AppCommit: &pb.AppCommit{
+				Protocol:    protocol,
+				Topic:       topic,
+				Coordinator: coordinators[0],
+				Commit:      commit,
+			},
+		})
+	}
+
+	return nil
+}
+
+// Order renders the values every process of the current system delivered in
+// total order, failing if two of them delivered different values at the same
+// position. A ? marks a position not reported yet
+func (h *Hub) Order() (string, error) {
+	h.mu.Lock()
+	defer h.unlock()
+
+	if h.system == nil {
+		return "", errors.New("no system initialized")
+	}
+
+	rows := [][]string{{"process", "delivered"}}
+	reference := make(map[int32]int32)
+	owner := make(map[int32]string)
+	var err error
+	for _, p := range h.system.processes {
+		name := processName(p)
+		delivered := h.order[name]
+
+		last := int32(0)
+		for sn := range delivered {
+			if sn > last {
+				last = sn
+			}
+		}
+
+		values := make([]string, 0, last)
+		for sn := int32(1); sn <= last; sn++ {
+			v, ok := delivered[sn]
+			if !ok {
+				values = append(values, "?")
+				continue
+			}
+			values = append(values, utils.Int32ToString(v))
+
+			if r, ok := reference[sn]; !ok {
+				reference[sn] = v
+				owner[sn] = name
+			} else if r != v && err == nil {
+				err = fmt.Errorf("%v delivered %v at #%v but %v delivered %v", owner[sn], r, sn, name, v)
+			}
+		}
+		rows = append(rows, []string{name, strings.Join(values, " ")})
+	}
+
+	return renderTable(rows), err
+}
+
+func (h *Hub) broadcast(name string, m *pb.Message) error {
+	h.mu.Lock()
+	defer h.unlock()
+
+	targets, err := h.resolve([]string{name})
+	if err != nil {
+		return err
+	}
+
+	h.send(targets[0], m)
+
+	return nil
+}
+
+// Write asks the given processes (all if none) to write the value in the register
+func (h *Hub) Write(register string, v int32, names []string) error {
+	return h.WriteValue(register, utils.Int32Value(v), names)
+}
+
+// WriteValue is Write with a value of any type
+func (h *Hub) WriteValue(register string, v *pb.Value, names []string) error {
+	h.mu.Lock()
+	defer h.unlock()
+
+	targets, err := h.resolve(names)
+	if err != nil {
+		return err
+	}
+
+	for _, p := range targets {
+		h.write(p, register, v, pb.RegisterKind_NNAR)
+	}
+
+	return nil
+}
+
+// Read asks the given processes (all if none) to read the register
+func (h *Hub) Read(register string, names []string) error {
+	h.mu.Lock()
+	defer h.unlock()
+
+	targets, err := h.resolve(names)
+	if err != nil {
+		return err
+	}
+
+	for _, p := range targets {
+		h.read(p, register, pb.RegisterKind_NNAR)
+	}
+
+	return nil
+}
+
+// RegularWrite asks the given processes (all if none) to write the value in the
+// regular register. Regular registers are not recorded, as they are not atomic
+func (h *Hub) RegularWrite(register string, v int32, names []string) error {
+	return h.RegularWriteValue(register, utils.Int32Value(v), names)
+}
+
+// RegularWriteValue is RegularWrite with a value of any type
+func (h *Hub) RegularWriteValue(register string, v *pb.Value, names []string) error {
+	h.mu.Lock()
+	defer h.unlock()
+
+	targets, err := h.resolve(names)
+	if err != nil {
+		return err
+	}
+
+	for _, p := range targets {
+		h.send(p, &pb.Message{
+			Type: pb.Message_APP_WRITE,
+			AppWrite: &pb.AppWrite{
+				Register: register,
+				Value:    v,
+				Kind:     pb.RegisterKind_ONRR,
+			},
+		})
+	}
+
+	return nil
+}
+
+// RegularRead asks the given processes (all if none) to read the regular register
+func (h *Hub) RegularRead(register string, names []string) error {
+	h.mu.Lock()
+	defer h.unlock()
+
+	targets, err := h.resolve(names)
+	if err != nil {
+		return err
+	}
+
+	for _, p := range targets {
+		h.send(p, &pb.Message{
+			Type: pb.Message_APP_READ,
+			AppRead: &pb.AppRead{
+				Register: register,
+				Kind:     pb.RegisterKind_ONRR,
+			},
+		})
+	}
+
+	return nil
+}
+
+// SingleWriterWrite asks the given processes (all if none) to write the value in
+// the single-writer atomic register, which only its writer may do. The
+// operations are recorded like the ones of the (N,N) registers
+func (h *Hub) SingleWriterWrite(register string, v int32, names []string) error {
+	return h.SingleWriterWriteValue(register, utils.Int32Value(v), names)
+}
+
+// SingleWriterWriteValue is SingleWriterWrite with a value of any type
+func (h *Hub) SingleWriterWriteValue(register string, v *pb.Value, names []string) error {
+	h.mu.Lock()
+	defer h.unlock()
+
+	targets, err := h.resolve(names)
+	if err != nil {
+		return err
+	}
+
+	for _, p := range targets {
+		h.write(p, register, v, pb.RegisterKind_ONAR)
+	}
+
+	return nil
+}
+
+// SingleWriterRead asks the given processes (all if none) to read the
+// single-writer atomic register
+func (h *Hub) SingleWriterRead(register string, names []string) error {
+	h.mu.Lock()
+	defer h.unlock()
+
+	targets, err := h.resolve(names)
+	if err != nil {
+		return err
+	}
+
+	for _, p := range targets {
+		h.read(p, register, pb.RegisterKind_ONAR)
+	}
+
+	return nil
+}
+
+// Storm makes all the processes issue a sequence of random reads and writes on
+// the register, each one starting when the previous one of the same process returns
+func (h *Hub) Storm(register string) error {
+	h.mu.Lock()
+	defer h.unlock()
+
+	targets, err := h.resolve(nil)
+	if err != nil {
+		return err
+	}
+
+	remaining := make(map[string]int)
+	h.storms[register] = remaining
+	for _, p := range targets {
+		remaining[processName(p)] = StormOps - 1
+		h.write(p, register, utils.Int32Value(h.randomValue()), pb.RegisterKind_NNAR)
+	}
+
+	return nil
+}
+
+func (h *Hub) continueStorm(register string, p *pb.ProcessId) {
+	remaining, ok := h.storms[register]
+	if !ok {
+		return
+	}
+
+	name := processName(p)
+	if remaining[name] <= 0 {
+		return
+	}
+	remaining[name]--
+
+	if h.rng.Intn(2) == 0 {
+		h.read(p, register, pb.RegisterKind_NNAR)
+	} else {
+		h.write(p, register, utils.Int32Value(h.randomValue()), pb.RegisterKind_NNAR)
+	}
+}
+
+// Consensus makes every process of the system propose a random value on the topic
+func (h *Hub) Consensus(topic string) error {
+	h.mu.Lock()
+	defer h.unlock()
+
+	targets, err := h.resolve(nil)
+	if err != nil {
+		return err
+	}
+
+	for _, p := range targets {
+		v := h.randomValue()
+		log.Info("%v/%v will propose %v", h.system.id, processName(p), v)
+		h.send(p, &pb.Message{
+			Type: pb.Message_APP_PROPOSE,
+			AppPropose: &pb.AppPropose{
+				Topic: topic,
+				Value: &pb.Value{Defined: true, V: v},
+			},
+		})
+	}
+
+	return nil
+}
+
+// Propose makes the given processes (all if none) propose the value on the
+// topic, the others not proposing anything
+func (h *Hub) Propose(topic string, v *pb.Value, names []string) error {
+	h.mu.Lock()
+	defer h.unlock()
+
+	targets, err := h.resolve(names)
+	if err != nil {
+		return err
+	}
+
+	for _, p := range targets {
+		h.send(p, &pb.Message{
+			Type: pb.Message_APP_PROPOSE,
+			AppPropose: &pb.AppPropose{
+				Topic: topic,
+				Value: v,
+			},
+		})
+	}
+
+	return nil
+}
+
+// RcPropose is Propose for the randomized consensus of the topic
+func (h *Hub) RcPropose(topic string, v *pb.Value, names []string) error {
+	h.mu.Lock()
+	defer h.unlock()
+
+	targets, err := h.resolve(names)
+	if err != nil {
+		return err
+	}
+
+	for _, p := range targets {
+		h.send(p, &pb.Message{
+			Type: pb.Message_APP_RC_PROPOSE,
+			AppRcPropose: &pb.AppRcPropose{
+				Topic: topic,
+				Value: v,
+			},
+		})
+	}
+
+	return nil
+}
+
+// Elect starts the leader election on the processes (all if none), which
+// report the leaders they trust from then on
+func (h *Hub) Elect(names []string) error {
+	h.mu.Lock()
+	defer h.unlock()
+
+	targets, err := h.resolve(names)
+	if err != nil {
+		return err
+	}
+
+	for _, p := range targets {
+		h.send(p, &pb.Message{
+			Type:     pb.Message_APP_ELECT,
+			AppElect: &pb.AppElect{},
+		})
+	}
+
+	return nil
+}
+
+// Lin checks whether the register operations recorded in the current system
+// are linearizable, returning the timeline and the verdict
+func (h *Hub) Lin(register string) (string, error) {
+	h.mu.Lock()
+	defer h.unlock()
+
+	if h.system == nil {
+		return "", errors.New("no system initialized")
+	}
+
+	ops, err := lin.Operations(h.recorder.Events())
+	if err != nil {
+		return "", err
+	}
+
+	registerOps, ok := ops[lin.RegisterKey(h.system.id, register)]
+	if !ok {
+		return "", fmt.Errorf("no operations recorded on register %v", register)
+	}
+
+	out := lin.Render(registerOps)
+	r := lin.Check(registerOps)
+	if r.Linearizable {
+		out += "The execution is atomic, witness order:\n" + lin.Format(r.Witness)
+	} else {
+		out += "The execution is not atomic, minimal violating subhistory:\n" + lin.Format(r.Violation)
+	}
+
+	return out, nil
+}
+
+func (h *Hub) write(p *pb.ProcessId, register string, value *pb.Value, kind pb.RegisterKind) {
+	h.pending[processName(p)+"/"+register] = h.recorder.Invoke(h.system.id, processName(p), register, lin.Write, value)
+	h.send(p, &pb.Message{
+		Type: pb.Message_APP_WRITE,
+		AppWrite: &pb.AppWrite{
+			Register: register,
+			Value:    value,
+			Kind:     kind,
+		},
+	})
+}
+
+func (h *Hub) read(p *pb.ProcessId, register string, kind pb.RegisterKind) {
+	h.pending[processName(p)+"/"+register] = h.recorder.Invoke(h.system.id, processName(p), register, lin.Read, nil)
+	h.send(p, &pb.Message{
+		Type: pb.Message_APP_READ,
+		AppRead: &pb.AppRead{
+			Register: register,
+			Kind:     kind,
+		},
+	})
+}
+
+func (h *Hub) recordReturn(p *pb.ProcessId, register string, op lin.Op, v *pb.Value) {
+	key := processName(p) + "/" + register
+	id, ok := h.pending[key]
+	if !ok {
+		return
+	}
+	delete(h.pending, key)
+
+	h.recorder.Return(id, h.system.id, processName(p), register, op, v)
+}
+
+// send queues the message to the process, sent by unlock. Called with mu held
+func (h *Hub) send(p *pb.ProcessId, m *pb.Message) {
+	m.FromAbstractionId = "hub"
+	m.ToAbstractionId = "app"
+	m.SystemId = h.system.id
+
+	// copied, as the processes of the system change under mu
+	msg := proto.Clone(&pb.Message{
+		Type:            pb.Message_PL_SEND,
+		ToAbstractionId: "app.pl",
+		SystemId:        h.system.id,
+		PlSend: &pb.PlSend{
+			Destination: p,
+			Message:     m,
+		},
+	}).(*pb.Message)
+	h.outbox = append(h.outbox, outgoing{pl: h.system.pl, to: msg.PlSend.Destination, m: msg})
+}
+
+// unlock releases mu, then sends the messages queued by send meanwhile
+func (h *Hub) unlock() {
+	outbox := h.outbox
+	h.outbox = nil
+	h.mu.Unlock()
+
+	for _, o := range outbox {
+		if err := o.pl.Send(o.m); err != nil {
+			log.Error("Failed to send %v to %v: %v", o.m.PlSend.Message.Type, processName(o.to), err)
+		}
+	}
+}
+
+// resolve maps process names (owner-index) to the processes of the current
+// system. No names means all the processes
+func (h *Hub) resolve(names []string) ([]*pb.ProcessId, error) {
+	if h.system == nil {
+		return nil, errors.New("no system initialized")
+	}
+
+	if len(names) == 0 {
+		return h.system.processes, nil
+	}
+
+	targets := make([]*pb.ProcessId, 0, len(names))
+	for _, n := range names {
+		var target *pb.ProcessId
+		for _, p := range h.system.processes {
+			if processName(p) == n {
+				target = p
+			}
+		}
+		if target == nil {
+			return nil, fmt.Errorf("process %v is not part of %v", n, h.system.id)
+		}
+		targets = append(targets, target)
+	}
+
+	return targets, nil
+}
+
+func (h *Hub) findProcess(host string, port int32) *pb.ProcessId {
+	for _, p := range h.processes {
+		if p.Host == host && p.Port == port {
+			return p
+		}
+	}
+
+	return nil
+}
+
+func (h *Hub) randomValue() int32 {
+	return int32(h.rng.Intn(90) + 10)
+}
+
+func processName(p *pb.ProcessId) string {
+	return p.Owner + "-" + utils.Int32ToString(p.Index)
+}
+
+func valueString(v *pb.Value) string {
+	return utils.ValueString(v)
+}
+
+func outcomeString(commit bool) string {
+	if commit {
+		return "commit"
+	}
+
+	return "abort"
+}
+
+func renderTable(rows [][]string) string {
+	widths := make([]int, len(rows[0]))
+	for _, r := range rows {
+		for i, c := range r {
+			if len(c) > widths[i] {
+				widths[i] = len(c)
+			}
+		}
+	}
+
+	sep := "+"
+	for _, w := range widths {
+		sep += strings.Repeat("-", w+2) + "+"
+	}
+
+	var b strings.Builder
+	b.WriteString(sep + "\n")
+	for _, r := range rows {
+		b.WriteString("|")
+		for i, c := range r {
+			b.WriteString(" " + c + strings.Repeat(" ", widths[i]-len(c)) + " |")
+		}
+		b.WriteString("\n" + sep + "\n")
+	}
+
+	return b.String()
+}
@@ -0,0 +1,226 @@
+package kv
+
+import (
+	"amcds/pb"
+	"amcds/utils"
+	"amcds/utils/clock"
+	"amcds/utils/log"
+	"errors"
+	"strings"
+	"sync"
+	"time"
+)
+
+var (
+	ErrClosed     = errors.New("the system of the key-value store was destroyed")
+	ErrInvalidKey = errors.New("invalid key, expected a non-empty key without '[', ']' or '.'")
+	ErrTimeout    = errors.New("the register of the key did not return in time")
+)
+
+// Service is the key-value store of a system, app.kv. Every key is held by an
+// nnar register app.kv.nnar[key], created when first used. A register runs
+// one operation at a time, so the operations on a key wait in its queue while
+// the ones on different keys run concurrently. Get and Put may be called from
+// any goroutine, the callbacks running on the event loop of the system.
+//
+// An operation which did not return within the timeout fails with ErrTimeout
+// and the next one on the key starts, so that a lost return does not block
+// the key forever. The register starts over when invoked again, dropping the
+// operation which timed out
+type Service struct {
+	id       string
+	systemId string
+	msgQueue chan *pb.Message
+	timeout  time.Duration
+	clock    clock.Clock
+
+	mu sync.Mutex
+	// operations by key, the first one running
+	queues map[string][]*op
+
+	// the system closes its queue once destroyed, so the clients must be done
+	// sending to it
+	closing sync.RWMutex
+	closed  bool
+}
+
+type op struct {
+	write bool
+	value *pb.Value
+	done  func(v *pb.Value, err error)
+	timer clock.Timer
+}
+
+func Create(abstractionId, systemId string, mQ chan *pb.Message, timeout time.Duration, c clock.Clock) *Service {
+	return &Service{
+		id:       abstractionId,
+		systemId: systemId,
+		msgQueue: mQ,
+		timeout:  timeout,
+		clock:    c,
+		queues:   make(map[string][]*op),
+	}
+}
+
+// CheckKey tells whether the key can name a register: the id of the register
+// holds it in brackets, so it may not have any nor dots
+func CheckKey(key string) error {
+	if key == "" || strings.ContainsAny(key, "[].") {
+		return ErrInvalidKey
+	}
+
+	return nil
+}
+
+// Get reads the key, done getting the value once the read returned
+func (kv *Service) Get(key string, done func(v *pb.Value, err error)) {
+	kv.enqueue(key, &op{done: done})
+}
+
+// Put writes the value of the key, done getting it back once the write returned
+func (kv *Service) Put(key string, v *pb.Value, done func(v *pb.Value, err error)) {
+	kv.enqueue(key, &op{write: true, value: v, done: done})
+}
+
+func (kv *Service) enqueue(key string, o *op) {
+	if err := CheckKey(key); err != nil {
+		o.done(nil, err)
+		return
+	}
+
+	kv.closing.RLock()
+	defer kv.closing.RUnlock()
+	if kv.closed {
+		o.done(nil, ErrClosed)
+		return
+	}
+
+	kv.mu.Lock()
+	kv.queues[key] = append(kv.queues[key], o)
+	first := len(kv.queues[key]) == 1
+	kv.mu.Unlock()
+
+	// sending outside the lock, the event loop taking it in Handle
+	if first {
+		kv.start(key, o)
+	}
+}
+
+// start triggers the operation on the register of the key. It must be the
+// first of the queue of the key
+func (kv *Service) start(key string, o *op) {
+	kv.mu.Lock()
+	o.timer = kv.clock.AfterFunc(kv.timeout, func() { kv.expire(key, o) })
+	kv.mu.Unlock()
+
+	m := &pb.Message{
+		Type:              pb.Message_NNAR_READ,
+		FromAbstractionId: kv.id,
+		ToAbstractionId:   kv.id + ".nnar[" + key + "]",
+		SystemId:          kv.systemId,
+		NnarRead:          &pb.NnarRead{},
+	}
+	if o.write {
+		m.Type, m.NnarRead = pb.Message_NNAR_WRITE, nil
+		m.NnarWrite = &pb.NnarWrite{Value: o.value}
+	}
+
+	kv.msgQueue <- m
+}
+
+func (kv *Service) Handle(m *pb.Message) error {
+	var v *pb.Value
+	switch m.Type {
+	case pb.Message_NNAR_READ_RETURN:
+		v = m.NnarReadReturn.Value
+	case pb.Message_NNAR_WRITE_RETURN:
+	default:
+		return errors.New("kv message type not supported")
+	}
+
+	key := utils.GetRegisterId(m.FromAbstractionId)
+
+	kv.mu.Lock()
+	queue := kv.queues[key]
+	// the return of an operation which timed out, the current one being of
+	// the other kind
+	if len(queue) == 0 || queue[0].write != (m.Type == pb.Message_NNAR_WRITE_RETURN) {
+		kv.mu.Unlock()
+		log.Warn("Unexpected %v for key %v", m.Type, key)
+		return nil
+	}
+
+	current := queue[0]
+	current.timer.Stop()
+	next := kv.pop(key)
+	kv.mu.Unlock()
+
+	if next != nil {
+		kv.start(key, next)
+	}
+
+	if current.write {
+		v = current.value
+	}
+	current.done(v, nil)
+
+	return nil
+}
+
+// expire fails the operation when it is still running on the register of the
+// key, starting the next one
+func (kv *Service) expire(key string, o *op) {
+	kv.closing.RLock()
+	defer kv.closing.RUnlock()
+	if kv.closed {
+		return
+	}
+
+	kv.mu.Lock()
+	if queue := kv.queues[key]; len(queue) == 0 || queue[0] != o {
+		kv.mu.Unlock()
+		return
+	}
+	next := kv.pop(key)
+	kv.mu.Unlock()
+
+	log.Warn("Operation on key %v timed out after %v", key, kv.timeout)
+	if next != nil {
+		kv.start(key, next)
+	}
+	o.done(nil, ErrTimeout)
+}
+
+// pop removes the first operation of the queue of the key, returning the next
+// one. Called with mu held
+func (kv *Service) pop(key string) *op {
+	queue := kv.queues[key]
+	if len(queue) == 1 {
+		delete(kv.queues, key)
+		return nil
+	}
+	kv.queues[key] = queue[1:]
+
+	return queue[1]
+}
+
+// Destroy fails the operations still queued, the system not handling them anymore
+func (kv *Service) Destroy() {
+	kv.closing.Lock()
+	kv.closed = true
+	kv.closing.Unlock()
+
+	kv.mu.Lock()
+	queues := kv.queues
+	kv.queues = make(map[string][]*op)
+	kv.mu.Unlock()
+
+	for _, queue := range queues {
+		for _, o := range queue {
+			if o.timer != nil {
+				o.timer.Stop()
+			}
+			o.done(nil, ErrClosed)
+		}
+	}
+}
@@ -0,0 +1,223 @@
+package kv
+
+import (
+	"amcds/pb"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"net/http"
+	"sync"
+)
+
+// Entry is the JSON form of a key and its value. Type is int32, int64, string
+// or bytes (base64 encoded in value). When writing it may be left out, a
+// number then being an int32 if it fits and an int64 otherwise, and a JSON
+// string a string
+type Entry struct {
+	Key     string          `json:"key"`
+	Defined bool            `json:"defined"`
+	Type    string          `json:"type,omitempty"`
+	Value   json.RawMessage `json:"value,omitempty"`
+}
+
+// Server exposes the key-value stores of the systems of a process over HTTP.
+// GET /kv/{key} reads the key and PUT /kv/{key} writes the entry in the body,
+// both answering with the entry once the register operation returned. Keys
+// may not be empty nor have '[', ']' or '.'. The system is chosen with
+// ?system=id, the last one added by default
+type Server struct {
+	mu       sync.Mutex
+	services map[string]*Service
+	latest   string
+	mux      *http.ServeMux
+}
+
+func CreateServer() *Server {
+	s := &Server{services: make(map[string]*Service), mux: http.NewServeMux()}
+	s.mux.HandleFunc("GET /kv/{key}", s.get)
+	s.mux.HandleFunc("PUT /kv/{key}", s.put)
+
+	return s
+}
+
+// Add makes the store of the system reachable, as the default one
+func (s *Server) Add(systemId string, kv *Service) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.services[systemId] = kv
+	s.latest = systemId
+}
+
+func (s *Server) Remove(systemId string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.services, systemId)
+	if s.latest == systemId {
+		s.latest = ""
+	}
+}
+
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mux.ServeHTTP(w, r)
+}
+
+func (s *Server) get(w http.ResponseWriter, r *http.Request) {
+	kv, err := s.service(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+
+	key := r.PathValue("key")
+	if err := CheckKey(key); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	s.wait(w, r, key, func(done func(*pb.Value, error)) { kv.Get(key, done) })
+}
+
+func (s *Server) put(w http.ResponseWriter, r *http.Request) {
+	kv, err := s.service(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+	key := r.PathValue("key")
+	if err := CheckKey(key); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	var e Entry
+	if err := json.NewDecoder(r.Body).Decode(&e); err != nil {
+		http.Error(w, "invalid entry: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+	v, err := toValue(e)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	s.wait(w, r, key, func(done func(*pb.Value, error)) { kv.Put(key, v, done) })
+}
+
+// wait starts the operation and answers once it returned, unless the client
+// gives up first. The operation still runs in that case
+func (s *Server) wait(w http.ResponseWriter, r *http.Request, key string, start func(done func(*pb.Value, error))) {
+	type result struct {
+		v   *pb.Value
+		err error
+	}
+	results := make(chan result, 1)
+	start(func(v *pb.Value, err error) { results <- result{v, err} })
+
+	select {
+	case res := <-results:
+		if errors.Is(res.err, ErrTimeout) {
+			http.Error(w, res.err.Error(), http.StatusGatewayTimeout)
+			return
+		}
+		if res.err != nil {
+			http.Error(w, res.err.Error(), http.StatusServiceUnavailable)
+			return
+		}
+		e, err := toEntry(key, res.v)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(e)
+	case <-r.Context().Done():
+	}
+}
+
+func (s *Server) service(r *http.Request) (*Service, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	id := r.URL.Query().Get("system")
+	if id == "" {
+		id = s.latest
+	}
+	kv, ok := s.services[id]
+	if !ok {
+		return nil, fmt.Errorf("no system %v on this process", id)
+	}
+
+	return kv, nil
+}
+
+func toValue(e Entry) (*pb.Value, error) {
+	if len(e.Value) == 0 {
+		return nil, errors.New("missing value")
+	}
+
+	var err error
+	v := &pb.Value{Defined: true}
+	switch e.Type {
+	case "":
+		if e.Value[0] == '"' {
+			v.Type = pb.ValueType_STRING
+			err = json.Unmarshal(e.Value, &v.StringValue)
+			break
+		}
+		var i int64
+		if err = json.Unmarshal(e.Value, &i); err == nil {
+			if i >= math.MinInt32 && i <= math.MaxInt32 {
+				v.V = int32(i)
+			} else {
+				v.Type, v.Int64Value = pb.ValueType_INT64, i
+			}
+		}
+	case "int32":
+		err = json.Unmarshal(e.Value, &v.V)
+	case "int64":
+		v.Type = pb.ValueType_INT64
+		err = json.Unmarshal(e.Value, &v.Int64Value)
+	case "string":
+		v.Type = pb.ValueType_STRING
+		err = json.Unmarshal(e.Value, &v.StringValue)
+	case "bytes":
+		v.Type = pb.ValueType_BYTES
+		err = json.Unmarshal(e.Value, &v.BytesValue)
+	default:
+		return nil, fmt.Errorf("invalid type %v, expected int32, int64, string or bytes", e.Type)
+	}
+	if err != nil && e.Type == "" {
+		return nil, fmt.Errorf("invalid value %s, expected an integer or a string", e.Value)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("invalid %v value %s", e.Type, e.Value)
+	}
+
+	return v, nil
+}
+
+func toEntry(key string, v *pb.Value) (Entry, error) {
+	e := Entry{Key: key, Defined: v.GetDefined()}
+	if !e.Defined {
+		return e, nil
+	}
+
+	var raw any
+	switch v.Type {
+	case pb.ValueType_INT64:
+		e.Type, raw = "int64", v.Int64Value
+	case pb.ValueType_STRING:
+		e.Type, raw = "string", v.StringValue
+	case pb.ValueType_BYTES:
+		e.Type, raw = "bytes", v.BytesValue
+	default:
+		e.Type, raw = "int32", v.V
+	}
+
+	data, err := json.Marshal(raw)
+	e.Value = data
+
+	return e, err
+}
@@ -0,0 +1,171 @@
+package lin
+
+import (
+	"math"
+	"sort"
+	"strings"
+)
+
+type Result struct {
+	Linearizable bool
+	// Witness is a legal sequential order of the operations (only when linearizable)
+	Witness []*Operation
+	// Violation is a minimal subset of operations which cannot be linearized
+	// (only when not linearizable): removing any one of them makes it legal
+	Violation []*Operation
+}
+
+type state struct {
+	done    []bool
+	defined bool
+	value   string
+}
+
+func (s *state) key() string {
+	var b strings.Builder
+	for _, d := range s.done {
+		if d {
+			b.WriteByte('1')
+		} else {
+			b.WriteByte('0')
+		}
+	}
+	if s.defined {
+		b.WriteString("|" + s.value)
+	}
+
+	return b.String()
+}
+
+type checker struct {
+	ops     []*Operation
+	visited map[string]bool
+	order   []int
+}
+
+// Check decides whether the operations of a single register, starting from an
+// undefined value, are linearizable
+func Check(ops []*Operation) Result {
+	order, ok := linearize(ops)
+	if ok {
+		witness := make([]*Operation, 0, len(order))
+		for _, i := range order {
+			witness = append(witness, ops[i])
+		}
+		return Result{Linearizable: true, Witness: witness}
+	}
+
+	return Result{Linearizable: false, Violation: shrink(ops)}
+}
+
+func linearize(ops []*Operation) ([]int, bool) {
+	c := &checker{
+		ops:     ops,
+		visited: make(map[string]bool),
+		order:   make([]int, 0, len(ops)),
+	}
+
+	ok := c.search(&state{done: make([]bool, len(ops))})
+
+	return c.order, ok
+}
+
+// search tries, depth first, every operation that may take effect next: one
+// that no other remaining completed operation precedes in real time
+func (c *checker) search(s *state) bool {
+	remaining := false
+	minReturn := int64(math.MaxInt64)
+	for i, o := range c.ops {
+		if s.done[i] || !o.Completed {
+			continue
+		}
+		remaining = true
+		if o.Return < minReturn {
+			minReturn = o.Return
+		}
+	}
+
+	if !remaining {
+		return true
+	}
+
+	if c.visited[s.key()] {
+		return false
+	}
+	c.visited[s.key()] = true
+
+	for i, o := range c.ops {
+		if s.done[i] || o.Invoke > minReturn {
+			continue
+		}
+
+		next := &state{done: s.done, defined: s.defined, value: s.value}
+		switch o.Op {
+		case Write:
+			next.defined, next.value = o.Defined, o.Value
+		case Read:
+			// a pending read constrains nothing, it simply never takes effect
+			if !o.Completed {
+				continue
+			}
+			if o.Defined != s.defined || (o.Defined && o.Value != s.value) {
+				continue
+			}
+		}
+
+		s.done[i] = true
+		c.order = append(c.order, i)
+		if c.search(next) {
+			return true
+		}
+		c.order = c.order[:len(c.order)-1]
+		s.done[i] = false
+	}
+
+	return false
+}
+
+// shrink removes operations one by one as long as the history stays illegal.
+// A write is only dropped when no remaining read returned its value, so the
+// violation is not just a read of a value that was removed
+func shrink(ops []*Operation) []*Operation {
+	kept := append([]*Operation{}, ops...)
+
+	for i := 0; i < len(kept); {
+		candidate := append(append([]*Operation{}, kept[:i]...), kept[i+1:]...)
+		if !explainsReads(candidate, ops) {
+			i++
+			continue
+		}
+		if _, ok := linearize(candidate); !ok {
+			kept = candidate
+		} else {
+			i++
+		}
+	}
+
+	sort.SliceStable(kept, func(i, j int) bool { return kept[i].Invoke < kept[j].Invoke })
+
+	return kept
+}
+
+// explainsReads checks that every defined value read in ops is written in ops,
+// unless it was not written in the whole history either
+func explainsReads(ops, all []*Operation) bool {
+	written := func(set []*Operation, v string) bool {
+		for _, o := range set {
+			if o.Op == Write && o.Defined && o.Value == v {
+				return true
+			}
+		}
+		return false
+	}
+
+	for _, o := range ops {
+		if o.Op == Read && o.Completed && o.Defined && !written(ops, o.Value) && written(all, o.Value) {
+			return false
+		}
+	}
+
+	return true
+}
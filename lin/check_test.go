@@ -0,0 +1,106 @@
+package lin
+
+import (
+	"testing"
+)
+
+func write(value string, invoke, ret int64) *Operation {
+	return &Operation{Op: Write, Defined: true, Value: value, Invoke: invoke, Return: ret, Completed: true}
+}
+
+func read(value string, invoke, ret int64) *Operation {
+	return &Operation{Op: Read, Defined: value != "", Value: value, Invoke: invoke, Return: ret, Completed: true}
+}
+
+func pending(o *Operation) *Operation {
+	o.Return, o.Completed = 0, false
+
+	return o
+}
+
+func TestCheck(t *testing.T) {
+	tests := []struct {
+		name         string
+		ops          []*Operation
+		linearizable bool
+		// indexes in ops of the witness, or of the violation when not linearizable
+		want []int
+	}{
+		{
+			name:         "sequential",
+			ops:          []*Operation{read("", 0, 10), write("1", 20, 30), read("1", 40, 50)},
+			linearizable: true,
+			want:         []int{0, 1, 2},
+		},
+		{
+			name:         "concurrent writes ordered by the read",
+			ops:          []*Operation{write("1", 0, 30), write("2", 10, 20), read("1", 40, 50)},
+			linearizable: true,
+			want:         []int{1, 0, 2},
+		},
+		{
+			name:         "read of a pending write",
+			ops:          []*Operation{pending(write("1", 0, 0)), read("1", 10, 20)},
+			linearizable: true,
+			want:         []int{0, 1},
+		},
+		{
+			name:         "pending write never taking effect",
+			ops:          []*Operation{pending(write("1", 0, 0)), read("", 10, 20)},
+			linearizable: true,
+			want:         []int{1},
+		},
+		{
+			name:         "overlapping write read old then new",
+			ops:          []*Operation{write("1", 0, 10), write("2", 20, 100), read("1", 30, 40), read("2", 50, 60)},
+			linearizable: true,
+			want:         []int{0, 2, 1, 3},
+		},
+		{
+			name:         "undefined read after a write",
+			ops:          []*Operation{write("1", 0, 10), read("", 20, 30)},
+			linearizable: false,
+			want:         []int{0, 1},
+		},
+		{
+			name:         "stale read",
+			ops:          []*Operation{write("1", 0, 10), write("2", 20, 30), read("1", 40, 50), read("2", 60, 70)},
+			linearizable: false,
+			want:         []int{0, 1, 2},
+		},
+		{
+			name:         "new old inversion",
+			ops:          []*Operation{write("1", 0, 10), write("2", 20, 100), read("2", 30, 40), read("1", 50, 60)},
+			linearizable: false,
+			want:         []int{0, 1, 2, 3},
+		},
+		{
+			name:         "read of a value never written",
+			ops:          []*Operation{write("1", 0, 10), read("3", 20, 30)},
+			linearizable: false,
+			want:         []int{1},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res := Check(tt.ops)
+			if res.Linearizable != tt.linearizable {
+				t.Fatalf("linearizable = %v, want %v", res.Linearizable, tt.linearizable)
+			}
+
+			got := res.Witness
+			if !res.Linearizable {
+				got = res.Violation
+			}
+			if len(got) != len(tt.want) {
+				t.Fatalf("got %v operations, want %v:\n%v", len(got), len(tt.want), Format(got))
+			}
+			for i, o := range got {
+				if o != tt.ops[tt.want[i]] {
+					t.Fatalf("operation %v is not operation %v of the history:\n%v", i, tt.want[i], Format(got))
+				}
+			}
+		})
+	}
+}
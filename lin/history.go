@@ -0,0 +1,271 @@
+package lin
+
+import (
+	"amcds/pb"
+	"amcds/utils"
+	"amcds/utils/clock"
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"sync"
+)
+
+type Kind string
+
+const (
+	Invoke Kind = "invoke"
+	Return Kind = "return"
+)
+
+type Op string
+
+const (
+	Read  Op = "read"
+	Write Op = "write"
+)
+
+// Source tells who recorded an event: the hub sees the operations it asks the
+// processes for, the processes the ones of their registers, so both record the
+// operations asked by the hub
+type Source string
+
+const (
+	Hub     Source = "hub"
+	Process Source = "process"
+)
+
+// Event is one line of a recorded history. Invocations of writes carry the
+// written value, returns of reads carry the value that was read
+type Event struct {
+	Kind     Kind   `json:"kind"`
+	Id       int    `json:"id"`
+	Source   Source `json:"source,omitempty"`
+	System   string `json:"system"`
+	Process  string `json:"process"`
+	Register string `json:"register"`
+	Op       Op     `json:"op"`
+	Defined  bool   `json:"defined"`
+	Value    string `json:"value,omitempty"`
+	Time     int64  `json:"time"`
+}
+
+// Operation is an invocation matched with its response. Pending operations
+// (invoked but never returned) have Completed set to false
+type Operation struct {
+	System    string
+	Process   string
+	Register  string
+	Op        Op
+	Defined   bool
+	Value     string
+	Invoke    int64
+	Return    int64
+	Completed bool
+}
+
+type Recorder struct {
+	mu     sync.Mutex
+	nextId int
+	events []Event
+	w      io.WriteCloser
+	clock  clock.Clock
+	source Source
+}
+
+// CreateRecorder returns a recorder keeping the history in memory
+func CreateRecorder() *Recorder {
+	return &Recorder{
+		events: make([]Event, 0),
+		clock:  clock.Real,
+	}
+}
+
+// CreateWithClock makes the recorder timestamp events with the given clock
+func (r *Recorder) CreateWithClock(c clock.Clock) *Recorder {
+	r.clock = c
+
+	return r
+}
+
+// CreateWithSource makes the recorder mark its events as recorded by source
+func (r *Recorder) CreateWithSource(source Source) *Recorder {
+	r.source = source
+
+	return r
+}
+
+// CreateFileRecorder returns a recorder that also appends every event as a json
+// line to the given file
+func CreateFileRecorder(path string) (*Recorder, error) {
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0644)
+	if err != nil {
+		return nil, err
+	}
+
+	r := CreateRecorder()
+	r.w = f
+
+	return r, nil
+}
+
+// Invoke records the start of an operation and returns its id, to be used
+// when recording the response
+func (r *Recorder) Invoke(system, process, register string, op Op, v *pb.Value) int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.nextId++
+	e := Event{
+		Kind:     Invoke,
+		Id:       r.nextId,
+		System:   system,
+		Process:  process,
+		Register: register,
+		Op:       op,
+	}
+	if op == Write {
+		e.Defined, e.Value = valueFields(v)
+	}
+	r.add(e)
+
+	return r.nextId
+}
+
+func (r *Recorder) Return(id int, system, process, register string, op Op, v *pb.Value) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	e := Event{
+		Kind:     Return,
+		Id:       id,
+		System:   system,
+		Process:  process,
+		Register: register,
+		Op:       op,
+	}
+	if op == Read {
+		e.Defined, e.Value = valueFields(v)
+	}
+	r.add(e)
+}
+
+func (r *Recorder) add(e Event) {
+	e.Source = r.source
+	e.Time = r.clock.Now().UnixNano()
+	r.events = append(r.events, e)
+
+	if r.w != nil {
+		data, _ := json.Marshal(e)
+		r.w.Write(append(data, '\n'))
+	}
+}
+
+// Events returns a copy of everything recorded so far
+func (r *Recorder) Events() []Event {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return append([]Event{}, r.events...)
+}
+
+func (r *Recorder) Close() error {
+	if r.w == nil {
+		return nil
+	}
+
+	return r.w.Close()
+}
+
+// ReadEvents parses a history file written by a file recorder
+func ReadEvents(rd io.Reader) ([]Event, error) {
+	events := make([]Event, 0)
+	scanner := bufio.NewScanner(rd)
+	for scanner.Scan() {
+		if len(scanner.Bytes()) == 0 {
+			continue
+		}
+		e := Event{}
+		if err := json.Unmarshal(scanner.Bytes(), &e); err != nil {
+			return nil, err
+		}
+		events = append(events, e)
+	}
+
+	return events, scanner.Err()
+}
+
+// Operations matches invocations with responses and groups the resulting
+// operations by system and register (as system/register), sorted by invocation
+// time. The events of a system must come either from the hub or from the
+// processes: both record the operations asked by the hub, which would then be
+// checked twice
+func Operations(events []Event) (map[string][]*Operation, error) {
+	type key struct {
+		source  Source
+		system  string
+		process string
+		id      int
+	}
+
+	pending := make(map[key]*Operation)
+	ops := make(map[string][]*Operation)
+	sources := make(map[string]Source)
+
+	sorted := append([]Event{}, events...)
+	sort.SliceStable(sorted, func(i, j int) bool { return sorted[i].Time < sorted[j].Time })
+
+	for _, e := range sorted {
+		if s, ok := sources[e.System]; ok && s != e.Source {
+			return nil, fmt.Errorf("system %v mixes events recorded by %q and %q, check either the hub or the process histories", e.System, s, e.Source)
+		}
+		sources[e.System] = e.Source
+
+		k := key{e.Source, e.System, e.Process, e.Id}
+		switch e.Kind {
+		case Invoke:
+			o := &Operation{
+				System:   e.System,
+				Process:  e.Process,
+				Register: e.Register,
+				Op:       e.Op,
+				Defined:  e.Defined,
+				Value:    e.Value,
+				Invoke:   e.Time,
+			}
+			pending[k] = o
+			ops[RegisterKey(e.System, e.Register)] = append(ops[RegisterKey(e.System, e.Register)], o)
+		case Return:
+			o, ok := pending[k]
+			if !ok {
+				return nil, errors.New("return of operation " + e.Process + "/" + utils.Int32ToString(int32(e.Id)) + " without invocation")
+			}
+			delete(pending, k)
+			o.Return = e.Time
+			o.Completed = true
+			if o.Op == Read {
+				o.Defined = e.Defined
+				o.Value = e.Value
+			}
+		default:
+			return nil, errors.New("unknown event kind " + string(e.Kind))
+		}
+	}
+
+	return ops, nil
+}
+
+func RegisterKey(system, register string) string {
+	return system + "/" + register
+}
+
+func valueFields(v *pb.Value) (bool, string) {
+	if v == nil || !v.Defined {
+		return false, ""
+	}
+
+	return true, utils.ValueString(v)
+}
@@ -0,0 +1,126 @@
+package lin
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// number of columns used by the timeline of Render
+const columns = 72
+
+// Render draws one line per process where every operation spans the columns
+// between its invocation and its response, with the value in the middle, the
+// same way the reference hub does for its lin command
+func Render(ops []*Operation) string {
+	if len(ops) == 0 {
+		return ""
+	}
+
+	start, end := ops[0].Invoke, ops[0].Invoke
+	processes := make([]string, 0)
+	byProcess := make(map[string][]*Operation)
+	for _, o := range ops {
+		if o.Invoke < start {
+			start = o.Invoke
+		}
+		if o.Invoke > end {
+			end = o.Invoke
+		}
+		if o.Completed && o.Return > end {
+			end = o.Return
+		}
+		if _, ok := byProcess[o.Process]; !ok {
+			processes = append(processes, o.Process)
+		}
+		byProcess[o.Process] = append(byProcess[o.Process], o)
+	}
+	sort.Strings(processes)
+
+	span := end - start
+	if span == 0 {
+		span = 1
+	}
+	column := func(t int64) int {
+		return int((t - start) * (columns - 1) / span)
+	}
+
+	width := 0
+	for _, p := range processes {
+		if len(p) > width {
+			width = len(p)
+		}
+	}
+
+	var b strings.Builder
+	for _, p := range processes {
+		line := []byte(strings.Repeat(" ", columns*2))
+		for _, o := range byProcess[p] {
+			from := column(o.Invoke) * 2
+			to := columns*2 - 1
+			if o.Completed {
+				to = column(o.Return)*2 + 1
+			}
+
+			c := byte('r')
+			if o.Op == Write {
+				c = 'w'
+			}
+			for i := from; i <= to; i++ {
+				line[i] = c
+			}
+
+			label := o.label()
+			mid := (from+to)/2 - len(label)/2
+			if mid < from {
+				mid = from
+			}
+			for i := 0; i < len(label) && mid+i < len(line); i++ {
+				line[mid+i] = label[i]
+			}
+		}
+		b.WriteString(fmt.Sprintf("%-*s: %s\n", width, p, strings.TrimRight(string(line), " ")))
+	}
+
+	return b.String()
+}
+
+// Format lists the operations in the given order, one per line, with their
+// invocation and response times in milliseconds since the first invocation
+func Format(ops []*Operation) string {
+	if len(ops) == 0 {
+		return ""
+	}
+
+	start := ops[0].Invoke
+	for _, o := range ops {
+		if o.Invoke < start {
+			start = o.Invoke
+		}
+	}
+	ms := func(t int64) string {
+		return fmt.Sprintf("%.3fms", float64(t-start)/1e6)
+	}
+
+	var b strings.Builder
+	for i, o := range ops {
+		ret := "pending"
+		if o.Completed {
+			ret = ms(o.Return)
+		}
+		b.WriteString(fmt.Sprintf("%3d. %v %v %v=%v [%v, %v]\n", i+1, o.Process, o.Op, o.Register, o.label(), ms(o.Invoke), ret))
+	}
+
+	return b.String()
+}
+
+func (o *Operation) label() string {
+	if o.Op == Read && !o.Completed {
+		return "?"
+	}
+	if !o.Defined {
+		return "_"
+	}
+
+	return o.Value
+}
@@ -1,14 +1,18 @@
 package main
 
 import (
+	"amcds/kv"
 	"amcds/pb"
 	"amcds/pl"
+	"amcds/pl/fault"
 	"amcds/system"
 	"amcds/tcp"
+	"amcds/utils/clock"
 	"amcds/utils/log"
 	"flag"
 	"fmt"
 	"net"
+	"net/http"
 	"os"
 	"os/signal"
 	"strconv"
@@ -56,6 +60,7 @@ func main() {
 	hubAddress := flag.String("hub", "127.0.0.1:5000", "Host:Port of the hub")
 	port := flag.Int("port", 5004, "Port on which the process runs")
 	index := flag.Int("index", 1, "Index of the process")
+	kvPort := flag.Int("kv-port", 0, "Port of the HTTP key-value API, disabled when 0")
 	flag.Parse()
 
 	host := "127.0.0.1"
@@ -64,13 +69,24 @@ func main() {
 
 	log.Instantiate()
 
+	// optional fault injection on the links between the processes
+	var injector *fault.Injector
+	faults, enabled, err := fault.LoadConfig()
+	if err != nil {
+		log.Fatal("Failed to load the fault injection settings: %v", err)
+	}
+	if enabled {
+		injector = fault.Create(fmt.Sprintf("%v-%v", *owner, *index), pl.TcpTransport{}, clock.Real, faults)
+		log.Info("Fault injection enabled %+v", faults)
+	}
+
 	networkMessages := make(chan *pb.Message, 4096)
 
 	// link between our system and hub (initial registration)
 	// only parse incoming messages (doesn't handle them)
 	pl := pl.Create(host, int32(*port), *hubAddress)
 
-	err := register(pl, *owner, int32(*index), *hubAddress)
+	err = register(pl, *owner, int32(*index), *hubAddress)
 	if err != nil {
 		log.Fatal("Failed to register the process %v", err)
 	}
@@ -92,6 +108,18 @@ func main() {
 	defer l.Close()
 	log.Info("%v-%v listening on port %v", *owner, *index, *port)
 
+	// key-value API over the registers of the systems
+	kvServer := kv.CreateServer()
+	if *kvPort != 0 {
+		kvAddress := host + ":" + fmt.Sprint(*kvPort)
+		go func() {
+			if err := http.ListenAndServe(kvAddress, kvServer); err != nil {
+				log.Fatal("Failed to serve the key-value API: %v", err)
+			}
+		}()
+		log.Info("%v-%v serving the key-value API on port %v", *owner, *index, *kvPort)
+	}
+
 	systems := make(SystemInventory, 0)
 	// Process link messages
 	go func() {
@@ -99,14 +127,43 @@ func main() {
 			switch m.NetworkMessage.Message.Type {
 			case pb.Message_PROC_DESTROY_SYSTEM:
 				if s, ok := systems[m.SystemId]; ok {
+					kvServer.Remove(m.SystemId)
 					s.Destroy()
-					s = nil
+					delete(systems, m.SystemId)
 				}
 			case pb.Message_PROC_INITIALIZE_SYSTEM:
+				// the hub starting the system again, the old one must stop
+				// handling its messages and timers
+				if old, ok := systems[m.SystemId]; ok {
+					kvServer.Remove(m.SystemId)
+					old.Destroy()
+				}
+				s := system.CreateSystem(m.NetworkMessage.Message, host, *owner, *hubAddress, int32(*port), int32(*index))
+				if injector != nil {
+					injector.Learn(m.NetworkMessage.Message.ProcInitializeSystem.Processes)
+					s.CreateWithRuntime(injector, clock.Real)
+				}
+				s.RegisterAbstractions()
+				s.StartEventLoop()
+				systems[m.SystemId] = s
+				kvServer.Add(m.SystemId, s.Kv())
+			case pb.Message_PROC_RECONFIGURE_SYSTEM:
+				if injector != nil {
+					injector.Learn(m.NetworkMessage.Message.ProcReconfigureSystem.Processes)
+				}
+				if s, ok := systems[m.SystemId]; ok {
+					s.AddMessage(m)
+					break
+				}
+				// the process joins a running system
 				s := system.CreateSystem(m.NetworkMessage.Message, host, *owner, *hubAddress, int32(*port), int32(*index))
+				if injector != nil {
+					s.CreateWithRuntime(injector, clock.Real)
+				}
 				s.RegisterAbstractions()
 				s.StartEventLoop()
 				systems[m.SystemId] = s
+				kvServer.Add(m.SystemId, s.Kv())
 			default:
 				log.Warn("AM PRIMIT %v", m)
 				if s, ok := systems[m.SystemId]; ok {
@@ -20,6 +20,107 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type ValueType int32
+
+const (
+	ValueType_INT32  ValueType = 0 // In v, the only type known by the reference hub
+	ValueType_BYTES  ValueType = 1 // In bytesValue
+	ValueType_STRING ValueType = 2 // In stringValue
+	ValueType_INT64  ValueType = 3 // In int64Value
+)
+
+// Enum value maps for ValueType.
+var (
+	ValueType_name = map[int32]string{
+		0: "INT32",
+		1: "BYTES",
+		2: "STRING",
+		3: "INT64",
+	}
+	ValueType_value = map[string]int32{
+		"INT32":  0,
+		"BYTES":  1,
+		"STRING": 2,
+		"INT64":  3,
+	}
+)
+
+func (x ValueType) Enum() *ValueType {
+	p := new(ValueType)
+	*p = x
+	return p
+}
+
+func (x ValueType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ValueType) Descriptor() protoreflect.EnumDescriptor {
+	return file_messages_proto_enumTypes[0].Descriptor()
+}
+
+func (ValueType) Type() protoreflect.EnumType {
+	return &file_messages_proto_enumTypes[0]
+}
+
+func (x ValueType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ValueType.Descriptor instead.
+func (ValueType) EnumDescriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{0}
+}
+
+type RegisterKind int32
+
+const (
+	RegisterKind_NNAR RegisterKind = 0 // (N,N) atomic register, app.nnar[register]
+	RegisterKind_ONRR RegisterKind = 1 // (1,N) regular register, app.onrr[register]
+	RegisterKind_ONAR RegisterKind = 2 // (1,N) atomic register, app.onar[register]
+)
+
+// Enum value maps for RegisterKind.
+var (
+	RegisterKind_name = map[int32]string{
+		0: "NNAR",
+		1: "ONRR",
+		2: "ONAR",
+	}
+	RegisterKind_value = map[string]int32{
+		"NNAR": 0,
+		"ONRR": 1,
+		"ONAR": 2,
+	}
+)
+
+func (x RegisterKind) Enum() *RegisterKind {
+	p := new(RegisterKind)
+	*p = x
+	return p
+}
+
+func (x RegisterKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RegisterKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_messages_proto_enumTypes[1].Descriptor()
+}
+
+func (RegisterKind) Type() protoreflect.EnumType {
+	return &file_messages_proto_enumTypes[1]
+}
+
+func (x RegisterKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RegisterKind.Descriptor instead.
+func (RegisterKind) EnumDescriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{1}
+}
+
 type Message_Type int32
 
 const (
@@ -35,6 +136,22 @@ const (
 	Message_APP_WRITE                       Message_Type = 9
 	Message_APP_READ_RETURN                 Message_Type = 10
 	Message_APP_WRITE_RETURN                Message_Type = 11
+	Message_APP_RELIABLE_BROADCAST          Message_Type = 12
+	Message_APP_UNIFORM_BROADCAST           Message_Type = 13
+	Message_APP_FIFO_BROADCAST              Message_Type = 14
+	Message_APP_CAUSAL_BROADCAST            Message_Type = 15
+	Message_APP_PROBABILISTIC_BROADCAST     Message_Type = 16
+	Message_APP_TOB_BROADCAST               Message_Type = 17
+	Message_APP_TOB_DELIVER                 Message_Type = 18
+	Message_APP_TRB                         Message_Type = 19
+	Message_APP_TRB_DELIVER                 Message_Type = 22
+	Message_APP_NBAC_PROPOSE                Message_Type = 23
+	Message_APP_NBAC_DECIDE                 Message_Type = 24
+	Message_APP_COMMIT                      Message_Type = 25
+	Message_APP_COMMIT_OUTCOME              Message_Type = 26
+	Message_APP_VS_BROADCAST                Message_Type = 27
+	Message_APP_VS_DELIVER                  Message_Type = 28
+	Message_APP_VIEW                        Message_Type = 29
 	Message_UC_DECIDE                       Message_Type = 20
 	Message_UC_PROPOSE                      Message_Type = 21
 	Message_EP_ABORT                        Message_Type = 30
@@ -68,56 +185,274 @@ const (
 	Message_EPFD_TIMEOUT                    Message_Type = 84
 	Message_PL_DELIVER                      Message_Type = 90
 	Message_PL_SEND                         Message_Type = 91
+	Message_PFD_CRASH                       Message_Type = 100
+	Message_PFD_INTERNAL_HEARTBEAT_REPLY    Message_Type = 101
+	Message_PFD_INTERNAL_HEARTBEAT_REQUEST  Message_Type = 102
+	Message_PFD_TIMEOUT                     Message_Type = 103
+	Message_LE_LEADER                       Message_Type = 110
+	Message_APP_ELECT                       Message_Type = 111
+	Message_APP_LEADER                      Message_Type = 112
+	Message_LE_START                        Message_Type = 113
+	Message_RB_BROADCAST                    Message_Type = 120
+	Message_RB_DELIVER                      Message_Type = 121
+	Message_RB_INTERNAL_DATA                Message_Type = 122
+	Message_URB_BROADCAST                   Message_Type = 130
+	Message_URB_DELIVER                     Message_Type = 131
+	Message_URB_INTERNAL_DATA               Message_Type = 132
+	Message_FRB_BROADCAST                   Message_Type = 140
+	Message_FRB_DELIVER                     Message_Type = 141
+	Message_FRB_INTERNAL_DATA               Message_Type = 142
+	Message_CRB_BROADCAST                   Message_Type = 150
+	Message_CRB_DELIVER                     Message_Type = 151
+	Message_CRB_INTERNAL_DATA               Message_Type = 152
+	Message_PB_BROADCAST                    Message_Type = 160
+	Message_PB_DELIVER                      Message_Type = 161
+	Message_PB_INTERNAL_GOSSIP              Message_Type = 162
+	Message_PB_INTERNAL_REQUEST             Message_Type = 163
+	Message_PB_INTERNAL_DATA                Message_Type = 164
+	Message_PB_TIMEOUT                      Message_Type = 165
+	Message_TOB_BROADCAST                   Message_Type = 170
+	Message_TOB_DELIVER                     Message_Type = 171
+	Message_TOB_INTERNAL_DATA               Message_Type = 172
+	Message_TRB_BROADCAST                   Message_Type = 180
+	Message_TRB_DELIVER                     Message_Type = 181
+	Message_TRB_INTERNAL_DATA               Message_Type = 182
+	Message_NBAC_PROPOSE                    Message_Type = 190
+	Message_NBAC_DECIDE                     Message_Type = 191
+	Message_NBAC_INTERNAL_VOTE              Message_Type = 192
+	Message_COMMIT_START                    Message_Type = 200
+	Message_COMMIT_OUTCOME                  Message_Type = 201
+	Message_COMMIT_INTERNAL_PREPARE         Message_Type = 202
+	Message_COMMIT_INTERNAL_VOTE            Message_Type = 203
+	Message_COMMIT_INTERNAL_PRECOMMIT       Message_Type = 204
+	Message_COMMIT_INTERNAL_ACK             Message_Type = 205
+	Message_COMMIT_INTERNAL_DECISION        Message_Type = 206
+	Message_COMMIT_INTERNAL_STATE           Message_Type = 207
+	Message_GM_VIEW                         Message_Type = 210
+	Message_GM_RECONFIGURE                  Message_Type = 211
+	Message_VS_BROADCAST                    Message_Type = 220
+	Message_VS_DELIVER                      Message_Type = 221
+	Message_VS_VIEW                         Message_Type = 222
+	Message_VS_INTERNAL_DATA                Message_Type = 223
+	Message_VS_INTERNAL_PENDING             Message_Type = 224
+	Message_PROC_RECONFIGURE_SYSTEM         Message_Type = 230
+	Message_RECONF_INTERNAL_STATE           Message_Type = 231
+	Message_ONRR_READ                       Message_Type = 240
+	Message_ONRR_READ_RETURN                Message_Type = 241
+	Message_ONRR_WRITE                      Message_Type = 242
+	Message_ONRR_WRITE_RETURN               Message_Type = 243
+	Message_ONRR_INTERNAL_READ              Message_Type = 244
+	Message_ONRR_INTERNAL_VALUE             Message_Type = 245
+	Message_ONRR_INTERNAL_WRITE             Message_Type = 246
+	Message_ONRR_INTERNAL_ACK               Message_Type = 247
+	Message_ONAR_READ                       Message_Type = 250
+	Message_ONAR_READ_RETURN                Message_Type = 251
+	Message_ONAR_WRITE                      Message_Type = 252
+	Message_ONAR_WRITE_RETURN               Message_Type = 253
+	Message_APP_LOG_APPEND                  Message_Type = 260
+	Message_APP_LOG_APPENDED                Message_Type = 261
+	Message_APP_LOG_COMMITTED               Message_Type = 262
+	Message_LOG_APPEND                      Message_Type = 270
+	Message_LOG_APPENDED                    Message_Type = 271
+	Message_LOG_COMMITTED                   Message_Type = 272
+	Message_LOG_INTERNAL_FORWARD            Message_Type = 273
+	Message_LOG_INTERNAL_PREPARE            Message_Type = 274
+	Message_LOG_INTERNAL_PROMISE            Message_Type = 275
+	Message_LOG_INTERNAL_ACCEPT             Message_Type = 276
+	Message_LOG_INTERNAL_ACCEPTED           Message_Type = 277
+	Message_LOG_INTERNAL_COMMIT             Message_Type = 278
+	Message_RAFT_TIMEOUT                    Message_Type = 280
+	Message_RAFT_INTERNAL_REQUEST_VOTE      Message_Type = 281
+	Message_RAFT_INTERNAL_VOTE              Message_Type = 282
+	Message_RAFT_INTERNAL_APPEND_ENTRIES    Message_Type = 283
+	Message_RAFT_INTERNAL_APPEND_RESULT     Message_Type = 284
+	Message_RAFT_INTERNAL_FORWARD           Message_Type = 285
+	Message_APP_RC_PROPOSE                  Message_Type = 290
+	Message_APP_RC_DECIDE                   Message_Type = 291
+	Message_RC_INTERNAL_PROPOSAL            Message_Type = 292
+	Message_BC_INTERNAL_PHASE               Message_Type = 293
+	Message_BC_INTERNAL_DECIDED             Message_Type = 294
+	Message_FLOODING_INTERNAL_PROPOSAL      Message_Type = 300
+	Message_FLOODING_INTERNAL_DECIDED       Message_Type = 301
+	Message_HIERARCHICAL_INTERNAL_PROPOSAL  Message_Type = 302
+	Message_HIERARCHICAL_INTERNAL_ACK       Message_Type = 303
+	Message_HIERARCHICAL_INTERNAL_DECIDED   Message_Type = 304
+	Message_APP_BCB_BROADCAST               Message_Type = 310
+	Message_APP_BRB_BROADCAST               Message_Type = 311
+	Message_BCB_BROADCAST                   Message_Type = 312
+	Message_BCB_DELIVER                     Message_Type = 313
+	Message_BRB_BROADCAST                   Message_Type = 314
+	Message_BRB_DELIVER                     Message_Type = 315
+	Message_BYZANTINE_INTERNAL_SEND         Message_Type = 316
+	Message_BYZANTINE_INTERNAL_ECHO         Message_Type = 317
+	Message_BYZANTINE_INTERNAL_READY        Message_Type = 318
 )
 
 // Enum value maps for Message_Type.
 var (
 	Message_Type_name = map[int32]string{
-		0:  "NETWORK_MESSAGE",
-		1:  "PROC_REGISTRATION",
-		2:  "PROC_INITIALIZE_SYSTEM",
-		3:  "PROC_DESTROY_SYSTEM",
-		4:  "APP_BROADCAST",
-		5:  "APP_VALUE",
-		6:  "APP_DECIDE",
-		7:  "APP_PROPOSE",
-		8:  "APP_READ",
-		9:  "APP_WRITE",
-		10: "APP_READ_RETURN",
-		11: "APP_WRITE_RETURN",
-		20: "UC_DECIDE",
-		21: "UC_PROPOSE",
-		30: "EP_ABORT",
-		31: "EP_ABORTED",
-		32: "EP_DECIDE",
-		33: "EP_INTERNAL_ACCEPT",
-		34: "EP_INTERNAL_DECIDED",
-		35: "EP_INTERNAL_READ",
-		36: "EP_INTERNAL_STATE",
-		37: "EP_INTERNAL_WRITE",
-		38: "EP_PROPOSE",
-		40: "EC_INTERNAL_NACK",
-		41: "EC_INTERNAL_NEW_EPOCH",
-		42: "EC_START_EPOCH",
-		50: "BEB_BROADCAST",
-		51: "BEB_DELIVER",
-		60: "ELD_TIMEOUT",
-		61: "ELD_TRUST",
-		70: "NNAR_INTERNAL_ACK",
-		71: "NNAR_INTERNAL_READ",
-		72: "NNAR_INTERNAL_VALUE",
-		73: "NNAR_INTERNAL_WRITE",
-		74: "NNAR_READ",
-		75: "NNAR_READ_RETURN",
-		76: "NNAR_WRITE",
-		77: "NNAR_WRITE_RETURN",
-		80: "EPFD_INTERNAL_HEARTBEAT_REPLY",
-		81: "EPFD_INTERNAL_HEARTBEAT_REQUEST",
-		82: "EPFD_RESTORE",
-		83: "EPFD_SUSPECT",
-		84: "EPFD_TIMEOUT",
-		90: "PL_DELIVER",
-		91: "PL_SEND",
+		0:   "NETWORK_MESSAGE",
+		1:   "PROC_REGISTRATION",
+		2:   "PROC_INITIALIZE_SYSTEM",
+		3:   "PROC_DESTROY_SYSTEM",
+		4:   "APP_BROADCAST",
+		5:   "APP_VALUE",
+		6:   "APP_DECIDE",
+		7:   "APP_PROPOSE",
+		8:   "APP_READ",
+		9:   "APP_WRITE",
+		10:  "APP_READ_RETURN",
+		11:  "APP_WRITE_RETURN",
+		12:  "APP_RELIABLE_BROADCAST",
+		13:  "APP_UNIFORM_BROADCAST",
+		14:  "APP_FIFO_BROADCAST",
+		15:  "APP_CAUSAL_BROADCAST",
+		16:  "APP_PROBABILISTIC_BROADCAST",
+		17:  "APP_TOB_BROADCAST",
+		18:  "APP_TOB_DELIVER",
+		19:  "APP_TRB",
+		22:  "APP_TRB_DELIVER",
+		23:  "APP_NBAC_PROPOSE",
+		24:  "APP_NBAC_DECIDE",
+		25:  "APP_COMMIT",
+		26:  "APP_COMMIT_OUTCOME",
+		27:  "APP_VS_BROADCAST",
+		28:  "APP_VS_DELIVER",
+		29:  "APP_VIEW",
+		20:  "UC_DECIDE",
+		21:  "UC_PROPOSE",
+		30:  "EP_ABORT",
+		31:  "EP_ABORTED",
+		32:  "EP_DECIDE",
+		33:  "EP_INTERNAL_ACCEPT",
+		34:  "EP_INTERNAL_DECIDED",
+		35:  "EP_INTERNAL_READ",
+		36:  "EP_INTERNAL_STATE",
+		37:  "EP_INTERNAL_WRITE",
+		38:  "EP_PROPOSE",
+		40:  "EC_INTERNAL_NACK",
+		41:  "EC_INTERNAL_NEW_EPOCH",
+		42:  "EC_START_EPOCH",
+		50:  "BEB_BROADCAST",
+		51:  "BEB_DELIVER",
+		60:  "ELD_TIMEOUT",
+		61:  "ELD_TRUST",
+		70:  "NNAR_INTERNAL_ACK",
+		71:  "NNAR_INTERNAL_READ",
+		72:  "NNAR_INTERNAL_VALUE",
+		73:  "NNAR_INTERNAL_WRITE",
+		74:  "NNAR_READ",
+		75:  "NNAR_READ_RETURN",
+		76:  "NNAR_WRITE",
+		77:  "NNAR_WRITE_RETURN",
+		80:  "EPFD_INTERNAL_HEARTBEAT_REPLY",
+		81:  "EPFD_INTERNAL_HEARTBEAT_REQUEST",
+		82:  "EPFD_RESTORE",
+		83:  "EPFD_SUSPECT",
+		84:  "EPFD_TIMEOUT",
+		90:  "PL_DELIVER",
+		91:  "PL_SEND",
+		100: "PFD_CRASH",
+		101: "PFD_INTERNAL_HEARTBEAT_REPLY",
+		102: "PFD_INTERNAL_HEARTBEAT_REQUEST",
+		103: "PFD_TIMEOUT",
+		110: "LE_LEADER",
+		111: "APP_ELECT",
+		112: "APP_LEADER",
+		113: "LE_START",
+		120: "RB_BROADCAST",
+		121: "RB_DELIVER",
+		122: "RB_INTERNAL_DATA",
+		130: "URB_BROADCAST",
+		131: "URB_DELIVER",
+		132: "URB_INTERNAL_DATA",
+		140: "FRB_BROADCAST",
+		141: "FRB_DELIVER",
+		142: "FRB_INTERNAL_DATA",
+		150: "CRB_BROADCAST",
+		151: "CRB_DELIVER",
+		152: "CRB_INTERNAL_DATA",
+		160: "PB_BROADCAST",
+		161: "PB_DELIVER",
+		162: "PB_INTERNAL_GOSSIP",
+		163: "PB_INTERNAL_REQUEST",
+		164: "PB_INTERNAL_DATA",
+		165: "PB_TIMEOUT",
+		170: "TOB_BROADCAST",
+		171: "TOB_DELIVER",
+		172: "TOB_INTERNAL_DATA",
+		180: "TRB_BROADCAST",
+		181: "TRB_DELIVER",
+		182: "TRB_INTERNAL_DATA",
+		190: "NBAC_PROPOSE",
+		191: "NBAC_DECIDE",
+		192: "NBAC_INTERNAL_VOTE",
+		200: "COMMIT_START",
+		201: "COMMIT_OUTCOME",
+		202: "COMMIT_INTERNAL_PREPARE",
+		203: "COMMIT_INTERNAL_VOTE",
+		204: "COMMIT_INTERNAL_PRECOMMIT",
+		205: "COMMIT_INTERNAL_ACK",
+		206: "COMMIT_INTERNAL_DECISION",
+		207: "COMMIT_INTERNAL_STATE",
+		210: "GM_VIEW",
+		211: "GM_RECONFIGURE",
+		220: "VS_BROADCAST",
+		221: "VS_DELIVER",
+		222: "VS_VIEW",
+		223: "VS_INTERNAL_DATA",
+		224: "VS_INTERNAL_PENDING",
+		230: "PROC_RECONFIGURE_SYSTEM",
+		231: "RECONF_INTERNAL_STATE",
+		240: "ONRR_READ",
+		241: "ONRR_READ_RETURN",
+		242: "ONRR_WRITE",
+		243: "ONRR_WRITE_RETURN",
+		244: "ONRR_INTERNAL_READ",
+		245: "ONRR_INTERNAL_VALUE",
+		246: "ONRR_INTERNAL_WRITE",
+		247: "ONRR_INTERNAL_ACK",
+		250: "ONAR_READ",
+		251: "ONAR_READ_RETURN",
+		252: "ONAR_WRITE",
+		253: "ONAR_WRITE_RETURN",
+		260: "APP_LOG_APPEND",
+		261: "APP_LOG_APPENDED",
+		262: "APP_LOG_COMMITTED",
+		270: "LOG_APPEND",
+		271: "LOG_APPENDED",
+		272: "LOG_COMMITTED",
+		273: "LOG_INTERNAL_FORWARD",
+		274: "LOG_INTERNAL_PREPARE",
+		275: "LOG_INTERNAL_PROMISE",
+		276: "LOG_INTERNAL_ACCEPT",
+		277: "LOG_INTERNAL_ACCEPTED",
+		278: "LOG_INTERNAL_COMMIT",
+		280: "RAFT_TIMEOUT",
+		281: "RAFT_INTERNAL_REQUEST_VOTE",
+		282: "RAFT_INTERNAL_VOTE",
+		283: "RAFT_INTERNAL_APPEND_ENTRIES",
+		284: "RAFT_INTERNAL_APPEND_RESULT",
+		285: "RAFT_INTERNAL_FORWARD",
+		290: "APP_RC_PROPOSE",
+		291: "APP_RC_DECIDE",
+		292: "RC_INTERNAL_PROPOSAL",
+		293: "BC_INTERNAL_PHASE",
+		294: "BC_INTERNAL_DECIDED",
+		300: "FLOODING_INTERNAL_PROPOSAL",
+		301: "FLOODING_INTERNAL_DECIDED",
+		302: "HIERARCHICAL_INTERNAL_PROPOSAL",
+		303: "HIERARCHICAL_INTERNAL_ACK",
+		304: "HIERARCHICAL_INTERNAL_DECIDED",
+		310: "APP_BCB_BROADCAST",
+		311: "APP_BRB_BROADCAST",
+		312: "BCB_BROADCAST",
+		313: "BCB_DELIVER",
+		314: "BRB_BROADCAST",
+		315: "BRB_DELIVER",
+		316: "BYZANTINE_INTERNAL_SEND",
+		317: "BYZANTINE_INTERNAL_ECHO",
+		318: "BYZANTINE_INTERNAL_READY",
 	}
 	Message_Type_value = map[string]int32{
 		"NETWORK_MESSAGE":                 0,
@@ -132,6 +467,22 @@ var (
 		"APP_WRITE":                       9,
 		"APP_READ_RETURN":                 10,
 		"APP_WRITE_RETURN":                11,
+		"APP_RELIABLE_BROADCAST":          12,
+		"APP_UNIFORM_BROADCAST":           13,
+		"APP_FIFO_BROADCAST":              14,
+		"APP_CAUSAL_BROADCAST":            15,
+		"APP_PROBABILISTIC_BROADCAST":     16,
+		"APP_TOB_BROADCAST":               17,
+		"APP_TOB_DELIVER":                 18,
+		"APP_TRB":                         19,
+		"APP_TRB_DELIVER":                 22,
+		"APP_NBAC_PROPOSE":                23,
+		"APP_NBAC_DECIDE":                 24,
+		"APP_COMMIT":                      25,
+		"APP_COMMIT_OUTCOME":              26,
+		"APP_VS_BROADCAST":                27,
+		"APP_VS_DELIVER":                  28,
+		"APP_VIEW":                        29,
 		"UC_DECIDE":                       20,
 		"UC_PROPOSE":                      21,
 		"EP_ABORT":                        30,
@@ -165,6 +516,107 @@ var (
 		"EPFD_TIMEOUT":                    84,
 		"PL_DELIVER":                      90,
 		"PL_SEND":                         91,
+		"PFD_CRASH":                       100,
+		"PFD_INTERNAL_HEARTBEAT_REPLY":    101,
+		"PFD_INTERNAL_HEARTBEAT_REQUEST":  102,
+		"PFD_TIMEOUT":                     103,
+		"LE_LEADER":                       110,
+		"APP_ELECT":                       111,
+		"APP_LEADER":                      112,
+		"LE_START":                        113,
+		"RB_BROADCAST":                    120,
+		"RB_DELIVER":                      121,
+		"RB_INTERNAL_DATA":                122,
+		"URB_BROADCAST":                   130,
+		"URB_DELIVER":                     131,
+		"URB_INTERNAL_DATA":               132,
+		"FRB_BROADCAST":                   140,
+		"FRB_DELIVER":                     141,
+		"FRB_INTERNAL_DATA":               142,
+		"CRB_BROADCAST":                   150,
+		"CRB_DELIVER":                     151,
+		"CRB_INTERNAL_DATA":               152,
+		"PB_BROADCAST":                    160,
+		"PB_DELIVER":                      161,
+		"PB_INTERNAL_GOSSIP":              162,
+		"PB_INTERNAL_REQUEST":             163,
+		"PB_INTERNAL_DATA":                164,
+		"PB_TIMEOUT":                      165,
+		"TOB_BROADCAST":                   170,
+		"TOB_DELIVER":                     171,
+		"TOB_INTERNAL_DATA":               172,
+		"TRB_BROADCAST":                   180,
+		"TRB_DELIVER":                     181,
+		"TRB_INTERNAL_DATA":               182,
+		"NBAC_PROPOSE":                    190,
+		"NBAC_DECIDE":                     191,
+		"NBAC_INTERNAL_VOTE":              192,
+		"COMMIT_START":                    200,
+		"COMMIT_OUTCOME":                  201,
+		"COMMIT_INTERNAL_PREPARE":         202,
+		"COMMIT_INTERNAL_VOTE":            203,
+		"COMMIT_INTERNAL_PRECOMMIT":       204,
+		"COMMIT_INTERNAL_ACK":             205,
+		"COMMIT_INTERNAL_DECISION":        206,
+		"COMMIT_INTERNAL_STATE":           207,
+		"GM_VIEW":                         210,
+		"GM_RECONFIGURE":                  211,
+		"VS_BROADCAST":                    220,
+		"VS_DELIVER":                      221,
+		"VS_VIEW":                         222,
+		"VS_INTERNAL_DATA":                223,
+		"VS_INTERNAL_PENDING":             224,
+		"PROC_RECONFIGURE_SYSTEM":         230,
+		"RECONF_INTERNAL_STATE":           231,
+		"ONRR_READ":                       240,
+		"ONRR_READ_RETURN":                241,
+		"ONRR_WRITE":                      242,
+		"ONRR_WRITE_RETURN":               243,
+		"ONRR_INTERNAL_READ":              244,
+		"ONRR_INTERNAL_VALUE":             245,
+		"ONRR_INTERNAL_WRITE":             246,
+		"ONRR_INTERNAL_ACK":               247,
+		"ONAR_READ":                       250,
+		"ONAR_READ_RETURN":                251,
+		"ONAR_WRITE":                      252,
+		"ONAR_WRITE_RETURN":               253,
+		"APP_LOG_APPEND":                  260,
+		"APP_LOG_APPENDED":                261,
+		"APP_LOG_COMMITTED":               262,
+		"LOG_APPEND":                      270,
+		"LOG_APPENDED":                    271,
+		"LOG_COMMITTED":                   272,
+		"LOG_INTERNAL_FORWARD":            273,
+		"LOG_INTERNAL_PREPARE":            274,
+		"LOG_INTERNAL_PROMISE":            275,
+		"LOG_INTERNAL_ACCEPT":             276,
+		"LOG_INTERNAL_ACCEPTED":           277,
+		"LOG_INTERNAL_COMMIT":             278,
+		"RAFT_TIMEOUT":                    280,
+		"RAFT_INTERNAL_REQUEST_VOTE":      281,
+		"RAFT_INTERNAL_VOTE":              282,
+		"RAFT_INTERNAL_APPEND_ENTRIES":    283,
+		"RAFT_INTERNAL_APPEND_RESULT":     284,
+		"RAFT_INTERNAL_FORWARD":           285,
+		"APP_RC_PROPOSE":                  290,
+		"APP_RC_DECIDE":                   291,
+		"RC_INTERNAL_PROPOSAL":            292,
+		"BC_INTERNAL_PHASE":               293,
+		"BC_INTERNAL_DECIDED":             294,
+		"FLOODING_INTERNAL_PROPOSAL":      300,
+		"FLOODING_INTERNAL_DECIDED":       301,
+		"HIERARCHICAL_INTERNAL_PROPOSAL":  302,
+		"HIERARCHICAL_INTERNAL_ACK":       303,
+		"HIERARCHICAL_INTERNAL_DECIDED":   304,
+		"APP_BCB_BROADCAST":               310,
+		"APP_BRB_BROADCAST":               311,
+		"BCB_BROADCAST":                   312,
+		"BCB_DELIVER":                     313,
+		"BRB_BROADCAST":                   314,
+		"BRB_DELIVER":                     315,
+		"BYZANTINE_INTERNAL_SEND":         316,
+		"BYZANTINE_INTERNAL_ECHO":         317,
+		"BYZANTINE_INTERNAL_READY":        318,
 	}
 )
 
@@ -179,11 +631,11 @@ func (x Message_Type) String() string {
 }
 
 func (Message_Type) Descriptor() protoreflect.EnumDescriptor {
-	return file_messages_proto_enumTypes[0].Descriptor()
+	return file_messages_proto_enumTypes[2].Descriptor()
 }
 
 func (Message_Type) Type() protoreflect.EnumType {
-	return &file_messages_proto_enumTypes[0]
+	return &file_messages_proto_enumTypes[2]
 }
 
 func (x Message_Type) Number() protoreflect.EnumNumber {
@@ -192,7 +644,7 @@ func (x Message_Type) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use Message_Type.Descriptor instead.
 func (Message_Type) EnumDescriptor() ([]byte, []int) {
-	return file_messages_proto_rawDescGZIP(), []int{47, 0}
+	return file_messages_proto_rawDescGZIP(), []int{174, 0}
 }
 
 // Data structures
@@ -280,8 +732,12 @@ type Value struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Defined bool  `protobuf:"varint,1,opt,name=defined,proto3" json:"defined,omitempty"`
-	V       int32 `protobuf:"varint,2,opt,name=v,proto3" json:"v,omitempty"` // Value; ignore if defined == false
+	Defined     bool      `protobuf:"varint,1,opt,name=defined,proto3" json:"defined,omitempty"`
+	V           int32     `protobuf:"varint,2,opt,name=v,proto3" json:"v,omitempty"`                         // Value; ignore if defined == false or type != INT32
+	Type        ValueType `protobuf:"varint,5,opt,name=type,proto3,enum=pb.ValueType" json:"type,omitempty"` // Field holding the value, v by default so that the values of the reference hub keep their meaning
+	BytesValue  []byte    `protobuf:"bytes,6,opt,name=bytesValue,proto3" json:"bytesValue,omitempty"`
+	StringValue string    `protobuf:"bytes,7,opt,name=stringValue,proto3" json:"stringValue,omitempty"`
+	Int64Value  int64     `protobuf:"varint,8,opt,name=int64Value,proto3" json:"int64Value,omitempty"`
 }
 
 func (x *Value) Reset() {
@@ -330,6 +786,34 @@ func (x *Value) GetV() int32 {
 	return 0
 }
 
+func (x *Value) GetType() ValueType {
+	if x != nil {
+		return x.Type
+	}
+	return ValueType_INT32
+}
+
+func (x *Value) GetBytesValue() []byte {
+	if x != nil {
+		return x.BytesValue
+	}
+	return nil
+}
+
+func (x *Value) GetStringValue() string {
+	if x != nil {
+		return x.StringValue
+	}
+	return ""
+}
+
+func (x *Value) GetInt64Value() int64 {
+	if x != nil {
+		return x.Int64Value
+	}
+	return 0
+}
+
 // Messages and events
 // Process
 type ProcRegistration struct {
@@ -394,7 +878,8 @@ type ProcInitializeSystem struct {
 	unknownFields protoimpl.UnknownFields
 
 	// be handled by the process.
-	Processes []*ProcessId `protobuf:"bytes,1,rep,name=processes,proto3" json:"processes,omitempty"` // List of processes involved in the consensus algorithm (PI set)
+	Processes []*ProcessId      `protobuf:"bytes,1,rep,name=processes,proto3" json:"processes,omitempty"`                                                                                       // List of processes involved in the consensus algorithm (PI set)
+	Settings  map[string]string `protobuf:"bytes,2,rep,name=settings,proto3" json:"settings,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"` // Optional overrides of the process settings (e.g. rb_algorithm=lazy) for this system
 }
 
 func (x *ProcInitializeSystem) Reset() {
@@ -436,6 +921,13 @@ func (x *ProcInitializeSystem) GetProcesses() []*ProcessId {
 	return nil
 }
 
+func (x *ProcInitializeSystem) GetSettings() map[string]string {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
 type ProcDestroySystem struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -474,18 +966,20 @@ func (*ProcDestroySystem) Descriptor() ([]byte, []int) {
 	return file_messages_proto_rawDescGZIP(), []int{4}
 }
 
-// APP, asteau au deaface cu bebx
-type AppBroadcast struct {
+type ProcReconfigureSystem struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Triggers a BEB broadcast containing AppValue populated with the value from AppBroadcast
-	Value *Value `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	// members of the current and of the next configuration. The processes joining create
+	// the system from it, the others ask app.gm to install the next configuration
+	Processes []*ProcessId      `protobuf:"bytes,1,rep,name=processes,proto3" json:"processes,omitempty"`                                                                                       // Next configuration
+	Previous  []*ProcessId      `protobuf:"bytes,2,rep,name=previous,proto3" json:"previous,omitempty"`                                                                                         // Current configuration
+	Settings  map[string]string `protobuf:"bytes,3,rep,name=settings,proto3" json:"settings,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"` // Settings of the system, for the processes joining
 }
 
-func (x *AppBroadcast) Reset() {
-	*x = AppBroadcast{}
+func (x *ProcReconfigureSystem) Reset() {
+	*x = ProcReconfigureSystem{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_messages_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -493,13 +987,13 @@ func (x *AppBroadcast) Reset() {
 	}
 }
 
-func (x *AppBroadcast) String() string {
+func (x *ProcReconfigureSystem) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AppBroadcast) ProtoMessage() {}
+func (*ProcReconfigureSystem) ProtoMessage() {}
 
-func (x *AppBroadcast) ProtoReflect() protoreflect.Message {
+func (x *ProcReconfigureSystem) ProtoReflect() protoreflect.Message {
 	mi := &file_messages_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -511,29 +1005,44 @@ func (x *AppBroadcast) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AppBroadcast.ProtoReflect.Descriptor instead.
-func (*AppBroadcast) Descriptor() ([]byte, []int) {
+// Deprecated: Use ProcReconfigureSystem.ProtoReflect.Descriptor instead.
+func (*ProcReconfigureSystem) Descriptor() ([]byte, []int) {
 	return file_messages_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *AppBroadcast) GetValue() *Value {
+func (x *ProcReconfigureSystem) GetProcesses() []*ProcessId {
 	if x != nil {
-		return x.Value
+		return x.Processes
 	}
 	return nil
 }
 
-type AppValue struct {
+func (x *ProcReconfigureSystem) GetPrevious() []*ProcessId {
+	if x != nil {
+		return x.Previous
+	}
+	return nil
+}
+
+func (x *ProcReconfigureSystem) GetSettings() map[string]string {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+// APP, asteau au deaface cu bebx
+type AppBroadcast struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Message(NetworkMessage(Message(AppValue)))
+	// Triggers a BEB broadcast containing AppValue populated with the value from AppBroadcast
 	Value *Value `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
 }
 
-func (x *AppValue) Reset() {
-	*x = AppValue{}
+func (x *AppBroadcast) Reset() {
+	*x = AppBroadcast{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_messages_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -541,13 +1050,13 @@ func (x *AppValue) Reset() {
 	}
 }
 
-func (x *AppValue) String() string {
+func (x *AppBroadcast) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AppValue) ProtoMessage() {}
+func (*AppBroadcast) ProtoMessage() {}
 
-func (x *AppValue) ProtoReflect() protoreflect.Message {
+func (x *AppBroadcast) ProtoReflect() protoreflect.Message {
 	mi := &file_messages_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -559,29 +1068,29 @@ func (x *AppValue) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AppValue.ProtoReflect.Descriptor instead.
-func (*AppValue) Descriptor() ([]byte, []int) {
+// Deprecated: Use AppBroadcast.ProtoReflect.Descriptor instead.
+func (*AppBroadcast) Descriptor() ([]byte, []int) {
 	return file_messages_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *AppValue) GetValue() *Value {
+func (x *AppBroadcast) GetValue() *Value {
 	if x != nil {
 		return x.Value
 	}
 	return nil
 }
 
-type AppPropose struct {
+type AppReliableBroadcast struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Topic string `protobuf:"bytes,1,opt,name=topic,proto3" json:"topic,omitempty"` // The topic on which to reach consensus. There will be a consensus instance for each topic
-	Value *Value `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"` // Random value chosen by the HUB
+	// Same as AppBroadcast through RB, the deliveries are sent to the HUB as AppValue
+	Value *Value `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
 }
 
-func (x *AppPropose) Reset() {
-	*x = AppPropose{}
+func (x *AppReliableBroadcast) Reset() {
+	*x = AppReliableBroadcast{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_messages_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -589,13 +1098,13 @@ func (x *AppPropose) Reset() {
 	}
 }
 
-func (x *AppPropose) String() string {
+func (x *AppReliableBroadcast) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AppPropose) ProtoMessage() {}
+func (*AppReliableBroadcast) ProtoMessage() {}
 
-func (x *AppPropose) ProtoReflect() protoreflect.Message {
+func (x *AppReliableBroadcast) ProtoReflect() protoreflect.Message {
 	mi := &file_messages_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -607,35 +1116,29 @@ func (x *AppPropose) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AppPropose.ProtoReflect.Descriptor instead.
-func (*AppPropose) Descriptor() ([]byte, []int) {
+// Deprecated: Use AppReliableBroadcast.ProtoReflect.Descriptor instead.
+func (*AppReliableBroadcast) Descriptor() ([]byte, []int) {
 	return file_messages_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *AppPropose) GetTopic() string {
-	if x != nil {
-		return x.Topic
-	}
-	return ""
-}
-
-func (x *AppPropose) GetValue() *Value {
+func (x *AppReliableBroadcast) GetValue() *Value {
 	if x != nil {
 		return x.Value
 	}
 	return nil
 }
 
-type AppDecide struct {
+type AppUniformBroadcast struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Value *Value `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"` // Decided value taken from UcDecide
+	// Same as AppBroadcast through URB, the deliveries are sent to the HUB as AppValue
+	Value *Value `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
 }
 
-func (x *AppDecide) Reset() {
-	*x = AppDecide{}
+func (x *AppUniformBroadcast) Reset() {
+	*x = AppUniformBroadcast{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_messages_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -643,13 +1146,13 @@ func (x *AppDecide) Reset() {
 	}
 }
 
-func (x *AppDecide) String() string {
+func (x *AppUniformBroadcast) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AppDecide) ProtoMessage() {}
+func (*AppUniformBroadcast) ProtoMessage() {}
 
-func (x *AppDecide) ProtoReflect() protoreflect.Message {
+func (x *AppUniformBroadcast) ProtoReflect() protoreflect.Message {
 	mi := &file_messages_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -661,28 +1164,29 @@ func (x *AppDecide) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AppDecide.ProtoReflect.Descriptor instead.
-func (*AppDecide) Descriptor() ([]byte, []int) {
+// Deprecated: Use AppUniformBroadcast.ProtoReflect.Descriptor instead.
+func (*AppUniformBroadcast) Descriptor() ([]byte, []int) {
 	return file_messages_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *AppDecide) GetValue() *Value {
+func (x *AppUniformBroadcast) GetValue() *Value {
 	if x != nil {
 		return x.Value
 	}
 	return nil
 }
 
-type AppRead struct {
+type AppFifoBroadcast struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Register string `protobuf:"bytes,1,opt,name=register,proto3" json:"register,omitempty"`
+	// Same as AppBroadcast through FRB, the deliveries are sent to the HUB as AppValue
+	Value *Value `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
 }
 
-func (x *AppRead) Reset() {
-	*x = AppRead{}
+func (x *AppFifoBroadcast) Reset() {
+	*x = AppFifoBroadcast{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_messages_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -690,13 +1194,13 @@ func (x *AppRead) Reset() {
 	}
 }
 
-func (x *AppRead) String() string {
+func (x *AppFifoBroadcast) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AppRead) ProtoMessage() {}
+func (*AppFifoBroadcast) ProtoMessage() {}
 
-func (x *AppRead) ProtoReflect() protoreflect.Message {
+func (x *AppFifoBroadcast) ProtoReflect() protoreflect.Message {
 	mi := &file_messages_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -708,29 +1212,29 @@ func (x *AppRead) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AppRead.ProtoReflect.Descriptor instead.
-func (*AppRead) Descriptor() ([]byte, []int) {
+// Deprecated: Use AppFifoBroadcast.ProtoReflect.Descriptor instead.
+func (*AppFifoBroadcast) Descriptor() ([]byte, []int) {
 	return file_messages_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *AppRead) GetRegister() string {
+func (x *AppFifoBroadcast) GetValue() *Value {
 	if x != nil {
-		return x.Register
+		return x.Value
 	}
-	return ""
+	return nil
 }
 
-type AppWrite struct {
+type AppCausalBroadcast struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Register string `protobuf:"bytes,1,opt,name=register,proto3" json:"register,omitempty"`
-	Value    *Value `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"` // Value to write in the register
+	// Same as AppBroadcast through CRB, the deliveries are sent to the HUB as AppValue
+	Value *Value `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
 }
 
-func (x *AppWrite) Reset() {
-	*x = AppWrite{}
+func (x *AppCausalBroadcast) Reset() {
+	*x = AppCausalBroadcast{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_messages_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -738,13 +1242,13 @@ func (x *AppWrite) Reset() {
 	}
 }
 
-func (x *AppWrite) String() string {
+func (x *AppCausalBroadcast) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AppWrite) ProtoMessage() {}
+func (*AppCausalBroadcast) ProtoMessage() {}
 
-func (x *AppWrite) ProtoReflect() protoreflect.Message {
+func (x *AppCausalBroadcast) ProtoReflect() protoreflect.Message {
 	mi := &file_messages_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -756,36 +1260,29 @@ func (x *AppWrite) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AppWrite.ProtoReflect.Descriptor instead.
-func (*AppWrite) Descriptor() ([]byte, []int) {
+// Deprecated: Use AppCausalBroadcast.ProtoReflect.Descriptor instead.
+func (*AppCausalBroadcast) Descriptor() ([]byte, []int) {
 	return file_messages_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *AppWrite) GetRegister() string {
-	if x != nil {
-		return x.Register
-	}
-	return ""
-}
-
-func (x *AppWrite) GetValue() *Value {
+func (x *AppCausalBroadcast) GetValue() *Value {
 	if x != nil {
 		return x.Value
 	}
 	return nil
 }
 
-type AppReadReturn struct {
+type AppProbabilisticBroadcast struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Register string `protobuf:"bytes,1,opt,name=register,proto3" json:"register,omitempty"`
-	Value    *Value `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"` // Value read from the register
+	// Same as AppBroadcast through PB, the deliveries are sent to the HUB as AppValue
+	Value *Value `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
 }
 
-func (x *AppReadReturn) Reset() {
-	*x = AppReadReturn{}
+func (x *AppProbabilisticBroadcast) Reset() {
+	*x = AppProbabilisticBroadcast{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_messages_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -793,13 +1290,13 @@ func (x *AppReadReturn) Reset() {
 	}
 }
 
-func (x *AppReadReturn) String() string {
+func (x *AppProbabilisticBroadcast) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AppReadReturn) ProtoMessage() {}
+func (*AppProbabilisticBroadcast) ProtoMessage() {}
 
-func (x *AppReadReturn) ProtoReflect() protoreflect.Message {
+func (x *AppProbabilisticBroadcast) ProtoReflect() protoreflect.Message {
 	mi := &file_messages_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -811,35 +1308,29 @@ func (x *AppReadReturn) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AppReadReturn.ProtoReflect.Descriptor instead.
-func (*AppReadReturn) Descriptor() ([]byte, []int) {
+// Deprecated: Use AppProbabilisticBroadcast.ProtoReflect.Descriptor instead.
+func (*AppProbabilisticBroadcast) Descriptor() ([]byte, []int) {
 	return file_messages_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *AppReadReturn) GetRegister() string {
-	if x != nil {
-		return x.Register
-	}
-	return ""
-}
-
-func (x *AppReadReturn) GetValue() *Value {
+func (x *AppProbabilisticBroadcast) GetValue() *Value {
 	if x != nil {
 		return x.Value
 	}
 	return nil
 }
 
-type AppWriteReturn struct {
+type AppBcbBroadcast struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Register string `protobuf:"bytes,1,opt,name=register,proto3" json:"register,omitempty"`
+	// Same as AppBroadcast through BCB, the deliveries are sent to the HUB as AppValue
+	Value *Value `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
 }
 
-func (x *AppWriteReturn) Reset() {
-	*x = AppWriteReturn{}
+func (x *AppBcbBroadcast) Reset() {
+	*x = AppBcbBroadcast{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_messages_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -847,13 +1338,13 @@ func (x *AppWriteReturn) Reset() {
 	}
 }
 
-func (x *AppWriteReturn) String() string {
+func (x *AppBcbBroadcast) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AppWriteReturn) ProtoMessage() {}
+func (*AppBcbBroadcast) ProtoMessage() {}
 
-func (x *AppWriteReturn) ProtoReflect() protoreflect.Message {
+func (x *AppBcbBroadcast) ProtoReflect() protoreflect.Message {
 	mi := &file_messages_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -865,30 +1356,29 @@ func (x *AppWriteReturn) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AppWriteReturn.ProtoReflect.Descriptor instead.
-func (*AppWriteReturn) Descriptor() ([]byte, []int) {
+// Deprecated: Use AppBcbBroadcast.ProtoReflect.Descriptor instead.
+func (*AppBcbBroadcast) Descriptor() ([]byte, []int) {
 	return file_messages_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *AppWriteReturn) GetRegister() string {
+func (x *AppBcbBroadcast) GetValue() *Value {
 	if x != nil {
-		return x.Register
+		return x.Value
 	}
-	return ""
+	return nil
 }
 
-// UC
-// In the Init event or constructor, initialize l (leader) with the max-rank process in PI
-type UcPropose struct {
+type AppBrbBroadcast struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
+	// Same as AppBroadcast through BRB, the deliveries are sent to the HUB as AppValue
 	Value *Value `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
 }
 
-func (x *UcPropose) Reset() {
-	*x = UcPropose{}
+func (x *AppBrbBroadcast) Reset() {
+	*x = AppBrbBroadcast{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_messages_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -896,13 +1386,13 @@ func (x *UcPropose) Reset() {
 	}
 }
 
-func (x *UcPropose) String() string {
+func (x *AppBrbBroadcast) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UcPropose) ProtoMessage() {}
+func (*AppBrbBroadcast) ProtoMessage() {}
 
-func (x *UcPropose) ProtoReflect() protoreflect.Message {
+func (x *AppBrbBroadcast) ProtoReflect() protoreflect.Message {
 	mi := &file_messages_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -914,28 +1404,29 @@ func (x *UcPropose) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UcPropose.ProtoReflect.Descriptor instead.
-func (*UcPropose) Descriptor() ([]byte, []int) {
+// Deprecated: Use AppBrbBroadcast.ProtoReflect.Descriptor instead.
+func (*AppBrbBroadcast) Descriptor() ([]byte, []int) {
 	return file_messages_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *UcPropose) GetValue() *Value {
+func (x *AppBrbBroadcast) GetValue() *Value {
 	if x != nil {
 		return x.Value
 	}
 	return nil
 }
 
-type UcDecide struct {
+type AppTobBroadcast struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
+	// Triggers a TOB broadcast containing AppValue populated with the value from AppTobBroadcast
 	Value *Value `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
 }
 
-func (x *UcDecide) Reset() {
-	*x = UcDecide{}
+func (x *AppTobBroadcast) Reset() {
+	*x = AppTobBroadcast{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_messages_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -943,13 +1434,13 @@ func (x *UcDecide) Reset() {
 	}
 }
 
-func (x *UcDecide) String() string {
+func (x *AppTobBroadcast) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UcDecide) ProtoMessage() {}
+func (*AppTobBroadcast) ProtoMessage() {}
 
-func (x *UcDecide) ProtoReflect() protoreflect.Message {
+func (x *AppTobBroadcast) ProtoReflect() protoreflect.Message {
 	mi := &file_messages_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -961,27 +1452,30 @@ func (x *UcDecide) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UcDecide.ProtoReflect.Descriptor instead.
-func (*UcDecide) Descriptor() ([]byte, []int) {
+// Deprecated: Use AppTobBroadcast.ProtoReflect.Descriptor instead.
+func (*AppTobBroadcast) Descriptor() ([]byte, []int) {
 	return file_messages_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *UcDecide) GetValue() *Value {
+func (x *AppTobBroadcast) GetValue() *Value {
 	if x != nil {
 		return x.Value
 	}
 	return nil
 }
 
-// EP
-type EpAbort struct {
+type AppTobDeliver struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	SequenceNumber int32      `protobuf:"varint,1,opt,name=sequenceNumber,proto3" json:"sequenceNumber,omitempty"` // Position of the message in the total order, starting at 1
+	Sender         *ProcessId `protobuf:"bytes,2,opt,name=sender,proto3" json:"sender,omitempty"`
+	Value          *Value     `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
 }
 
-func (x *EpAbort) Reset() {
-	*x = EpAbort{}
+func (x *AppTobDeliver) Reset() {
+	*x = AppTobDeliver{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_messages_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -989,13 +1483,13 @@ func (x *EpAbort) Reset() {
 	}
 }
 
-func (x *EpAbort) String() string {
+func (x *AppTobDeliver) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EpAbort) ProtoMessage() {}
+func (*AppTobDeliver) ProtoMessage() {}
 
-func (x *EpAbort) ProtoReflect() protoreflect.Message {
+func (x *AppTobDeliver) ProtoReflect() protoreflect.Message {
 	mi := &file_messages_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1007,23 +1501,45 @@ func (x *EpAbort) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EpAbort.ProtoReflect.Descriptor instead.
-func (*EpAbort) Descriptor() ([]byte, []int) {
+// Deprecated: Use AppTobDeliver.ProtoReflect.Descriptor instead.
+func (*AppTobDeliver) Descriptor() ([]byte, []int) {
 	return file_messages_proto_rawDescGZIP(), []int{15}
 }
 
-type EpAborted struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *AppTobDeliver) GetSequenceNumber() int32 {
+	if x != nil {
+		return x.SequenceNumber
+	}
+	return 0
+}
 
-	Ets            int32  `protobuf:"varint,1,opt,name=ets,proto3" json:"ets,omitempty"`                       // Needed to know in UC the timestamp of the EP, where the algorithm says "such that ts = ets do"
-	ValueTimestamp int32  `protobuf:"varint,2,opt,name=valueTimestamp,proto3" json:"valueTimestamp,omitempty"` // The timestamp part of the "state" mentioned in the algorithm
-	Value          *Value `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`                    // The value part of the "state" mentioned in the algorithm
+func (x *AppTobDeliver) GetSender() *ProcessId {
+	if x != nil {
+		return x.Sender
+	}
+	return nil
 }
 
-func (x *EpAborted) Reset() {
-	*x = EpAborted{}
+func (x *AppTobDeliver) GetValue() *Value {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+type AppTrb struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// app.trb[topic] instance of the sender. Only the sender broadcasts the value
+	Topic  string     `protobuf:"bytes,1,opt,name=topic,proto3" json:"topic,omitempty"`
+	Sender *ProcessId `protobuf:"bytes,2,opt,name=sender,proto3" json:"sender,omitempty"`
+	Value  *Value     `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *AppTrb) Reset() {
+	*x = AppTrb{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_messages_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1031,13 +1547,13 @@ func (x *EpAborted) Reset() {
 	}
 }
 
-func (x *EpAborted) String() string {
+func (x *AppTrb) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EpAborted) ProtoMessage() {}
+func (*AppTrb) ProtoMessage() {}
 
-func (x *EpAborted) ProtoReflect() protoreflect.Message {
+func (x *AppTrb) ProtoReflect() protoreflect.Message {
 	mi := &file_messages_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1049,42 +1565,45 @@ func (x *EpAborted) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EpAborted.ProtoReflect.Descriptor instead.
-func (*EpAborted) Descriptor() ([]byte, []int) {
+// Deprecated: Use AppTrb.ProtoReflect.Descriptor instead.
+func (*AppTrb) Descriptor() ([]byte, []int) {
 	return file_messages_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *EpAborted) GetEts() int32 {
+func (x *AppTrb) GetTopic() string {
 	if x != nil {
-		return x.Ets
+		return x.Topic
 	}
-	return 0
+	return ""
 }
 
-func (x *EpAborted) GetValueTimestamp() int32 {
+func (x *AppTrb) GetSender() *ProcessId {
 	if x != nil {
-		return x.ValueTimestamp
+		return x.Sender
 	}
-	return 0
+	return nil
 }
 
-func (x *EpAborted) GetValue() *Value {
+func (x *AppTrb) GetValue() *Value {
 	if x != nil {
 		return x.Value
 	}
 	return nil
 }
 
-type EpPropose struct {
+type AppTrbDeliver struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Value *Value `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	Topic  string     `protobuf:"bytes,1,opt,name=topic,proto3" json:"topic,omitempty"`
+	Sender *ProcessId `protobuf:"bytes,2,opt,name=sender,proto3" json:"sender,omitempty"`
+	Value  *Value     `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`    // Ignore if failed == true
+	Failed bool       `protobuf:"varint,4,opt,name=failed,proto3" json:"failed,omitempty"` // The sender crashed before its value reached anyone
 }
 
-func (x *EpPropose) Reset() {
-	*x = EpPropose{}
+func (x *AppTrbDeliver) Reset() {
+	*x = AppTrbDeliver{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_messages_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1092,13 +1611,13 @@ func (x *EpPropose) Reset() {
 	}
 }
 
-func (x *EpPropose) String() string {
+func (x *AppTrbDeliver) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EpPropose) ProtoMessage() {}
+func (*AppTrbDeliver) ProtoMessage() {}
 
-func (x *EpPropose) ProtoReflect() protoreflect.Message {
+func (x *AppTrbDeliver) ProtoReflect() protoreflect.Message {
 	mi := &file_messages_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1110,29 +1629,51 @@ func (x *EpPropose) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EpPropose.ProtoReflect.Descriptor instead.
-func (*EpPropose) Descriptor() ([]byte, []int) {
+// Deprecated: Use AppTrbDeliver.ProtoReflect.Descriptor instead.
+func (*AppTrbDeliver) Descriptor() ([]byte, []int) {
 	return file_messages_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *EpPropose) GetValue() *Value {
+func (x *AppTrbDeliver) GetTopic() string {
+	if x != nil {
+		return x.Topic
+	}
+	return ""
+}
+
+func (x *AppTrbDeliver) GetSender() *ProcessId {
+	if x != nil {
+		return x.Sender
+	}
+	return nil
+}
+
+func (x *AppTrbDeliver) GetValue() *Value {
 	if x != nil {
 		return x.Value
 	}
 	return nil
 }
 
-type EpDecide struct {
+func (x *AppTrbDeliver) GetFailed() bool {
+	if x != nil {
+		return x.Failed
+	}
+	return false
+}
+
+type AppNbacPropose struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Ets   int32  `protobuf:"varint,1,opt,name=ets,proto3" json:"ets,omitempty"` // Needed to know in UC the timestamp of the EP, where the algorithm says "such that ts = ets do"
-	Value *Value `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	// in the app.nbac[topic] instance
+	Topic  string `protobuf:"bytes,1,opt,name=topic,proto3" json:"topic,omitempty"`
+	Commit bool   `protobuf:"varint,2,opt,name=commit,proto3" json:"commit,omitempty"` // Vote commit, otherwise abort
 }
 
-func (x *EpDecide) Reset() {
-	*x = EpDecide{}
+func (x *AppNbacPropose) Reset() {
+	*x = AppNbacPropose{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_messages_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1140,13 +1681,13 @@ func (x *EpDecide) Reset() {
 	}
 }
 
-func (x *EpDecide) String() string {
+func (x *AppNbacPropose) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EpDecide) ProtoMessage() {}
+func (*AppNbacPropose) ProtoMessage() {}
 
-func (x *EpDecide) ProtoReflect() protoreflect.Message {
+func (x *AppNbacPropose) ProtoReflect() protoreflect.Message {
 	mi := &file_messages_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1158,33 +1699,36 @@ func (x *EpDecide) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EpDecide.ProtoReflect.Descriptor instead.
-func (*EpDecide) Descriptor() ([]byte, []int) {
+// Deprecated: Use AppNbacPropose.ProtoReflect.Descriptor instead.
+func (*AppNbacPropose) Descriptor() ([]byte, []int) {
 	return file_messages_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *EpDecide) GetEts() int32 {
+func (x *AppNbacPropose) GetTopic() string {
 	if x != nil {
-		return x.Ets
+		return x.Topic
 	}
-	return 0
+	return ""
 }
 
-func (x *EpDecide) GetValue() *Value {
+func (x *AppNbacPropose) GetCommit() bool {
 	if x != nil {
-		return x.Value
+		return x.Commit
 	}
-	return nil
+	return false
 }
 
-type EpInternalRead struct {
+type AppNbacDecide struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Topic  string `protobuf:"bytes,1,opt,name=topic,proto3" json:"topic,omitempty"`
+	Commit bool   `protobuf:"varint,2,opt,name=commit,proto3" json:"commit,omitempty"` // Decided to commit, otherwise to abort
 }
 
-func (x *EpInternalRead) Reset() {
-	*x = EpInternalRead{}
+func (x *AppNbacDecide) Reset() {
+	*x = AppNbacDecide{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_messages_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1192,13 +1736,13 @@ func (x *EpInternalRead) Reset() {
 	}
 }
 
-func (x *EpInternalRead) String() string {
+func (x *AppNbacDecide) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EpInternalRead) ProtoMessage() {}
+func (*AppNbacDecide) ProtoMessage() {}
 
-func (x *EpInternalRead) ProtoReflect() protoreflect.Message {
+func (x *AppNbacDecide) ProtoReflect() protoreflect.Message {
 	mi := &file_messages_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1210,22 +1754,39 @@ func (x *EpInternalRead) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EpInternalRead.ProtoReflect.Descriptor instead.
-func (*EpInternalRead) Descriptor() ([]byte, []int) {
+// Deprecated: Use AppNbacDecide.ProtoReflect.Descriptor instead.
+func (*AppNbacDecide) Descriptor() ([]byte, []int) {
 	return file_messages_proto_rawDescGZIP(), []int{19}
 }
 
-type EpInternalState struct {
+func (x *AppNbacDecide) GetTopic() string {
+	if x != nil {
+		return x.Topic
+	}
+	return ""
+}
+
+func (x *AppNbacDecide) GetCommit() bool {
+	if x != nil {
+		return x.Commit
+	}
+	return false
+}
+
+type AppCommit struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ValueTimestamp int32  `protobuf:"varint,1,opt,name=valueTimestamp,proto3" json:"valueTimestamp,omitempty"`
-	Value          *Value `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	// takes part in the app.2pc[topic] or app.3pc[topic] transaction of the coordinator
+	Protocol    string     `protobuf:"bytes,1,opt,name=protocol,proto3" json:"protocol,omitempty"` // 2pc or 3pc
+	Topic       string     `protobuf:"bytes,2,opt,name=topic,proto3" json:"topic,omitempty"`
+	Coordinator *ProcessId `protobuf:"bytes,3,opt,name=coordinator,proto3" json:"coordinator,omitempty"`
+	Commit      bool       `protobuf:"varint,4,opt,name=commit,proto3" json:"commit,omitempty"` // Vote commit, otherwise abort
 }
 
-func (x *EpInternalState) Reset() {
-	*x = EpInternalState{}
+func (x *AppCommit) Reset() {
+	*x = AppCommit{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_messages_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1233,13 +1794,13 @@ func (x *EpInternalState) Reset() {
 	}
 }
 
-func (x *EpInternalState) String() string {
+func (x *AppCommit) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EpInternalState) ProtoMessage() {}
+func (*AppCommit) ProtoMessage() {}
 
-func (x *EpInternalState) ProtoReflect() protoreflect.Message {
+func (x *AppCommit) ProtoReflect() protoreflect.Message {
 	mi := &file_messages_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1251,35 +1812,53 @@ func (x *EpInternalState) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EpInternalState.ProtoReflect.Descriptor instead.
-func (*EpInternalState) Descriptor() ([]byte, []int) {
+// Deprecated: Use AppCommit.ProtoReflect.Descriptor instead.
+func (*AppCommit) Descriptor() ([]byte, []int) {
 	return file_messages_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *EpInternalState) GetValueTimestamp() int32 {
+func (x *AppCommit) GetProtocol() string {
 	if x != nil {
-		return x.ValueTimestamp
+		return x.Protocol
 	}
-	return 0
+	return ""
 }
 
-func (x *EpInternalState) GetValue() *Value {
+func (x *AppCommit) GetTopic() string {
 	if x != nil {
-		return x.Value
+		return x.Topic
+	}
+	return ""
+}
+
+func (x *AppCommit) GetCoordinator() *ProcessId {
+	if x != nil {
+		return x.Coordinator
 	}
 	return nil
 }
 
-type EpInternalWrite struct {
+func (x *AppCommit) GetCommit() bool {
+	if x != nil {
+		return x.Commit
+	}
+	return false
+}
+
+type AppCommitOutcome struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Value *Value `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	Protocol  string `protobuf:"bytes,1,opt,name=protocol,proto3" json:"protocol,omitempty"`
+	Topic     string `protobuf:"bytes,2,opt,name=topic,proto3" json:"topic,omitempty"`
+	Committed bool   `protobuf:"varint,3,opt,name=committed,proto3" json:"committed,omitempty"` // Ignore if blocked == true
+	Blocked   bool   `protobuf:"varint,4,opt,name=blocked,proto3" json:"blocked,omitempty"`     // The coordinator crashed while the process could not decide on its own (2pc only)
+	Latency   int64  `protobuf:"varint,5,opt,name=latency,proto3" json:"latency,omitempty"`     // Nanoseconds since the process learned about the transaction
 }
 
-func (x *EpInternalWrite) Reset() {
-	*x = EpInternalWrite{}
+func (x *AppCommitOutcome) Reset() {
+	*x = AppCommitOutcome{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_messages_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1287,13 +1866,13 @@ func (x *EpInternalWrite) Reset() {
 	}
 }
 
-func (x *EpInternalWrite) String() string {
+func (x *AppCommitOutcome) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EpInternalWrite) ProtoMessage() {}
+func (*AppCommitOutcome) ProtoMessage() {}
 
-func (x *EpInternalWrite) ProtoReflect() protoreflect.Message {
+func (x *AppCommitOutcome) ProtoReflect() protoreflect.Message {
 	mi := &file_messages_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1305,26 +1884,57 @@ func (x *EpInternalWrite) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EpInternalWrite.ProtoReflect.Descriptor instead.
-func (*EpInternalWrite) Descriptor() ([]byte, []int) {
+// Deprecated: Use AppCommitOutcome.ProtoReflect.Descriptor instead.
+func (*AppCommitOutcome) Descriptor() ([]byte, []int) {
 	return file_messages_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *EpInternalWrite) GetValue() *Value {
+func (x *AppCommitOutcome) GetProtocol() string {
 	if x != nil {
-		return x.Value
+		return x.Protocol
 	}
-	return nil
+	return ""
 }
 
-type EpInternalAccept struct {
+func (x *AppCommitOutcome) GetTopic() string {
+	if x != nil {
+		return x.Topic
+	}
+	return ""
+}
+
+func (x *AppCommitOutcome) GetCommitted() bool {
+	if x != nil {
+		return x.Committed
+	}
+	return false
+}
+
+func (x *AppCommitOutcome) GetBlocked() bool {
+	if x != nil {
+		return x.Blocked
+	}
+	return false
+}
+
+func (x *AppCommitOutcome) GetLatency() int64 {
+	if x != nil {
+		return x.Latency
+	}
+	return 0
+}
+
+type AppVsBroadcast struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// broadcasts the value in the current view of app.vs
+	Value *Value `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
 }
 
-func (x *EpInternalAccept) Reset() {
-	*x = EpInternalAccept{}
+func (x *AppVsBroadcast) Reset() {
+	*x = AppVsBroadcast{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_messages_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1332,13 +1942,13 @@ func (x *EpInternalAccept) Reset() {
 	}
 }
 
-func (x *EpInternalAccept) String() string {
+func (x *AppVsBroadcast) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EpInternalAccept) ProtoMessage() {}
+func (*AppVsBroadcast) ProtoMessage() {}
 
-func (x *EpInternalAccept) ProtoReflect() protoreflect.Message {
+func (x *AppVsBroadcast) ProtoReflect() protoreflect.Message {
 	mi := &file_messages_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1350,21 +1960,30 @@ func (x *EpInternalAccept) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EpInternalAccept.ProtoReflect.Descriptor instead.
-func (*EpInternalAccept) Descriptor() ([]byte, []int) {
+// Deprecated: Use AppVsBroadcast.ProtoReflect.Descriptor instead.
+func (*AppVsBroadcast) Descriptor() ([]byte, []int) {
 	return file_messages_proto_rawDescGZIP(), []int{22}
 }
 
-type EpInternalDecided struct {
+func (x *AppVsBroadcast) GetValue() *Value {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+type AppVsDeliver struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Value *Value `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	Sender *ProcessId `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	Value  *Value     `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	ViewId int32      `protobuf:"varint,3,opt,name=viewId,proto3" json:"viewId,omitempty"` // View in which the value was delivered
 }
 
-func (x *EpInternalDecided) Reset() {
-	*x = EpInternalDecided{}
+func (x *AppVsDeliver) Reset() {
+	*x = AppVsDeliver{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_messages_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1372,13 +1991,13 @@ func (x *EpInternalDecided) Reset() {
 	}
 }
 
-func (x *EpInternalDecided) String() string {
+func (x *AppVsDeliver) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EpInternalDecided) ProtoMessage() {}
+func (*AppVsDeliver) ProtoMessage() {}
 
-func (x *EpInternalDecided) ProtoReflect() protoreflect.Message {
+func (x *AppVsDeliver) ProtoReflect() protoreflect.Message {
 	mi := &file_messages_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1390,28 +2009,44 @@ func (x *EpInternalDecided) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EpInternalDecided.ProtoReflect.Descriptor instead.
-func (*EpInternalDecided) Descriptor() ([]byte, []int) {
+// Deprecated: Use AppVsDeliver.ProtoReflect.Descriptor instead.
+func (*AppVsDeliver) Descriptor() ([]byte, []int) {
 	return file_messages_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *EpInternalDecided) GetValue() *Value {
+func (x *AppVsDeliver) GetSender() *ProcessId {
+	if x != nil {
+		return x.Sender
+	}
+	return nil
+}
+
+func (x *AppVsDeliver) GetValue() *Value {
 	if x != nil {
 		return x.Value
 	}
 	return nil
 }
 
-// EC
-// In the Init event or constructor, initialize "trusted" with the max-rank process in PI
-type EcInternalNack struct {
+func (x *AppVsDeliver) GetViewId() int32 {
+	if x != nil {
+		return x.ViewId
+	}
+	return 0
+}
+
+type AppView struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Source  string       `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"` // gm or vs
+	Id      int32        `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	Members []*ProcessId `protobuf:"bytes,3,rep,name=members,proto3" json:"members,omitempty"`
 }
 
-func (x *EcInternalNack) Reset() {
-	*x = EcInternalNack{}
+func (x *AppView) Reset() {
+	*x = AppView{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_messages_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1419,13 +2054,13 @@ func (x *EcInternalNack) Reset() {
 	}
 }
 
-func (x *EcInternalNack) String() string {
+func (x *AppView) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EcInternalNack) ProtoMessage() {}
+func (*AppView) ProtoMessage() {}
 
-func (x *EcInternalNack) ProtoReflect() protoreflect.Message {
+func (x *AppView) ProtoReflect() protoreflect.Message {
 	mi := &file_messages_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1437,22 +2072,43 @@ func (x *EcInternalNack) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EcInternalNack.ProtoReflect.Descriptor instead.
-func (*EcInternalNack) Descriptor() ([]byte, []int) {
+// Deprecated: Use AppView.ProtoReflect.Descriptor instead.
+func (*AppView) Descriptor() ([]byte, []int) {
 	return file_messages_proto_rawDescGZIP(), []int{24}
 }
 
-type EcStartEpoch struct {
+func (x *AppView) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *AppView) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AppView) GetMembers() []*ProcessId {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+type AppLogAppend struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	NewTimestamp int32      `protobuf:"varint,1,opt,name=newTimestamp,proto3" json:"newTimestamp,omitempty"`
-	NewLeader    *ProcessId `protobuf:"bytes,2,opt,name=newLeader,proto3" json:"newLeader,omitempty"`
+	// the value to the replicated log app.log
+	Value *Value `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
 }
 
-func (x *EcStartEpoch) Reset() {
-	*x = EcStartEpoch{}
+func (x *AppLogAppend) Reset() {
+	*x = AppLogAppend{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_messages_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1460,13 +2116,13 @@ func (x *EcStartEpoch) Reset() {
 	}
 }
 
-func (x *EcStartEpoch) String() string {
+func (x *AppLogAppend) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EcStartEpoch) ProtoMessage() {}
+func (*AppLogAppend) ProtoMessage() {}
 
-func (x *EcStartEpoch) ProtoReflect() protoreflect.Message {
+func (x *AppLogAppend) ProtoReflect() protoreflect.Message {
 	mi := &file_messages_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1478,35 +2134,29 @@ func (x *EcStartEpoch) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EcStartEpoch.ProtoReflect.Descriptor instead.
-func (*EcStartEpoch) Descriptor() ([]byte, []int) {
+// Deprecated: Use AppLogAppend.ProtoReflect.Descriptor instead.
+func (*AppLogAppend) Descriptor() ([]byte, []int) {
 	return file_messages_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *EcStartEpoch) GetNewTimestamp() int32 {
-	if x != nil {
-		return x.NewTimestamp
-	}
-	return 0
-}
-
-func (x *EcStartEpoch) GetNewLeader() *ProcessId {
+func (x *AppLogAppend) GetValue() *Value {
 	if x != nil {
-		return x.NewLeader
+		return x.Value
 	}
 	return nil
 }
 
-type EcInternalNewEpoch struct {
+type AppLogAppended struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Timestamp int32 `protobuf:"varint,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Slot  int32  `protobuf:"varint,1,opt,name=slot,proto3" json:"slot,omitempty"`
+	Value *Value `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
 }
 
-func (x *EcInternalNewEpoch) Reset() {
-	*x = EcInternalNewEpoch{}
+func (x *AppLogAppended) Reset() {
+	*x = AppLogAppended{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_messages_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1514,13 +2164,13 @@ func (x *EcInternalNewEpoch) Reset() {
 	}
 }
 
-func (x *EcInternalNewEpoch) String() string {
+func (x *AppLogAppended) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EcInternalNewEpoch) ProtoMessage() {}
+func (*AppLogAppended) ProtoMessage() {}
 
-func (x *EcInternalNewEpoch) ProtoReflect() protoreflect.Message {
+func (x *AppLogAppended) ProtoReflect() protoreflect.Message {
 	mi := &file_messages_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1532,30 +2182,36 @@ func (x *EcInternalNewEpoch) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EcInternalNewEpoch.ProtoReflect.Descriptor instead.
-func (*EcInternalNewEpoch) Descriptor() ([]byte, []int) {
+// Deprecated: Use AppLogAppended.ProtoReflect.Descriptor instead.
+func (*AppLogAppended) Descriptor() ([]byte, []int) {
 	return file_messages_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *EcInternalNewEpoch) GetTimestamp() int32 {
+func (x *AppLogAppended) GetSlot() int32 {
 	if x != nil {
-		return x.Timestamp
+		return x.Slot
 	}
 	return 0
 }
 
-// BEB, e pus intentionat message ca poate sa contina orice, de exemplu in carte
-// contine un triplet cu 3 valori [DATA,self,m]
-type BebBroadcast struct {
+func (x *AppLogAppended) GetValue() *Value {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+type AppLogCommitted struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Message *Message `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Slot  int32  `protobuf:"varint,1,opt,name=slot,proto3" json:"slot,omitempty"`
+	Value *Value `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
 }
 
-func (x *BebBroadcast) Reset() {
-	*x = BebBroadcast{}
+func (x *AppLogCommitted) Reset() {
+	*x = AppLogCommitted{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_messages_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1563,13 +2219,13 @@ func (x *BebBroadcast) Reset() {
 	}
 }
 
-func (x *BebBroadcast) String() string {
+func (x *AppLogCommitted) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BebBroadcast) ProtoMessage() {}
+func (*AppLogCommitted) ProtoMessage() {}
 
-func (x *BebBroadcast) ProtoReflect() protoreflect.Message {
+func (x *AppLogCommitted) ProtoReflect() protoreflect.Message {
 	mi := &file_messages_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1581,29 +2237,36 @@ func (x *BebBroadcast) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BebBroadcast.ProtoReflect.Descriptor instead.
-func (*BebBroadcast) Descriptor() ([]byte, []int) {
+// Deprecated: Use AppLogCommitted.ProtoReflect.Descriptor instead.
+func (*AppLogCommitted) Descriptor() ([]byte, []int) {
 	return file_messages_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *BebBroadcast) GetMessage() *Message {
+func (x *AppLogCommitted) GetSlot() int32 {
 	if x != nil {
-		return x.Message
+		return x.Slot
+	}
+	return 0
+}
+
+func (x *AppLogCommitted) GetValue() *Value {
+	if x != nil {
+		return x.Value
 	}
 	return nil
 }
 
-type BebDeliver struct {
+type AppValue struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Message *Message   `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
-	Sender  *ProcessId `protobuf:"bytes,2,opt,name=sender,proto3" json:"sender,omitempty"`
+	// Message(NetworkMessage(Message(AppValue)))
+	Value *Value `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
 }
 
-func (x *BebDeliver) Reset() {
-	*x = BebDeliver{}
+func (x *AppValue) Reset() {
+	*x = AppValue{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_messages_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1611,13 +2274,13 @@ func (x *BebDeliver) Reset() {
 	}
 }
 
-func (x *BebDeliver) String() string {
+func (x *AppValue) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BebDeliver) ProtoMessage() {}
+func (*AppValue) ProtoMessage() {}
 
-func (x *BebDeliver) ProtoReflect() protoreflect.Message {
+func (x *AppValue) ProtoReflect() protoreflect.Message {
 	mi := &file_messages_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1629,34 +2292,29 @@ func (x *BebDeliver) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BebDeliver.ProtoReflect.Descriptor instead.
-func (*BebDeliver) Descriptor() ([]byte, []int) {
+// Deprecated: Use AppValue.ProtoReflect.Descriptor instead.
+func (*AppValue) Descriptor() ([]byte, []int) {
 	return file_messages_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *BebDeliver) GetMessage() *Message {
-	if x != nil {
-		return x.Message
-	}
-	return nil
-}
-
-func (x *BebDeliver) GetSender() *ProcessId {
+func (x *AppValue) GetValue() *Value {
 	if x != nil {
-		return x.Sender
+		return x.Value
 	}
 	return nil
 }
 
-// ELD
-type EldTimeout struct {
+type AppPropose struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Topic string `protobuf:"bytes,1,opt,name=topic,proto3" json:"topic,omitempty"` // The topic on which to reach consensus. There will be a consensus instance for each topic
+	Value *Value `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"` // Random value chosen by the HUB
 }
 
-func (x *EldTimeout) Reset() {
-	*x = EldTimeout{}
+func (x *AppPropose) Reset() {
+	*x = AppPropose{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_messages_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1664,13 +2322,13 @@ func (x *EldTimeout) Reset() {
 	}
 }
 
-func (x *EldTimeout) String() string {
+func (x *AppPropose) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EldTimeout) ProtoMessage() {}
+func (*AppPropose) ProtoMessage() {}
 
-func (x *EldTimeout) ProtoReflect() protoreflect.Message {
+func (x *AppPropose) ProtoReflect() protoreflect.Message {
 	mi := &file_messages_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1682,21 +2340,35 @@ func (x *EldTimeout) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EldTimeout.ProtoReflect.Descriptor instead.
-func (*EldTimeout) Descriptor() ([]byte, []int) {
+// Deprecated: Use AppPropose.ProtoReflect.Descriptor instead.
+func (*AppPropose) Descriptor() ([]byte, []int) {
 	return file_messages_proto_rawDescGZIP(), []int{29}
 }
 
-type EldTrust struct {
+func (x *AppPropose) GetTopic() string {
+	if x != nil {
+		return x.Topic
+	}
+	return ""
+}
+
+func (x *AppPropose) GetValue() *Value {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+type AppDecide struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Process *ProcessId `protobuf:"bytes,1,opt,name=process,proto3" json:"process,omitempty"`
+	Value *Value `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"` // Decided value taken from UcDecide
 }
 
-func (x *EldTrust) Reset() {
-	*x = EldTrust{}
+func (x *AppDecide) Reset() {
+	*x = AppDecide{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_messages_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1704,13 +2376,13 @@ func (x *EldTrust) Reset() {
 	}
 }
 
-func (x *EldTrust) String() string {
+func (x *AppDecide) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EldTrust) ProtoMessage() {}
+func (*AppDecide) ProtoMessage() {}
 
-func (x *EldTrust) ProtoReflect() protoreflect.Message {
+func (x *AppDecide) ProtoReflect() protoreflect.Message {
 	mi := &file_messages_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1722,27 +2394,29 @@ func (x *EldTrust) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EldTrust.ProtoReflect.Descriptor instead.
-func (*EldTrust) Descriptor() ([]byte, []int) {
+// Deprecated: Use AppDecide.ProtoReflect.Descriptor instead.
+func (*AppDecide) Descriptor() ([]byte, []int) {
 	return file_messages_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *EldTrust) GetProcess() *ProcessId {
+func (x *AppDecide) GetValue() *Value {
 	if x != nil {
-		return x.Process
+		return x.Value
 	}
 	return nil
 }
 
-// NNAR
-type NnarRead struct {
+type AppRead struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Register string       `protobuf:"bytes,1,opt,name=register,proto3" json:"register,omitempty"`
+	Kind     RegisterKind `protobuf:"varint,2,opt,name=kind,proto3,enum=pb.RegisterKind" json:"kind,omitempty"`
 }
 
-func (x *NnarRead) Reset() {
-	*x = NnarRead{}
+func (x *AppRead) Reset() {
+	*x = AppRead{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_messages_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1750,13 +2424,13 @@ func (x *NnarRead) Reset() {
 	}
 }
 
-func (x *NnarRead) String() string {
+func (x *AppRead) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*NnarRead) ProtoMessage() {}
+func (*AppRead) ProtoMessage() {}
 
-func (x *NnarRead) ProtoReflect() protoreflect.Message {
+func (x *AppRead) ProtoReflect() protoreflect.Message {
 	mi := &file_messages_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1768,21 +2442,37 @@ func (x *NnarRead) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use NnarRead.ProtoReflect.Descriptor instead.
-func (*NnarRead) Descriptor() ([]byte, []int) {
+// Deprecated: Use AppRead.ProtoReflect.Descriptor instead.
+func (*AppRead) Descriptor() ([]byte, []int) {
 	return file_messages_proto_rawDescGZIP(), []int{31}
 }
 
-type NnarInternalRead struct {
+func (x *AppRead) GetRegister() string {
+	if x != nil {
+		return x.Register
+	}
+	return ""
+}
+
+func (x *AppRead) GetKind() RegisterKind {
+	if x != nil {
+		return x.Kind
+	}
+	return RegisterKind_NNAR
+}
+
+type AppWrite struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ReadId int32 `protobuf:"varint,1,opt,name=readId,proto3" json:"readId,omitempty"`
+	Register string       `protobuf:"bytes,1,opt,name=register,proto3" json:"register,omitempty"`
+	Value    *Value       `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"` // Value to write in the register
+	Kind     RegisterKind `protobuf:"varint,3,opt,name=kind,proto3,enum=pb.RegisterKind" json:"kind,omitempty"`
 }
 
-func (x *NnarInternalRead) Reset() {
-	*x = NnarInternalRead{}
+func (x *AppWrite) Reset() {
+	*x = AppWrite{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_messages_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1790,13 +2480,13 @@ func (x *NnarInternalRead) Reset() {
 	}
 }
 
-func (x *NnarInternalRead) String() string {
+func (x *AppWrite) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*NnarInternalRead) ProtoMessage() {}
+func (*AppWrite) ProtoMessage() {}
 
-func (x *NnarInternalRead) ProtoReflect() protoreflect.Message {
+func (x *AppWrite) ProtoReflect() protoreflect.Message {
 	mi := &file_messages_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1808,31 +2498,44 @@ func (x *NnarInternalRead) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use NnarInternalRead.ProtoReflect.Descriptor instead.
-func (*NnarInternalRead) Descriptor() ([]byte, []int) {
+// Deprecated: Use AppWrite.ProtoReflect.Descriptor instead.
+func (*AppWrite) Descriptor() ([]byte, []int) {
 	return file_messages_proto_rawDescGZIP(), []int{32}
 }
 
-func (x *NnarInternalRead) GetReadId() int32 {
+func (x *AppWrite) GetRegister() string {
 	if x != nil {
-		return x.ReadId
+		return x.Register
 	}
-	return 0
+	return ""
 }
 
-type NnarInternalValue struct {
+func (x *AppWrite) GetValue() *Value {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+func (x *AppWrite) GetKind() RegisterKind {
+	if x != nil {
+		return x.Kind
+	}
+	return RegisterKind_NNAR
+}
+
+type AppReadReturn struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ReadId     int32  `protobuf:"varint,1,opt,name=readId,proto3" json:"readId,omitempty"`
-	Timestamp  int32  `protobuf:"varint,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
-	WriterRank int32  `protobuf:"varint,3,opt,name=writerRank,proto3" json:"writerRank,omitempty"`
-	Value      *Value `protobuf:"bytes,4,opt,name=value,proto3" json:"value,omitempty"`
+	Register string       `protobuf:"bytes,1,opt,name=register,proto3" json:"register,omitempty"`
+	Value    *Value       `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"` // Value read from the register
+	Kind     RegisterKind `protobuf:"varint,3,opt,name=kind,proto3,enum=pb.RegisterKind" json:"kind,omitempty"`
 }
 
-func (x *NnarInternalValue) Reset() {
-	*x = NnarInternalValue{}
+func (x *AppReadReturn) Reset() {
+	*x = AppReadReturn{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_messages_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1840,13 +2543,13 @@ func (x *NnarInternalValue) Reset() {
 	}
 }
 
-func (x *NnarInternalValue) String() string {
+func (x *AppReadReturn) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*NnarInternalValue) ProtoMessage() {}
+func (*AppReadReturn) ProtoMessage() {}
 
-func (x *NnarInternalValue) ProtoReflect() protoreflect.Message {
+func (x *AppReadReturn) ProtoReflect() protoreflect.Message {
 	mi := &file_messages_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1858,52 +2561,44 @@ func (x *NnarInternalValue) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use NnarInternalValue.ProtoReflect.Descriptor instead.
-func (*NnarInternalValue) Descriptor() ([]byte, []int) {
+// Deprecated: Use AppReadReturn.ProtoReflect.Descriptor instead.
+func (*AppReadReturn) Descriptor() ([]byte, []int) {
 	return file_messages_proto_rawDescGZIP(), []int{33}
 }
 
-func (x *NnarInternalValue) GetReadId() int32 {
-	if x != nil {
-		return x.ReadId
-	}
-	return 0
-}
-
-func (x *NnarInternalValue) GetTimestamp() int32 {
+func (x *AppReadReturn) GetRegister() string {
 	if x != nil {
-		return x.Timestamp
+		return x.Register
 	}
-	return 0
+	return ""
 }
 
-func (x *NnarInternalValue) GetWriterRank() int32 {
+func (x *AppReadReturn) GetValue() *Value {
 	if x != nil {
-		return x.WriterRank
+		return x.Value
 	}
-	return 0
+	return nil
 }
 
-func (x *NnarInternalValue) GetValue() *Value {
+func (x *AppReadReturn) GetKind() RegisterKind {
 	if x != nil {
-		return x.Value
+		return x.Kind
 	}
-	return nil
+	return RegisterKind_NNAR
 }
 
-type NnarInternalWrite struct {
+type AppWriteReturn struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ReadId     int32  `protobuf:"varint,1,opt,name=readId,proto3" json:"readId,omitempty"`
-	Timestamp  int32  `protobuf:"varint,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
-	WriterRank int32  `protobuf:"varint,3,opt,name=writerRank,proto3" json:"writerRank,omitempty"`
-	Value      *Value `protobuf:"bytes,4,opt,name=value,proto3" json:"value,omitempty"`
+	Register string       `protobuf:"bytes,1,opt,name=register,proto3" json:"register,omitempty"`
+	Kind     RegisterKind `protobuf:"varint,2,opt,name=kind,proto3,enum=pb.RegisterKind" json:"kind,omitempty"`
+	Error    string       `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"` // Why the write was rejected, empty when it completed
 }
 
-func (x *NnarInternalWrite) Reset() {
-	*x = NnarInternalWrite{}
+func (x *AppWriteReturn) Reset() {
+	*x = AppWriteReturn{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_messages_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1911,13 +2606,13 @@ func (x *NnarInternalWrite) Reset() {
 	}
 }
 
-func (x *NnarInternalWrite) String() string {
+func (x *AppWriteReturn) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*NnarInternalWrite) ProtoMessage() {}
+func (*AppWriteReturn) ProtoMessage() {}
 
-func (x *NnarInternalWrite) ProtoReflect() protoreflect.Message {
+func (x *AppWriteReturn) ProtoReflect() protoreflect.Message {
 	mi := &file_messages_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1929,40 +2624,35 @@ func (x *NnarInternalWrite) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use NnarInternalWrite.ProtoReflect.Descriptor instead.
-func (*NnarInternalWrite) Descriptor() ([]byte, []int) {
+// Deprecated: Use AppWriteReturn.ProtoReflect.Descriptor instead.
+func (*AppWriteReturn) Descriptor() ([]byte, []int) {
 	return file_messages_proto_rawDescGZIP(), []int{34}
 }
 
-func (x *NnarInternalWrite) GetReadId() int32 {
+func (x *AppWriteReturn) GetRegister() string {
 	if x != nil {
-		return x.ReadId
+		return x.Register
 	}
-	return 0
+	return ""
 }
 
-func (x *NnarInternalWrite) GetTimestamp() int32 {
+func (x *AppWriteReturn) GetKind() RegisterKind {
 	if x != nil {
-		return x.Timestamp
+		return x.Kind
 	}
-	return 0
+	return RegisterKind_NNAR
 }
 
-func (x *NnarInternalWrite) GetWriterRank() int32 {
+func (x *AppWriteReturn) GetError() string {
 	if x != nil {
-		return x.WriterRank
+		return x.Error
 	}
-	return 0
+	return ""
 }
 
-func (x *NnarInternalWrite) GetValue() *Value {
-	if x != nil {
-		return x.Value
-	}
-	return nil
-}
-
-type NnarWrite struct {
+// UC
+// In the Init event or constructor, initialize l (leader) with the max-rank process in PI
+type UcPropose struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
@@ -1970,8 +2660,8 @@ type NnarWrite struct {
 	Value *Value `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
 }
 
-func (x *NnarWrite) Reset() {
-	*x = NnarWrite{}
+func (x *UcPropose) Reset() {
+	*x = UcPropose{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_messages_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1979,13 +2669,13 @@ func (x *NnarWrite) Reset() {
 	}
 }
 
-func (x *NnarWrite) String() string {
+func (x *UcPropose) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*NnarWrite) ProtoMessage() {}
+func (*UcPropose) ProtoMessage() {}
 
-func (x *NnarWrite) ProtoReflect() protoreflect.Message {
+func (x *UcPropose) ProtoReflect() protoreflect.Message {
 	mi := &file_messages_proto_msgTypes[35]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1997,28 +2687,28 @@ func (x *NnarWrite) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use NnarWrite.ProtoReflect.Descriptor instead.
-func (*NnarWrite) Descriptor() ([]byte, []int) {
+// Deprecated: Use UcPropose.ProtoReflect.Descriptor instead.
+func (*UcPropose) Descriptor() ([]byte, []int) {
 	return file_messages_proto_rawDescGZIP(), []int{35}
 }
 
-func (x *NnarWrite) GetValue() *Value {
+func (x *UcPropose) GetValue() *Value {
 	if x != nil {
 		return x.Value
 	}
 	return nil
 }
 
-type NnarInternalAck struct {
+type UcDecide struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ReadId int32 `protobuf:"varint,1,opt,name=readId,proto3" json:"readId,omitempty"`
+	Value *Value `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
 }
 
-func (x *NnarInternalAck) Reset() {
-	*x = NnarInternalAck{}
+func (x *UcDecide) Reset() {
+	*x = UcDecide{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_messages_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2026,13 +2716,13 @@ func (x *NnarInternalAck) Reset() {
 	}
 }
 
-func (x *NnarInternalAck) String() string {
+func (x *UcDecide) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*NnarInternalAck) ProtoMessage() {}
+func (*UcDecide) ProtoMessage() {}
 
-func (x *NnarInternalAck) ProtoReflect() protoreflect.Message {
+func (x *UcDecide) ProtoReflect() protoreflect.Message {
 	mi := &file_messages_proto_msgTypes[36]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2044,28 +2734,27 @@ func (x *NnarInternalAck) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use NnarInternalAck.ProtoReflect.Descriptor instead.
-func (*NnarInternalAck) Descriptor() ([]byte, []int) {
+// Deprecated: Use UcDecide.ProtoReflect.Descriptor instead.
+func (*UcDecide) Descriptor() ([]byte, []int) {
 	return file_messages_proto_rawDescGZIP(), []int{36}
 }
 
-func (x *NnarInternalAck) GetReadId() int32 {
+func (x *UcDecide) GetValue() *Value {
 	if x != nil {
-		return x.ReadId
+		return x.Value
 	}
-	return 0
+	return nil
 }
 
-type NnarReadReturn struct {
+// EP
+type EpAbort struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Value *Value `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
 }
 
-func (x *NnarReadReturn) Reset() {
-	*x = NnarReadReturn{}
+func (x *EpAbort) Reset() {
+	*x = EpAbort{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_messages_proto_msgTypes[37]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2073,13 +2762,13 @@ func (x *NnarReadReturn) Reset() {
 	}
 }
 
-func (x *NnarReadReturn) String() string {
+func (x *EpAbort) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*NnarReadReturn) ProtoMessage() {}
+func (*EpAbort) ProtoMessage() {}
 
-func (x *NnarReadReturn) ProtoReflect() protoreflect.Message {
+func (x *EpAbort) ProtoReflect() protoreflect.Message {
 	mi := &file_messages_proto_msgTypes[37]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2091,26 +2780,23 @@ func (x *NnarReadReturn) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use NnarReadReturn.ProtoReflect.Descriptor instead.
-func (*NnarReadReturn) Descriptor() ([]byte, []int) {
+// Deprecated: Use EpAbort.ProtoReflect.Descriptor instead.
+func (*EpAbort) Descriptor() ([]byte, []int) {
 	return file_messages_proto_rawDescGZIP(), []int{37}
 }
 
-func (x *NnarReadReturn) GetValue() *Value {
-	if x != nil {
-		return x.Value
-	}
-	return nil
-}
-
-type NnarWriteReturn struct {
+type EpAborted struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Ets            int32  `protobuf:"varint,1,opt,name=ets,proto3" json:"ets,omitempty"`                       // Needed to know in UC the timestamp of the EP, where the algorithm says "such that ts = ets do"
+	ValueTimestamp int32  `protobuf:"varint,2,opt,name=valueTimestamp,proto3" json:"valueTimestamp,omitempty"` // The timestamp part of the "state" mentioned in the algorithm
+	Value          *Value `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`                    // The value part of the "state" mentioned in the algorithm
 }
 
-func (x *NnarWriteReturn) Reset() {
-	*x = NnarWriteReturn{}
+func (x *EpAborted) Reset() {
+	*x = EpAborted{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_messages_proto_msgTypes[38]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2118,13 +2804,13 @@ func (x *NnarWriteReturn) Reset() {
 	}
 }
 
-func (x *NnarWriteReturn) String() string {
+func (x *EpAborted) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*NnarWriteReturn) ProtoMessage() {}
+func (*EpAborted) ProtoMessage() {}
 
-func (x *NnarWriteReturn) ProtoReflect() protoreflect.Message {
+func (x *EpAborted) ProtoReflect() protoreflect.Message {
 	mi := &file_messages_proto_msgTypes[38]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2136,21 +2822,42 @@ func (x *NnarWriteReturn) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use NnarWriteReturn.ProtoReflect.Descriptor instead.
-func (*NnarWriteReturn) Descriptor() ([]byte, []int) {
+// Deprecated: Use EpAborted.ProtoReflect.Descriptor instead.
+func (*EpAborted) Descriptor() ([]byte, []int) {
 	return file_messages_proto_rawDescGZIP(), []int{38}
 }
 
-// EPFD
-// Use as timer delay "delta" 100 milliseconds
-type EpfdTimeout struct {
+func (x *EpAborted) GetEts() int32 {
+	if x != nil {
+		return x.Ets
+	}
+	return 0
+}
+
+func (x *EpAborted) GetValueTimestamp() int32 {
+	if x != nil {
+		return x.ValueTimestamp
+	}
+	return 0
+}
+
+func (x *EpAborted) GetValue() *Value {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+type EpPropose struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Value *Value `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
 }
 
-func (x *EpfdTimeout) Reset() {
-	*x = EpfdTimeout{}
+func (x *EpPropose) Reset() {
+	*x = EpPropose{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_messages_proto_msgTypes[39]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2158,13 +2865,13 @@ func (x *EpfdTimeout) Reset() {
 	}
 }
 
-func (x *EpfdTimeout) String() string {
+func (x *EpPropose) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EpfdTimeout) ProtoMessage() {}
+func (*EpPropose) ProtoMessage() {}
 
-func (x *EpfdTimeout) ProtoReflect() protoreflect.Message {
+func (x *EpPropose) ProtoReflect() protoreflect.Message {
 	mi := &file_messages_proto_msgTypes[39]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2176,19 +2883,29 @@ func (x *EpfdTimeout) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EpfdTimeout.ProtoReflect.Descriptor instead.
-func (*EpfdTimeout) Descriptor() ([]byte, []int) {
+// Deprecated: Use EpPropose.ProtoReflect.Descriptor instead.
+func (*EpPropose) Descriptor() ([]byte, []int) {
 	return file_messages_proto_rawDescGZIP(), []int{39}
 }
 
-type EpfdInternalHeartbeatRequest struct {
+func (x *EpPropose) GetValue() *Value {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+type EpDecide struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Ets   int32  `protobuf:"varint,1,opt,name=ets,proto3" json:"ets,omitempty"` // Needed to know in UC the timestamp of the EP, where the algorithm says "such that ts = ets do"
+	Value *Value `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
 }
 
-func (x *EpfdInternalHeartbeatRequest) Reset() {
-	*x = EpfdInternalHeartbeatRequest{}
+func (x *EpDecide) Reset() {
+	*x = EpDecide{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_messages_proto_msgTypes[40]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2196,13 +2913,13 @@ func (x *EpfdInternalHeartbeatRequest) Reset() {
 	}
 }
 
-func (x *EpfdInternalHeartbeatRequest) String() string {
+func (x *EpDecide) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EpfdInternalHeartbeatRequest) ProtoMessage() {}
+func (*EpDecide) ProtoMessage() {}
 
-func (x *EpfdInternalHeartbeatRequest) ProtoReflect() protoreflect.Message {
+func (x *EpDecide) ProtoReflect() protoreflect.Message {
 	mi := &file_messages_proto_msgTypes[40]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2214,19 +2931,33 @@ func (x *EpfdInternalHeartbeatRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EpfdInternalHeartbeatRequest.ProtoReflect.Descriptor instead.
-func (*EpfdInternalHeartbeatRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use EpDecide.ProtoReflect.Descriptor instead.
+func (*EpDecide) Descriptor() ([]byte, []int) {
 	return file_messages_proto_rawDescGZIP(), []int{40}
 }
 
-type EpfdInternalHeartbeatReply struct {
+func (x *EpDecide) GetEts() int32 {
+	if x != nil {
+		return x.Ets
+	}
+	return 0
+}
+
+func (x *EpDecide) GetValue() *Value {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+type EpInternalRead struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *EpfdInternalHeartbeatReply) Reset() {
-	*x = EpfdInternalHeartbeatReply{}
+func (x *EpInternalRead) Reset() {
+	*x = EpInternalRead{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_messages_proto_msgTypes[41]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2234,13 +2965,13 @@ func (x *EpfdInternalHeartbeatReply) Reset() {
 	}
 }
 
-func (x *EpfdInternalHeartbeatReply) String() string {
+func (x *EpInternalRead) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EpfdInternalHeartbeatReply) ProtoMessage() {}
+func (*EpInternalRead) ProtoMessage() {}
 
-func (x *EpfdInternalHeartbeatReply) ProtoReflect() protoreflect.Message {
+func (x *EpInternalRead) ProtoReflect() protoreflect.Message {
 	mi := &file_messages_proto_msgTypes[41]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2252,21 +2983,22 @@ func (x *EpfdInternalHeartbeatReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EpfdInternalHeartbeatReply.ProtoReflect.Descriptor instead.
-func (*EpfdInternalHeartbeatReply) Descriptor() ([]byte, []int) {
+// Deprecated: Use EpInternalRead.ProtoReflect.Descriptor instead.
+func (*EpInternalRead) Descriptor() ([]byte, []int) {
 	return file_messages_proto_rawDescGZIP(), []int{41}
 }
 
-type EpfdSuspect struct {
+type EpInternalState struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Process *ProcessId `protobuf:"bytes,1,opt,name=process,proto3" json:"process,omitempty"`
+	ValueTimestamp int32  `protobuf:"varint,1,opt,name=valueTimestamp,proto3" json:"valueTimestamp,omitempty"`
+	Value          *Value `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
 }
 
-func (x *EpfdSuspect) Reset() {
-	*x = EpfdSuspect{}
+func (x *EpInternalState) Reset() {
+	*x = EpInternalState{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_messages_proto_msgTypes[42]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2274,13 +3006,13 @@ func (x *EpfdSuspect) Reset() {
 	}
 }
 
-func (x *EpfdSuspect) String() string {
+func (x *EpInternalState) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EpfdSuspect) ProtoMessage() {}
+func (*EpInternalState) ProtoMessage() {}
 
-func (x *EpfdSuspect) ProtoReflect() protoreflect.Message {
+func (x *EpInternalState) ProtoReflect() protoreflect.Message {
 	mi := &file_messages_proto_msgTypes[42]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2292,28 +3024,35 @@ func (x *EpfdSuspect) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EpfdSuspect.ProtoReflect.Descriptor instead.
-func (*EpfdSuspect) Descriptor() ([]byte, []int) {
+// Deprecated: Use EpInternalState.ProtoReflect.Descriptor instead.
+func (*EpInternalState) Descriptor() ([]byte, []int) {
 	return file_messages_proto_rawDescGZIP(), []int{42}
 }
 
-func (x *EpfdSuspect) GetProcess() *ProcessId {
+func (x *EpInternalState) GetValueTimestamp() int32 {
 	if x != nil {
-		return x.Process
+		return x.ValueTimestamp
+	}
+	return 0
+}
+
+func (x *EpInternalState) GetValue() *Value {
+	if x != nil {
+		return x.Value
 	}
 	return nil
 }
 
-type EpfdRestore struct {
+type EpInternalWrite struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Process *ProcessId `protobuf:"bytes,1,opt,name=process,proto3" json:"process,omitempty"`
+	Value *Value `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
 }
 
-func (x *EpfdRestore) Reset() {
-	*x = EpfdRestore{}
+func (x *EpInternalWrite) Reset() {
+	*x = EpInternalWrite{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_messages_proto_msgTypes[43]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2321,13 +3060,13 @@ func (x *EpfdRestore) Reset() {
 	}
 }
 
-func (x *EpfdRestore) String() string {
+func (x *EpInternalWrite) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EpfdRestore) ProtoMessage() {}
+func (*EpInternalWrite) ProtoMessage() {}
 
-func (x *EpfdRestore) ProtoReflect() protoreflect.Message {
+func (x *EpInternalWrite) ProtoReflect() protoreflect.Message {
 	mi := &file_messages_proto_msgTypes[43]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2339,30 +3078,26 @@ func (x *EpfdRestore) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EpfdRestore.ProtoReflect.Descriptor instead.
-func (*EpfdRestore) Descriptor() ([]byte, []int) {
+// Deprecated: Use EpInternalWrite.ProtoReflect.Descriptor instead.
+func (*EpInternalWrite) Descriptor() ([]byte, []int) {
 	return file_messages_proto_rawDescGZIP(), []int{43}
 }
 
-func (x *EpfdRestore) GetProcess() *ProcessId {
+func (x *EpInternalWrite) GetValue() *Value {
 	if x != nil {
-		return x.Process
+		return x.Value
 	}
 	return nil
 }
 
-// PL
-type PlSend struct {
+type EpInternalAccept struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Destination *ProcessId `protobuf:"bytes,1,opt,name=destination,proto3" json:"destination,omitempty"`
-	Message     *Message   `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
 }
 
-func (x *PlSend) Reset() {
-	*x = PlSend{}
+func (x *EpInternalAccept) Reset() {
+	*x = EpInternalAccept{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_messages_proto_msgTypes[44]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2370,13 +3105,13 @@ func (x *PlSend) Reset() {
 	}
 }
 
-func (x *PlSend) String() string {
+func (x *EpInternalAccept) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PlSend) ProtoMessage() {}
+func (*EpInternalAccept) ProtoMessage() {}
 
-func (x *PlSend) ProtoReflect() protoreflect.Message {
+func (x *EpInternalAccept) ProtoReflect() protoreflect.Message {
 	mi := &file_messages_proto_msgTypes[44]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2388,36 +3123,21 @@ func (x *PlSend) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PlSend.ProtoReflect.Descriptor instead.
-func (*PlSend) Descriptor() ([]byte, []int) {
+// Deprecated: Use EpInternalAccept.ProtoReflect.Descriptor instead.
+func (*EpInternalAccept) Descriptor() ([]byte, []int) {
 	return file_messages_proto_rawDescGZIP(), []int{44}
 }
 
-func (x *PlSend) GetDestination() *ProcessId {
-	if x != nil {
-		return x.Destination
-	}
-	return nil
-}
-
-func (x *PlSend) GetMessage() *Message {
-	if x != nil {
-		return x.Message
-	}
-	return nil
-}
-
-type PlDeliver struct {
+type EpInternalDecided struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Sender  *ProcessId `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
-	Message *Message   `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Value *Value `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
 }
 
-func (x *PlDeliver) Reset() {
-	*x = PlDeliver{}
+func (x *EpInternalDecided) Reset() {
+	*x = EpInternalDecided{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_messages_proto_msgTypes[45]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2425,13 +3145,13 @@ func (x *PlDeliver) Reset() {
 	}
 }
 
-func (x *PlDeliver) String() string {
+func (x *EpInternalDecided) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PlDeliver) ProtoMessage() {}
+func (*EpInternalDecided) ProtoMessage() {}
 
-func (x *PlDeliver) ProtoReflect() protoreflect.Message {
+func (x *EpInternalDecided) ProtoReflect() protoreflect.Message {
 	mi := &file_messages_proto_msgTypes[45]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2443,53 +3163,30 @@ func (x *PlDeliver) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PlDeliver.ProtoReflect.Descriptor instead.
-func (*PlDeliver) Descriptor() ([]byte, []int) {
+// Deprecated: Use EpInternalDecided.ProtoReflect.Descriptor instead.
+func (*EpInternalDecided) Descriptor() ([]byte, []int) {
 	return file_messages_proto_rawDescGZIP(), []int{45}
 }
 
-func (x *PlDeliver) GetSender() *ProcessId {
-	if x != nil {
-		return x.Sender
-	}
-	return nil
-}
-
-func (x *PlDeliver) GetMessage() *Message {
+func (x *EpInternalDecided) GetValue() *Value {
 	if x != nil {
-		return x.Message
+		return x.Value
 	}
 	return nil
 }
 
-// Network-traveling message
-// When handling MessageA(PlSend(MessageB)) create MessageC(NetworkMessage(MessageB)), setting:
-//
-//	MessageC.SystemId = MessageA.SystemId
-//	MessageC.ToAbstractionId = MessageA.ToAbstractionId
-//	NetworkMessage.senderHost = N/A (ignore)
-//	NetworkMessage.senderListeningPort = The your listening port
-//
-// Then marshal MessageC to byte buffer and send:
-//
-//	bytes 0 - 3: buffer length
-//	bytes 4 -  : buffer data
-//
-// When unmarshalling from a buffer received from the network create MessageD(PlDeliver(MessageB)), setting:
-//
-//	MessageD.ToAbstractionId = MessageC.ToAbstractionId
-type NetworkMessage struct {
+// EC
+// In the Init event or constructor, initialize "trusted" with the max-rank process in PI
+type EcInternalNack struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	SenderHost          string   `protobuf:"bytes,1,opt,name=senderHost,proto3" json:"senderHost,omitempty"`
-	SenderListeningPort int32    `protobuf:"varint,2,opt,name=senderListeningPort,proto3" json:"senderListeningPort,omitempty"`
-	Message             *Message `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	Timestamp int32 `protobuf:"varint,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"` // Of the rejected EcInternalNewEpoch, or of the newer epoch started by the sender, which the
 }
 
-func (x *NetworkMessage) Reset() {
-	*x = NetworkMessage{}
+func (x *EcInternalNack) Reset() {
+	*x = EcInternalNack{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_messages_proto_msgTypes[46]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2497,13 +3194,13 @@ func (x *NetworkMessage) Reset() {
 	}
 }
 
-func (x *NetworkMessage) String() string {
+func (x *EcInternalNack) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*NetworkMessage) ProtoMessage() {}
+func (*EcInternalNack) ProtoMessage() {}
 
-func (x *NetworkMessage) ProtoReflect() protoreflect.Message {
+func (x *EcInternalNack) ProtoReflect() protoreflect.Message {
 	mi := &file_messages_proto_msgTypes[46]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2515,92 +3212,29 @@ func (x *NetworkMessage) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use NetworkMessage.ProtoReflect.Descriptor instead.
-func (*NetworkMessage) Descriptor() ([]byte, []int) {
+// Deprecated: Use EcInternalNack.ProtoReflect.Descriptor instead.
+func (*EcInternalNack) Descriptor() ([]byte, []int) {
 	return file_messages_proto_rawDescGZIP(), []int{46}
 }
 
-func (x *NetworkMessage) GetSenderHost() string {
-	if x != nil {
-		return x.SenderHost
-	}
-	return ""
-}
-
-func (x *NetworkMessage) GetSenderListeningPort() int32 {
+func (x *EcInternalNack) GetTimestamp() int32 {
 	if x != nil {
-		return x.SenderListeningPort
+		return x.Timestamp
 	}
 	return 0
 }
 
-func (x *NetworkMessage) GetMessage() *Message {
-	if x != nil {
-		return x.Message
-	}
-	return nil
-}
-
-// Wrapper message
-type Message struct {
+type EcStartEpoch struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Type                         Message_Type                  `protobuf:"varint,1,opt,name=type,proto3,enum=pb.Message_Type" json:"type,omitempty"`
-	MessageUuid                  string                        `protobuf:"bytes,2,opt,name=messageUuid,proto3" json:"messageUuid,omitempty"`
-	FromAbstractionId            string                        `protobuf:"bytes,3,opt,name=FromAbstractionId,proto3" json:"FromAbstractionId,omitempty"` // Abstraction ID constructed as shown to the right of the system diagram
-	ToAbstractionId              string                        `protobuf:"bytes,4,opt,name=ToAbstractionId,proto3" json:"ToAbstractionId,omitempty"`     // Abstraction ID constructed as shown to the right of the system diagram
-	SystemId                     string                        `protobuf:"bytes,5,opt,name=systemId,proto3" json:"systemId,omitempty"`
-	NetworkMessage               *NetworkMessage               `protobuf:"bytes,6,opt,name=networkMessage,proto3" json:"networkMessage,omitempty"`
-	ProcRegistration             *ProcRegistration             `protobuf:"bytes,7,opt,name=procRegistration,proto3" json:"procRegistration,omitempty"`
-	ProcInitializeSystem         *ProcInitializeSystem         `protobuf:"bytes,8,opt,name=procInitializeSystem,proto3" json:"procInitializeSystem,omitempty"`
-	ProcDestroySystem            *ProcDestroySystem            `protobuf:"bytes,9,opt,name=procDestroySystem,proto3" json:"procDestroySystem,omitempty"`
-	AppBroadcast                 *AppBroadcast                 `protobuf:"bytes,10,opt,name=appBroadcast,proto3" json:"appBroadcast,omitempty"`
-	AppValue                     *AppValue                     `protobuf:"bytes,11,opt,name=appValue,proto3" json:"appValue,omitempty"`
-	AppPropose                   *AppPropose                   `protobuf:"bytes,12,opt,name=appPropose,proto3" json:"appPropose,omitempty"`
-	AppDecide                    *AppDecide                    `protobuf:"bytes,13,opt,name=appDecide,proto3" json:"appDecide,omitempty"`
-	AppRead                      *AppRead                      `protobuf:"bytes,14,opt,name=appRead,proto3" json:"appRead,omitempty"`
-	AppWrite                     *AppWrite                     `protobuf:"bytes,15,opt,name=appWrite,proto3" json:"appWrite,omitempty"`
-	AppReadReturn                *AppReadReturn                `protobuf:"bytes,16,opt,name=appReadReturn,proto3" json:"appReadReturn,omitempty"`
-	AppWriteReturn               *AppWriteReturn               `protobuf:"bytes,17,opt,name=appWriteReturn,proto3" json:"appWriteReturn,omitempty"`
-	UcDecide                     *UcDecide                     `protobuf:"bytes,20,opt,name=ucDecide,proto3" json:"ucDecide,omitempty"`
-	UcPropose                    *UcPropose                    `protobuf:"bytes,21,opt,name=ucPropose,proto3" json:"ucPropose,omitempty"`
-	EpAbort                      *EpAbort                      `protobuf:"bytes,30,opt,name=epAbort,proto3" json:"epAbort,omitempty"`
-	EpAborted                    *EpAborted                    `protobuf:"bytes,31,opt,name=epAborted,proto3" json:"epAborted,omitempty"`
-	EpInternalAccept             *EpInternalAccept             `protobuf:"bytes,32,opt,name=epInternalAccept,proto3" json:"epInternalAccept,omitempty"`
-	EpDecide                     *EpDecide                     `protobuf:"bytes,33,opt,name=epDecide,proto3" json:"epDecide,omitempty"`
-	EpInternalDecided            *EpInternalDecided            `protobuf:"bytes,34,opt,name=epInternalDecided,proto3" json:"epInternalDecided,omitempty"`
-	EpPropose                    *EpPropose                    `protobuf:"bytes,35,opt,name=epPropose,proto3" json:"epPropose,omitempty"`
-	EpInternalRead               *EpInternalRead               `protobuf:"bytes,36,opt,name=epInternalRead,proto3" json:"epInternalRead,omitempty"`
-	EpInternalState              *EpInternalState              `protobuf:"bytes,37,opt,name=epInternalState,proto3" json:"epInternalState,omitempty"`
-	EpInternalWrite              *EpInternalWrite              `protobuf:"bytes,38,opt,name=epInternalWrite,proto3" json:"epInternalWrite,omitempty"`
-	EcInternalNack               *EcInternalNack               `protobuf:"bytes,41,opt,name=ecInternalNack,proto3" json:"ecInternalNack,omitempty"`
-	EcInternalNewEpoch           *EcInternalNewEpoch           `protobuf:"bytes,42,opt,name=ecInternalNewEpoch,proto3" json:"ecInternalNewEpoch,omitempty"`
-	EcStartEpoch                 *EcStartEpoch                 `protobuf:"bytes,43,opt,name=ecStartEpoch,proto3" json:"ecStartEpoch,omitempty"`
-	BebBroadcast                 *BebBroadcast                 `protobuf:"bytes,50,opt,name=bebBroadcast,proto3" json:"bebBroadcast,omitempty"`
-	BebDeliver                   *BebDeliver                   `protobuf:"bytes,51,opt,name=bebDeliver,proto3" json:"bebDeliver,omitempty"`
-	EldTimeout                   *EldTimeout                   `protobuf:"bytes,60,opt,name=eldTimeout,proto3" json:"eldTimeout,omitempty"`
-	EldTrust                     *EldTrust                     `protobuf:"bytes,61,opt,name=eldTrust,proto3" json:"eldTrust,omitempty"`
-	NnarInternalAck              *NnarInternalAck              `protobuf:"bytes,70,opt,name=nnarInternalAck,proto3" json:"nnarInternalAck,omitempty"`
-	NnarInternalRead             *NnarInternalRead             `protobuf:"bytes,71,opt,name=nnarInternalRead,proto3" json:"nnarInternalRead,omitempty"`
-	NnarInternalValue            *NnarInternalValue            `protobuf:"bytes,72,opt,name=nnarInternalValue,proto3" json:"nnarInternalValue,omitempty"`
-	NnarInternalWrite            *NnarInternalWrite            `protobuf:"bytes,73,opt,name=nnarInternalWrite,proto3" json:"nnarInternalWrite,omitempty"`
-	NnarRead                     *NnarRead                     `protobuf:"bytes,74,opt,name=nnarRead,proto3" json:"nnarRead,omitempty"`
-	NnarReadReturn               *NnarReadReturn               `protobuf:"bytes,75,opt,name=nnarReadReturn,proto3" json:"nnarReadReturn,omitempty"`
-	NnarWrite                    *NnarWrite                    `protobuf:"bytes,76,opt,name=nnarWrite,proto3" json:"nnarWrite,omitempty"`
-	NnarWriteReturn              *NnarWriteReturn              `protobuf:"bytes,77,opt,name=nnarWriteReturn,proto3" json:"nnarWriteReturn,omitempty"`
-	EpfdTimeout                  *EpfdTimeout                  `protobuf:"bytes,80,opt,name=epfdTimeout,proto3" json:"epfdTimeout,omitempty"`
-	EpfdInternalHeartbeatRequest *EpfdInternalHeartbeatRequest `protobuf:"bytes,81,opt,name=epfdInternalHeartbeatRequest,proto3" json:"epfdInternalHeartbeatRequest,omitempty"`
-	EpfdInternalHeartbeatReply   *EpfdInternalHeartbeatReply   `protobuf:"bytes,82,opt,name=epfdInternalHeartbeatReply,proto3" json:"epfdInternalHeartbeatReply,omitempty"`
-	EpfdSuspect                  *EpfdSuspect                  `protobuf:"bytes,83,opt,name=epfdSuspect,proto3" json:"epfdSuspect,omitempty"`
-	EpfdRestore                  *EpfdRestore                  `protobuf:"bytes,84,opt,name=epfdRestore,proto3" json:"epfdRestore,omitempty"`
-	PlDeliver                    *PlDeliver                    `protobuf:"bytes,90,opt,name=plDeliver,proto3" json:"plDeliver,omitempty"`
-	PlSend                       *PlSend                       `protobuf:"bytes,91,opt,name=plSend,proto3" json:"plSend,omitempty"`
+	NewTimestamp int32      `protobuf:"varint,1,opt,name=newTimestamp,proto3" json:"newTimestamp,omitempty"`
+	NewLeader    *ProcessId `protobuf:"bytes,2,opt,name=newLeader,proto3" json:"newLeader,omitempty"`
 }
 
-func (x *Message) Reset() {
-	*x = Message{}
+func (x *EcStartEpoch) Reset() {
+	*x = EcStartEpoch{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_messages_proto_msgTypes[47]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2608,13 +3242,13 @@ func (x *Message) Reset() {
 	}
 }
 
-func (x *Message) String() string {
+func (x *EcStartEpoch) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Message) ProtoMessage() {}
+func (*EcStartEpoch) ProtoMessage() {}
 
-func (x *Message) ProtoReflect() protoreflect.Message {
+func (x *EcStartEpoch) ProtoReflect() protoreflect.Message {
 	mi := &file_messages_proto_msgTypes[47]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2626,924 +3260,11742 @@ func (x *Message) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Message.ProtoReflect.Descriptor instead.
-func (*Message) Descriptor() ([]byte, []int) {
+// Deprecated: Use EcStartEpoch.ProtoReflect.Descriptor instead.
+func (*EcStartEpoch) Descriptor() ([]byte, []int) {
 	return file_messages_proto_rawDescGZIP(), []int{47}
 }
 
-func (x *Message) GetType() Message_Type {
+func (x *EcStartEpoch) GetNewTimestamp() int32 {
 	if x != nil {
-		return x.Type
+		return x.NewTimestamp
 	}
-	return Message_NETWORK_MESSAGE
+	return 0
 }
 
-func (x *Message) GetMessageUuid() string {
+func (x *EcStartEpoch) GetNewLeader() *ProcessId {
 	if x != nil {
-		return x.MessageUuid
+		return x.NewLeader
 	}
-	return ""
+	return nil
 }
 
-func (x *Message) GetFromAbstractionId() string {
-	if x != nil {
-		return x.FromAbstractionId
-	}
-	return ""
-}
+type EcInternalNewEpoch struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-func (x *Message) GetToAbstractionId() string {
-	if x != nil {
-		return x.ToAbstractionId
-	}
-	return ""
+	Timestamp int32 `protobuf:"varint,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
 }
 
-func (x *Message) GetSystemId() string {
-	if x != nil {
-		return x.SystemId
+func (x *EcInternalNewEpoch) Reset() {
+	*x = EcInternalNewEpoch{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_messages_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *Message) GetNetworkMessage() *NetworkMessage {
-	if x != nil {
-		return x.NetworkMessage
-	}
-	return nil
+func (x *EcInternalNewEpoch) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *Message) GetProcRegistration() *ProcRegistration {
-	if x != nil {
-		return x.ProcRegistration
-	}
-	return nil
-}
+func (*EcInternalNewEpoch) ProtoMessage() {}
 
-func (x *Message) GetProcInitializeSystem() *ProcInitializeSystem {
-	if x != nil {
-		return x.ProcInitializeSystem
+func (x *EcInternalNewEpoch) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *Message) GetProcDestroySystem() *ProcDestroySystem {
-	if x != nil {
-		return x.ProcDestroySystem
-	}
-	return nil
+// Deprecated: Use EcInternalNewEpoch.ProtoReflect.Descriptor instead.
+func (*EcInternalNewEpoch) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{48}
 }
 
-func (x *Message) GetAppBroadcast() *AppBroadcast {
+func (x *EcInternalNewEpoch) GetTimestamp() int32 {
 	if x != nil {
-		return x.AppBroadcast
+		return x.Timestamp
 	}
-	return nil
+	return 0
 }
 
-func (x *Message) GetAppValue() *AppValue {
-	if x != nil {
-		return x.AppValue
-	}
-	return nil
-}
+// BEB, e pus intentionat message ca poate sa contina orice, de exemplu in carte
+// contine un triplet cu 3 valori [DATA,self,m]
+type BebBroadcast struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-func (x *Message) GetAppPropose() *AppPropose {
-	if x != nil {
-		return x.AppPropose
-	}
-	return nil
+	Message *Message `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
 }
 
-func (x *Message) GetAppDecide() *AppDecide {
-	if x != nil {
-		return x.AppDecide
+func (x *BebBroadcast) Reset() {
+	*x = BebBroadcast{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_messages_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
 
-func (x *Message) GetAppRead() *AppRead {
-	if x != nil {
-		return x.AppRead
-	}
-	return nil
+func (x *BebBroadcast) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *Message) GetAppWrite() *AppWrite {
-	if x != nil {
-		return x.AppWrite
-	}
-	return nil
-}
+func (*BebBroadcast) ProtoMessage() {}
 
-func (x *Message) GetAppReadReturn() *AppReadReturn {
-	if x != nil {
-		return x.AppReadReturn
+func (x *BebBroadcast) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *Message) GetAppWriteReturn() *AppWriteReturn {
-	if x != nil {
-		return x.AppWriteReturn
-	}
-	return nil
+// Deprecated: Use BebBroadcast.ProtoReflect.Descriptor instead.
+func (*BebBroadcast) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{49}
 }
 
-func (x *Message) GetUcDecide() *UcDecide {
+func (x *BebBroadcast) GetMessage() *Message {
 	if x != nil {
-		return x.UcDecide
+		return x.Message
 	}
 	return nil
 }
 
-func (x *Message) GetUcPropose() *UcPropose {
-	if x != nil {
-		return x.UcPropose
-	}
-	return nil
+type BebDeliver struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Message *Message   `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Sender  *ProcessId `protobuf:"bytes,2,opt,name=sender,proto3" json:"sender,omitempty"`
 }
 
-func (x *Message) GetEpAbort() *EpAbort {
-	if x != nil {
-		return x.EpAbort
+func (x *BebDeliver) Reset() {
+	*x = BebDeliver{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_messages_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
 
-func (x *Message) GetEpAborted() *EpAborted {
-	if x != nil {
-		return x.EpAborted
-	}
-	return nil
+func (x *BebDeliver) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *Message) GetEpInternalAccept() *EpInternalAccept {
-	if x != nil {
-		return x.EpInternalAccept
+func (*BebDeliver) ProtoMessage() {}
+
+func (x *BebDeliver) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *Message) GetEpDecide() *EpDecide {
-	if x != nil {
-		return x.EpDecide
-	}
-	return nil
+// Deprecated: Use BebDeliver.ProtoReflect.Descriptor instead.
+func (*BebDeliver) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{50}
 }
 
-func (x *Message) GetEpInternalDecided() *EpInternalDecided {
+func (x *BebDeliver) GetMessage() *Message {
 	if x != nil {
-		return x.EpInternalDecided
+		return x.Message
 	}
 	return nil
 }
 
-func (x *Message) GetEpPropose() *EpPropose {
+func (x *BebDeliver) GetSender() *ProcessId {
 	if x != nil {
-		return x.EpPropose
+		return x.Sender
 	}
 	return nil
 }
 
-func (x *Message) GetEpInternalRead() *EpInternalRead {
-	if x != nil {
-		return x.EpInternalRead
-	}
-	return nil
+// RB
+// Lazy (with PFD) or eager, chosen with the rb_algorithm setting. Messages are identified by their messageId
+type RbBroadcast struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Message *Message `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
 }
 
-func (x *Message) GetEpInternalState() *EpInternalState {
-	if x != nil {
-		return x.EpInternalState
+func (x *RbBroadcast) Reset() {
+	*x = RbBroadcast{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_messages_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
 
-func (x *Message) GetEpInternalWrite() *EpInternalWrite {
-	if x != nil {
-		return x.EpInternalWrite
-	}
-	return nil
+func (x *RbBroadcast) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *Message) GetEcInternalNack() *EcInternalNack {
-	if x != nil {
-		return x.EcInternalNack
+func (*RbBroadcast) ProtoMessage() {}
+
+func (x *RbBroadcast) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *Message) GetEcInternalNewEpoch() *EcInternalNewEpoch {
-	if x != nil {
-		return x.EcInternalNewEpoch
-	}
-	return nil
+// Deprecated: Use RbBroadcast.ProtoReflect.Descriptor instead.
+func (*RbBroadcast) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{51}
 }
 
-func (x *Message) GetEcStartEpoch() *EcStartEpoch {
+func (x *RbBroadcast) GetMessage() *Message {
 	if x != nil {
-		return x.EcStartEpoch
+		return x.Message
 	}
 	return nil
 }
 
-func (x *Message) GetBebBroadcast() *BebBroadcast {
-	if x != nil {
-		return x.BebBroadcast
-	}
-	return nil
+type RbDeliver struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Message *Message   `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Sender  *ProcessId `protobuf:"bytes,2,opt,name=sender,proto3" json:"sender,omitempty"` // The process which broadcast the message, not the one which relayed it
 }
 
-func (x *Message) GetBebDeliver() *BebDeliver {
-	if x != nil {
-		return x.BebDeliver
+func (x *RbDeliver) Reset() {
+	*x = RbDeliver{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_messages_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
 
-func (x *Message) GetEldTimeout() *EldTimeout {
-	if x != nil {
-		return x.EldTimeout
-	}
-	return nil
+func (x *RbDeliver) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *Message) GetEldTrust() *EldTrust {
-	if x != nil {
-		return x.EldTrust
+func (*RbDeliver) ProtoMessage() {}
+
+func (x *RbDeliver) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *Message) GetNnarInternalAck() *NnarInternalAck {
-	if x != nil {
-		return x.NnarInternalAck
-	}
-	return nil
+// Deprecated: Use RbDeliver.ProtoReflect.Descriptor instead.
+func (*RbDeliver) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{52}
 }
 
-func (x *Message) GetNnarInternalRead() *NnarInternalRead {
+func (x *RbDeliver) GetMessage() *Message {
 	if x != nil {
-		return x.NnarInternalRead
+		return x.Message
 	}
 	return nil
 }
 
-func (x *Message) GetNnarInternalValue() *NnarInternalValue {
+func (x *RbDeliver) GetSender() *ProcessId {
 	if x != nil {
-		return x.NnarInternalValue
+		return x.Sender
 	}
 	return nil
 }
 
-func (x *Message) GetNnarInternalWrite() *NnarInternalWrite {
-	if x != nil {
-		return x.NnarInternalWrite
-	}
-	return nil
+type RbInternalData struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MessageId string     `protobuf:"bytes,1,opt,name=messageId,proto3" json:"messageId,omitempty"`
+	Sender    *ProcessId `protobuf:"bytes,2,opt,name=sender,proto3" json:"sender,omitempty"`
+	Message   *Message   `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
 }
 
-func (x *Message) GetNnarRead() *NnarRead {
-	if x != nil {
-		return x.NnarRead
+func (x *RbInternalData) Reset() {
+	*x = RbInternalData{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_messages_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
 
-func (x *Message) GetNnarReadReturn() *NnarReadReturn {
-	if x != nil {
-		return x.NnarReadReturn
-	}
-	return nil
+func (x *RbInternalData) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *Message) GetNnarWrite() *NnarWrite {
-	if x != nil {
-		return x.NnarWrite
+func (*RbInternalData) ProtoMessage() {}
+
+func (x *RbInternalData) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *Message) GetNnarWriteReturn() *NnarWriteReturn {
-	if x != nil {
-		return x.NnarWriteReturn
-	}
-	return nil
+// Deprecated: Use RbInternalData.ProtoReflect.Descriptor instead.
+func (*RbInternalData) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{53}
 }
 
-func (x *Message) GetEpfdTimeout() *EpfdTimeout {
+func (x *RbInternalData) GetMessageId() string {
 	if x != nil {
-		return x.EpfdTimeout
+		return x.MessageId
 	}
-	return nil
+	return ""
 }
 
-func (x *Message) GetEpfdInternalHeartbeatRequest() *EpfdInternalHeartbeatRequest {
+func (x *RbInternalData) GetSender() *ProcessId {
 	if x != nil {
-		return x.EpfdInternalHeartbeatRequest
+		return x.Sender
 	}
 	return nil
 }
 
-func (x *Message) GetEpfdInternalHeartbeatReply() *EpfdInternalHeartbeatReply {
+func (x *RbInternalData) GetMessage() *Message {
 	if x != nil {
-		return x.EpfdInternalHeartbeatReply
+		return x.Message
 	}
 	return nil
 }
 
-func (x *Message) GetEpfdSuspect() *EpfdSuspect {
-	if x != nil {
-		return x.EpfdSuspect
+// URB
+// All-ack (with PFD) or majority-ack, chosen with the urb_algorithm setting
+type UrbBroadcast struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Message *Message `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *UrbBroadcast) Reset() {
+	*x = UrbBroadcast{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_messages_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UrbBroadcast) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UrbBroadcast) ProtoMessage() {}
+
+func (x *UrbBroadcast) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UrbBroadcast.ProtoReflect.Descriptor instead.
+func (*UrbBroadcast) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *UrbBroadcast) GetMessage() *Message {
+	if x != nil {
+		return x.Message
 	}
 	return nil
 }
 
-func (x *Message) GetEpfdRestore() *EpfdRestore {
+type UrbDeliver struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Message *Message   `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Sender  *ProcessId `protobuf:"bytes,2,opt,name=sender,proto3" json:"sender,omitempty"`
+}
+
+func (x *UrbDeliver) Reset() {
+	*x = UrbDeliver{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_messages_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UrbDeliver) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UrbDeliver) ProtoMessage() {}
+
+func (x *UrbDeliver) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UrbDeliver.ProtoReflect.Descriptor instead.
+func (*UrbDeliver) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *UrbDeliver) GetMessage() *Message {
 	if x != nil {
-		return x.EpfdRestore
+		return x.Message
 	}
 	return nil
 }
 
-func (x *Message) GetPlDeliver() *PlDeliver {
+func (x *UrbDeliver) GetSender() *ProcessId {
 	if x != nil {
-		return x.PlDeliver
+		return x.Sender
 	}
 	return nil
 }
 
-func (x *Message) GetPlSend() *PlSend {
+type UrbInternalData struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MessageId string     `protobuf:"bytes,1,opt,name=messageId,proto3" json:"messageId,omitempty"`
+	Sender    *ProcessId `protobuf:"bytes,2,opt,name=sender,proto3" json:"sender,omitempty"`
+	Message   *Message   `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *UrbInternalData) Reset() {
+	*x = UrbInternalData{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_messages_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UrbInternalData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UrbInternalData) ProtoMessage() {}
+
+func (x *UrbInternalData) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UrbInternalData.ProtoReflect.Descriptor instead.
+func (*UrbInternalData) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *UrbInternalData) GetMessageId() string {
 	if x != nil {
-		return x.PlSend
+		return x.MessageId
+	}
+	return ""
+}
+
+func (x *UrbInternalData) GetSender() *ProcessId {
+	if x != nil {
+		return x.Sender
 	}
 	return nil
 }
 
-var File_messages_proto protoreflect.FileDescriptor
+func (x *UrbInternalData) GetMessage() *Message {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
 
-var file_messages_proto_rawDesc = []byte{
-	0x0a, 0x0e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x12, 0x02, 0x70, 0x62, 0x22, 0x73, 0x0a, 0x09, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x49,
-	0x64, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x04, 0x68, 0x6f, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x77, 0x6e,
-	0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12,
-	0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05,
-	0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x22, 0x2f, 0x0a, 0x05, 0x56, 0x61, 0x6c,
-	0x75, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x65, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x07, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x65, 0x64, 0x12, 0x0c, 0x0a, 0x01,
-	0x76, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x01, 0x76, 0x22, 0x3e, 0x0a, 0x10, 0x50, 0x72,
-	0x6f, 0x63, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14,
-	0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6f,
-	0x77, 0x6e, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x22, 0x43, 0x0a, 0x14, 0x50, 0x72,
-	0x6f, 0x63, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x53, 0x79, 0x73, 0x74,
-	0x65, 0x6d, 0x12, 0x2b, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x65, 0x73, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65,
-	0x73, 0x73, 0x49, 0x64, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x65, 0x73, 0x22,
-	0x13, 0x0a, 0x11, 0x50, 0x72, 0x6f, 0x63, 0x44, 0x65, 0x73, 0x74, 0x72, 0x6f, 0x79, 0x53, 0x79,
-	0x73, 0x74, 0x65, 0x6d, 0x22, 0x2f, 0x0a, 0x0c, 0x41, 0x70, 0x70, 0x42, 0x72, 0x6f, 0x61, 0x64,
-	0x63, 0x61, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x70, 0x62, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x2b, 0x0a, 0x08, 0x41, 0x70, 0x70, 0x56, 0x61, 0x6c, 0x75,
-	0x65, 0x12, 0x1f, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x09, 0x2e, 0x70, 0x62, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x22, 0x43, 0x0a, 0x0a, 0x41, 0x70, 0x70, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65,
-	0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x12, 0x1f, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x70, 0x62, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65,
-	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x2c, 0x0a, 0x09, 0x41, 0x70, 0x70, 0x44, 0x65,
-	0x63, 0x69, 0x64, 0x65, 0x12, 0x1f, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x70, 0x62, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x25, 0x0a, 0x07, 0x41, 0x70, 0x70, 0x52, 0x65, 0x61, 0x64,
-	0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x22, 0x47, 0x0a, 0x08,
-	0x41, 0x70, 0x70, 0x57, 0x72, 0x69, 0x74, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x67, 0x69,
-	0x73, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x67, 0x69,
-	0x73, 0x74, 0x65, 0x72, 0x12, 0x1f, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x70, 0x62, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x4c, 0x0a, 0x0d, 0x41, 0x70, 0x70, 0x52, 0x65, 0x61, 0x64,
-	0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74,
-	0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74,
-	0x65, 0x72, 0x12, 0x1f, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x09, 0x2e, 0x70, 0x62, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x22, 0x2c, 0x0a, 0x0e, 0x41, 0x70, 0x70, 0x57, 0x72, 0x69, 0x74, 0x65, 0x52,
-	0x65, 0x74, 0x75, 0x72, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65,
-	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65,
-	0x72, 0x22, 0x2c, 0x0a, 0x09, 0x55, 0x63, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x12, 0x1f,
-	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e,
-	0x70, 0x62, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22,
-	0x2b, 0x0a, 0x08, 0x55, 0x63, 0x44, 0x65, 0x63, 0x69, 0x64, 0x65, 0x12, 0x1f, 0x0a, 0x05, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x70, 0x62, 0x2e,
-	0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x09, 0x0a, 0x07,
-	0x45, 0x70, 0x41, 0x62, 0x6f, 0x72, 0x74, 0x22, 0x66, 0x0a, 0x09, 0x45, 0x70, 0x41, 0x62, 0x6f,
-	0x72, 0x74, 0x65, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x65, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x03, 0x65, 0x74, 0x73, 0x12, 0x26, 0x0a, 0x0e, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x54,
-	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x1f,
-	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e,
-	0x70, 0x62, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22,
-	0x2c, 0x0a, 0x09, 0x45, 0x70, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x05,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x70, 0x62,
-	0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x3d, 0x0a,
-	0x08, 0x45, 0x70, 0x44, 0x65, 0x63, 0x69, 0x64, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x65, 0x74, 0x73,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x65, 0x74, 0x73, 0x12, 0x1f, 0x0a, 0x05, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x70, 0x62, 0x2e,
-	0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x10, 0x0a, 0x0e,
-	0x45, 0x70, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x52, 0x65, 0x61, 0x64, 0x22, 0x5a,
-	0x0a, 0x0f, 0x45, 0x70, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x53, 0x74, 0x61, 0x74,
-	0x65, 0x12, 0x26, 0x0a, 0x0e, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
-	0x61, 0x6d, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x76, 0x61, 0x6c, 0x75, 0x65,
-	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x1f, 0x0a, 0x05, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x70, 0x62, 0x2e, 0x56, 0x61,
-	0x6c, 0x75, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x32, 0x0a, 0x0f, 0x45, 0x70,
-	0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x57, 0x72, 0x69, 0x74, 0x65, 0x12, 0x1f, 0x0a,
-	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x70,
-	0x62, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x12,
-	0x0a, 0x10, 0x45, 0x70, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x41, 0x63, 0x63, 0x65,
-	0x70, 0x74, 0x22, 0x34, 0x0a, 0x11, 0x45, 0x70, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c,
-	0x44, 0x65, 0x63, 0x69, 0x64, 0x65, 0x64, 0x12, 0x1f, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x70, 0x62, 0x2e, 0x56, 0x61, 0x6c, 0x75,
-	0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x10, 0x0a, 0x0e, 0x45, 0x63, 0x49, 0x6e,
-	0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x4e, 0x61, 0x63, 0x6b, 0x22, 0x5f, 0x0a, 0x0c, 0x45, 0x63,
-	0x53, 0x74, 0x61, 0x72, 0x74, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x22, 0x0a, 0x0c, 0x6e, 0x65,
-	0x77, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x0c, 0x6e, 0x65, 0x77, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x2b,
-	0x0a, 0x09, 0x6e, 0x65, 0x77, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x0d, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x49, 0x64,
-	0x52, 0x09, 0x6e, 0x65, 0x77, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x22, 0x32, 0x0a, 0x12, 0x45,
-	0x63, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x4e, 0x65, 0x77, 0x45, 0x70, 0x6f, 0x63,
-	0x68, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22,
-	0x35, 0x0a, 0x0c, 0x42, 0x65, 0x62, 0x42, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x12,
-	0x25, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x0b, 0x2e, 0x70, 0x62, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x07, 0x6d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x5a, 0x0a, 0x0a, 0x42, 0x65, 0x62, 0x44, 0x65, 0x6c,
-	0x69, 0x76, 0x65, 0x72, 0x12, 0x25, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x62, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x25, 0x0a, 0x06, 0x73,
-	0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x70, 0x62,
-	0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x49, 0x64, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x64,
-	0x65, 0x72, 0x22, 0x0c, 0x0a, 0x0a, 0x45, 0x6c, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74,
-	0x22, 0x33, 0x0a, 0x08, 0x45, 0x6c, 0x64, 0x54, 0x72, 0x75, 0x73, 0x74, 0x12, 0x27, 0x0a, 0x07,
-	0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e,
-	0x70, 0x62, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x49, 0x64, 0x52, 0x07, 0x70, 0x72,
-	0x6f, 0x63, 0x65, 0x73, 0x73, 0x22, 0x0a, 0x0a, 0x08, 0x4e, 0x6e, 0x61, 0x72, 0x52, 0x65, 0x61,
-	0x64, 0x22, 0x2a, 0x0a, 0x10, 0x4e, 0x6e, 0x61, 0x72, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61,
-	0x6c, 0x52, 0x65, 0x61, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x64, 0x49, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x72, 0x65, 0x61, 0x64, 0x49, 0x64, 0x22, 0x8a, 0x01,
-	0x0a, 0x11, 0x4e, 0x6e, 0x61, 0x72, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x56, 0x61,
-	0x6c, 0x75, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x64, 0x49, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x06, 0x72, 0x65, 0x61, 0x64, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x74,
-	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09,
-	0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x1e, 0x0a, 0x0a, 0x77, 0x72, 0x69,
-	0x74, 0x65, 0x72, 0x52, 0x61, 0x6e, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x77,
-	0x72, 0x69, 0x74, 0x65, 0x72, 0x52, 0x61, 0x6e, 0x6b, 0x12, 0x1f, 0x0a, 0x05, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x70, 0x62, 0x2e, 0x56, 0x61,
-	0x6c, 0x75, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x8a, 0x01, 0x0a, 0x11, 0x4e,
-	0x6e, 0x61, 0x72, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x57, 0x72, 0x69, 0x74, 0x65,
-	0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x64, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x06, 0x72, 0x65, 0x61, 0x64, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65,
-	0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x74, 0x69, 0x6d,
-	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x1e, 0x0a, 0x0a, 0x77, 0x72, 0x69, 0x74, 0x65, 0x72,
-	0x52, 0x61, 0x6e, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x77, 0x72, 0x69, 0x74,
-	0x65, 0x72, 0x52, 0x61, 0x6e, 0x6b, 0x12, 0x1f, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x70, 0x62, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65,
-	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x2c, 0x0a, 0x09, 0x4e, 0x6e, 0x61, 0x72, 0x57,
-	0x72, 0x69, 0x74, 0x65, 0x12, 0x1f, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x70, 0x62, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x29, 0x0a, 0x0f, 0x4e, 0x6e, 0x61, 0x72, 0x49, 0x6e, 0x74,
-	0x65, 0x72, 0x6e, 0x61, 0x6c, 0x41, 0x63, 0x6b, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x64,
-	0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x72, 0x65, 0x61, 0x64, 0x49, 0x64,
-	0x22, 0x31, 0x0a, 0x0e, 0x4e, 0x6e, 0x61, 0x72, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x74, 0x75,
-	0x72, 0x6e, 0x12, 0x1f, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x09, 0x2e, 0x70, 0x62, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x22, 0x11, 0x0a, 0x0f, 0x4e, 0x6e, 0x61, 0x72, 0x57, 0x72, 0x69, 0x74, 0x65,
-	0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x22, 0x0d, 0x0a, 0x0b, 0x45, 0x70, 0x66, 0x64, 0x54, 0x69,
-	0x6d, 0x65, 0x6f, 0x75, 0x74, 0x22, 0x1e, 0x0a, 0x1c, 0x45, 0x70, 0x66, 0x64, 0x49, 0x6e, 0x74,
-	0x65, 0x72, 0x6e, 0x61, 0x6c, 0x48, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x1c, 0x0a, 0x1a, 0x45, 0x70, 0x66, 0x64, 0x49, 0x6e, 0x74,
-	0x65, 0x72, 0x6e, 0x61, 0x6c, 0x48, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x52, 0x65,
-	0x70, 0x6c, 0x79, 0x22, 0x36, 0x0a, 0x0b, 0x45, 0x70, 0x66, 0x64, 0x53, 0x75, 0x73, 0x70, 0x65,
-	0x63, 0x74, 0x12, 0x27, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73,
-	0x49, 0x64, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x22, 0x36, 0x0a, 0x0b, 0x45,
-	0x70, 0x66, 0x64, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x12, 0x27, 0x0a, 0x07, 0x70, 0x72,
-	0x6f, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x70, 0x62,
-	0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x49, 0x64, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x63,
-	0x65, 0x73, 0x73, 0x22, 0x60, 0x0a, 0x06, 0x50, 0x6c, 0x53, 0x65, 0x6e, 0x64, 0x12, 0x2f, 0x0a,
-	0x0b, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x49,
-	0x64, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x25,
-	0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x0b, 0x2e, 0x70, 0x62, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x07, 0x6d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x59, 0x0a, 0x09, 0x50, 0x6c, 0x44, 0x65, 0x6c, 0x69, 0x76,
-	0x65, 0x72, 0x12, 0x25, 0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x49,
-	0x64, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x25, 0x0a, 0x07, 0x6d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x62, 0x2e,
-	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x22, 0x89, 0x01, 0x0a, 0x0e, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x4d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x48, 0x6f, 0x73,
-	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x48,
-	0x6f, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x13, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x4c, 0x69, 0x73,
-	0x74, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x13, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x69, 0x6e,
-	0x67, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x25, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x62, 0x2e, 0x4d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0xba, 0x1c, 0x0a,
-	0x07, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x24, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x70, 0x62, 0x2e, 0x4d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x20,
-	0x0a, 0x0b, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x55, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0b, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x55, 0x75, 0x69, 0x64,
-	0x12, 0x2c, 0x0a, 0x11, 0x46, 0x72, 0x6f, 0x6d, 0x41, 0x62, 0x73, 0x74, 0x72, 0x61, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x46, 0x72, 0x6f,
-	0x6d, 0x41, 0x62, 0x73, 0x74, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x28,
-	0x0a, 0x0f, 0x54, 0x6f, 0x41, 0x62, 0x73, 0x74, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49,
-	0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x54, 0x6f, 0x41, 0x62, 0x73, 0x74, 0x72,
-	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x79, 0x73, 0x74,
-	0x65, 0x6d, 0x49, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x79, 0x73, 0x74,
-	0x65, 0x6d, 0x49, 0x64, 0x12, 0x3a, 0x0a, 0x0e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x4d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70,
-	0x62, 0x2e, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x52, 0x0e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x12, 0x40, 0x0a, 0x10, 0x70, 0x72, 0x6f, 0x63, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x62, 0x2e,
-	0x50, 0x72, 0x6f, 0x63, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x52, 0x10, 0x70, 0x72, 0x6f, 0x63, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x12, 0x4c, 0x0a, 0x14, 0x70, 0x72, 0x6f, 0x63, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61,
-	0x6c, 0x69, 0x7a, 0x65, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x18, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61,
-	0x6c, 0x69, 0x7a, 0x65, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x52, 0x14, 0x70, 0x72, 0x6f, 0x63,
-	0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d,
-	0x12, 0x43, 0x0a, 0x11, 0x70, 0x72, 0x6f, 0x63, 0x44, 0x65, 0x73, 0x74, 0x72, 0x6f, 0x79, 0x53,
-	0x79, 0x73, 0x74, 0x65, 0x6d, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x70, 0x62,
-	0x2e, 0x50, 0x72, 0x6f, 0x63, 0x44, 0x65, 0x73, 0x74, 0x72, 0x6f, 0x79, 0x53, 0x79, 0x73, 0x74,
-	0x65, 0x6d, 0x52, 0x11, 0x70, 0x72, 0x6f, 0x63, 0x44, 0x65, 0x73, 0x74, 0x72, 0x6f, 0x79, 0x53,
-	0x79, 0x73, 0x74, 0x65, 0x6d, 0x12, 0x34, 0x0a, 0x0c, 0x61, 0x70, 0x70, 0x42, 0x72, 0x6f, 0x61,
-	0x64, 0x63, 0x61, 0x73, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x62,
-	0x2e, 0x41, 0x70, 0x70, 0x42, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x52, 0x0c, 0x61,
-	0x70, 0x70, 0x42, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x12, 0x28, 0x0a, 0x08, 0x61,
-	0x70, 0x70, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e,
-	0x70, 0x62, 0x2e, 0x41, 0x70, 0x70, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x08, 0x61, 0x70, 0x70,
-	0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x2e, 0x0a, 0x0a, 0x61, 0x70, 0x70, 0x50, 0x72, 0x6f, 0x70,
-	0x6f, 0x73, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x62, 0x2e, 0x41,
-	0x70, 0x70, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x52, 0x0a, 0x61, 0x70, 0x70, 0x50, 0x72,
-	0x6f, 0x70, 0x6f, 0x73, 0x65, 0x12, 0x2b, 0x0a, 0x09, 0x61, 0x70, 0x70, 0x44, 0x65, 0x63, 0x69,
-	0x64, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x70, 0x62, 0x2e, 0x41, 0x70,
-	0x70, 0x44, 0x65, 0x63, 0x69, 0x64, 0x65, 0x52, 0x09, 0x61, 0x70, 0x70, 0x44, 0x65, 0x63, 0x69,
-	0x64, 0x65, 0x12, 0x25, 0x0a, 0x07, 0x61, 0x70, 0x70, 0x52, 0x65, 0x61, 0x64, 0x18, 0x0e, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x62, 0x2e, 0x41, 0x70, 0x70, 0x52, 0x65, 0x61, 0x64,
-	0x52, 0x07, 0x61, 0x70, 0x70, 0x52, 0x65, 0x61, 0x64, 0x12, 0x28, 0x0a, 0x08, 0x61, 0x70, 0x70,
-	0x57, 0x72, 0x69, 0x74, 0x65, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x62,
-	0x2e, 0x41, 0x70, 0x70, 0x57, 0x72, 0x69, 0x74, 0x65, 0x52, 0x08, 0x61, 0x70, 0x70, 0x57, 0x72,
-	0x69, 0x74, 0x65, 0x12, 0x37, 0x0a, 0x0d, 0x61, 0x70, 0x70, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65,
-	0x74, 0x75, 0x72, 0x6e, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x62, 0x2e,
-	0x41, 0x70, 0x70, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x52, 0x0d, 0x61,
-	0x70, 0x70, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x12, 0x3a, 0x0a, 0x0e,
-	0x61, 0x70, 0x70, 0x57, 0x72, 0x69, 0x74, 0x65, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x18, 0x11,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70, 0x62, 0x2e, 0x41, 0x70, 0x70, 0x57, 0x72, 0x69,
-	0x74, 0x65, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x52, 0x0e, 0x61, 0x70, 0x70, 0x57, 0x72, 0x69,
-	0x74, 0x65, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x12, 0x28, 0x0a, 0x08, 0x75, 0x63, 0x44, 0x65,
-	0x63, 0x69, 0x64, 0x65, 0x18, 0x14, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x62, 0x2e,
-	0x55, 0x63, 0x44, 0x65, 0x63, 0x69, 0x64, 0x65, 0x52, 0x08, 0x75, 0x63, 0x44, 0x65, 0x63, 0x69,
-	0x64, 0x65, 0x12, 0x2b, 0x0a, 0x09, 0x75, 0x63, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x18,
-	0x15, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x70, 0x62, 0x2e, 0x55, 0x63, 0x50, 0x72, 0x6f,
-	0x70, 0x6f, 0x73, 0x65, 0x52, 0x09, 0x75, 0x63, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x12,
-	0x25, 0x0a, 0x07, 0x65, 0x70, 0x41, 0x62, 0x6f, 0x72, 0x74, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x0b, 0x2e, 0x70, 0x62, 0x2e, 0x45, 0x70, 0x41, 0x62, 0x6f, 0x72, 0x74, 0x52, 0x07, 0x65,
-	0x70, 0x41, 0x62, 0x6f, 0x72, 0x74, 0x12, 0x2b, 0x0a, 0x09, 0x65, 0x70, 0x41, 0x62, 0x6f, 0x72,
-	0x74, 0x65, 0x64, 0x18, 0x1f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x70, 0x62, 0x2e, 0x45,
-	0x70, 0x41, 0x62, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x52, 0x09, 0x65, 0x70, 0x41, 0x62, 0x6f, 0x72,
-	0x74, 0x65, 0x64, 0x12, 0x40, 0x0a, 0x10, 0x65, 0x70, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61,
-	0x6c, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x18, 0x20, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e,
-	0x70, 0x62, 0x2e, 0x45, 0x70, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x41, 0x63, 0x63,
-	0x65, 0x70, 0x74, 0x52, 0x10, 0x65, 0x70, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x41,
-	0x63, 0x63, 0x65, 0x70, 0x74, 0x12, 0x28, 0x0a, 0x08, 0x65, 0x70, 0x44, 0x65, 0x63, 0x69, 0x64,
-	0x65, 0x18, 0x21, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x45, 0x70, 0x44,
-	0x65, 0x63, 0x69, 0x64, 0x65, 0x52, 0x08, 0x65, 0x70, 0x44, 0x65, 0x63, 0x69, 0x64, 0x65, 0x12,
-	0x43, 0x0a, 0x11, 0x65, 0x70, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x44, 0x65, 0x63,
-	0x69, 0x64, 0x65, 0x64, 0x18, 0x22, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x70, 0x62, 0x2e,
-	0x45, 0x70, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x44, 0x65, 0x63, 0x69, 0x64, 0x65,
-	0x64, 0x52, 0x11, 0x65, 0x70, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x44, 0x65, 0x63,
-	0x69, 0x64, 0x65, 0x64, 0x12, 0x2b, 0x0a, 0x09, 0x65, 0x70, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73,
-	0x65, 0x18, 0x23, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x70, 0x62, 0x2e, 0x45, 0x70, 0x50,
-	0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x52, 0x09, 0x65, 0x70, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73,
-	0x65, 0x12, 0x3a, 0x0a, 0x0e, 0x65, 0x70, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x52,
-	0x65, 0x61, 0x64, 0x18, 0x24, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70, 0x62, 0x2e, 0x45,
-	0x70, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x52, 0x65, 0x61, 0x64, 0x52, 0x0e, 0x65,
-	0x70, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x52, 0x65, 0x61, 0x64, 0x12, 0x3d, 0x0a,
-	0x0f, 0x65, 0x70, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x65,
-	0x18, 0x25, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x62, 0x2e, 0x45, 0x70, 0x49, 0x6e,
-	0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x0f, 0x65, 0x70, 0x49,
-	0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x3d, 0x0a, 0x0f,
-	0x65, 0x70, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x57, 0x72, 0x69, 0x74, 0x65, 0x18,
-	0x26, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x62, 0x2e, 0x45, 0x70, 0x49, 0x6e, 0x74,
-	0x65, 0x72, 0x6e, 0x61, 0x6c, 0x57, 0x72, 0x69, 0x74, 0x65, 0x52, 0x0f, 0x65, 0x70, 0x49, 0x6e,
-	0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x57, 0x72, 0x69, 0x74, 0x65, 0x12, 0x3a, 0x0a, 0x0e, 0x65,
-	0x63, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x4e, 0x61, 0x63, 0x6b, 0x18, 0x29, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70, 0x62, 0x2e, 0x45, 0x63, 0x49, 0x6e, 0x74, 0x65, 0x72,
-	0x6e, 0x61, 0x6c, 0x4e, 0x61, 0x63, 0x6b, 0x52, 0x0e, 0x65, 0x63, 0x49, 0x6e, 0x74, 0x65, 0x72,
-	0x6e, 0x61, 0x6c, 0x4e, 0x61, 0x63, 0x6b, 0x12, 0x46, 0x0a, 0x12, 0x65, 0x63, 0x49, 0x6e, 0x74,
-	0x65, 0x72, 0x6e, 0x61, 0x6c, 0x4e, 0x65, 0x77, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x2a, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70, 0x62, 0x2e, 0x45, 0x63, 0x49, 0x6e, 0x74, 0x65, 0x72,
-	0x6e, 0x61, 0x6c, 0x4e, 0x65, 0x77, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x52, 0x12, 0x65, 0x63, 0x49,
-	0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x4e, 0x65, 0x77, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x12,
-	0x34, 0x0a, 0x0c, 0x65, 0x63, 0x53, 0x74, 0x61, 0x72, 0x74, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x18,
-	0x2b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x62, 0x2e, 0x45, 0x63, 0x53, 0x74, 0x61,
-	0x72, 0x74, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x52, 0x0c, 0x65, 0x63, 0x53, 0x74, 0x61, 0x72, 0x74,
-	0x45, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x34, 0x0a, 0x0c, 0x62, 0x65, 0x62, 0x42, 0x72, 0x6f, 0x61,
-	0x64, 0x63, 0x61, 0x73, 0x74, 0x18, 0x32, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x62,
-	0x2e, 0x42, 0x65, 0x62, 0x42, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x52, 0x0c, 0x62,
-	0x65, 0x62, 0x42, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x12, 0x2e, 0x0a, 0x0a, 0x62,
-	0x65, 0x62, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x18, 0x33, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x0e, 0x2e, 0x70, 0x62, 0x2e, 0x42, 0x65, 0x62, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x52,
-	0x0a, 0x62, 0x65, 0x62, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x12, 0x2e, 0x0a, 0x0a, 0x65,
-	0x6c, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x3c, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x0e, 0x2e, 0x70, 0x62, 0x2e, 0x45, 0x6c, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x52,
-	0x0a, 0x65, 0x6c, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x28, 0x0a, 0x08, 0x65,
-	0x6c, 0x64, 0x54, 0x72, 0x75, 0x73, 0x74, 0x18, 0x3d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e,
-	0x70, 0x62, 0x2e, 0x45, 0x6c, 0x64, 0x54, 0x72, 0x75, 0x73, 0x74, 0x52, 0x08, 0x65, 0x6c, 0x64,
-	0x54, 0x72, 0x75, 0x73, 0x74, 0x12, 0x3d, 0x0a, 0x0f, 0x6e, 0x6e, 0x61, 0x72, 0x49, 0x6e, 0x74,
-	0x65, 0x72, 0x6e, 0x61, 0x6c, 0x41, 0x63, 0x6b, 0x18, 0x46, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13,
-	0x2e, 0x70, 0x62, 0x2e, 0x4e, 0x6e, 0x61, 0x72, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c,
-	0x41, 0x63, 0x6b, 0x52, 0x0f, 0x6e, 0x6e, 0x61, 0x72, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61,
-	0x6c, 0x41, 0x63, 0x6b, 0x12, 0x40, 0x0a, 0x10, 0x6e, 0x6e, 0x61, 0x72, 0x49, 0x6e, 0x74, 0x65,
-	0x72, 0x6e, 0x61, 0x6c, 0x52, 0x65, 0x61, 0x64, 0x18, 0x47, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14,
-	0x2e, 0x70, 0x62, 0x2e, 0x4e, 0x6e, 0x61, 0x72, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c,
-	0x52, 0x65, 0x61, 0x64, 0x52, 0x10, 0x6e, 0x6e, 0x61, 0x72, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e,
-	0x61, 0x6c, 0x52, 0x65, 0x61, 0x64, 0x12, 0x43, 0x0a, 0x11, 0x6e, 0x6e, 0x61, 0x72, 0x49, 0x6e,
-	0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x48, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x15, 0x2e, 0x70, 0x62, 0x2e, 0x4e, 0x6e, 0x61, 0x72, 0x49, 0x6e, 0x74, 0x65, 0x72,
-	0x6e, 0x61, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x11, 0x6e, 0x6e, 0x61, 0x72, 0x49, 0x6e,
-	0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x43, 0x0a, 0x11, 0x6e,
-	0x6e, 0x61, 0x72, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x57, 0x72, 0x69, 0x74, 0x65,
-	0x18, 0x49, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x70, 0x62, 0x2e, 0x4e, 0x6e, 0x61, 0x72,
-	0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x57, 0x72, 0x69, 0x74, 0x65, 0x52, 0x11, 0x6e,
-	0x6e, 0x61, 0x72, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x57, 0x72, 0x69, 0x74, 0x65,
-	0x12, 0x28, 0x0a, 0x08, 0x6e, 0x6e, 0x61, 0x72, 0x52, 0x65, 0x61, 0x64, 0x18, 0x4a, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x4e, 0x6e, 0x61, 0x72, 0x52, 0x65, 0x61, 0x64,
-	0x52, 0x08, 0x6e, 0x6e, 0x61, 0x72, 0x52, 0x65, 0x61, 0x64, 0x12, 0x3a, 0x0a, 0x0e, 0x6e, 0x6e,
-	0x61, 0x72, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x18, 0x4b, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70, 0x62, 0x2e, 0x4e, 0x6e, 0x61, 0x72, 0x52, 0x65, 0x61, 0x64,
-	0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x52, 0x0e, 0x6e, 0x6e, 0x61, 0x72, 0x52, 0x65, 0x61, 0x64,
-	0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x12, 0x2b, 0x0a, 0x09, 0x6e, 0x6e, 0x61, 0x72, 0x57, 0x72,
-	0x69, 0x74, 0x65, 0x18, 0x4c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x70, 0x62, 0x2e, 0x4e,
-	0x6e, 0x61, 0x72, 0x57, 0x72, 0x69, 0x74, 0x65, 0x52, 0x09, 0x6e, 0x6e, 0x61, 0x72, 0x57, 0x72,
-	0x69, 0x74, 0x65, 0x12, 0x3d, 0x0a, 0x0f, 0x6e, 0x6e, 0x61, 0x72, 0x57, 0x72, 0x69, 0x74, 0x65,
-	0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x18, 0x4d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70,
-	0x62, 0x2e, 0x4e, 0x6e, 0x61, 0x72, 0x57, 0x72, 0x69, 0x74, 0x65, 0x52, 0x65, 0x74, 0x75, 0x72,
-	0x6e, 0x52, 0x0f, 0x6e, 0x6e, 0x61, 0x72, 0x57, 0x72, 0x69, 0x74, 0x65, 0x52, 0x65, 0x74, 0x75,
-	0x72, 0x6e, 0x12, 0x31, 0x0a, 0x0b, 0x65, 0x70, 0x66, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75,
-	0x74, 0x18, 0x50, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x62, 0x2e, 0x45, 0x70, 0x66,
-	0x64, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x52, 0x0b, 0x65, 0x70, 0x66, 0x64, 0x54, 0x69,
-	0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x64, 0x0a, 0x1c, 0x65, 0x70, 0x66, 0x64, 0x49, 0x6e, 0x74,
-	0x65, 0x72, 0x6e, 0x61, 0x6c, 0x48, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x51, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x70, 0x62,
-	0x2e, 0x45, 0x70, 0x66, 0x64, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x48, 0x65, 0x61,
-	0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x1c, 0x65,
-	0x70, 0x66, 0x64, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x48, 0x65, 0x61, 0x72, 0x74,
-	0x62, 0x65, 0x61, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x5e, 0x0a, 0x1a, 0x65,
-	0x70, 0x66, 0x64, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x48, 0x65, 0x61, 0x72, 0x74,
-	0x62, 0x65, 0x61, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x18, 0x52, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x1e, 0x2e, 0x70, 0x62, 0x2e, 0x45, 0x70, 0x66, 0x64, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61,
-	0x6c, 0x48, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x52,
-	0x1a, 0x65, 0x70, 0x66, 0x64, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x48, 0x65, 0x61,
-	0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x31, 0x0a, 0x0b, 0x65,
-	0x70, 0x66, 0x64, 0x53, 0x75, 0x73, 0x70, 0x65, 0x63, 0x74, 0x18, 0x53, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x0f, 0x2e, 0x70, 0x62, 0x2e, 0x45, 0x70, 0x66, 0x64, 0x53, 0x75, 0x73, 0x70, 0x65, 0x63,
-	0x74, 0x52, 0x0b, 0x65, 0x70, 0x66, 0x64, 0x53, 0x75, 0x73, 0x70, 0x65, 0x63, 0x74, 0x12, 0x31,
-	0x0a, 0x0b, 0x65, 0x70, 0x66, 0x64, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x18, 0x54, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x62, 0x2e, 0x45, 0x70, 0x66, 0x64, 0x52, 0x65, 0x73,
-	0x74, 0x6f, 0x72, 0x65, 0x52, 0x0b, 0x65, 0x70, 0x66, 0x64, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72,
-	0x65, 0x12, 0x2b, 0x0a, 0x09, 0x70, 0x6c, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x18, 0x5a,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x6c, 0x44, 0x65, 0x6c, 0x69,
-	0x76, 0x65, 0x72, 0x52, 0x09, 0x70, 0x6c, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x12, 0x22,
-	0x0a, 0x06, 0x70, 0x6c, 0x53, 0x65, 0x6e, 0x64, 0x18, 0x5b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a,
-	0x2e, 0x70, 0x62, 0x2e, 0x50, 0x6c, 0x53, 0x65, 0x6e, 0x64, 0x52, 0x06, 0x70, 0x6c, 0x53, 0x65,
-	0x6e, 0x64, 0x22, 0x94, 0x07, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x13, 0x0a, 0x0f, 0x4e,
-	0x45, 0x54, 0x57, 0x4f, 0x52, 0x4b, 0x5f, 0x4d, 0x45, 0x53, 0x53, 0x41, 0x47, 0x45, 0x10, 0x00,
-	0x12, 0x15, 0x0a, 0x11, 0x50, 0x52, 0x4f, 0x43, 0x5f, 0x52, 0x45, 0x47, 0x49, 0x53, 0x54, 0x52,
-	0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x01, 0x12, 0x1a, 0x0a, 0x16, 0x50, 0x52, 0x4f, 0x43, 0x5f,
-	0x49, 0x4e, 0x49, 0x54, 0x49, 0x41, 0x4c, 0x49, 0x5a, 0x45, 0x5f, 0x53, 0x59, 0x53, 0x54, 0x45,
-	0x4d, 0x10, 0x02, 0x12, 0x17, 0x0a, 0x13, 0x50, 0x52, 0x4f, 0x43, 0x5f, 0x44, 0x45, 0x53, 0x54,
-	0x52, 0x4f, 0x59, 0x5f, 0x53, 0x59, 0x53, 0x54, 0x45, 0x4d, 0x10, 0x03, 0x12, 0x11, 0x0a, 0x0d,
-	0x41, 0x50, 0x50, 0x5f, 0x42, 0x52, 0x4f, 0x41, 0x44, 0x43, 0x41, 0x53, 0x54, 0x10, 0x04, 0x12,
-	0x0d, 0x0a, 0x09, 0x41, 0x50, 0x50, 0x5f, 0x56, 0x41, 0x4c, 0x55, 0x45, 0x10, 0x05, 0x12, 0x0e,
-	0x0a, 0x0a, 0x41, 0x50, 0x50, 0x5f, 0x44, 0x45, 0x43, 0x49, 0x44, 0x45, 0x10, 0x06, 0x12, 0x0f,
-	0x0a, 0x0b, 0x41, 0x50, 0x50, 0x5f, 0x50, 0x52, 0x4f, 0x50, 0x4f, 0x53, 0x45, 0x10, 0x07, 0x12,
-	0x0c, 0x0a, 0x08, 0x41, 0x50, 0x50, 0x5f, 0x52, 0x45, 0x41, 0x44, 0x10, 0x08, 0x12, 0x0d, 0x0a,
-	0x09, 0x41, 0x50, 0x50, 0x5f, 0x57, 0x52, 0x49, 0x54, 0x45, 0x10, 0x09, 0x12, 0x13, 0x0a, 0x0f,
-	0x41, 0x50, 0x50, 0x5f, 0x52, 0x45, 0x41, 0x44, 0x5f, 0x52, 0x45, 0x54, 0x55, 0x52, 0x4e, 0x10,
-	0x0a, 0x12, 0x14, 0x0a, 0x10, 0x41, 0x50, 0x50, 0x5f, 0x57, 0x52, 0x49, 0x54, 0x45, 0x5f, 0x52,
-	0x45, 0x54, 0x55, 0x52, 0x4e, 0x10, 0x0b, 0x12, 0x0d, 0x0a, 0x09, 0x55, 0x43, 0x5f, 0x44, 0x45,
-	0x43, 0x49, 0x44, 0x45, 0x10, 0x14, 0x12, 0x0e, 0x0a, 0x0a, 0x55, 0x43, 0x5f, 0x50, 0x52, 0x4f,
-	0x50, 0x4f, 0x53, 0x45, 0x10, 0x15, 0x12, 0x0c, 0x0a, 0x08, 0x45, 0x50, 0x5f, 0x41, 0x42, 0x4f,
-	0x52, 0x54, 0x10, 0x1e, 0x12, 0x0e, 0x0a, 0x0a, 0x45, 0x50, 0x5f, 0x41, 0x42, 0x4f, 0x52, 0x54,
-	0x45, 0x44, 0x10, 0x1f, 0x12, 0x0d, 0x0a, 0x09, 0x45, 0x50, 0x5f, 0x44, 0x45, 0x43, 0x49, 0x44,
-	0x45, 0x10, 0x20, 0x12, 0x16, 0x0a, 0x12, 0x45, 0x50, 0x5f, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x4e,
-	0x41, 0x4c, 0x5f, 0x41, 0x43, 0x43, 0x45, 0x50, 0x54, 0x10, 0x21, 0x12, 0x17, 0x0a, 0x13, 0x45,
-	0x50, 0x5f, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x4e, 0x41, 0x4c, 0x5f, 0x44, 0x45, 0x43, 0x49, 0x44,
-	0x45, 0x44, 0x10, 0x22, 0x12, 0x14, 0x0a, 0x10, 0x45, 0x50, 0x5f, 0x49, 0x4e, 0x54, 0x45, 0x52,
-	0x4e, 0x41, 0x4c, 0x5f, 0x52, 0x45, 0x41, 0x44, 0x10, 0x23, 0x12, 0x15, 0x0a, 0x11, 0x45, 0x50,
-	0x5f, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x4e, 0x41, 0x4c, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x10,
-	0x24, 0x12, 0x15, 0x0a, 0x11, 0x45, 0x50, 0x5f, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x4e, 0x41, 0x4c,
-	0x5f, 0x57, 0x52, 0x49, 0x54, 0x45, 0x10, 0x25, 0x12, 0x0e, 0x0a, 0x0a, 0x45, 0x50, 0x5f, 0x50,
-	0x52, 0x4f, 0x50, 0x4f, 0x53, 0x45, 0x10, 0x26, 0x12, 0x14, 0x0a, 0x10, 0x45, 0x43, 0x5f, 0x49,
-	0x4e, 0x54, 0x45, 0x52, 0x4e, 0x41, 0x4c, 0x5f, 0x4e, 0x41, 0x43, 0x4b, 0x10, 0x28, 0x12, 0x19,
-	0x0a, 0x15, 0x45, 0x43, 0x5f, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x4e, 0x41, 0x4c, 0x5f, 0x4e, 0x45,
-	0x57, 0x5f, 0x45, 0x50, 0x4f, 0x43, 0x48, 0x10, 0x29, 0x12, 0x12, 0x0a, 0x0e, 0x45, 0x43, 0x5f,
-	0x53, 0x54, 0x41, 0x52, 0x54, 0x5f, 0x45, 0x50, 0x4f, 0x43, 0x48, 0x10, 0x2a, 0x12, 0x11, 0x0a,
-	0x0d, 0x42, 0x45, 0x42, 0x5f, 0x42, 0x52, 0x4f, 0x41, 0x44, 0x43, 0x41, 0x53, 0x54, 0x10, 0x32,
-	0x12, 0x0f, 0x0a, 0x0b, 0x42, 0x45, 0x42, 0x5f, 0x44, 0x45, 0x4c, 0x49, 0x56, 0x45, 0x52, 0x10,
-	0x33, 0x12, 0x0f, 0x0a, 0x0b, 0x45, 0x4c, 0x44, 0x5f, 0x54, 0x49, 0x4d, 0x45, 0x4f, 0x55, 0x54,
-	0x10, 0x3c, 0x12, 0x0d, 0x0a, 0x09, 0x45, 0x4c, 0x44, 0x5f, 0x54, 0x52, 0x55, 0x53, 0x54, 0x10,
-	0x3d, 0x12, 0x15, 0x0a, 0x11, 0x4e, 0x4e, 0x41, 0x52, 0x5f, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x4e,
-	0x41, 0x4c, 0x5f, 0x41, 0x43, 0x4b, 0x10, 0x46, 0x12, 0x16, 0x0a, 0x12, 0x4e, 0x4e, 0x41, 0x52,
-	0x5f, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x4e, 0x41, 0x4c, 0x5f, 0x52, 0x45, 0x41, 0x44, 0x10, 0x47,
-	0x12, 0x17, 0x0a, 0x13, 0x4e, 0x4e, 0x41, 0x52, 0x5f, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x4e, 0x41,
-	0x4c, 0x5f, 0x56, 0x41, 0x4c, 0x55, 0x45, 0x10, 0x48, 0x12, 0x17, 0x0a, 0x13, 0x4e, 0x4e, 0x41,
-	0x52, 0x5f, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x4e, 0x41, 0x4c, 0x5f, 0x57, 0x52, 0x49, 0x54, 0x45,
-	0x10, 0x49, 0x12, 0x0d, 0x0a, 0x09, 0x4e, 0x4e, 0x41, 0x52, 0x5f, 0x52, 0x45, 0x41, 0x44, 0x10,
-	0x4a, 0x12, 0x14, 0x0a, 0x10, 0x4e, 0x4e, 0x41, 0x52, 0x5f, 0x52, 0x45, 0x41, 0x44, 0x5f, 0x52,
-	0x45, 0x54, 0x55, 0x52, 0x4e, 0x10, 0x4b, 0x12, 0x0e, 0x0a, 0x0a, 0x4e, 0x4e, 0x41, 0x52, 0x5f,
-	0x57, 0x52, 0x49, 0x54, 0x45, 0x10, 0x4c, 0x12, 0x15, 0x0a, 0x11, 0x4e, 0x4e, 0x41, 0x52, 0x5f,
-	0x57, 0x52, 0x49, 0x54, 0x45, 0x5f, 0x52, 0x45, 0x54, 0x55, 0x52, 0x4e, 0x10, 0x4d, 0x12, 0x21,
-	0x0a, 0x1d, 0x45, 0x50, 0x46, 0x44, 0x5f, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x4e, 0x41, 0x4c, 0x5f,
-	0x48, 0x45, 0x41, 0x52, 0x54, 0x42, 0x45, 0x41, 0x54, 0x5f, 0x52, 0x45, 0x50, 0x4c, 0x59, 0x10,
-	0x50, 0x12, 0x23, 0x0a, 0x1f, 0x45, 0x50, 0x46, 0x44, 0x5f, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x4e,
-	0x41, 0x4c, 0x5f, 0x48, 0x45, 0x41, 0x52, 0x54, 0x42, 0x45, 0x41, 0x54, 0x5f, 0x52, 0x45, 0x51,
-	0x55, 0x45, 0x53, 0x54, 0x10, 0x51, 0x12, 0x10, 0x0a, 0x0c, 0x45, 0x50, 0x46, 0x44, 0x5f, 0x52,
-	0x45, 0x53, 0x54, 0x4f, 0x52, 0x45, 0x10, 0x52, 0x12, 0x10, 0x0a, 0x0c, 0x45, 0x50, 0x46, 0x44,
-	0x5f, 0x53, 0x55, 0x53, 0x50, 0x45, 0x43, 0x54, 0x10, 0x53, 0x12, 0x10, 0x0a, 0x0c, 0x45, 0x50,
-	0x46, 0x44, 0x5f, 0x54, 0x49, 0x4d, 0x45, 0x4f, 0x55, 0x54, 0x10, 0x54, 0x12, 0x0e, 0x0a, 0x0a,
-	0x50, 0x4c, 0x5f, 0x44, 0x45, 0x4c, 0x49, 0x56, 0x45, 0x52, 0x10, 0x5a, 0x12, 0x0b, 0x0a, 0x07,
-	0x50, 0x4c, 0x5f, 0x53, 0x45, 0x4e, 0x44, 0x10, 0x5b, 0x42, 0x06, 0x5a, 0x04, 0x2e, 0x3b, 0x70,
-	0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+// FRB
+// Stacked on RB, messages of the same sender are delivered in the order they were broadcast
+type FrbBroadcast struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Message *Message `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
 }
 
-var (
-	file_messages_proto_rawDescOnce sync.Once
-	file_messages_proto_rawDescData = file_messages_proto_rawDesc
-)
+func (x *FrbBroadcast) Reset() {
+	*x = FrbBroadcast{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_messages_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
 
-func file_messages_proto_rawDescGZIP() []byte {
-	file_messages_proto_rawDescOnce.Do(func() {
-		file_messages_proto_rawDescData = protoimpl.X.CompressGZIP(file_messages_proto_rawDescData)
-	})
-	return file_messages_proto_rawDescData
+func (x *FrbBroadcast) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-var file_messages_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_messages_proto_msgTypes = make([]protoimpl.MessageInfo, 48)
-var file_messages_proto_goTypes = []interface{}{
-	(Message_Type)(0),                    // 0: pb.Message.Type
-	(*ProcessId)(nil),                    // 1: pb.ProcessId
-	(*Value)(nil),                        // 2: pb.Value
-	(*ProcRegistration)(nil),             // 3: pb.ProcRegistration
-	(*ProcInitializeSystem)(nil),         // 4: pb.ProcInitializeSystem
-	(*ProcDestroySystem)(nil),            // 5: pb.ProcDestroySystem
-	(*AppBroadcast)(nil),                 // 6: pb.AppBroadcast
-	(*AppValue)(nil),                     // 7: pb.AppValue
-	(*AppPropose)(nil),                   // 8: pb.AppPropose
-	(*AppDecide)(nil),                    // 9: pb.AppDecide
-	(*AppRead)(nil),                      // 10: pb.AppRead
-	(*AppWrite)(nil),                     // 11: pb.AppWrite
-	(*AppReadReturn)(nil),                // 12: pb.AppReadReturn
-	(*AppWriteReturn)(nil),               // 13: pb.AppWriteReturn
-	(*UcPropose)(nil),                    // 14: pb.UcPropose
-	(*UcDecide)(nil),                     // 15: pb.UcDecide
-	(*EpAbort)(nil),                      // 16: pb.EpAbort
-	(*EpAborted)(nil),                    // 17: pb.EpAborted
-	(*EpPropose)(nil),                    // 18: pb.EpPropose
-	(*EpDecide)(nil),                     // 19: pb.EpDecide
-	(*EpInternalRead)(nil),               // 20: pb.EpInternalRead
-	(*EpInternalState)(nil),              // 21: pb.EpInternalState
-	(*EpInternalWrite)(nil),              // 22: pb.EpInternalWrite
-	(*EpInternalAccept)(nil),             // 23: pb.EpInternalAccept
-	(*EpInternalDecided)(nil),            // 24: pb.EpInternalDecided
-	(*EcInternalNack)(nil),               // 25: pb.EcInternalNack
-	(*EcStartEpoch)(nil),                 // 26: pb.EcStartEpoch
-	(*EcInternalNewEpoch)(nil),           // 27: pb.EcInternalNewEpoch
-	(*BebBroadcast)(nil),                 // 28: pb.BebBroadcast
-	(*BebDeliver)(nil),                   // 29: pb.BebDeliver
-	(*EldTimeout)(nil),                   // 30: pb.EldTimeout
-	(*EldTrust)(nil),                     // 31: pb.EldTrust
-	(*NnarRead)(nil),                     // 32: pb.NnarRead
-	(*NnarInternalRead)(nil),             // 33: pb.NnarInternalRead
-	(*NnarInternalValue)(nil),            // 34: pb.NnarInternalValue
-	(*NnarInternalWrite)(nil),            // 35: pb.NnarInternalWrite
-	(*NnarWrite)(nil),                    // 36: pb.NnarWrite
-	(*NnarInternalAck)(nil),              // 37: pb.NnarInternalAck
-	(*NnarReadReturn)(nil),               // 38: pb.NnarReadReturn
-	(*NnarWriteReturn)(nil),              // 39: pb.NnarWriteReturn
-	(*EpfdTimeout)(nil),                  // 40: pb.EpfdTimeout
-	(*EpfdInternalHeartbeatRequest)(nil), // 41: pb.EpfdInternalHeartbeatRequest
-	(*EpfdInternalHeartbeatReply)(nil),   // 42: pb.EpfdInternalHeartbeatReply
-	(*EpfdSuspect)(nil),                  // 43: pb.EpfdSuspect
-	(*EpfdRestore)(nil),                  // 44: pb.EpfdRestore
-	(*PlSend)(nil),                       // 45: pb.PlSend
-	(*PlDeliver)(nil),                    // 46: pb.PlDeliver
-	(*NetworkMessage)(nil),               // 47: pb.NetworkMessage
-	(*Message)(nil),                      // 48: pb.Message
+func (*FrbBroadcast) ProtoMessage() {}
+
+func (x *FrbBroadcast) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
-var file_messages_proto_depIdxs = []int32{
-	1,  // 0: pb.ProcInitializeSystem.processes:type_name -> pb.ProcessId
-	2,  // 1: pb.AppBroadcast.value:type_name -> pb.Value
-	2,  // 2: pb.AppValue.value:type_name -> pb.Value
-	2,  // 3: pb.AppPropose.value:type_name -> pb.Value
-	2,  // 4: pb.AppDecide.value:type_name -> pb.Value
-	2,  // 5: pb.AppWrite.value:type_name -> pb.Value
-	2,  // 6: pb.AppReadReturn.value:type_name -> pb.Value
-	2,  // 7: pb.UcPropose.value:type_name -> pb.Value
-	2,  // 8: pb.UcDecide.value:type_name -> pb.Value
-	2,  // 9: pb.EpAborted.value:type_name -> pb.Value
-	2,  // 10: pb.EpPropose.value:type_name -> pb.Value
-	2,  // 11: pb.EpDecide.value:type_name -> pb.Value
-	2,  // 12: pb.EpInternalState.value:type_name -> pb.Value
-	2,  // 13: pb.EpInternalWrite.value:type_name -> pb.Value
-	2,  // 14: pb.EpInternalDecided.value:type_name -> pb.Value
-	1,  // 15: pb.EcStartEpoch.newLeader:type_name -> pb.ProcessId
-	48, // 16: pb.BebBroadcast.message:type_name -> pb.Message
-	48, // 17: pb.BebDeliver.message:type_name -> pb.Message
-	1,  // 18: pb.BebDeliver.sender:type_name -> pb.ProcessId
-	1,  // 19: pb.EldTrust.process:type_name -> pb.ProcessId
-	2,  // 20: pb.NnarInternalValue.value:type_name -> pb.Value
-	2,  // 21: pb.NnarInternalWrite.value:type_name -> pb.Value
-	2,  // 22: pb.NnarWrite.value:type_name -> pb.Value
-	2,  // 23: pb.NnarReadReturn.value:type_name -> pb.Value
-	1,  // 24: pb.EpfdSuspect.process:type_name -> pb.ProcessId
-	1,  // 25: pb.EpfdRestore.process:type_name -> pb.ProcessId
-	1,  // 26: pb.PlSend.destination:type_name -> pb.ProcessId
-	48, // 27: pb.PlSend.message:type_name -> pb.Message
-	1,  // 28: pb.PlDeliver.sender:type_name -> pb.ProcessId
-	48, // 29: pb.PlDeliver.message:type_name -> pb.Message
-	48, // 30: pb.NetworkMessage.message:type_name -> pb.Message
-	0,  // 31: pb.Message.type:type_name -> pb.Message.Type
-	47, // 32: pb.Message.networkMessage:type_name -> pb.NetworkMessage
-	3,  // 33: pb.Message.procRegistration:type_name -> pb.ProcRegistration
-	4,  // 34: pb.Message.procInitializeSystem:type_name -> pb.ProcInitializeSystem
-	5,  // 35: pb.Message.procDestroySystem:type_name -> pb.ProcDestroySystem
-	6,  // 36: pb.Message.appBroadcast:type_name -> pb.AppBroadcast
-	7,  // 37: pb.Message.appValue:type_name -> pb.AppValue
-	8,  // 38: pb.Message.appPropose:type_name -> pb.AppPropose
-	9,  // 39: pb.Message.appDecide:type_name -> pb.AppDecide
-	10, // 40: pb.Message.appRead:type_name -> pb.AppRead
-	11, // 41: pb.Message.appWrite:type_name -> pb.AppWrite
-	12, // 42: pb.Message.appReadReturn:type_name -> pb.AppReadReturn
-	13, // 43: pb.Message.appWriteReturn:type_name -> pb.AppWriteReturn
-	15, // 44: pb.Message.ucDecide:type_name -> pb.UcDecide
-	14, // 45: pb.Message.ucPropose:type_name -> pb.UcPropose
-	16, // 46: pb.Message.epAbort:type_name -> pb.EpAbort
-	17, // 47: pb.Message.epAborted:type_name -> pb.EpAborted
-	23, // 48: pb.Message.epInternalAccept:type_name -> pb.EpInternalAccept
-	19, // 49: pb.Message.epDecide:type_name -> pb.EpDecide
-	24, // 50: pb.Message.epInternalDecided:type_name -> pb.EpInternalDecided
-	18, // 51: pb.Message.epPropose:type_name -> pb.EpPropose
-	20, // 52: pb.Message.epInternalRead:type_name -> pb.EpInternalRead
-	21, // 53: pb.Message.epInternalState:type_name -> pb.EpInternalState
-	22, // 54: pb.Message.epInternalWrite:type_name -> pb.EpInternalWrite
-	25, // 55: pb.Message.ecInternalNack:type_name -> pb.EcInternalNack
-	27, // 56: pb.Message.ecInternalNewEpoch:type_name -> pb.EcInternalNewEpoch
-	26, // 57: pb.Message.ecStartEpoch:type_name -> pb.EcStartEpoch
-	28, // 58: pb.Message.bebBroadcast:type_name -> pb.BebBroadcast
-	29, // 59: pb.Message.bebDeliver:type_name -> pb.BebDeliver
-	30, // 60: pb.Message.eldTimeout:type_name -> pb.EldTimeout
-	31, // 61: pb.Message.eldTrust:type_name -> pb.EldTrust
-	37, // 62: pb.Message.nnarInternalAck:type_name -> pb.NnarInternalAck
-	33, // 63: pb.Message.nnarInternalRead:type_name -> pb.NnarInternalRead
-	34, // 64: pb.Message.nnarInternalValue:type_name -> pb.NnarInternalValue
-	35, // 65: pb.Message.nnarInternalWrite:type_name -> pb.NnarInternalWrite
-	32, // 66: pb.Message.nnarRead:type_name -> pb.NnarRead
-	38, // 67: pb.Message.nnarReadReturn:type_name -> pb.NnarReadReturn
-	36, // 68: pb.Message.nnarWrite:type_name -> pb.NnarWrite
-	39, // 69: pb.Message.nnarWriteReturn:type_name -> pb.NnarWriteReturn
-	40, // 70: pb.Message.epfdTimeout:type_name -> pb.EpfdTimeout
-	41, // 71: pb.Message.epfdInternalHeartbeatRequest:type_name -> pb.EpfdInternalHeartbeatRequest
-	42, // 72: pb.Message.epfdInternalHeartbeatReply:type_name -> pb.EpfdInternalHeartbeatReply
-	43, // 73: pb.Message.epfdSuspect:type_name -> pb.EpfdSuspect
-	44, // 74: pb.Message.epfdRestore:type_name -> pb.EpfdRestore
-	46, // 75: pb.Message.plDeliver:type_name -> pb.PlDeliver
-	45, // 76: pb.Message.plSend:type_name -> pb.PlSend
-	77, // [77:77] is the sub-list for method output_type
-	77, // [77:77] is the sub-list for method input_type
-	77, // [77:77] is the sub-list for extension type_name
-	77, // [77:77] is the sub-list for extension extendee
-	0,  // [0:77] is the sub-list for field type_name
+
+// Deprecated: Use FrbBroadcast.ProtoReflect.Descriptor instead.
+func (*FrbBroadcast) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{57}
 }
 
-func init() { file_messages_proto_init() }
-func file_messages_proto_init() {
-	if File_messages_proto != nil {
-		return
+func (x *FrbBroadcast) GetMessage() *Message {
+	if x != nil {
+		return x.Message
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_messages_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProcessId); i {
+	return nil
+}
+
+type FrbDeliver struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Message *Message   `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Sender  *ProcessId `protobuf:"bytes,2,opt,name=sender,proto3" json:"sender,omitempty"`
+}
+
+func (x *FrbDeliver) Reset() {
+	*x = FrbDeliver{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_messages_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FrbDeliver) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FrbDeliver) ProtoMessage() {}
+
+func (x *FrbDeliver) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FrbDeliver.ProtoReflect.Descriptor instead.
+func (*FrbDeliver) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *FrbDeliver) GetMessage() *Message {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+func (x *FrbDeliver) GetSender() *ProcessId {
+	if x != nil {
+		return x.Sender
+	}
+	return nil
+}
+
+type FrbInternalData struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SequenceNumber int32    `protobuf:"varint,1,opt,name=sequenceNumber,proto3" json:"sequenceNumber,omitempty"` // Starts from 1 for every sender
+	Message        *Message `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *FrbInternalData) Reset() {
+	*x = FrbInternalData{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_messages_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FrbInternalData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FrbInternalData) ProtoMessage() {}
+
+func (x *FrbInternalData) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FrbInternalData.ProtoReflect.Descriptor instead.
+func (*FrbInternalData) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *FrbInternalData) GetSequenceNumber() int32 {
+	if x != nil {
+		return x.SequenceNumber
+	}
+	return 0
+}
+
+func (x *FrbInternalData) GetMessage() *Message {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+// CRB
+// Stacked on RB, no-waiting (past list) or waiting (vector clock), chosen with the crb_algorithm setting
+type CrbBroadcast struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Message *Message `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *CrbBroadcast) Reset() {
+	*x = CrbBroadcast{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_messages_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CrbBroadcast) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CrbBroadcast) ProtoMessage() {}
+
+func (x *CrbBroadcast) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CrbBroadcast.ProtoReflect.Descriptor instead.
+func (*CrbBroadcast) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *CrbBroadcast) GetMessage() *Message {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type CrbDeliver struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Message *Message   `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Sender  *ProcessId `protobuf:"bytes,2,opt,name=sender,proto3" json:"sender,omitempty"`
+}
+
+func (x *CrbDeliver) Reset() {
+	*x = CrbDeliver{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_messages_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CrbDeliver) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CrbDeliver) ProtoMessage() {}
+
+func (x *CrbDeliver) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CrbDeliver.ProtoReflect.Descriptor instead.
+func (*CrbDeliver) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *CrbDeliver) GetMessage() *Message {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+func (x *CrbDeliver) GetSender() *ProcessId {
+	if x != nil {
+		return x.Sender
+	}
+	return nil
+}
+
+type CrbPastEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MessageId string     `protobuf:"bytes,1,opt,name=messageId,proto3" json:"messageId,omitempty"`
+	Sender    *ProcessId `protobuf:"bytes,2,opt,name=sender,proto3" json:"sender,omitempty"`
+	Message   *Message   `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *CrbPastEntry) Reset() {
+	*x = CrbPastEntry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_messages_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CrbPastEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CrbPastEntry) ProtoMessage() {}
+
+func (x *CrbPastEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CrbPastEntry.ProtoReflect.Descriptor instead.
+func (*CrbPastEntry) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *CrbPastEntry) GetMessageId() string {
+	if x != nil {
+		return x.MessageId
+	}
+	return ""
+}
+
+func (x *CrbPastEntry) GetSender() *ProcessId {
+	if x != nil {
+		return x.Sender
+	}
+	return nil
+}
+
+func (x *CrbPastEntry) GetMessage() *Message {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type CrbInternalData struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MessageId   string          `protobuf:"bytes,1,opt,name=messageId,proto3" json:"messageId,omitempty"`
+	Past        []*CrbPastEntry `protobuf:"bytes,2,rep,name=past,proto3" json:"past,omitempty"`                       // No-waiting: the messages delivered by the sender before broadcasting
+	VectorClock []int32         `protobuf:"varint,3,rep,packed,name=vectorClock,proto3" json:"vectorClock,omitempty"` // Waiting: messages of every sender delivered before broadcasting, indexed by rank position
+	Message     *Message        `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *CrbInternalData) Reset() {
+	*x = CrbInternalData{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_messages_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CrbInternalData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CrbInternalData) ProtoMessage() {}
+
+func (x *CrbInternalData) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CrbInternalData.ProtoReflect.Descriptor instead.
+func (*CrbInternalData) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *CrbInternalData) GetMessageId() string {
+	if x != nil {
+		return x.MessageId
+	}
+	return ""
+}
+
+func (x *CrbInternalData) GetPast() []*CrbPastEntry {
+	if x != nil {
+		return x.Past
+	}
+	return nil
+}
+
+func (x *CrbInternalData) GetVectorClock() []int32 {
+	if x != nil {
+		return x.VectorClock
+	}
+	return nil
+}
+
+func (x *CrbInternalData) GetMessage() *Message {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+// PB
+// Eager or lazy gossip, chosen with the pb_algorithm setting. Every process relays a message to pb_fanout random
+// processes, for pb_rounds rounds. The wrapping Message of PbInternalGossip carries the messageUuid
+type PbBroadcast struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Message *Message `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *PbBroadcast) Reset() {
+	*x = PbBroadcast{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_messages_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PbBroadcast) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PbBroadcast) ProtoMessage() {}
+
+func (x *PbBroadcast) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PbBroadcast.ProtoReflect.Descriptor instead.
+func (*PbBroadcast) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *PbBroadcast) GetMessage() *Message {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type PbDeliver struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Message *Message   `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Sender  *ProcessId `protobuf:"bytes,2,opt,name=sender,proto3" json:"sender,omitempty"`
+}
+
+func (x *PbDeliver) Reset() {
+	*x = PbDeliver{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_messages_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PbDeliver) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PbDeliver) ProtoMessage() {}
+
+func (x *PbDeliver) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PbDeliver.ProtoReflect.Descriptor instead.
+func (*PbDeliver) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *PbDeliver) GetMessage() *Message {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+func (x *PbDeliver) GetSender() *ProcessId {
+	if x != nil {
+		return x.Sender
+	}
+	return nil
+}
+
+type PbInternalGossip struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sender         *ProcessId `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	SequenceNumber int32      `protobuf:"varint,2,opt,name=sequenceNumber,proto3" json:"sequenceNumber,omitempty"`
+	Rounds         int32      `protobuf:"varint,3,opt,name=rounds,proto3" json:"rounds,omitempty"` // Rounds left, including the current one
+	Message        *Message   `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *PbInternalGossip) Reset() {
+	*x = PbInternalGossip{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_messages_proto_msgTypes[66]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PbInternalGossip) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PbInternalGossip) ProtoMessage() {}
+
+func (x *PbInternalGossip) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[66]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PbInternalGossip.ProtoReflect.Descriptor instead.
+func (*PbInternalGossip) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *PbInternalGossip) GetSender() *ProcessId {
+	if x != nil {
+		return x.Sender
+	}
+	return nil
+}
+
+func (x *PbInternalGossip) GetSequenceNumber() int32 {
+	if x != nil {
+		return x.SequenceNumber
+	}
+	return 0
+}
+
+func (x *PbInternalGossip) GetRounds() int32 {
+	if x != nil {
+		return x.Rounds
+	}
+	return 0
+}
+
+func (x *PbInternalGossip) GetMessage() *Message {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+// Lazy only: asks for a missing message, relayed for the given number of rounds until a process stored it
+type PbInternalRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Requester      *ProcessId `protobuf:"bytes,1,opt,name=requester,proto3" json:"requester,omitempty"`
+	Sender         *ProcessId `protobuf:"bytes,2,opt,name=sender,proto3" json:"sender,omitempty"`
+	SequenceNumber int32      `protobuf:"varint,3,opt,name=sequenceNumber,proto3" json:"sequenceNumber,omitempty"`
+	Rounds         int32      `protobuf:"varint,4,opt,name=rounds,proto3" json:"rounds,omitempty"`
+}
+
+func (x *PbInternalRequest) Reset() {
+	*x = PbInternalRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_messages_proto_msgTypes[67]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PbInternalRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PbInternalRequest) ProtoMessage() {}
+
+func (x *PbInternalRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[67]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PbInternalRequest.ProtoReflect.Descriptor instead.
+func (*PbInternalRequest) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *PbInternalRequest) GetRequester() *ProcessId {
+	if x != nil {
+		return x.Requester
+	}
+	return nil
+}
+
+func (x *PbInternalRequest) GetSender() *ProcessId {
+	if x != nil {
+		return x.Sender
+	}
+	return nil
+}
+
+func (x *PbInternalRequest) GetSequenceNumber() int32 {
+	if x != nil {
+		return x.SequenceNumber
+	}
+	return 0
+}
+
+func (x *PbInternalRequest) GetRounds() int32 {
+	if x != nil {
+		return x.Rounds
+	}
+	return 0
+}
+
+// Lazy only: a stored message sent back to the requester
+type PbInternalData struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sender         *ProcessId `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	SequenceNumber int32      `protobuf:"varint,2,opt,name=sequenceNumber,proto3" json:"sequenceNumber,omitempty"`
+	Message        *Message   `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *PbInternalData) Reset() {
+	*x = PbInternalData{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_messages_proto_msgTypes[68]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PbInternalData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PbInternalData) ProtoMessage() {}
+
+func (x *PbInternalData) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[68]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PbInternalData.ProtoReflect.Descriptor instead.
+func (*PbInternalData) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *PbInternalData) GetSender() *ProcessId {
+	if x != nil {
+		return x.Sender
+	}
+	return nil
+}
+
+func (x *PbInternalData) GetSequenceNumber() int32 {
+	if x != nil {
+		return x.SequenceNumber
+	}
+	return 0
+}
+
+func (x *PbInternalData) GetMessage() *Message {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+// Lazy only: gives up on the messages of the sender missing before sequenceNumber
+type PbTimeout struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sender         *ProcessId `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	SequenceNumber int32      `protobuf:"varint,2,opt,name=sequenceNumber,proto3" json:"sequenceNumber,omitempty"`
+}
+
+func (x *PbTimeout) Reset() {
+	*x = PbTimeout{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_messages_proto_msgTypes[69]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PbTimeout) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PbTimeout) ProtoMessage() {}
+
+func (x *PbTimeout) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[69]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PbTimeout.ProtoReflect.Descriptor instead.
+func (*PbTimeout) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *PbTimeout) GetSender() *ProcessId {
+	if x != nil {
+		return x.Sender
+	}
+	return nil
+}
+
+func (x *PbTimeout) GetSequenceNumber() int32 {
+	if x != nil {
+		return x.SequenceNumber
+	}
+	return 0
+}
+
+// TOB
+// Consensus-based total-order broadcast. Messages are rb broadcast, then the processes agree on the batch to deliver
+// next with successive uc instances, one per round: app.tob.uc[1], app.tob.uc[2], ...
+type TobBroadcast struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Message *Message `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *TobBroadcast) Reset() {
+	*x = TobBroadcast{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_messages_proto_msgTypes[70]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TobBroadcast) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TobBroadcast) ProtoMessage() {}
+
+func (x *TobBroadcast) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[70]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TobBroadcast.ProtoReflect.Descriptor instead.
+func (*TobBroadcast) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *TobBroadcast) GetMessage() *Message {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type TobDeliver struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sender         *ProcessId `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	Message        *Message   `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	SequenceNumber int32      `protobuf:"varint,3,opt,name=sequenceNumber,proto3" json:"sequenceNumber,omitempty"` // Position of the message in the total order, starting at 1
+}
+
+func (x *TobDeliver) Reset() {
+	*x = TobDeliver{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_messages_proto_msgTypes[71]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TobDeliver) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TobDeliver) ProtoMessage() {}
+
+func (x *TobDeliver) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[71]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TobDeliver.ProtoReflect.Descriptor instead.
+func (*TobDeliver) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *TobDeliver) GetSender() *ProcessId {
+	if x != nil {
+		return x.Sender
+	}
+	return nil
+}
+
+func (x *TobDeliver) GetMessage() *Message {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+func (x *TobDeliver) GetSequenceNumber() int32 {
+	if x != nil {
+		return x.SequenceNumber
+	}
+	return 0
+}
+
+type TobInternalData struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MessageId string   `protobuf:"bytes,1,opt,name=messageId,proto3" json:"messageId,omitempty"`
+	Message   *Message `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *TobInternalData) Reset() {
+	*x = TobInternalData{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_messages_proto_msgTypes[72]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TobInternalData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TobInternalData) ProtoMessage() {}
+
+func (x *TobInternalData) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[72]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TobInternalData.ProtoReflect.Descriptor instead.
+func (*TobInternalData) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *TobInternalData) GetMessageId() string {
+	if x != nil {
+		return x.MessageId
+	}
+	return ""
+}
+
+func (x *TobInternalData) GetMessage() *Message {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type TobEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MessageId string     `protobuf:"bytes,1,opt,name=messageId,proto3" json:"messageId,omitempty"`
+	Sender    *ProcessId `protobuf:"bytes,2,opt,name=sender,proto3" json:"sender,omitempty"`
+	Message   *Message   `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *TobEntry) Reset() {
+	*x = TobEntry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_messages_proto_msgTypes[73]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TobEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TobEntry) ProtoMessage() {}
+
+func (x *TobEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[73]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TobEntry.ProtoReflect.Descriptor instead.
+func (*TobEntry) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *TobEntry) GetMessageId() string {
+	if x != nil {
+		return x.MessageId
+	}
+	return ""
+}
+
+func (x *TobEntry) GetSender() *ProcessId {
+	if x != nil {
+		return x.Sender
+	}
+	return nil
+}
+
+func (x *TobEntry) GetMessage() *Message {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type TobBatch struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Entries []*TobEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+}
+
+func (x *TobBatch) Reset() {
+	*x = TobBatch{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_messages_proto_msgTypes[74]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TobBatch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TobBatch) ProtoMessage() {}
+
+func (x *TobBatch) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[74]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TobBatch.ProtoReflect.Descriptor instead.
+func (*TobBatch) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *TobBatch) GetEntries() []*TobEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+// TRB
+// Consensus-based terminating reliable broadcast, one instance per sender: app.trb[topic]. Every process gets a
+// TrbBroadcast to learn the sender, only the sender beb broadcasts its message. The processes propose to
+// app.trb[topic].uc the message or, when the perfect failure detector reports the sender first, the failure
+type TrbBroadcast struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sender  *ProcessId `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	Message *Message   `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *TrbBroadcast) Reset() {
+	*x = TrbBroadcast{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_messages_proto_msgTypes[75]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TrbBroadcast) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrbBroadcast) ProtoMessage() {}
+
+func (x *TrbBroadcast) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[75]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrbBroadcast.ProtoReflect.Descriptor instead.
+func (*TrbBroadcast) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *TrbBroadcast) GetSender() *ProcessId {
+	if x != nil {
+		return x.Sender
+	}
+	return nil
+}
+
+func (x *TrbBroadcast) GetMessage() *Message {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type TrbDeliver struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sender  *ProcessId `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	Message *Message   `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"` // Ignore if failed == true
+	Failed  bool       `protobuf:"varint,3,opt,name=failed,proto3" json:"failed,omitempty"`
+}
+
+func (x *TrbDeliver) Reset() {
+	*x = TrbDeliver{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_messages_proto_msgTypes[76]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TrbDeliver) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrbDeliver) ProtoMessage() {}
+
+func (x *TrbDeliver) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[76]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrbDeliver.ProtoReflect.Descriptor instead.
+func (*TrbDeliver) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *TrbDeliver) GetSender() *ProcessId {
+	if x != nil {
+		return x.Sender
+	}
+	return nil
+}
+
+func (x *TrbDeliver) GetMessage() *Message {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+func (x *TrbDeliver) GetFailed() bool {
+	if x != nil {
+		return x.Failed
+	}
+	return false
+}
+
+type TrbInternalData struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Message *Message `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *TrbInternalData) Reset() {
+	*x = TrbInternalData{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_messages_proto_msgTypes[77]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TrbInternalData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrbInternalData) ProtoMessage() {}
+
+func (x *TrbInternalData) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[77]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrbInternalData.ProtoReflect.Descriptor instead.
+func (*TrbInternalData) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *TrbInternalData) GetMessage() *Message {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type TrbProposal struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sender  *ProcessId `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	Message *Message   `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"` // Ignore if failed == true
+	Failed  bool       `protobuf:"varint,3,opt,name=failed,proto3" json:"failed,omitempty"`
+}
+
+func (x *TrbProposal) Reset() {
+	*x = TrbProposal{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_messages_proto_msgTypes[78]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TrbProposal) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrbProposal) ProtoMessage() {}
+
+func (x *TrbProposal) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[78]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrbProposal.ProtoReflect.Descriptor instead.
+func (*TrbProposal) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *TrbProposal) GetSender() *ProcessId {
+	if x != nil {
+		return x.Sender
+	}
+	return nil
+}
+
+func (x *TrbProposal) GetMessage() *Message {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+func (x *TrbProposal) GetFailed() bool {
+	if x != nil {
+		return x.Failed
+	}
+	return false
+}
+
+// NBAC
+// Consensus-based non-blocking atomic commit, one instance per transaction: app.nbac[topic]. The votes are beb
+// broadcast, app.nbac[topic].uc decides commit (value 1) when every process voted commit, abort (value 0) as soon as
+// one votes abort or the perfect failure detector reports a crash
+type NbacPropose struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Commit bool `protobuf:"varint,1,opt,name=commit,proto3" json:"commit,omitempty"`
+}
+
+func (x *NbacPropose) Reset() {
+	*x = NbacPropose{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_messages_proto_msgTypes[79]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NbacPropose) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NbacPropose) ProtoMessage() {}
+
+func (x *NbacPropose) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[79]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NbacPropose.ProtoReflect.Descriptor instead.
+func (*NbacPropose) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *NbacPropose) GetCommit() bool {
+	if x != nil {
+		return x.Commit
+	}
+	return false
+}
+
+type NbacDecide struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Commit bool `protobuf:"varint,1,opt,name=commit,proto3" json:"commit,omitempty"`
+}
+
+func (x *NbacDecide) Reset() {
+	*x = NbacDecide{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_messages_proto_msgTypes[80]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NbacDecide) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NbacDecide) ProtoMessage() {}
+
+func (x *NbacDecide) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[80]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NbacDecide.ProtoReflect.Descriptor instead.
+func (*NbacDecide) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *NbacDecide) GetCommit() bool {
+	if x != nil {
+		return x.Commit
+	}
+	return false
+}
+
+type NbacInternalVote struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Commit bool `protobuf:"varint,1,opt,name=commit,proto3" json:"commit,omitempty"`
+}
+
+func (x *NbacInternalVote) Reset() {
+	*x = NbacInternalVote{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_messages_proto_msgTypes[81]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NbacInternalVote) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NbacInternalVote) ProtoMessage() {}
+
+func (x *NbacInternalVote) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[81]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NbacInternalVote.ProtoReflect.Descriptor instead.
+func (*NbacInternalVote) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *NbacInternalVote) GetCommit() bool {
+	if x != nil {
+		return x.Commit
+	}
+	return false
+}
+
+// 2PC, 3PC
+// Two and three phase commit, one instance per transaction: app.2pc[topic] or app.3pc[topic]. The coordinator sends
+// CommitInternalPrepare to every process, which answers with its vote over pl. Timeouts come from the eventually
+// perfect failure detector of the instance. In 3pc the processes send CommitInternalState to a new coordinator when
+// the first one is suspected, which decides for them
+type CommitStart struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Coordinator *ProcessId `protobuf:"bytes,1,opt,name=coordinator,proto3" json:"coordinator,omitempty"`
+	Commit      bool       `protobuf:"varint,2,opt,name=commit,proto3" json:"commit,omitempty"` // Vote
+}
+
+func (x *CommitStart) Reset() {
+	*x = CommitStart{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_messages_proto_msgTypes[82]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CommitStart) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommitStart) ProtoMessage() {}
+
+func (x *CommitStart) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[82]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommitStart.ProtoReflect.Descriptor instead.
+func (*CommitStart) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *CommitStart) GetCoordinator() *ProcessId {
+	if x != nil {
+		return x.Coordinator
+	}
+	return nil
+}
+
+func (x *CommitStart) GetCommit() bool {
+	if x != nil {
+		return x.Commit
+	}
+	return false
+}
+
+type CommitOutcome struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Committed bool  `protobuf:"varint,1,opt,name=committed,proto3" json:"committed,omitempty"`
+	Blocked   bool  `protobuf:"varint,2,opt,name=blocked,proto3" json:"blocked,omitempty"`
+	Latency   int64 `protobuf:"varint,3,opt,name=latency,proto3" json:"latency,omitempty"`
+}
+
+func (x *CommitOutcome) Reset() {
+	*x = CommitOutcome{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_messages_proto_msgTypes[83]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CommitOutcome) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommitOutcome) ProtoMessage() {}
+
+func (x *CommitOutcome) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[83]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommitOutcome.ProtoReflect.Descriptor instead.
+func (*CommitOutcome) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *CommitOutcome) GetCommitted() bool {
+	if x != nil {
+		return x.Committed
+	}
+	return false
+}
+
+func (x *CommitOutcome) GetBlocked() bool {
+	if x != nil {
+		return x.Blocked
+	}
+	return false
+}
+
+func (x *CommitOutcome) GetLatency() int64 {
+	if x != nil {
+		return x.Latency
+	}
+	return 0
+}
+
+type CommitInternalPrepare struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *CommitInternalPrepare) Reset() {
+	*x = CommitInternalPrepare{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_messages_proto_msgTypes[84]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CommitInternalPrepare) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommitInternalPrepare) ProtoMessage() {}
+
+func (x *CommitInternalPrepare) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[84]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommitInternalPrepare.ProtoReflect.Descriptor instead.
+func (*CommitInternalPrepare) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{84}
+}
+
+type CommitInternalVote struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Commit bool `protobuf:"varint,1,opt,name=commit,proto3" json:"commit,omitempty"`
+}
+
+func (x *CommitInternalVote) Reset() {
+	*x = CommitInternalVote{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_messages_proto_msgTypes[85]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CommitInternalVote) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommitInternalVote) ProtoMessage() {}
+
+func (x *CommitInternalVote) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[85]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommitInternalVote.ProtoReflect.Descriptor instead.
+func (*CommitInternalVote) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *CommitInternalVote) GetCommit() bool {
+	if x != nil {
+		return x.Commit
+	}
+	return false
+}
+
+type CommitInternalPrecommit struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *CommitInternalPrecommit) Reset() {
+	*x = CommitInternalPrecommit{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_messages_proto_msgTypes[86]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CommitInternalPrecommit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommitInternalPrecommit) ProtoMessage() {}
+
+func (x *CommitInternalPrecommit) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[86]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommitInternalPrecommit.ProtoReflect.Descriptor instead.
+func (*CommitInternalPrecommit) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{86}
+}
+
+type CommitInternalAck struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *CommitInternalAck) Reset() {
+	*x = CommitInternalAck{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_messages_proto_msgTypes[87]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CommitInternalAck) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommitInternalAck) ProtoMessage() {}
+
+func (x *CommitInternalAck) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[87]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommitInternalAck.ProtoReflect.Descriptor instead.
+func (*CommitInternalAck) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{87}
+}
+
+type CommitInternalDecision struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Commit bool `protobuf:"varint,1,opt,name=commit,proto3" json:"commit,omitempty"`
+}
+
+func (x *CommitInternalDecision) Reset() {
+	*x = CommitInternalDecision{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_messages_proto_msgTypes[88]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CommitInternalDecision) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommitInternalDecision) ProtoMessage() {}
+
+func (x *CommitInternalDecision) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[88]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommitInternalDecision.ProtoReflect.Descriptor instead.
+func (*CommitInternalDecision) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *CommitInternalDecision) GetCommit() bool {
+	if x != nil {
+		return x.Commit
+	}
+	return false
+}
+
+type CommitInternalState struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Precommitted bool `protobuf:"varint,1,opt,name=precommitted,proto3" json:"precommitted,omitempty"`
+	Decided      bool `protobuf:"varint,2,opt,name=decided,proto3" json:"decided,omitempty"`
+	Commit       bool `protobuf:"varint,3,opt,name=commit,proto3" json:"commit,omitempty"` // Ignore if decided == false
+}
+
+func (x *CommitInternalState) Reset() {
+	*x = CommitInternalState{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_messages_proto_msgTypes[89]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CommitInternalState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommitInternalState) ProtoMessage() {}
+
+func (x *CommitInternalState) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[89]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommitInternalState.ProtoReflect.Descriptor instead.
+func (*CommitInternalState) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *CommitInternalState) GetPrecommitted() bool {
+	if x != nil {
+		return x.Precommitted
+	}
+	return false
+}
+
+func (x *CommitInternalState) GetDecided() bool {
+	if x != nil {
+		return x.Decided
+	}
+	return false
+}
+
+func (x *CommitInternalState) GetCommit() bool {
+	if x != nil {
+		return x.Commit
+	}
+	return false
+}
+
+// GM
+// Consensus-based group membership: app.gm, created with the system when MEMBERSHIP=gm or with the first app.vs. It
+// proposes to app.gm.uc[id], as a GmView in the bytes of the value, the view id without the processes its perfect
+// failure detector reported, installs the decided view and sends GmView to its parent and the abstractions subscribed
+// to it. GmReconfigure makes it propose the given members instead, once per reconfiguration
+type GmView struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id      int32        `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Members []*ProcessId `protobuf:"bytes,2,rep,name=members,proto3" json:"members,omitempty"`
+}
+
+func (x *GmView) Reset() {
+	*x = GmView{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_messages_proto_msgTypes[90]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GmView) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GmView) ProtoMessage() {}
+
+func (x *GmView) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[90]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GmView.ProtoReflect.Descriptor instead.
+func (*GmView) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *GmView) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *GmView) GetMembers() []*ProcessId {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+type GmReconfigure struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Members []*ProcessId `protobuf:"bytes,1,rep,name=members,proto3" json:"members,omitempty"`
+}
+
+func (x *GmReconfigure) Reset() {
+	*x = GmReconfigure{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_messages_proto_msgTypes[91]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GmReconfigure) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GmReconfigure) ProtoMessage() {}
+
+func (x *GmReconfigure) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[91]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GmReconfigure.ProtoReflect.Descriptor instead.
+func (*GmReconfigure) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *GmReconfigure) GetMembers() []*ProcessId {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+// VS
+// View-synchronous broadcast on top of gm: app.vs. Messages are beb broadcast in the current view. When gm installs a
+// new view, broadcasts are held back and every member sends the messages it delivered in the view in
+// VsInternalPending. Once they came from all members of the view of gm, app.vs.uc[id] decides the next view and the
+// messages to deliver before installing it
+type VsBroadcast struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Message *Message `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *VsBroadcast) Reset() {
+	*x = VsBroadcast{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_messages_proto_msgTypes[92]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VsBroadcast) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VsBroadcast) ProtoMessage() {}
+
+func (x *VsBroadcast) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[92]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VsBroadcast.ProtoReflect.Descriptor instead.
+func (*VsBroadcast) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *VsBroadcast) GetMessage() *Message {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type VsDeliver struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sender  *ProcessId `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	Message *Message   `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	ViewId  int32      `protobuf:"varint,3,opt,name=viewId,proto3" json:"viewId,omitempty"`
+}
+
+func (x *VsDeliver) Reset() {
+	*x = VsDeliver{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_messages_proto_msgTypes[93]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VsDeliver) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VsDeliver) ProtoMessage() {}
+
+func (x *VsDeliver) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[93]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VsDeliver.ProtoReflect.Descriptor instead.
+func (*VsDeliver) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *VsDeliver) GetSender() *ProcessId {
+	if x != nil {
+		return x.Sender
+	}
+	return nil
+}
+
+func (x *VsDeliver) GetMessage() *Message {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+func (x *VsDeliver) GetViewId() int32 {
+	if x != nil {
+		return x.ViewId
+	}
+	return 0
+}
+
+type VsView struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id      int32        `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Members []*ProcessId `protobuf:"bytes,2,rep,name=members,proto3" json:"members,omitempty"`
+}
+
+func (x *VsView) Reset() {
+	*x = VsView{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_messages_proto_msgTypes[94]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VsView) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VsView) ProtoMessage() {}
+
+func (x *VsView) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[94]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VsView.ProtoReflect.Descriptor instead.
+func (*VsView) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *VsView) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *VsView) GetMembers() []*ProcessId {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+type VsInternalData struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ViewId    int32    `protobuf:"varint,1,opt,name=viewId,proto3" json:"viewId,omitempty"`
+	MessageId string   `protobuf:"bytes,2,opt,name=messageId,proto3" json:"messageId,omitempty"` // Unique per sender
+	Message   *Message `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *VsInternalData) Reset() {
+	*x = VsInternalData{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_messages_proto_msgTypes[95]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VsInternalData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VsInternalData) ProtoMessage() {}
+
+func (x *VsInternalData) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[95]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VsInternalData.ProtoReflect.Descriptor instead.
+func (*VsInternalData) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *VsInternalData) GetViewId() int32 {
+	if x != nil {
+		return x.ViewId
+	}
+	return 0
+}
+
+func (x *VsInternalData) GetMessageId() string {
+	if x != nil {
+		return x.MessageId
+	}
+	return ""
+}
+
+func (x *VsInternalData) GetMessage() *Message {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type VsInternalPending struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ViewId    int32       `protobuf:"varint,1,opt,name=viewId,proto3" json:"viewId,omitempty"`
+	Delivered []*TobEntry `protobuf:"bytes,2,rep,name=delivered,proto3" json:"delivered,omitempty"`
+}
+
+func (x *VsInternalPending) Reset() {
+	*x = VsInternalPending{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_messages_proto_msgTypes[96]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VsInternalPending) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VsInternalPending) ProtoMessage() {}
+
+func (x *VsInternalPending) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[96]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VsInternalPending.ProtoReflect.Descriptor instead.
+func (*VsInternalPending) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *VsInternalPending) GetViewId() int32 {
+	if x != nil {
+		return x.ViewId
+	}
+	return 0
+}
+
+func (x *VsInternalPending) GetDelivered() []*TobEntry {
+	if x != nil {
+		return x.Delivered
+	}
+	return nil
+}
+
+type VsProposal struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	View      *VsView     `protobuf:"bytes,1,opt,name=view,proto3" json:"view,omitempty"`           // Next view
+	Delivered []*TobEntry `protobuf:"bytes,2,rep,name=delivered,proto3" json:"delivered,omitempty"` // Messages to deliver in the current view before installing it
+}
+
+func (x *VsProposal) Reset() {
+	*x = VsProposal{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_messages_proto_msgTypes[97]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VsProposal) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VsProposal) ProtoMessage() {}
+
+func (x *VsProposal) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[97]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VsProposal.ProtoReflect.Descriptor instead.
+func (*VsProposal) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *VsProposal) GetView() *VsView {
+	if x != nil {
+		return x.View
+	}
+	return nil
+}
+
+func (x *VsProposal) GetDelivered() []*TobEntry {
+	if x != nil {
+		return x.Delivered
+	}
+	return nil
+}
+
+// RECONF
+// Reconfiguration of a running system: app.reconf follows the views of app.gm. When a view adds members, the members
+// of the previous view send them the state of their nnar registers, taken once the registers moved to the new view and
+// stopped answering in the previous one. Every member of the new view handles the messages of the registers only once it
+// got the state from half of the previous view, keeping the highest timestamp of every register. A process joining also
+// holds back the messages of gm and vs until then
+type NnarState struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key        string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Timestamp  int32  `protobuf:"varint,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	WriterRank int32  `protobuf:"varint,3,opt,name=writerRank,proto3" json:"writerRank,omitempty"`
+	Value      *Value `protobuf:"bytes,4,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *NnarState) Reset() {
+	*x = NnarState{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_messages_proto_msgTypes[98]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NnarState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NnarState) ProtoMessage() {}
+
+func (x *NnarState) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[98]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NnarState.ProtoReflect.Descriptor instead.
+func (*NnarState) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *NnarState) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *NnarState) GetTimestamp() int32 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *NnarState) GetWriterRank() int32 {
+	if x != nil {
+		return x.WriterRank
+	}
+	return 0
+}
+
+func (x *NnarState) GetValue() *Value {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+type ReconfInternalState struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	View      *GmView      `protobuf:"bytes,1,opt,name=view,proto3" json:"view,omitempty"`          // View adding the process
+	Previous  int32        `protobuf:"varint,2,opt,name=previous,proto3" json:"previous,omitempty"` // Number of members of the previous view
+	Registers []*NnarState `protobuf:"bytes,3,rep,name=registers,proto3" json:"registers,omitempty"`
+}
+
+func (x *ReconfInternalState) Reset() {
+	*x = ReconfInternalState{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_messages_proto_msgTypes[99]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReconfInternalState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReconfInternalState) ProtoMessage() {}
+
+func (x *ReconfInternalState) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[99]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReconfInternalState.ProtoReflect.Descriptor instead.
+func (*ReconfInternalState) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *ReconfInternalState) GetView() *GmView {
+	if x != nil {
+		return x.View
+	}
+	return nil
+}
+
+func (x *ReconfInternalState) GetPrevious() int32 {
+	if x != nil {
+		return x.Previous
+	}
+	return 0
+}
+
+func (x *ReconfInternalState) GetRegisters() []*NnarState {
+	if x != nil {
+		return x.Registers
+	}
+	return nil
+}
+
+// ELD
+type EldTimeout struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *EldTimeout) Reset() {
+	*x = EldTimeout{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_messages_proto_msgTypes[100]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EldTimeout) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EldTimeout) ProtoMessage() {}
+
+func (x *EldTimeout) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[100]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EldTimeout.ProtoReflect.Descriptor instead.
+func (*EldTimeout) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{100}
+}
+
+type EldTrust struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Process *ProcessId `protobuf:"bytes,1,opt,name=process,proto3" json:"process,omitempty"`
+}
+
+func (x *EldTrust) Reset() {
+	*x = EldTrust{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_messages_proto_msgTypes[101]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EldTrust) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EldTrust) ProtoMessage() {}
+
+func (x *EldTrust) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[101]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EldTrust.ProtoReflect.Descriptor instead.
+func (*EldTrust) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{101}
+}
+
+func (x *EldTrust) GetProcess() *ProcessId {
+	if x != nil {
+		return x.Process
+	}
+	return nil
+}
+
+// NNAR
+type NnarRead struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *NnarRead) Reset() {
+	*x = NnarRead{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_messages_proto_msgTypes[102]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NnarRead) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NnarRead) ProtoMessage() {}
+
+func (x *NnarRead) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[102]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NnarRead.ProtoReflect.Descriptor instead.
+func (*NnarRead) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{102}
+}
+
+type NnarInternalRead struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ReadId int32 `protobuf:"varint,1,opt,name=readId,proto3" json:"readId,omitempty"`
+	ViewId int32 `protobuf:"varint,2,opt,name=viewId,proto3" json:"viewId,omitempty"` // View of gm in which the operation runs, 0 without gm
+}
+
+func (x *NnarInternalRead) Reset() {
+	*x = NnarInternalRead{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_messages_proto_msgTypes[103]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NnarInternalRead) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NnarInternalRead) ProtoMessage() {}
+
+func (x *NnarInternalRead) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[103]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NnarInternalRead.ProtoReflect.Descriptor instead.
+func (*NnarInternalRead) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{103}
+}
+
+func (x *NnarInternalRead) GetReadId() int32 {
+	if x != nil {
+		return x.ReadId
+	}
+	return 0
+}
+
+func (x *NnarInternalRead) GetViewId() int32 {
+	if x != nil {
+		return x.ViewId
+	}
+	return 0
+}
+
+type NnarInternalValue struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ReadId     int32  `protobuf:"varint,1,opt,name=readId,proto3" json:"readId,omitempty"`
+	Timestamp  int32  `protobuf:"varint,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	WriterRank int32  `protobuf:"varint,3,opt,name=writerRank,proto3" json:"writerRank,omitempty"`
+	Value      *Value `protobuf:"bytes,4,opt,name=value,proto3" json:"value,omitempty"`
+	ViewId     int32  `protobuf:"varint,5,opt,name=viewId,proto3" json:"viewId,omitempty"`
+}
+
+func (x *NnarInternalValue) Reset() {
+	*x = NnarInternalValue{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_messages_proto_msgTypes[104]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NnarInternalValue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NnarInternalValue) ProtoMessage() {}
+
+func (x *NnarInternalValue) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[104]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NnarInternalValue.ProtoReflect.Descriptor instead.
+func (*NnarInternalValue) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{104}
+}
+
+func (x *NnarInternalValue) GetReadId() int32 {
+	if x != nil {
+		return x.ReadId
+	}
+	return 0
+}
+
+func (x *NnarInternalValue) GetTimestamp() int32 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *NnarInternalValue) GetWriterRank() int32 {
+	if x != nil {
+		return x.WriterRank
+	}
+	return 0
+}
+
+func (x *NnarInternalValue) GetValue() *Value {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+func (x *NnarInternalValue) GetViewId() int32 {
+	if x != nil {
+		return x.ViewId
+	}
+	return 0
+}
+
+type NnarInternalWrite struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ReadId     int32  `protobuf:"varint,1,opt,name=readId,proto3" json:"readId,omitempty"`
+	Timestamp  int32  `protobuf:"varint,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	WriterRank int32  `protobuf:"varint,3,opt,name=writerRank,proto3" json:"writerRank,omitempty"`
+	Value      *Value `protobuf:"bytes,4,opt,name=value,proto3" json:"value,omitempty"`
+	ViewId     int32  `protobuf:"varint,5,opt,name=viewId,proto3" json:"viewId,omitempty"`
+}
+
+func (x *NnarInternalWrite) Reset() {
+	*x = NnarInternalWrite{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_messages_proto_msgTypes[105]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NnarInternalWrite) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NnarInternalWrite) ProtoMessage() {}
+
+func (x *NnarInternalWrite) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[105]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NnarInternalWrite.ProtoReflect.Descriptor instead.
+func (*NnarInternalWrite) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{105}
+}
+
+func (x *NnarInternalWrite) GetReadId() int32 {
+	if x != nil {
+		return x.ReadId
+	}
+	return 0
+}
+
+func (x *NnarInternalWrite) GetTimestamp() int32 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *NnarInternalWrite) GetWriterRank() int32 {
+	if x != nil {
+		return x.WriterRank
+	}
+	return 0
+}
+
+func (x *NnarInternalWrite) GetValue() *Value {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+func (x *NnarInternalWrite) GetViewId() int32 {
+	if x != nil {
+		return x.ViewId
+	}
+	return 0
+}
+
+type NnarWrite struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Value *Value `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *NnarWrite) Reset() {
+	*x = NnarWrite{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_messages_proto_msgTypes[106]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NnarWrite) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NnarWrite) ProtoMessage() {}
+
+func (x *NnarWrite) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[106]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NnarWrite.ProtoReflect.Descriptor instead.
+func (*NnarWrite) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{106}
+}
+
+func (x *NnarWrite) GetValue() *Value {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+type NnarInternalAck struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ReadId int32 `protobuf:"varint,1,opt,name=readId,proto3" json:"readId,omitempty"`
+	ViewId int32 `protobuf:"varint,2,opt,name=viewId,proto3" json:"viewId,omitempty"`
+}
+
+func (x *NnarInternalAck) Reset() {
+	*x = NnarInternalAck{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_messages_proto_msgTypes[107]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NnarInternalAck) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NnarInternalAck) ProtoMessage() {}
+
+func (x *NnarInternalAck) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[107]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NnarInternalAck.ProtoReflect.Descriptor instead.
+func (*NnarInternalAck) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{107}
+}
+
+func (x *NnarInternalAck) GetReadId() int32 {
+	if x != nil {
+		return x.ReadId
+	}
+	return 0
+}
+
+func (x *NnarInternalAck) GetViewId() int32 {
+	if x != nil {
+		return x.ViewId
+	}
+	return 0
+}
+
+// Durable state of a register in the crash-recovery model (logged majority voting, algorithm 4.12), saved in the store of
+// the process (STATE_DIR) before acknowledging a write and when an operation starts or ends. A process reinitialized with
+// the same systemId recovers it and restarts the operation it was running
+type NnarLog struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	State    *NnarState `protobuf:"bytes,1,opt,name=state,proto3" json:"state,omitempty"`
+	ReadId   int32      `protobuf:"varint,2,opt,name=readId,proto3" json:"readId,omitempty"`
+	Active   bool       `protobuf:"varint,3,opt,name=active,proto3" json:"active,omitempty"`   // An operation was in progress
+	Reading  bool       `protobuf:"varint,4,opt,name=reading,proto3" json:"reading,omitempty"` // The operation in progress is a read
+	WriteVal *Value     `protobuf:"bytes,5,opt,name=writeVal,proto3" json:"writeVal,omitempty"`
+}
+
+func (x *NnarLog) Reset() {
+	*x = NnarLog{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_messages_proto_msgTypes[108]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NnarLog) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NnarLog) ProtoMessage() {}
+
+func (x *NnarLog) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[108]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NnarLog.ProtoReflect.Descriptor instead.
+func (*NnarLog) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{108}
+}
+
+func (x *NnarLog) GetState() *NnarState {
+	if x != nil {
+		return x.State
+	}
+	return nil
+}
+
+func (x *NnarLog) GetReadId() int32 {
+	if x != nil {
+		return x.ReadId
+	}
+	return 0
+}
+
+func (x *NnarLog) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
+func (x *NnarLog) GetReading() bool {
+	if x != nil {
+		return x.Reading
+	}
+	return false
+}
+
+func (x *NnarLog) GetWriteVal() *Value {
+	if x != nil {
+		return x.WriteVal
+	}
+	return nil
+}
+
+type NnarReadReturn struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Value *Value `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *NnarReadReturn) Reset() {
+	*x = NnarReadReturn{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_messages_proto_msgTypes[109]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NnarReadReturn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NnarReadReturn) ProtoMessage() {}
+
+func (x *NnarReadReturn) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[109]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NnarReadReturn.ProtoReflect.Descriptor instead.
+func (*NnarReadReturn) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{109}
+}
+
+func (x *NnarReadReturn) GetValue() *Value {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+type NnarWriteReturn struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *NnarWriteReturn) Reset() {
+	*x = NnarWriteReturn{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_messages_proto_msgTypes[110]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NnarWriteReturn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NnarWriteReturn) ProtoMessage() {}
+
+func (x *NnarWriteReturn) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[110]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NnarWriteReturn.ProtoReflect.Descriptor instead.
+func (*NnarWriteReturn) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{110}
+}
+
+// ONRR
+// (1,N) regular register: app.onrr[register], read-one-write-all with a perfect failure detector (algorithm 4.1) or
+// majority voting (algorithm 4.2) depending on ONRR_ALGORITHM. A single process is expected to write a register
+type OnrrRead struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *OnrrRead) Reset() {
+	*x = OnrrRead{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_messages_proto_msgTypes[111]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OnrrRead) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OnrrRead) ProtoMessage() {}
+
+func (x *OnrrRead) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[111]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OnrrRead.ProtoReflect.Descriptor instead.
+func (*OnrrRead) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{111}
+}
+
+type OnrrReadReturn struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Value *Value `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *OnrrReadReturn) Reset() {
+	*x = OnrrReadReturn{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_messages_proto_msgTypes[112]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OnrrReadReturn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OnrrReadReturn) ProtoMessage() {}
+
+func (x *OnrrReadReturn) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[112]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OnrrReadReturn.ProtoReflect.Descriptor instead.
+func (*OnrrReadReturn) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{112}
+}
+
+func (x *OnrrReadReturn) GetValue() *Value {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+type OnrrWrite struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Value *Value `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *OnrrWrite) Reset() {
+	*x = OnrrWrite{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_messages_proto_msgTypes[113]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OnrrWrite) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OnrrWrite) ProtoMessage() {}
+
+func (x *OnrrWrite) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[113]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OnrrWrite.ProtoReflect.Descriptor instead.
+func (*OnrrWrite) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{113}
+}
+
+func (x *OnrrWrite) GetValue() *Value {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+type OnrrWriteReturn struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *OnrrWriteReturn) Reset() {
+	*x = OnrrWriteReturn{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_messages_proto_msgTypes[114]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OnrrWriteReturn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OnrrWriteReturn) ProtoMessage() {}
+
+func (x *OnrrWriteReturn) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[114]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OnrrWriteReturn.ProtoReflect.Descriptor instead.
+func (*OnrrWriteReturn) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{114}
+}
+
+type OnrrInternalRead struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ReadId int32 `protobuf:"varint,1,opt,name=readId,proto3" json:"readId,omitempty"`
+}
+
+func (x *OnrrInternalRead) Reset() {
+	*x = OnrrInternalRead{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_messages_proto_msgTypes[115]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OnrrInternalRead) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OnrrInternalRead) ProtoMessage() {}
+
+func (x *OnrrInternalRead) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[115]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OnrrInternalRead.ProtoReflect.Descriptor instead.
+func (*OnrrInternalRead) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{115}
+}
+
+func (x *OnrrInternalRead) GetReadId() int32 {
+	if x != nil {
+		return x.ReadId
+	}
+	return 0
+}
+
+type OnrrInternalValue struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ReadId    int32  `protobuf:"varint,1,opt,name=readId,proto3" json:"readId,omitempty"`
+	Timestamp int32  `protobuf:"varint,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Value     *Value `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *OnrrInternalValue) Reset() {
+	*x = OnrrInternalValue{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_messages_proto_msgTypes[116]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OnrrInternalValue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OnrrInternalValue) ProtoMessage() {}
+
+func (x *OnrrInternalValue) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[116]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OnrrInternalValue.ProtoReflect.Descriptor instead.
+func (*OnrrInternalValue) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{116}
+}
+
+func (x *OnrrInternalValue) GetReadId() int32 {
+	if x != nil {
+		return x.ReadId
+	}
+	return 0
+}
+
+func (x *OnrrInternalValue) GetTimestamp() int32 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *OnrrInternalValue) GetValue() *Value {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+type OnrrInternalWrite struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Timestamp int32  `protobuf:"varint,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"` // Majority voting only
+	Value     *Value `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *OnrrInternalWrite) Reset() {
+	*x = OnrrInternalWrite{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_messages_proto_msgTypes[117]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OnrrInternalWrite) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OnrrInternalWrite) ProtoMessage() {}
+
+func (x *OnrrInternalWrite) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[117]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OnrrInternalWrite.ProtoReflect.Descriptor instead.
+func (*OnrrInternalWrite) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{117}
+}
+
+func (x *OnrrInternalWrite) GetTimestamp() int32 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *OnrrInternalWrite) GetValue() *Value {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+type OnrrInternalAck struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Timestamp int32 `protobuf:"varint,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"` // Majority voting only
+}
+
+func (x *OnrrInternalAck) Reset() {
+	*x = OnrrInternalAck{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_messages_proto_msgTypes[118]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OnrrInternalAck) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OnrrInternalAck) ProtoMessage() {}
+
+func (x *OnrrInternalAck) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[118]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OnrrInternalAck.ProtoReflect.Descriptor instead.
+func (*OnrrInternalAck) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{118}
+}
+
+func (x *OnrrInternalAck) GetTimestamp() int32 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+// ONAR
+// (1,N) atomic register: app.onar[register], read-impose write-all with a perfect failure detector (algorithm 4.5) or
+// read-impose write-majority (algorithm 4.6) depending on ONAR_ALGORITHM. Only the writer (ONAR_WRITER, the max-rank
+// process by default) may write a register. The internal messages are the NNAR ones, with the rank of the writer
+type OnarRead struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *OnarRead) Reset() {
+	*x = OnarRead{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_messages_proto_msgTypes[119]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OnarRead) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OnarRead) ProtoMessage() {}
+
+func (x *OnarRead) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[119]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OnarRead.ProtoReflect.Descriptor instead.
+func (*OnarRead) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{119}
+}
+
+type OnarReadReturn struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Value *Value `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *OnarReadReturn) Reset() {
+	*x = OnarReadReturn{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_messages_proto_msgTypes[120]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OnarReadReturn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OnarReadReturn) ProtoMessage() {}
+
+func (x *OnarReadReturn) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[120]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OnarReadReturn.ProtoReflect.Descriptor instead.
+func (*OnarReadReturn) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{120}
+}
+
+func (x *OnarReadReturn) GetValue() *Value {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+type OnarWrite struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Value *Value `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *OnarWrite) Reset() {
+	*x = OnarWrite{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_messages_proto_msgTypes[121]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OnarWrite) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OnarWrite) ProtoMessage() {}
+
+func (x *OnarWrite) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[121]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OnarWrite.ProtoReflect.Descriptor instead.
+func (*OnarWrite) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{121}
+}
+
+func (x *OnarWrite) GetValue() *Value {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+type OnarWriteReturn struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Error string `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"` // Set when the write was rejected, e.g. because the process is not the writer
+}
+
+func (x *OnarWriteReturn) Reset() {
+	*x = OnarWriteReturn{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_messages_proto_msgTypes[122]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OnarWriteReturn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OnarWriteReturn) ProtoMessage() {}
+
+func (x *OnarWriteReturn) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[122]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OnarWriteReturn.ProtoReflect.Descriptor instead.
+func (*OnarWriteReturn) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{122}
+}
+
+func (x *OnarWriteReturn) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+// LOG
+// Multi-Paxos replicated log: app.log decides a sequence of slots with a stable leader. The leader elected by app.log.ec
+// prepares once with the epoch timestamp of ec, getting from a majority the entries they accepted, then every appended
+// value takes one accept round trip. A ballot is the epoch timestamp with the rank of its leader; epoch 0 belongs to
+// the max-rank process, which starts without preparing. The others forward the values appended on them to the leader
+type LogAppend struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Value *Value `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *LogAppend) Reset() {
+	*x = LogAppend{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_messages_proto_msgTypes[123]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LogAppend) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LogAppend) ProtoMessage() {}
+
+func (x *LogAppend) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[123]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LogAppend.ProtoReflect.Descriptor instead.
+func (*LogAppend) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{123}
+}
+
+func (x *LogAppend) GetValue() *Value {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+type LogAppended struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Slot  int32  `protobuf:"varint,1,opt,name=slot,proto3" json:"slot,omitempty"`
+	Value *Value `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *LogAppended) Reset() {
+	*x = LogAppended{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_messages_proto_msgTypes[124]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LogAppended) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LogAppended) ProtoMessage() {}
+
+func (x *LogAppended) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[124]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LogAppended.ProtoReflect.Descriptor instead.
+func (*LogAppended) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{124}
+}
+
+func (x *LogAppended) GetSlot() int32 {
+	if x != nil {
+		return x.Slot
+	}
+	return 0
+}
+
+func (x *LogAppended) GetValue() *Value {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+type LogCommitted struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Slot  int32  `protobuf:"varint,1,opt,name=slot,proto3" json:"slot,omitempty"`
+	Value *Value `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *LogCommitted) Reset() {
+	*x = LogCommitted{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_messages_proto_msgTypes[125]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LogCommitted) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LogCommitted) ProtoMessage() {}
+
+func (x *LogCommitted) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[125]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LogCommitted.ProtoReflect.Descriptor instead.
+func (*LogCommitted) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{125}
+}
+
+func (x *LogCommitted) GetSlot() int32 {
+	if x != nil {
+		return x.Slot
+	}
+	return 0
+}
+
+func (x *LogCommitted) GetValue() *Value {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+type LogEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id    string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"` // Unique per value appended, empty for the no-op filling a slot nobody accepted a value for
+	Value *Value `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *LogEntry) Reset() {
+	*x = LogEntry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_messages_proto_msgTypes[126]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LogEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LogEntry) ProtoMessage() {}
+
+func (x *LogEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[126]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LogEntry.ProtoReflect.Descriptor instead.
+func (*LogEntry) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{126}
+}
+
+func (x *LogEntry) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *LogEntry) GetValue() *Value {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+type LogSlot struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Slot       int32     `protobuf:"varint,1,opt,name=slot,proto3" json:"slot,omitempty"`
+	Entry      *LogEntry `protobuf:"bytes,2,opt,name=entry,proto3" json:"entry,omitempty"`
+	Epoch      int32     `protobuf:"varint,3,opt,name=epoch,proto3" json:"epoch,omitempty"` // Ballot in which the entry was accepted, in promises only
+	LeaderRank int32     `protobuf:"varint,4,opt,name=leaderRank,proto3" json:"leaderRank,omitempty"`
+}
+
+func (x *LogSlot) Reset() {
+	*x = LogSlot{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_messages_proto_msgTypes[127]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LogSlot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LogSlot) ProtoMessage() {}
+
+func (x *LogSlot) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[127]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LogSlot.ProtoReflect.Descriptor instead.
+func (*LogSlot) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{127}
+}
+
+func (x *LogSlot) GetSlot() int32 {
+	if x != nil {
+		return x.Slot
+	}
+	return 0
+}
+
+func (x *LogSlot) GetEntry() *LogEntry {
+	if x != nil {
+		return x.Entry
+	}
+	return nil
+}
+
+func (x *LogSlot) GetEpoch() int32 {
+	if x != nil {
+		return x.Epoch
+	}
+	return 0
+}
+
+func (x *LogSlot) GetLeaderRank() int32 {
+	if x != nil {
+		return x.LeaderRank
+	}
+	return 0
+}
+
+type LogInternalForward struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Entries []*LogEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+}
+
+func (x *LogInternalForward) Reset() {
+	*x = LogInternalForward{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_messages_proto_msgTypes[128]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LogInternalForward) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LogInternalForward) ProtoMessage() {}
+
+func (x *LogInternalForward) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[128]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LogInternalForward.ProtoReflect.Descriptor instead.
+func (*LogInternalForward) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{128}
+}
+
+func (x *LogInternalForward) GetEntries() []*LogEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+type LogInternalPrepare struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Epoch      int32 `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	LeaderRank int32 `protobuf:"varint,2,opt,name=leaderRank,proto3" json:"leaderRank,omitempty"`
+	From       int32 `protobuf:"varint,3,opt,name=from,proto3" json:"from,omitempty"` // First slot the leader did not commit
+}
+
+func (x *LogInternalPrepare) Reset() {
+	*x = LogInternalPrepare{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_messages_proto_msgTypes[129]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LogInternalPrepare) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LogInternalPrepare) ProtoMessage() {}
+
+func (x *LogInternalPrepare) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[129]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LogInternalPrepare.ProtoReflect.Descriptor instead.
+func (*LogInternalPrepare) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{129}
+}
+
+func (x *LogInternalPrepare) GetEpoch() int32 {
+	if x != nil {
+		return x.Epoch
+	}
+	return 0
+}
+
+func (x *LogInternalPrepare) GetLeaderRank() int32 {
+	if x != nil {
+		return x.LeaderRank
+	}
+	return 0
+}
+
+func (x *LogInternalPrepare) GetFrom() int32 {
+	if x != nil {
+		return x.From
+	}
+	return 0
+}
+
+type LogInternalPromise struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Epoch      int32      `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	LeaderRank int32      `protobuf:"varint,2,opt,name=leaderRank,proto3" json:"leaderRank,omitempty"`
+	Next       int32      `protobuf:"varint,3,opt,name=next,proto3" json:"next,omitempty"`        // First slot the process did not commit, for the leader to send it the ones it misses
+	Accepted   []*LogSlot `protobuf:"bytes,4,rep,name=accepted,proto3" json:"accepted,omitempty"` // From the slot asked in the prepare
+}
+
+func (x *LogInternalPromise) Reset() {
+	*x = LogInternalPromise{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_messages_proto_msgTypes[130]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LogInternalPromise) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LogInternalPromise) ProtoMessage() {}
+
+func (x *LogInternalPromise) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[130]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LogInternalPromise.ProtoReflect.Descriptor instead.
+func (*LogInternalPromise) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{130}
+}
+
+func (x *LogInternalPromise) GetEpoch() int32 {
+	if x != nil {
+		return x.Epoch
+	}
+	return 0
+}
+
+func (x *LogInternalPromise) GetLeaderRank() int32 {
+	if x != nil {
+		return x.LeaderRank
+	}
+	return 0
+}
+
+func (x *LogInternalPromise) GetNext() int32 {
+	if x != nil {
+		return x.Next
+	}
+	return 0
+}
+
+func (x *LogInternalPromise) GetAccepted() []*LogSlot {
+	if x != nil {
+		return x.Accepted
+	}
+	return nil
+}
+
+type LogInternalAccept struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Epoch      int32      `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	LeaderRank int32      `protobuf:"varint,2,opt,name=leaderRank,proto3" json:"leaderRank,omitempty"`
+	Slots      []*LogSlot `protobuf:"bytes,3,rep,name=slots,proto3" json:"slots,omitempty"`
+}
+
+func (x *LogInternalAccept) Reset() {
+	*x = LogInternalAccept{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_messages_proto_msgTypes[131]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LogInternalAccept) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LogInternalAccept) ProtoMessage() {}
+
+func (x *LogInternalAccept) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[131]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LogInternalAccept.ProtoReflect.Descriptor instead.
+func (*LogInternalAccept) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{131}
+}
+
+func (x *LogInternalAccept) GetEpoch() int32 {
+	if x != nil {
+		return x.Epoch
+	}
+	return 0
+}
+
+func (x *LogInternalAccept) GetLeaderRank() int32 {
+	if x != nil {
+		return x.LeaderRank
+	}
+	return 0
+}
+
+func (x *LogInternalAccept) GetSlots() []*LogSlot {
+	if x != nil {
+		return x.Slots
+	}
+	return nil
+}
+
+type LogInternalAccepted struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Epoch      int32   `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	LeaderRank int32   `protobuf:"varint,2,opt,name=leaderRank,proto3" json:"leaderRank,omitempty"`
+	Slots      []int32 `protobuf:"varint,3,rep,packed,name=slots,proto3" json:"slots,omitempty"`
+}
+
+func (x *LogInternalAccepted) Reset() {
+	*x = LogInternalAccepted{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_messages_proto_msgTypes[132]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LogInternalAccepted) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LogInternalAccepted) ProtoMessage() {}
+
+func (x *LogInternalAccepted) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[132]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LogInternalAccepted.ProtoReflect.Descriptor instead.
+func (*LogInternalAccepted) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{132}
+}
+
+func (x *LogInternalAccepted) GetEpoch() int32 {
+	if x != nil {
+		return x.Epoch
+	}
+	return 0
+}
+
+func (x *LogInternalAccepted) GetLeaderRank() int32 {
+	if x != nil {
+		return x.LeaderRank
+	}
+	return 0
+}
+
+func (x *LogInternalAccepted) GetSlots() []int32 {
+	if x != nil {
+		return x.Slots
+	}
+	return nil
+}
+
+type LogInternalCommit struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Slots []*LogSlot `protobuf:"bytes,1,rep,name=slots,proto3" json:"slots,omitempty"`
+}
+
+func (x *LogInternalCommit) Reset() {
+	*x = LogInternalCommit{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_messages_proto_msgTypes[133]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LogInternalCommit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LogInternalCommit) ProtoMessage() {}
+
+func (x *LogInternalCommit) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[133]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LogInternalCommit.ProtoReflect.Descriptor instead.
+func (*LogInternalCommit) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{133}
+}
+
+func (x *LogInternalCommit) GetSlots() []*LogSlot {
+	if x != nil {
+		return x.Slots
+	}
+	return nil
+}
+
+// RAFT
+// Raft instance taking the place of a uc instance when UC_ALGORITHM is raft, with the same UcPropose and UcDecide. The
+// processes elect a leader per term with randomized election timeouts (RAFT_TIMEOUT to twice it), which replicates its
+// log, starting each term with a no-op entry. The instance decides the first committed entry with a defined value.
+// A process forwards its proposal to every leader it learns about until it decides
+type RaftEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Term  int32  `protobuf:"varint,1,opt,name=term,proto3" json:"term,omitempty"`
+	Value *Value `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"` // Undefined for the no-op of a new leader
+}
+
+func (x *RaftEntry) Reset() {
+	*x = RaftEntry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_messages_proto_msgTypes[134]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RaftEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RaftEntry) ProtoMessage() {}
+
+func (x *RaftEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[134]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RaftEntry.ProtoReflect.Descriptor instead.
+func (*RaftEntry) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{134}
+}
+
+func (x *RaftEntry) GetTerm() int32 {
+	if x != nil {
+		return x.Term
+	}
+	return 0
+}
+
+func (x *RaftEntry) GetValue() *Value {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+type RaftTimeout struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Timer int32 `protobuf:"varint,1,opt,name=timer,proto3" json:"timer,omitempty"` // Ignored unless it is the last timer started
+}
+
+func (x *RaftTimeout) Reset() {
+	*x = RaftTimeout{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_messages_proto_msgTypes[135]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RaftTimeout) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RaftTimeout) ProtoMessage() {}
+
+func (x *RaftTimeout) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[135]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RaftTimeout.ProtoReflect.Descriptor instead.
+func (*RaftTimeout) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{135}
+}
+
+func (x *RaftTimeout) GetTimer() int32 {
+	if x != nil {
+		return x.Timer
+	}
+	return 0
+}
+
+type RaftInternalRequestVote struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Term         int32 `protobuf:"varint,1,opt,name=term,proto3" json:"term,omitempty"`
+	LastLogIndex int32 `protobuf:"varint,2,opt,name=lastLogIndex,proto3" json:"lastLogIndex,omitempty"`
+	LastLogTerm  int32 `protobuf:"varint,3,opt,name=lastLogTerm,proto3" json:"lastLogTerm,omitempty"`
+}
+
+func (x *RaftInternalRequestVote) Reset() {
+	*x = RaftInternalRequestVote{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_messages_proto_msgTypes[136]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RaftInternalRequestVote) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RaftInternalRequestVote) ProtoMessage() {}
+
+func (x *RaftInternalRequestVote) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[136]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RaftInternalRequestVote.ProtoReflect.Descriptor instead.
+func (*RaftInternalRequestVote) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{136}
+}
+
+func (x *RaftInternalRequestVote) GetTerm() int32 {
+	if x != nil {
+		return x.Term
+	}
+	return 0
+}
+
+func (x *RaftInternalRequestVote) GetLastLogIndex() int32 {
+	if x != nil {
+		return x.LastLogIndex
+	}
+	return 0
+}
+
+func (x *RaftInternalRequestVote) GetLastLogTerm() int32 {
+	if x != nil {
+		return x.LastLogTerm
+	}
+	return 0
+}
+
+type RaftInternalVote struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Term    int32 `protobuf:"varint,1,opt,name=term,proto3" json:"term,omitempty"`
+	Granted bool  `protobuf:"varint,2,opt,name=granted,proto3" json:"granted,omitempty"`
+}
+
+func (x *RaftInternalVote) Reset() {
+	*x = RaftInternalVote{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_messages_proto_msgTypes[137]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RaftInternalVote) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RaftInternalVote) ProtoMessage() {}
+
+func (x *RaftInternalVote) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[137]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RaftInternalVote.ProtoReflect.Descriptor instead.
+func (*RaftInternalVote) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{137}
+}
+
+func (x *RaftInternalVote) GetTerm() int32 {
+	if x != nil {
+		return x.Term
+	}
+	return 0
+}
+
+func (x *RaftInternalVote) GetGranted() bool {
+	if x != nil {
+		return x.Granted
+	}
+	return false
+}
+
+type RaftInternalAppendEntries struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Term         int32        `protobuf:"varint,1,opt,name=term,proto3" json:"term,omitempty"`
+	PrevLogIndex int32        `protobuf:"varint,2,opt,name=prevLogIndex,proto3" json:"prevLogIndex,omitempty"`
+	PrevLogTerm  int32        `protobuf:"varint,3,opt,name=prevLogTerm,proto3" json:"prevLogTerm,omitempty"`
+	Entries      []*RaftEntry `protobuf:"bytes,4,rep,name=entries,proto3" json:"entries,omitempty"` // Empty for a heartbeat
+	LeaderCommit int32        `protobuf:"varint,5,opt,name=leaderCommit,proto3" json:"leaderCommit,omitempty"`
+}
+
+func (x *RaftInternalAppendEntries) Reset() {
+	*x = RaftInternalAppendEntries{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_messages_proto_msgTypes[138]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RaftInternalAppendEntries) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RaftInternalAppendEntries) ProtoMessage() {}
+
+func (x *RaftInternalAppendEntries) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[138]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RaftInternalAppendEntries.ProtoReflect.Descriptor instead.
+func (*RaftInternalAppendEntries) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{138}
+}
+
+func (x *RaftInternalAppendEntries) GetTerm() int32 {
+	if x != nil {
+		return x.Term
+	}
+	return 0
+}
+
+func (x *RaftInternalAppendEntries) GetPrevLogIndex() int32 {
+	if x != nil {
+		return x.PrevLogIndex
+	}
+	return 0
+}
+
+func (x *RaftInternalAppendEntries) GetPrevLogTerm() int32 {
+	if x != nil {
+		return x.PrevLogTerm
+	}
+	return 0
+}
+
+func (x *RaftInternalAppendEntries) GetEntries() []*RaftEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+func (x *RaftInternalAppendEntries) GetLeaderCommit() int32 {
+	if x != nil {
+		return x.LeaderCommit
+	}
+	return 0
+}
+
+type RaftInternalAppendResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Term       int32 `protobuf:"varint,1,opt,name=term,proto3" json:"term,omitempty"`
+	Success    bool  `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`
+	MatchIndex int32 `protobuf:"varint,3,opt,name=matchIndex,proto3" json:"matchIndex,omitempty"` // Last entry matching the leader on success, otherwise the last entry of the follower
+}
+
+func (x *RaftInternalAppendResult) Reset() {
+	*x = RaftInternalAppendResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_messages_proto_msgTypes[139]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RaftInternalAppendResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RaftInternalAppendResult) ProtoMessage() {}
+
+func (x *RaftInternalAppendResult) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[139]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RaftInternalAppendResult.ProtoReflect.Descriptor instead.
+func (*RaftInternalAppendResult) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{139}
+}
+
+func (x *RaftInternalAppendResult) GetTerm() int32 {
+	if x != nil {
+		return x.Term
+	}
+	return 0
+}
+
+func (x *RaftInternalAppendResult) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *RaftInternalAppendResult) GetMatchIndex() int32 {
+	if x != nil {
+		return x.MatchIndex
+	}
+	return 0
+}
+
+type RaftInternalForward struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Value *Value `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *RaftInternalForward) Reset() {
+	*x = RaftInternalForward{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_messages_proto_msgTypes[140]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RaftInternalForward) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RaftInternalForward) ProtoMessage() {}
+
+func (x *RaftInternalForward) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[140]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RaftInternalForward.ProtoReflect.Descriptor instead.
+func (*RaftInternalForward) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{140}
+}
+
+func (x *RaftInternalForward) GetValue() *Value {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+// RC
+// Randomized consensus: app.rc[topic] decides one of the proposed values, with probability 1 and without any timing
+// assumption, as long as a majority of the processes is correct. Every process rb-broadcasts its proposal, then runs
+// binary instances app.rc[topic].bc[k] deciding whether to adopt the proposal of the k-th process (modulo N, by rank),
+// proposing 1 when it delivered that proposal. The first instance deciding 1 gives the value. A binary instance runs
+// rounds of two phases over beb, taking the output of a coin (RC_COIN, common or local) when the second phase shows no
+// value, and rb-broadcasts its decision. Both take UcPropose and answer UcDecide
+type AppRcPropose struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Topic string `protobuf:"bytes,1,opt,name=topic,proto3" json:"topic,omitempty"`
+	Value *Value `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *AppRcPropose) Reset() {
+	*x = AppRcPropose{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_messages_proto_msgTypes[141]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AppRcPropose) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AppRcPropose) ProtoMessage() {}
+
+func (x *AppRcPropose) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[141]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AppRcPropose.ProtoReflect.Descriptor instead.
+func (*AppRcPropose) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{141}
+}
+
+func (x *AppRcPropose) GetTopic() string {
+	if x != nil {
+		return x.Topic
+	}
+	return ""
+}
+
+func (x *AppRcPropose) GetValue() *Value {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+type AppRcDecide struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Topic string `protobuf:"bytes,1,opt,name=topic,proto3" json:"topic,omitempty"`
+	Value *Value `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *AppRcDecide) Reset() {
+	*x = AppRcDecide{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_messages_proto_msgTypes[142]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AppRcDecide) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AppRcDecide) ProtoMessage() {}
+
+func (x *AppRcDecide) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[142]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AppRcDecide.ProtoReflect.Descriptor instead.
+func (*AppRcDecide) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{142}
+}
+
+func (x *AppRcDecide) GetTopic() string {
+	if x != nil {
+		return x.Topic
+	}
+	return ""
+}
+
+func (x *AppRcDecide) GetValue() *Value {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+type RcInternalProposal struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Value *Value `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *RcInternalProposal) Reset() {
+	*x = RcInternalProposal{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_messages_proto_msgTypes[143]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RcInternalProposal) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RcInternalProposal) ProtoMessage() {}
+
+func (x *RcInternalProposal) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[143]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RcInternalProposal.ProtoReflect.Descriptor instead.
+func (*RcInternalProposal) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{143}
+}
+
+func (x *RcInternalProposal) GetValue() *Value {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+type BcInternalPhase struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Round int32  `protobuf:"varint,1,opt,name=round,proto3" json:"round,omitempty"`
+	Phase int32  `protobuf:"varint,2,opt,name=phase,proto3" json:"phase,omitempty"` // 1 or 2
+	Value *Value `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`  // 0 or 1, undefined when the first phase showed no majority
+}
+
+func (x *BcInternalPhase) Reset() {
+	*x = BcInternalPhase{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_messages_proto_msgTypes[144]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BcInternalPhase) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BcInternalPhase) ProtoMessage() {}
+
+func (x *BcInternalPhase) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[144]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BcInternalPhase.ProtoReflect.Descriptor instead.
+func (*BcInternalPhase) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{144}
+}
+
+func (x *BcInternalPhase) GetRound() int32 {
+	if x != nil {
+		return x.Round
+	}
+	return 0
+}
+
+func (x *BcInternalPhase) GetPhase() int32 {
+	if x != nil {
+		return x.Phase
+	}
+	return 0
+}
+
+func (x *BcInternalPhase) GetValue() *Value {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+type BcInternalDecided struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Value *Value `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *BcInternalDecided) Reset() {
+	*x = BcInternalDecided{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_messages_proto_msgTypes[145]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BcInternalDecided) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BcInternalDecided) ProtoMessage() {}
+
+func (x *BcInternalDecided) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[145]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BcInternalDecided.ProtoReflect.Descriptor instead.
+func (*BcInternalDecided) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{145}
+}
+
+func (x *BcInternalDecided) GetValue() *Value {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+// FLOODING
+// Flooding consensus (algorithm 5.1) and flooding uniform consensus (algorithm 5.3), selected with UC_ALGORITHM for
+// the uc instances in fail-stop systems. The processes beb-broadcast the proposals they know every round, until a round
+// brings no news from the processes not detected as crashed by the perfect failure detector, or for N rounds when
+// uniform. They decide the smallest proposal (by type, then value). They take UcPropose and answer UcDecide
+type FloodingInternalProposal struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Round     int32    `protobuf:"varint,1,opt,name=round,proto3" json:"round,omitempty"`
+	Proposals []*Value `protobuf:"bytes,2,rep,name=proposals,proto3" json:"proposals,omitempty"`
+}
+
+func (x *FloodingInternalProposal) Reset() {
+	*x = FloodingInternalProposal{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_messages_proto_msgTypes[146]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FloodingInternalProposal) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FloodingInternalProposal) ProtoMessage() {}
+
+func (x *FloodingInternalProposal) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[146]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FloodingInternalProposal.ProtoReflect.Descriptor instead.
+func (*FloodingInternalProposal) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{146}
+}
+
+func (x *FloodingInternalProposal) GetRound() int32 {
+	if x != nil {
+		return x.Round
+	}
+	return 0
+}
+
+func (x *FloodingInternalProposal) GetProposals() []*Value {
+	if x != nil {
+		return x.Proposals
+	}
+	return nil
+}
+
+type FloodingInternalDecided struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Value *Value `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *FloodingInternalDecided) Reset() {
+	*x = FloodingInternalDecided{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_messages_proto_msgTypes[147]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FloodingInternalDecided) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FloodingInternalDecided) ProtoMessage() {}
+
+func (x *FloodingInternalDecided) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[147]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FloodingInternalDecided.ProtoReflect.Descriptor instead.
+func (*FloodingInternalDecided) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{147}
+}
+
+func (x *FloodingInternalDecided) GetValue() *Value {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+// HIERARCHICAL
+// Hierarchical consensus (algorithm 5.2) and hierarchical uniform consensus (algorithm 5.4), selected like flooding.
+// The processes lead one round each, by rank, a leader imposing its proposal unless it crashed. The regular one
+// decides right away and beb-broadcasts the decision, the uniform one waits for the processes not crashed to
+// acknowledge the proposal before rb-broadcasting the decision
+type HierarchicalInternalProposal struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Value *Value `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *HierarchicalInternalProposal) Reset() {
+	*x = HierarchicalInternalProposal{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_messages_proto_msgTypes[148]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HierarchicalInternalProposal) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HierarchicalInternalProposal) ProtoMessage() {}
+
+func (x *HierarchicalInternalProposal) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[148]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HierarchicalInternalProposal.ProtoReflect.Descriptor instead.
+func (*HierarchicalInternalProposal) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{148}
+}
+
+func (x *HierarchicalInternalProposal) GetValue() *Value {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+type HierarchicalInternalAck struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *HierarchicalInternalAck) Reset() {
+	*x = HierarchicalInternalAck{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_messages_proto_msgTypes[149]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HierarchicalInternalAck) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HierarchicalInternalAck) ProtoMessage() {}
+
+func (x *HierarchicalInternalAck) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[149]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HierarchicalInternalAck.ProtoReflect.Descriptor instead.
+func (*HierarchicalInternalAck) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{149}
+}
+
+type HierarchicalInternalDecided struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Value *Value `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *HierarchicalInternalDecided) Reset() {
+	*x = HierarchicalInternalDecided{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_messages_proto_msgTypes[150]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HierarchicalInternalDecided) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HierarchicalInternalDecided) ProtoMessage() {}
+
+func (x *HierarchicalInternalDecided) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[150]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HierarchicalInternalDecided.ProtoReflect.Descriptor instead.
+func (*HierarchicalInternalDecided) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{150}
+}
+
+func (x *HierarchicalInternalDecided) GetValue() *Value {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+// BCB, BRB
+// Byzantine consistent broadcast (authenticated echo broadcast, algorithm 3.16) and byzantine reliable broadcast
+// (authenticated double-echo broadcast, algorithm 3.18), tolerating f < N/3 byzantine processes over authenticated
+// perfect links. Every broadcast is an instance named by its sender and sequence number, in which the processes echo
+// the first message they get from the sender itself and count the echoes, and readies for brb, of every message
+// (compared byte for byte). bcb delivers a message echoed by more than (N+f)/2 processes. brb gets ready for it then,
+// or once f+1 processes are ready for it, and delivers it once 2f+1 processes are
+type BcbBroadcast struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Message *Message `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *BcbBroadcast) Reset() {
+	*x = BcbBroadcast{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_messages_proto_msgTypes[151]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BcbBroadcast) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BcbBroadcast) ProtoMessage() {}
+
+func (x *BcbBroadcast) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[151]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BcbBroadcast.ProtoReflect.Descriptor instead.
+func (*BcbBroadcast) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{151}
+}
+
+func (x *BcbBroadcast) GetMessage() *Message {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type BcbDeliver struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Message *Message   `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Sender  *ProcessId `protobuf:"bytes,2,opt,name=sender,proto3" json:"sender,omitempty"`
+}
+
+func (x *BcbDeliver) Reset() {
+	*x = BcbDeliver{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_messages_proto_msgTypes[152]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BcbDeliver) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BcbDeliver) ProtoMessage() {}
+
+func (x *BcbDeliver) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[152]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BcbDeliver.ProtoReflect.Descriptor instead.
+func (*BcbDeliver) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{152}
+}
+
+func (x *BcbDeliver) GetMessage() *Message {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+func (x *BcbDeliver) GetSender() *ProcessId {
+	if x != nil {
+		return x.Sender
+	}
+	return nil
+}
+
+type BrbBroadcast struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Message *Message `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *BrbBroadcast) Reset() {
+	*x = BrbBroadcast{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_messages_proto_msgTypes[153]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BrbBroadcast) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BrbBroadcast) ProtoMessage() {}
+
+func (x *BrbBroadcast) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[153]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BrbBroadcast.ProtoReflect.Descriptor instead.
+func (*BrbBroadcast) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{153}
+}
+
+func (x *BrbBroadcast) GetMessage() *Message {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type BrbDeliver struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Message *Message   `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Sender  *ProcessId `protobuf:"bytes,2,opt,name=sender,proto3" json:"sender,omitempty"`
+}
+
+func (x *BrbDeliver) Reset() {
+	*x = BrbDeliver{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_messages_proto_msgTypes[154]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BrbDeliver) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BrbDeliver) ProtoMessage() {}
+
+func (x *BrbDeliver) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[154]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BrbDeliver.ProtoReflect.Descriptor instead.
+func (*BrbDeliver) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{154}
+}
+
+func (x *BrbDeliver) GetMessage() *Message {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+func (x *BrbDeliver) GetSender() *ProcessId {
+	if x != nil {
+		return x.Sender
+	}
+	return nil
+}
+
+type ByzantineInternalSend struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sender         *ProcessId `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	SequenceNumber int32      `protobuf:"varint,2,opt,name=sequenceNumber,proto3" json:"sequenceNumber,omitempty"`
+	Message        *Message   `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *ByzantineInternalSend) Reset() {
+	*x = ByzantineInternalSend{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_messages_proto_msgTypes[155]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ByzantineInternalSend) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ByzantineInternalSend) ProtoMessage() {}
+
+func (x *ByzantineInternalSend) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[155]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ByzantineInternalSend.ProtoReflect.Descriptor instead.
+func (*ByzantineInternalSend) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{155}
+}
+
+func (x *ByzantineInternalSend) GetSender() *ProcessId {
+	if x != nil {
+		return x.Sender
+	}
+	return nil
+}
+
+func (x *ByzantineInternalSend) GetSequenceNumber() int32 {
+	if x != nil {
+		return x.SequenceNumber
+	}
+	return 0
+}
+
+func (x *ByzantineInternalSend) GetMessage() *Message {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type ByzantineInternalEcho struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sender         *ProcessId `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	SequenceNumber int32      `protobuf:"varint,2,opt,name=sequenceNumber,proto3" json:"sequenceNumber,omitempty"`
+	Message        *Message   `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *ByzantineInternalEcho) Reset() {
+	*x = ByzantineInternalEcho{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_messages_proto_msgTypes[156]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ByzantineInternalEcho) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ByzantineInternalEcho) ProtoMessage() {}
+
+func (x *ByzantineInternalEcho) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[156]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ByzantineInternalEcho.ProtoReflect.Descriptor instead.
+func (*ByzantineInternalEcho) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{156}
+}
+
+func (x *ByzantineInternalEcho) GetSender() *ProcessId {
+	if x != nil {
+		return x.Sender
+	}
+	return nil
+}
+
+func (x *ByzantineInternalEcho) GetSequenceNumber() int32 {
+	if x != nil {
+		return x.SequenceNumber
+	}
+	return 0
+}
+
+func (x *ByzantineInternalEcho) GetMessage() *Message {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type ByzantineInternalReady struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sender         *ProcessId `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	SequenceNumber int32      `protobuf:"varint,2,opt,name=sequenceNumber,proto3" json:"sequenceNumber,omitempty"`
+	Message        *Message   `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *ByzantineInternalReady) Reset() {
+	*x = ByzantineInternalReady{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_messages_proto_msgTypes[157]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ByzantineInternalReady) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ByzantineInternalReady) ProtoMessage() {}
+
+func (x *ByzantineInternalReady) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[157]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ByzantineInternalReady.ProtoReflect.Descriptor instead.
+func (*ByzantineInternalReady) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{157}
+}
+
+func (x *ByzantineInternalReady) GetSender() *ProcessId {
+	if x != nil {
+		return x.Sender
+	}
+	return nil
+}
+
+func (x *ByzantineInternalReady) GetSequenceNumber() int32 {
+	if x != nil {
+		return x.SequenceNumber
+	}
+	return 0
+}
+
+func (x *ByzantineInternalReady) GetMessage() *Message {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+// EPFD
+// Use as timer delay "delta" 100 milliseconds
+type EpfdTimeout struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *EpfdTimeout) Reset() {
+	*x = EpfdTimeout{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_messages_proto_msgTypes[158]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EpfdTimeout) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EpfdTimeout) ProtoMessage() {}
+
+func (x *EpfdTimeout) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[158]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EpfdTimeout.ProtoReflect.Descriptor instead.
+func (*EpfdTimeout) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{158}
+}
+
+type EpfdInternalHeartbeatRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *EpfdInternalHeartbeatRequest) Reset() {
+	*x = EpfdInternalHeartbeatRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_messages_proto_msgTypes[159]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EpfdInternalHeartbeatRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EpfdInternalHeartbeatRequest) ProtoMessage() {}
+
+func (x *EpfdInternalHeartbeatRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[159]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EpfdInternalHeartbeatRequest.ProtoReflect.Descriptor instead.
+func (*EpfdInternalHeartbeatRequest) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{159}
+}
+
+type EpfdInternalHeartbeatReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *EpfdInternalHeartbeatReply) Reset() {
+	*x = EpfdInternalHeartbeatReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_messages_proto_msgTypes[160]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EpfdInternalHeartbeatReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EpfdInternalHeartbeatReply) ProtoMessage() {}
+
+func (x *EpfdInternalHeartbeatReply) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[160]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EpfdInternalHeartbeatReply.ProtoReflect.Descriptor instead.
+func (*EpfdInternalHeartbeatReply) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{160}
+}
+
+type EpfdSuspect struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Process *ProcessId `protobuf:"bytes,1,opt,name=process,proto3" json:"process,omitempty"`
+}
+
+func (x *EpfdSuspect) Reset() {
+	*x = EpfdSuspect{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_messages_proto_msgTypes[161]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EpfdSuspect) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EpfdSuspect) ProtoMessage() {}
+
+func (x *EpfdSuspect) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[161]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EpfdSuspect.ProtoReflect.Descriptor instead.
+func (*EpfdSuspect) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{161}
+}
+
+func (x *EpfdSuspect) GetProcess() *ProcessId {
+	if x != nil {
+		return x.Process
+	}
+	return nil
+}
+
+type EpfdRestore struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Process *ProcessId `protobuf:"bytes,1,opt,name=process,proto3" json:"process,omitempty"`
+}
+
+func (x *EpfdRestore) Reset() {
+	*x = EpfdRestore{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_messages_proto_msgTypes[162]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EpfdRestore) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EpfdRestore) ProtoMessage() {}
+
+func (x *EpfdRestore) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[162]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EpfdRestore.ProtoReflect.Descriptor instead.
+func (*EpfdRestore) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{162}
+}
+
+func (x *EpfdRestore) GetProcess() *ProcessId {
+	if x != nil {
+		return x.Process
+	}
+	return nil
+}
+
+// PFD
+// Use as timer delay the PFD_TIMEOUT setting, 200 milliseconds by default
+type PfdTimeout struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *PfdTimeout) Reset() {
+	*x = PfdTimeout{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_messages_proto_msgTypes[163]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PfdTimeout) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PfdTimeout) ProtoMessage() {}
+
+func (x *PfdTimeout) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[163]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PfdTimeout.ProtoReflect.Descriptor instead.
+func (*PfdTimeout) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{163}
+}
+
+type PfdInternalHeartbeatRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *PfdInternalHeartbeatRequest) Reset() {
+	*x = PfdInternalHeartbeatRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_messages_proto_msgTypes[164]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PfdInternalHeartbeatRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PfdInternalHeartbeatRequest) ProtoMessage() {}
+
+func (x *PfdInternalHeartbeatRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[164]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PfdInternalHeartbeatRequest.ProtoReflect.Descriptor instead.
+func (*PfdInternalHeartbeatRequest) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{164}
+}
+
+type PfdInternalHeartbeatReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *PfdInternalHeartbeatReply) Reset() {
+	*x = PfdInternalHeartbeatReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_messages_proto_msgTypes[165]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PfdInternalHeartbeatReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PfdInternalHeartbeatReply) ProtoMessage() {}
+
+func (x *PfdInternalHeartbeatReply) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[165]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PfdInternalHeartbeatReply.ProtoReflect.Descriptor instead.
+func (*PfdInternalHeartbeatReply) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{165}
+}
+
+type PfdCrash struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Process *ProcessId `protobuf:"bytes,1,opt,name=process,proto3" json:"process,omitempty"`
+}
+
+func (x *PfdCrash) Reset() {
+	*x = PfdCrash{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_messages_proto_msgTypes[166]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PfdCrash) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PfdCrash) ProtoMessage() {}
+
+func (x *PfdCrash) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[166]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PfdCrash.ProtoReflect.Descriptor instead.
+func (*PfdCrash) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{166}
+}
+
+func (x *PfdCrash) GetProcess() *ProcessId {
+	if x != nil {
+		return x.Process
+	}
+	return nil
+}
+
+// LE
+// Built on PFD, the leader is the max-rank process among the ones not detected as crashed. The leader election of an
+// abstraction (<parent>.le) is created when first addressed, app.le when the HUB sends AppElect to a process or when
+// the heartbeats of the pfd of another one arrive. Its LeLeader indications are sent to the HUB as AppLeader
+type AppElect struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *AppElect) Reset() {
+	*x = AppElect{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_messages_proto_msgTypes[167]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AppElect) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AppElect) ProtoMessage() {}
+
+func (x *AppElect) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[167]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AppElect.ProtoReflect.Descriptor instead.
+func (*AppElect) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{167}
+}
+
+type AppLeader struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Leader *ProcessId `protobuf:"bytes,1,opt,name=leader,proto3" json:"leader,omitempty"`
+}
+
+func (x *AppLeader) Reset() {
+	*x = AppLeader{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_messages_proto_msgTypes[168]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AppLeader) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AppLeader) ProtoMessage() {}
+
+func (x *AppLeader) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[168]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AppLeader.ProtoReflect.Descriptor instead.
+func (*AppLeader) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{168}
+}
+
+func (x *AppLeader) GetLeader() *ProcessId {
+	if x != nil {
+		return x.Leader
+	}
+	return nil
+}
+
+type LeStart struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *LeStart) Reset() {
+	*x = LeStart{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_messages_proto_msgTypes[169]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LeStart) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeStart) ProtoMessage() {}
+
+func (x *LeStart) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[169]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeStart.ProtoReflect.Descriptor instead.
+func (*LeStart) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{169}
+}
+
+type LeLeader struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Process *ProcessId `protobuf:"bytes,1,opt,name=process,proto3" json:"process,omitempty"`
+}
+
+func (x *LeLeader) Reset() {
+	*x = LeLeader{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_messages_proto_msgTypes[170]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LeLeader) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeLeader) ProtoMessage() {}
+
+func (x *LeLeader) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[170]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeLeader.ProtoReflect.Descriptor instead.
+func (*LeLeader) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{170}
+}
+
+func (x *LeLeader) GetProcess() *ProcessId {
+	if x != nil {
+		return x.Process
+	}
+	return nil
+}
+
+// PL
+type PlSend struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Destination *ProcessId `protobuf:"bytes,1,opt,name=destination,proto3" json:"destination,omitempty"`
+	Message     *Message   `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *PlSend) Reset() {
+	*x = PlSend{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_messages_proto_msgTypes[171]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PlSend) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlSend) ProtoMessage() {}
+
+func (x *PlSend) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[171]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlSend.ProtoReflect.Descriptor instead.
+func (*PlSend) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{171}
+}
+
+func (x *PlSend) GetDestination() *ProcessId {
+	if x != nil {
+		return x.Destination
+	}
+	return nil
+}
+
+func (x *PlSend) GetMessage() *Message {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type PlDeliver struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sender  *ProcessId `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	Message *Message   `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *PlDeliver) Reset() {
+	*x = PlDeliver{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_messages_proto_msgTypes[172]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PlDeliver) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlDeliver) ProtoMessage() {}
+
+func (x *PlDeliver) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[172]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlDeliver.ProtoReflect.Descriptor instead.
+func (*PlDeliver) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{172}
+}
+
+func (x *PlDeliver) GetSender() *ProcessId {
+	if x != nil {
+		return x.Sender
+	}
+	return nil
+}
+
+func (x *PlDeliver) GetMessage() *Message {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+// Network-traveling message
+// When handling MessageA(PlSend(MessageB)) create MessageC(NetworkMessage(MessageB)), setting:
+//
+//	MessageC.SystemId = MessageA.SystemId
+//	MessageC.ToAbstractionId = MessageA.ToAbstractionId
+//	NetworkMessage.senderHost = N/A (ignore)
+//	NetworkMessage.senderListeningPort = The your listening port
+//
+// Then marshal MessageC to byte buffer and send:
+//
+//	bytes 0 - 3: buffer length
+//	bytes 4 -  : buffer data
+//
+// When unmarshalling from a buffer received from the network create MessageD(PlDeliver(MessageB)), setting:
+//
+//	MessageD.ToAbstractionId = MessageC.ToAbstractionId
+type NetworkMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SenderHost          string   `protobuf:"bytes,1,opt,name=senderHost,proto3" json:"senderHost,omitempty"`
+	SenderListeningPort int32    `protobuf:"varint,2,opt,name=senderListeningPort,proto3" json:"senderListeningPort,omitempty"`
+	Message             *Message `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *NetworkMessage) Reset() {
+	*x = NetworkMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_messages_proto_msgTypes[173]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NetworkMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NetworkMessage) ProtoMessage() {}
+
+func (x *NetworkMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[173]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NetworkMessage.ProtoReflect.Descriptor instead.
+func (*NetworkMessage) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{173}
+}
+
+func (x *NetworkMessage) GetSenderHost() string {
+	if x != nil {
+		return x.SenderHost
+	}
+	return ""
+}
+
+func (x *NetworkMessage) GetSenderListeningPort() int32 {
+	if x != nil {
+		return x.SenderListeningPort
+	}
+	return 0
+}
+
+func (x *NetworkMessage) GetMessage() *Message {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+// Wrapper message
+type Message struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type                         Message_Type                  `protobuf:"varint,1,opt,name=type,proto3,enum=pb.Message_Type" json:"type,omitempty"`
+	MessageUuid                  string                        `protobuf:"bytes,2,opt,name=messageUuid,proto3" json:"messageUuid,omitempty"`
+	FromAbstractionId            string                        `protobuf:"bytes,3,opt,name=FromAbstractionId,proto3" json:"FromAbstractionId,omitempty"` // Abstraction ID constructed as shown to the right of the system diagram
+	ToAbstractionId              string                        `protobuf:"bytes,4,opt,name=ToAbstractionId,proto3" json:"ToAbstractionId,omitempty"`     // Abstraction ID constructed as shown to the right of the system diagram
+	SystemId                     string                        `protobuf:"bytes,5,opt,name=systemId,proto3" json:"systemId,omitempty"`
+	NetworkMessage               *NetworkMessage               `protobuf:"bytes,6,opt,name=networkMessage,proto3" json:"networkMessage,omitempty"`
+	ProcRegistration             *ProcRegistration             `protobuf:"bytes,7,opt,name=procRegistration,proto3" json:"procRegistration,omitempty"`
+	ProcInitializeSystem         *ProcInitializeSystem         `protobuf:"bytes,8,opt,name=procInitializeSystem,proto3" json:"procInitializeSystem,omitempty"`
+	ProcDestroySystem            *ProcDestroySystem            `protobuf:"bytes,9,opt,name=procDestroySystem,proto3" json:"procDestroySystem,omitempty"`
+	AppBroadcast                 *AppBroadcast                 `protobuf:"bytes,10,opt,name=appBroadcast,proto3" json:"appBroadcast,omitempty"`
+	AppValue                     *AppValue                     `protobuf:"bytes,11,opt,name=appValue,proto3" json:"appValue,omitempty"`
+	AppPropose                   *AppPropose                   `protobuf:"bytes,12,opt,name=appPropose,proto3" json:"appPropose,omitempty"`
+	AppDecide                    *AppDecide                    `protobuf:"bytes,13,opt,name=appDecide,proto3" json:"appDecide,omitempty"`
+	AppRead                      *AppRead                      `protobuf:"bytes,14,opt,name=appRead,proto3" json:"appRead,omitempty"`
+	AppWrite                     *AppWrite                     `protobuf:"bytes,15,opt,name=appWrite,proto3" json:"appWrite,omitempty"`
+	AppReadReturn                *AppReadReturn                `protobuf:"bytes,16,opt,name=appReadReturn,proto3" json:"appReadReturn,omitempty"`
+	AppWriteReturn               *AppWriteReturn               `protobuf:"bytes,17,opt,name=appWriteReturn,proto3" json:"appWriteReturn,omitempty"`
+	AppReliableBroadcast         *AppReliableBroadcast         `protobuf:"bytes,18,opt,name=appReliableBroadcast,proto3" json:"appReliableBroadcast,omitempty"`
+	AppUniformBroadcast          *AppUniformBroadcast          `protobuf:"bytes,19,opt,name=appUniformBroadcast,proto3" json:"appUniformBroadcast,omitempty"`
+	AppFifoBroadcast             *AppFifoBroadcast             `protobuf:"bytes,22,opt,name=appFifoBroadcast,proto3" json:"appFifoBroadcast,omitempty"`
+	AppCausalBroadcast           *AppCausalBroadcast           `protobuf:"bytes,23,opt,name=appCausalBroadcast,proto3" json:"appCausalBroadcast,omitempty"`
+	AppProbabilisticBroadcast    *AppProbabilisticBroadcast    `protobuf:"bytes,24,opt,name=appProbabilisticBroadcast,proto3" json:"appProbabilisticBroadcast,omitempty"`
+	AppTobBroadcast              *AppTobBroadcast              `protobuf:"bytes,25,opt,name=appTobBroadcast,proto3" json:"appTobBroadcast,omitempty"`
+	AppTobDeliver                *AppTobDeliver                `protobuf:"bytes,26,opt,name=appTobDeliver,proto3" json:"appTobDeliver,omitempty"`
+	AppTrb                       *AppTrb                       `protobuf:"bytes,27,opt,name=appTrb,proto3" json:"appTrb,omitempty"`
+	AppTrbDeliver                *AppTrbDeliver                `protobuf:"bytes,28,opt,name=appTrbDeliver,proto3" json:"appTrbDeliver,omitempty"`
+	AppNbacPropose               *AppNbacPropose               `protobuf:"bytes,29,opt,name=appNbacPropose,proto3" json:"appNbacPropose,omitempty"`
+	AppNbacDecide                *AppNbacDecide                `protobuf:"bytes,39,opt,name=appNbacDecide,proto3" json:"appNbacDecide,omitempty"`
+	AppCommit                    *AppCommit                    `protobuf:"bytes,44,opt,name=appCommit,proto3" json:"appCommit,omitempty"`
+	AppCommitOutcome             *AppCommitOutcome             `protobuf:"bytes,45,opt,name=appCommitOutcome,proto3" json:"appCommitOutcome,omitempty"`
+	AppVsBroadcast               *AppVsBroadcast               `protobuf:"bytes,46,opt,name=appVsBroadcast,proto3" json:"appVsBroadcast,omitempty"`
+	AppVsDeliver                 *AppVsDeliver                 `protobuf:"bytes,47,opt,name=appVsDeliver,proto3" json:"appVsDeliver,omitempty"`
+	AppView                      *AppView                      `protobuf:"bytes,48,opt,name=appView,proto3" json:"appView,omitempty"`
+	UcDecide                     *UcDecide                     `protobuf:"bytes,20,opt,name=ucDecide,proto3" json:"ucDecide,omitempty"`
+	UcPropose                    *UcPropose                    `protobuf:"bytes,21,opt,name=ucPropose,proto3" json:"ucPropose,omitempty"`
+	EpAbort                      *EpAbort                      `protobuf:"bytes,30,opt,name=epAbort,proto3" json:"epAbort,omitempty"`
+	EpAborted                    *EpAborted                    `protobuf:"bytes,31,opt,name=epAborted,proto3" json:"epAborted,omitempty"`
+	EpInternalAccept             *EpInternalAccept             `protobuf:"bytes,32,opt,name=epInternalAccept,proto3" json:"epInternalAccept,omitempty"`
+	EpDecide                     *EpDecide                     `protobuf:"bytes,33,opt,name=epDecide,proto3" json:"epDecide,omitempty"`
+	EpInternalDecided            *EpInternalDecided            `protobuf:"bytes,34,opt,name=epInternalDecided,proto3" json:"epInternalDecided,omitempty"`
+	EpPropose                    *EpPropose                    `protobuf:"bytes,35,opt,name=epPropose,proto3" json:"epPropose,omitempty"`
+	EpInternalRead               *EpInternalRead               `protobuf:"bytes,36,opt,name=epInternalRead,proto3" json:"epInternalRead,omitempty"`
+	EpInternalState              *EpInternalState              `protobuf:"bytes,37,opt,name=epInternalState,proto3" json:"epInternalState,omitempty"`
+	EpInternalWrite              *EpInternalWrite              `protobuf:"bytes,38,opt,name=epInternalWrite,proto3" json:"epInternalWrite,omitempty"`
+	EcInternalNack               *EcInternalNack               `protobuf:"bytes,41,opt,name=ecInternalNack,proto3" json:"ecInternalNack,omitempty"`
+	EcInternalNewEpoch           *EcInternalNewEpoch           `protobuf:"bytes,42,opt,name=ecInternalNewEpoch,proto3" json:"ecInternalNewEpoch,omitempty"`
+	EcStartEpoch                 *EcStartEpoch                 `protobuf:"bytes,43,opt,name=ecStartEpoch,proto3" json:"ecStartEpoch,omitempty"`
+	BebBroadcast                 *BebBroadcast                 `protobuf:"bytes,50,opt,name=bebBroadcast,proto3" json:"bebBroadcast,omitempty"`
+	BebDeliver                   *BebDeliver                   `protobuf:"bytes,51,opt,name=bebDeliver,proto3" json:"bebDeliver,omitempty"`
+	EldTimeout                   *EldTimeout                   `protobuf:"bytes,60,opt,name=eldTimeout,proto3" json:"eldTimeout,omitempty"`
+	EldTrust                     *EldTrust                     `protobuf:"bytes,61,opt,name=eldTrust,proto3" json:"eldTrust,omitempty"`
+	NnarInternalAck              *NnarInternalAck              `protobuf:"bytes,70,opt,name=nnarInternalAck,proto3" json:"nnarInternalAck,omitempty"`
+	NnarInternalRead             *NnarInternalRead             `protobuf:"bytes,71,opt,name=nnarInternalRead,proto3" json:"nnarInternalRead,omitempty"`
+	NnarInternalValue            *NnarInternalValue            `protobuf:"bytes,72,opt,name=nnarInternalValue,proto3" json:"nnarInternalValue,omitempty"`
+	NnarInternalWrite            *NnarInternalWrite            `protobuf:"bytes,73,opt,name=nnarInternalWrite,proto3" json:"nnarInternalWrite,omitempty"`
+	NnarRead                     *NnarRead                     `protobuf:"bytes,74,opt,name=nnarRead,proto3" json:"nnarRead,omitempty"`
+	NnarReadReturn               *NnarReadReturn               `protobuf:"bytes,75,opt,name=nnarReadReturn,proto3" json:"nnarReadReturn,omitempty"`
+	NnarWrite                    *NnarWrite                    `protobuf:"bytes,76,opt,name=nnarWrite,proto3" json:"nnarWrite,omitempty"`
+	NnarWriteReturn              *NnarWriteReturn              `protobuf:"bytes,77,opt,name=nnarWriteReturn,proto3" json:"nnarWriteReturn,omitempty"`
+	EpfdTimeout                  *EpfdTimeout                  `protobuf:"bytes,80,opt,name=epfdTimeout,proto3" json:"epfdTimeout,omitempty"`
+	EpfdInternalHeartbeatRequest *EpfdInternalHeartbeatRequest `protobuf:"bytes,81,opt,name=epfdInternalHeartbeatRequest,proto3" json:"epfdInternalHeartbeatRequest,omitempty"`
+	EpfdInternalHeartbeatReply   *EpfdInternalHeartbeatReply   `protobuf:"bytes,82,opt,name=epfdInternalHeartbeatReply,proto3" json:"epfdInternalHeartbeatReply,omitempty"`
+	EpfdSuspect                  *EpfdSuspect                  `protobuf:"bytes,83,opt,name=epfdSuspect,proto3" json:"epfdSuspect,omitempty"`
+	EpfdRestore                  *EpfdRestore                  `protobuf:"bytes,84,opt,name=epfdRestore,proto3" json:"epfdRestore,omitempty"`
+	PlDeliver                    *PlDeliver                    `protobuf:"bytes,90,opt,name=plDeliver,proto3" json:"plDeliver,omitempty"`
+	PlSend                       *PlSend                       `protobuf:"bytes,91,opt,name=plSend,proto3" json:"plSend,omitempty"`
+	PfdTimeout                   *PfdTimeout                   `protobuf:"bytes,100,opt,name=pfdTimeout,proto3" json:"pfdTimeout,omitempty"`
+	PfdInternalHeartbeatRequest  *PfdInternalHeartbeatRequest  `protobuf:"bytes,101,opt,name=pfdInternalHeartbeatRequest,proto3" json:"pfdInternalHeartbeatRequest,omitempty"`
+	PfdInternalHeartbeatReply    *PfdInternalHeartbeatReply    `protobuf:"bytes,102,opt,name=pfdInternalHeartbeatReply,proto3" json:"pfdInternalHeartbeatReply,omitempty"`
+	PfdCrash                     *PfdCrash                     `protobuf:"bytes,103,opt,name=pfdCrash,proto3" json:"pfdCrash,omitempty"`
+	LeLeader                     *LeLeader                     `protobuf:"bytes,110,opt,name=leLeader,proto3" json:"leLeader,omitempty"`
+	AppElect                     *AppElect                     `protobuf:"bytes,111,opt,name=appElect,proto3" json:"appElect,omitempty"`
+	AppLeader                    *AppLeader                    `protobuf:"bytes,112,opt,name=appLeader,proto3" json:"appLeader,omitempty"`
+	LeStart                      *LeStart                      `protobuf:"bytes,113,opt,name=leStart,proto3" json:"leStart,omitempty"`
+	RbBroadcast                  *RbBroadcast                  `protobuf:"bytes,120,opt,name=rbBroadcast,proto3" json:"rbBroadcast,omitempty"`
+	RbDeliver                    *RbDeliver                    `protobuf:"bytes,121,opt,name=rbDeliver,proto3" json:"rbDeliver,omitempty"`
+	RbInternalData               *RbInternalData               `protobuf:"bytes,122,opt,name=rbInternalData,proto3" json:"rbInternalData,omitempty"`
+	UrbBroadcast                 *UrbBroadcast                 `protobuf:"bytes,130,opt,name=urbBroadcast,proto3" json:"urbBroadcast,omitempty"`
+	UrbDeliver                   *UrbDeliver                   `protobuf:"bytes,131,opt,name=urbDeliver,proto3" json:"urbDeliver,omitempty"`
+	UrbInternalData              *UrbInternalData              `protobuf:"bytes,132,opt,name=urbInternalData,proto3" json:"urbInternalData,omitempty"`
+	FrbBroadcast                 *FrbBroadcast                 `protobuf:"bytes,140,opt,name=frbBroadcast,proto3" json:"frbBroadcast,omitempty"`
+	FrbDeliver                   *FrbDeliver                   `protobuf:"bytes,141,opt,name=frbDeliver,proto3" json:"frbDeliver,omitempty"`
+	FrbInternalData              *FrbInternalData              `protobuf:"bytes,142,opt,name=frbInternalData,proto3" json:"frbInternalData,omitempty"`
+	CrbBroadcast                 *CrbBroadcast                 `protobuf:"bytes,150,opt,name=crbBroadcast,proto3" json:"crbBroadcast,omitempty"`
+	CrbDeliver                   *CrbDeliver                   `protobuf:"bytes,151,opt,name=crbDeliver,proto3" json:"crbDeliver,omitempty"`
+	CrbInternalData              *CrbInternalData              `protobuf:"bytes,152,opt,name=crbInternalData,proto3" json:"crbInternalData,omitempty"`
+	PbBroadcast                  *PbBroadcast                  `protobuf:"bytes,160,opt,name=pbBroadcast,proto3" json:"pbBroadcast,omitempty"`
+	PbDeliver                    *PbDeliver                    `protobuf:"bytes,161,opt,name=pbDeliver,proto3" json:"pbDeliver,omitempty"`
+	PbInternalGossip             *PbInternalGossip             `protobuf:"bytes,162,opt,name=pbInternalGossip,proto3" json:"pbInternalGossip,omitempty"`
+	PbInternalRequest            *PbInternalRequest            `protobuf:"bytes,163,opt,name=pbInternalRequest,proto3" json:"pbInternalRequest,omitempty"`
+	PbInternalData               *PbInternalData               `protobuf:"bytes,164,opt,name=pbInternalData,proto3" json:"pbInternalData,omitempty"`
+	PbTimeout                    *PbTimeout                    `protobuf:"bytes,165,opt,name=pbTimeout,proto3" json:"pbTimeout,omitempty"`
+	TobBroadcast                 *TobBroadcast                 `protobuf:"bytes,170,opt,name=tobBroadcast,proto3" json:"tobBroadcast,omitempty"`
+	TobDeliver                   *TobDeliver                   `protobuf:"bytes,171,opt,name=tobDeliver,proto3" json:"tobDeliver,omitempty"`
+	TobInternalData              *TobInternalData              `protobuf:"bytes,172,opt,name=tobInternalData,proto3" json:"tobInternalData,omitempty"`
+	TrbBroadcast                 *TrbBroadcast                 `protobuf:"bytes,180,opt,name=trbBroadcast,proto3" json:"trbBroadcast,omitempty"`
+	TrbDeliver                   *TrbDeliver                   `protobuf:"bytes,181,opt,name=trbDeliver,proto3" json:"trbDeliver,omitempty"`
+	TrbInternalData              *TrbInternalData              `protobuf:"bytes,182,opt,name=trbInternalData,proto3" json:"trbInternalData,omitempty"`
+	NbacPropose                  *NbacPropose                  `protobuf:"bytes,190,opt,name=nbacPropose,proto3" json:"nbacPropose,omitempty"`
+	NbacDecide                   *NbacDecide                   `protobuf:"bytes,191,opt,name=nbacDecide,proto3" json:"nbacDecide,omitempty"`
+	NbacInternalVote             *NbacInternalVote             `protobuf:"bytes,192,opt,name=nbacInternalVote,proto3" json:"nbacInternalVote,omitempty"`
+	CommitStart                  *CommitStart                  `protobuf:"bytes,200,opt,name=commitStart,proto3" json:"commitStart,omitempty"`
+	CommitOutcome                *CommitOutcome                `protobuf:"bytes,201,opt,name=commitOutcome,proto3" json:"commitOutcome,omitempty"`
+	CommitInternalPrepare        *CommitInternalPrepare        `protobuf:"bytes,202,opt,name=commitInternalPrepare,proto3" json:"commitInternalPrepare,omitempty"`
+	CommitInternalVote           *CommitInternalVote           `protobuf:"bytes,203,opt,name=commitInternalVote,proto3" json:"commitInternalVote,omitempty"`
+	CommitInternalPrecommit      *CommitInternalPrecommit      `protobuf:"bytes,204,opt,name=commitInternalPrecommit,proto3" json:"commitInternalPrecommit,omitempty"`
+	CommitInternalAck            *CommitInternalAck            `protobuf:"bytes,205,opt,name=commitInternalAck,proto3" json:"commitInternalAck,omitempty"`
+	CommitInternalDecision       *CommitInternalDecision       `protobuf:"bytes,206,opt,name=commitInternalDecision,proto3" json:"commitInternalDecision,omitempty"`
+	CommitInternalState          *CommitInternalState          `protobuf:"bytes,207,opt,name=commitInternalState,proto3" json:"commitInternalState,omitempty"`
+	GmView                       *GmView                       `protobuf:"bytes,210,opt,name=gmView,proto3" json:"gmView,omitempty"`
+	GmReconfigure                *GmReconfigure                `protobuf:"bytes,211,opt,name=gmReconfigure,proto3" json:"gmReconfigure,omitempty"`
+	VsBroadcast                  *VsBroadcast                  `protobuf:"bytes,220,opt,name=vsBroadcast,proto3" json:"vsBroadcast,omitempty"`
+	VsDeliver                    *VsDeliver                    `protobuf:"bytes,221,opt,name=vsDeliver,proto3" json:"vsDeliver,omitempty"`
+	VsView                       *VsView                       `protobuf:"bytes,222,opt,name=vsView,proto3" json:"vsView,omitempty"`
+	VsInternalData               *VsInternalData               `protobuf:"bytes,223,opt,name=vsInternalData,proto3" json:"vsInternalData,omitempty"`
+	VsInternalPending            *VsInternalPending            `protobuf:"bytes,224,opt,name=vsInternalPending,proto3" json:"vsInternalPending,omitempty"`
+	ProcReconfigureSystem        *ProcReconfigureSystem        `protobuf:"bytes,230,opt,name=procReconfigureSystem,proto3" json:"procReconfigureSystem,omitempty"`
+	ReconfInternalState          *ReconfInternalState          `protobuf:"bytes,231,opt,name=reconfInternalState,proto3" json:"reconfInternalState,omitempty"`
+	OnrrRead                     *OnrrRead                     `protobuf:"bytes,240,opt,name=onrrRead,proto3" json:"onrrRead,omitempty"`
+	OnrrReadReturn               *OnrrReadReturn               `protobuf:"bytes,241,opt,name=onrrReadReturn,proto3" json:"onrrReadReturn,omitempty"`
+	OnrrWrite                    *OnrrWrite                    `protobuf:"bytes,242,opt,name=onrrWrite,proto3" json:"onrrWrite,omitempty"`
+	OnrrWriteReturn              *OnrrWriteReturn              `protobuf:"bytes,243,opt,name=onrrWriteReturn,proto3" json:"onrrWriteReturn,omitempty"`
+	OnrrInternalRead             *OnrrInternalRead             `protobuf:"bytes,244,opt,name=onrrInternalRead,proto3" json:"onrrInternalRead,omitempty"`
+	OnrrInternalValue            *OnrrInternalValue            `protobuf:"bytes,245,opt,name=onrrInternalValue,proto3" json:"onrrInternalValue,omitempty"`
+	OnrrInternalWrite            *OnrrInternalWrite            `protobuf:"bytes,246,opt,name=onrrInternalWrite,proto3" json:"onrrInternalWrite,omitempty"`
+	OnrrInternalAck              *OnrrInternalAck              `protobuf:"bytes,247,opt,name=onrrInternalAck,proto3" json:"onrrInternalAck,omitempty"`
+	OnarRead                     *OnarRead                     `protobuf:"bytes,250,opt,name=onarRead,proto3" json:"onarRead,omitempty"`
+	OnarReadReturn               *OnarReadReturn               `protobuf:"bytes,251,opt,name=onarReadReturn,proto3" json:"onarReadReturn,omitempty"`
+	OnarWrite                    *OnarWrite                    `protobuf:"bytes,252,opt,name=onarWrite,proto3" json:"onarWrite,omitempty"`
+	OnarWriteReturn              *OnarWriteReturn              `protobuf:"bytes,253,opt,name=onarWriteReturn,proto3" json:"onarWriteReturn,omitempty"`
+	AppLogAppend                 *AppLogAppend                 `protobuf:"bytes,260,opt,name=appLogAppend,proto3" json:"appLogAppend,omitempty"`
+	AppLogAppended               *AppLogAppended               `protobuf:"bytes,261,opt,name=appLogAppended,proto3" json:"appLogAppended,omitempty"`
+	AppLogCommitted              *AppLogCommitted              `protobuf:"bytes,262,opt,name=appLogCommitted,proto3" json:"appLogCommitted,omitempty"`
+	LogAppend                    *LogAppend                    `protobuf:"bytes,270,opt,name=logAppend,proto3" json:"logAppend,omitempty"`
+	LogAppended                  *LogAppended                  `protobuf:"bytes,271,opt,name=logAppended,proto3" json:"logAppended,omitempty"`
+	LogCommitted                 *LogCommitted                 `protobuf:"bytes,272,opt,name=logCommitted,proto3" json:"logCommitted,omitempty"`
+	LogInternalForward           *LogInternalForward           `protobuf:"bytes,273,opt,name=logInternalForward,proto3" json:"logInternalForward,omitempty"`
+	LogInternalPrepare           *LogInternalPrepare           `protobuf:"bytes,274,opt,name=logInternalPrepare,proto3" json:"logInternalPrepare,omitempty"`
+	LogInternalPromise           *LogInternalPromise           `protobuf:"bytes,275,opt,name=logInternalPromise,proto3" json:"logInternalPromise,omitempty"`
+	LogInternalAccept            *LogInternalAccept            `protobuf:"bytes,276,opt,name=logInternalAccept,proto3" json:"logInternalAccept,omitempty"`
+	LogInternalAccepted          *LogInternalAccepted          `protobuf:"bytes,277,opt,name=logInternalAccepted,proto3" json:"logInternalAccepted,omitempty"`
+	LogInternalCommit            *LogInternalCommit            `protobuf:"bytes,278,opt,name=logInternalCommit,proto3" json:"logInternalCommit,omitempty"`
+	RaftTimeout                  *RaftTimeout                  `protobuf:"bytes,280,opt,name=raftTimeout,proto3" json:"raftTimeout,omitempty"`
+	RaftInternalRequestVote      *RaftInternalRequestVote      `protobuf:"bytes,281,opt,name=raftInternalRequestVote,proto3" json:"raftInternalRequestVote,omitempty"`
+	RaftInternalVote             *RaftInternalVote             `protobuf:"bytes,282,opt,name=raftInternalVote,proto3" json:"raftInternalVote,omitempty"`
+	RaftInternalAppendEntries    *RaftInternalAppendEntries    `protobuf:"bytes,283,opt,name=raftInternalAppendEntries,proto3" json:"raftInternalAppendEntries,omitempty"`
+	RaftInternalAppendResult     *RaftInternalAppendResult     `protobuf:"bytes,284,opt,name=raftInternalAppendResult,proto3" json:"raftInternalAppendResult,omitempty"`
+	RaftInternalForward          *RaftInternalForward          `protobuf:"bytes,285,opt,name=raftInternalForward,proto3" json:"raftInternalForward,omitempty"`
+	AppRcPropose                 *AppRcPropose                 `protobuf:"bytes,290,opt,name=appRcPropose,proto3" json:"appRcPropose,omitempty"`
+	AppRcDecide                  *AppRcDecide                  `protobuf:"bytes,291,opt,name=appRcDecide,proto3" json:"appRcDecide,omitempty"`
+	RcInternalProposal           *RcInternalProposal           `protobuf:"bytes,292,opt,name=rcInternalProposal,proto3" json:"rcInternalProposal,omitempty"`
+	BcInternalPhase              *BcInternalPhase              `protobuf:"bytes,293,opt,name=bcInternalPhase,proto3" json:"bcInternalPhase,omitempty"`
+	BcInternalDecided            *BcInternalDecided            `protobuf:"bytes,294,opt,name=bcInternalDecided,proto3" json:"bcInternalDecided,omitempty"`
+	FloodingInternalProposal     *FloodingInternalProposal     `protobuf:"bytes,300,opt,name=floodingInternalProposal,proto3" json:"floodingInternalProposal,omitempty"`
+	FloodingInternalDecided      *FloodingInternalDecided      `protobuf:"bytes,301,opt,name=floodingInternalDecided,proto3" json:"floodingInternalDecided,omitempty"`
+	HierarchicalInternalProposal *HierarchicalInternalProposal `protobuf:"bytes,302,opt,name=hierarchicalInternalProposal,proto3" json:"hierarchicalInternalProposal,omitempty"`
+	HierarchicalInternalAck      *HierarchicalInternalAck      `protobuf:"bytes,303,opt,name=hierarchicalInternalAck,proto3" json:"hierarchicalInternalAck,omitempty"`
+	HierarchicalInternalDecided  *HierarchicalInternalDecided  `protobuf:"bytes,304,opt,name=hierarchicalInternalDecided,proto3" json:"hierarchicalInternalDecided,omitempty"`
+	AppBcbBroadcast              *AppBcbBroadcast              `protobuf:"bytes,310,opt,name=appBcbBroadcast,proto3" json:"appBcbBroadcast,omitempty"`
+	AppBrbBroadcast              *AppBrbBroadcast              `protobuf:"bytes,311,opt,name=appBrbBroadcast,proto3" json:"appBrbBroadcast,omitempty"`
+	BcbBroadcast                 *BcbBroadcast                 `protobuf:"bytes,312,opt,name=bcbBroadcast,proto3" json:"bcbBroadcast,omitempty"`
+	BcbDeliver                   *BcbDeliver                   `protobuf:"bytes,313,opt,name=bcbDeliver,proto3" json:"bcbDeliver,omitempty"`
+	BrbBroadcast                 *BrbBroadcast                 `protobuf:"bytes,314,opt,name=brbBroadcast,proto3" json:"brbBroadcast,omitempty"`
+	BrbDeliver                   *BrbDeliver                   `protobuf:"bytes,315,opt,name=brbDeliver,proto3" json:"brbDeliver,omitempty"`
+	ByzantineInternalSend        *ByzantineInternalSend        `protobuf:"bytes,316,opt,name=byzantineInternalSend,proto3" json:"byzantineInternalSend,omitempty"`
+	ByzantineInternalEcho        *ByzantineInternalEcho        `protobuf:"bytes,317,opt,name=byzantineInternalEcho,proto3" json:"byzantineInternalEcho,omitempty"`
+	ByzantineInternalReady       *ByzantineInternalReady       `protobuf:"bytes,318,opt,name=byzantineInternalReady,proto3" json:"byzantineInternalReady,omitempty"`
+}
+
+func (x *Message) Reset() {
+	*x = Message{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_messages_proto_msgTypes[174]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Message) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Message) ProtoMessage() {}
+
+func (x *Message) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[174]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Message.ProtoReflect.Descriptor instead.
+func (*Message) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{174}
+}
+
+func (x *Message) GetType() Message_Type {
+	if x != nil {
+		return x.Type
+	}
+	return Message_NETWORK_MESSAGE
+}
+
+func (x *Message) GetMessageUuid() string {
+	if x != nil {
+		return x.MessageUuid
+	}
+	return ""
+}
+
+func (x *Message) GetFromAbstractionId() string {
+	if x != nil {
+		return x.FromAbstractionId
+	}
+	return ""
+}
+
+func (x *Message) GetToAbstractionId() string {
+	if x != nil {
+		return x.ToAbstractionId
+	}
+	return ""
+}
+
+func (x *Message) GetSystemId() string {
+	if x != nil {
+		return x.SystemId
+	}
+	return ""
+}
+
+func (x *Message) GetNetworkMessage() *NetworkMessage {
+	if x != nil {
+		return x.NetworkMessage
+	}
+	return nil
+}
+
+func (x *Message) GetProcRegistration() *ProcRegistration {
+	if x != nil {
+		return x.ProcRegistration
+	}
+	return nil
+}
+
+func (x *Message) GetProcInitializeSystem() *ProcInitializeSystem {
+	if x != nil {
+		return x.ProcInitializeSystem
+	}
+	return nil
+}
+
+func (x *Message) GetProcDestroySystem() *ProcDestroySystem {
+	if x != nil {
+		return x.ProcDestroySystem
+	}
+	return nil
+}
+
+func (x *Message) GetAppBroadcast() *AppBroadcast {
+	if x != nil {
+		return x.AppBroadcast
+	}
+	return nil
+}
+
+func (x *Message) GetAppValue() *AppValue {
+	if x != nil {
+		return x.AppValue
+	}
+	return nil
+}
+
+func (x *Message) GetAppPropose() *AppPropose {
+	if x != nil {
+		return x.AppPropose
+	}
+	return nil
+}
+
+func (x *Message) GetAppDecide() *AppDecide {
+	if x != nil {
+		return x.AppDecide
+	}
+	return nil
+}
+
+func (x *Message) GetAppRead() *AppRead {
+	if x != nil {
+		return x.AppRead
+	}
+	return nil
+}
+
+func (x *Message) GetAppWrite() *AppWrite {
+	if x != nil {
+		return x.AppWrite
+	}
+	return nil
+}
+
+func (x *Message) GetAppReadReturn() *AppReadReturn {
+	if x != nil {
+		return x.AppReadReturn
+	}
+	return nil
+}
+
+func (x *Message) GetAppWriteReturn() *AppWriteReturn {
+	if x != nil {
+		return x.AppWriteReturn
+	}
+	return nil
+}
+
+func (x *Message) GetAppReliableBroadcast() *AppReliableBroadcast {
+	if x != nil {
+		return x.AppReliableBroadcast
+	}
+	return nil
+}
+
+func (x *Message) GetAppUniformBroadcast() *AppUniformBroadcast {
+	if x != nil {
+		return x.AppUniformBroadcast
+	}
+	return nil
+}
+
+func (x *Message) GetAppFifoBroadcast() *AppFifoBroadcast {
+	if x != nil {
+		return x.AppFifoBroadcast
+	}
+	return nil
+}
+
+func (x *Message) GetAppCausalBroadcast() *AppCausalBroadcast {
+	if x != nil {
+		return x.AppCausalBroadcast
+	}
+	return nil
+}
+
+func (x *Message) GetAppProbabilisticBroadcast() *AppProbabilisticBroadcast {
+	if x != nil {
+		return x.AppProbabilisticBroadcast
+	}
+	return nil
+}
+
+func (x *Message) GetAppTobBroadcast() *AppTobBroadcast {
+	if x != nil {
+		return x.AppTobBroadcast
+	}
+	return nil
+}
+
+func (x *Message) GetAppTobDeliver() *AppTobDeliver {
+	if x != nil {
+		return x.AppTobDeliver
+	}
+	return nil
+}
+
+func (x *Message) GetAppTrb() *AppTrb {
+	if x != nil {
+		return x.AppTrb
+	}
+	return nil
+}
+
+func (x *Message) GetAppTrbDeliver() *AppTrbDeliver {
+	if x != nil {
+		return x.AppTrbDeliver
+	}
+	return nil
+}
+
+func (x *Message) GetAppNbacPropose() *AppNbacPropose {
+	if x != nil {
+		return x.AppNbacPropose
+	}
+	return nil
+}
+
+func (x *Message) GetAppNbacDecide() *AppNbacDecide {
+	if x != nil {
+		return x.AppNbacDecide
+	}
+	return nil
+}
+
+func (x *Message) GetAppCommit() *AppCommit {
+	if x != nil {
+		return x.AppCommit
+	}
+	return nil
+}
+
+func (x *Message) GetAppCommitOutcome() *AppCommitOutcome {
+	if x != nil {
+		return x.AppCommitOutcome
+	}
+	return nil
+}
+
+func (x *Message) GetAppVsBroadcast() *AppVsBroadcast {
+	if x != nil {
+		return x.AppVsBroadcast
+	}
+	return nil
+}
+
+func (x *Message) GetAppVsDeliver() *AppVsDeliver {
+	if x != nil {
+		return x.AppVsDeliver
+	}
+	return nil
+}
+
+func (x *Message) GetAppView() *AppView {
+	if x != nil {
+		return x.AppView
+	}
+	return nil
+}
+
+func (x *Message) GetUcDecide() *UcDecide {
+	if x != nil {
+		return x.UcDecide
+	}
+	return nil
+}
+
+func (x *Message) GetUcPropose() *UcPropose {
+	if x != nil {
+		return x.UcPropose
+	}
+	return nil
+}
+
+func (x *Message) GetEpAbort() *EpAbort {
+	if x != nil {
+		return x.EpAbort
+	}
+	return nil
+}
+
+func (x *Message) GetEpAborted() *EpAborted {
+	if x != nil {
+		return x.EpAborted
+	}
+	return nil
+}
+
+func (x *Message) GetEpInternalAccept() *EpInternalAccept {
+	if x != nil {
+		return x.EpInternalAccept
+	}
+	return nil
+}
+
+func (x *Message) GetEpDecide() *EpDecide {
+	if x != nil {
+		return x.EpDecide
+	}
+	return nil
+}
+
+func (x *Message) GetEpInternalDecided() *EpInternalDecided {
+	if x != nil {
+		return x.EpInternalDecided
+	}
+	return nil
+}
+
+func (x *Message) GetEpPropose() *EpPropose {
+	if x != nil {
+		return x.EpPropose
+	}
+	return nil
+}
+
+func (x *Message) GetEpInternalRead() *EpInternalRead {
+	if x != nil {
+		return x.EpInternalRead
+	}
+	return nil
+}
+
+func (x *Message) GetEpInternalState() *EpInternalState {
+	if x != nil {
+		return x.EpInternalState
+	}
+	return nil
+}
+
+func (x *Message) GetEpInternalWrite() *EpInternalWrite {
+	if x != nil {
+		return x.EpInternalWrite
+	}
+	return nil
+}
+
+func (x *Message) GetEcInternalNack() *EcInternalNack {
+	if x != nil {
+		return x.EcInternalNack
+	}
+	return nil
+}
+
+func (x *Message) GetEcInternalNewEpoch() *EcInternalNewEpoch {
+	if x != nil {
+		return x.EcInternalNewEpoch
+	}
+	return nil
+}
+
+func (x *Message) GetEcStartEpoch() *EcStartEpoch {
+	if x != nil {
+		return x.EcStartEpoch
+	}
+	return nil
+}
+
+func (x *Message) GetBebBroadcast() *BebBroadcast {
+	if x != nil {
+		return x.BebBroadcast
+	}
+	return nil
+}
+
+func (x *Message) GetBebDeliver() *BebDeliver {
+	if x != nil {
+		return x.BebDeliver
+	}
+	return nil
+}
+
+func (x *Message) GetEldTimeout() *EldTimeout {
+	if x != nil {
+		return x.EldTimeout
+	}
+	return nil
+}
+
+func (x *Message) GetEldTrust() *EldTrust {
+	if x != nil {
+		return x.EldTrust
+	}
+	return nil
+}
+
+func (x *Message) GetNnarInternalAck() *NnarInternalAck {
+	if x != nil {
+		return x.NnarInternalAck
+	}
+	return nil
+}
+
+func (x *Message) GetNnarInternalRead() *NnarInternalRead {
+	if x != nil {
+		return x.NnarInternalRead
+	}
+	return nil
+}
+
+func (x *Message) GetNnarInternalValue() *NnarInternalValue {
+	if x != nil {
+		return x.NnarInternalValue
+	}
+	return nil
+}
+
+func (x *Message) GetNnarInternalWrite() *NnarInternalWrite {
+	if x != nil {
+		return x.NnarInternalWrite
+	}
+	return nil
+}
+
+func (x *Message) GetNnarRead() *NnarRead {
+	if x != nil {
+		return x.NnarRead
+	}
+	return nil
+}
+
+func (x *Message) GetNnarReadReturn() *NnarReadReturn {
+	if x != nil {
+		return x.NnarReadReturn
+	}
+	return nil
+}
+
+func (x *Message) GetNnarWrite() *NnarWrite {
+	if x != nil {
+		return x.NnarWrite
+	}
+	return nil
+}
+
+func (x *Message) GetNnarWriteReturn() *NnarWriteReturn {
+	if x != nil {
+		return x.NnarWriteReturn
+	}
+	return nil
+}
+
+func (x *Message) GetEpfdTimeout() *EpfdTimeout {
+	if x != nil {
+		return x.EpfdTimeout
+	}
+	return nil
+}
+
+func (x *Message) GetEpfdInternalHeartbeatRequest() *EpfdInternalHeartbeatRequest {
+	if x != nil {
+		return x.EpfdInternalHeartbeatRequest
+	}
+	return nil
+}
+
+func (x *Message) GetEpfdInternalHeartbeatReply() *EpfdInternalHeartbeatReply {
+	if x != nil {
+		return x.EpfdInternalHeartbeatReply
+	}
+	return nil
+}
+
+func (x *Message) GetEpfdSuspect() *EpfdSuspect {
+	if x != nil {
+		return x.EpfdSuspect
+	}
+	return nil
+}
+
+func (x *Message) GetEpfdRestore() *EpfdRestore {
+	if x != nil {
+		return x.EpfdRestore
+	}
+	return nil
+}
+
+func (x *Message) GetPlDeliver() *PlDeliver {
+	if x != nil {
+		return x.PlDeliver
+	}
+	return nil
+}
+
+func (x *Message) GetPlSend() *PlSend {
+	if x != nil {
+		return x.PlSend
+	}
+	return nil
+}
+
+func (x *Message) GetPfdTimeout() *PfdTimeout {
+	if x != nil {
+		return x.PfdTimeout
+	}
+	return nil
+}
+
+func (x *Message) GetPfdInternalHeartbeatRequest() *PfdInternalHeartbeatRequest {
+	if x != nil {
+		return x.PfdInternalHeartbeatRequest
+	}
+	return nil
+}
+
+func (x *Message) GetPfdInternalHeartbeatReply() *PfdInternalHeartbeatReply {
+	if x != nil {
+		return x.PfdInternalHeartbeatReply
+	}
+	return nil
+}
+
+func (x *Message) GetPfdCrash() *PfdCrash {
+	if x != nil {
+		return x.PfdCrash
+	}
+	return nil
+}
+
+func (x *Message) GetLeLeader() *LeLeader {
+	if x != nil {
+		return x.LeLeader
+	}
+	return nil
+}
+
+func (x *Message) GetAppElect() *AppElect {
+	if x != nil {
+		return x.AppElect
+	}
+	return nil
+}
+
+func (x *Message) GetAppLeader() *AppLeader {
+	if x != nil {
+		return x.AppLeader
+	}
+	return nil
+}
+
+func (x *Message) GetLeStart() *LeStart {
+	if x != nil {
+		return x.LeStart
+	}
+	return nil
+}
+
+func (x *Message) GetRbBroadcast() *RbBroadcast {
+	if x != nil {
+		return x.RbBroadcast
+	}
+	return nil
+}
+
+func (x *Message) GetRbDeliver() *RbDeliver {
+	if x != nil {
+		return x.RbDeliver
+	}
+	return nil
+}
+
+func (x *Message) GetRbInternalData() *RbInternalData {
+	if x != nil {
+		return x.RbInternalData
+	}
+	return nil
+}
+
+func (x *Message) GetUrbBroadcast() *UrbBroadcast {
+	if x != nil {
+		return x.UrbBroadcast
+	}
+	return nil
+}
+
+func (x *Message) GetUrbDeliver() *UrbDeliver {
+	if x != nil {
+		return x.UrbDeliver
+	}
+	return nil
+}
+
+func (x *Message) GetUrbInternalData() *UrbInternalData {
+	if x != nil {
+		return x.UrbInternalData
+	}
+	return nil
+}
+
+func (x *Message) GetFrbBroadcast() *FrbBroadcast {
+	if x != nil {
+		return x.FrbBroadcast
+	}
+	return nil
+}
+
+func (x *Message) GetFrbDeliver() *FrbDeliver {
+	if x != nil {
+		return x.FrbDeliver
+	}
+	return nil
+}
+
+func (x *Message) GetFrbInternalData() *FrbInternalData {
+	if x != nil {
+		return x.FrbInternalData
+	}
+	return nil
+}
+
+func (x *Message) GetCrbBroadcast() *CrbBroadcast {
+	if x != nil {
+		return x.CrbBroadcast
+	}
+	return nil
+}
+
+func (x *Message) GetCrbDeliver() *CrbDeliver {
+	if x != nil {
+		return x.CrbDeliver
+	}
+	return nil
+}
+
+func (x *Message) GetCrbInternalData() *CrbInternalData {
+	if x != nil {
+		return x.CrbInternalData
+	}
+	return nil
+}
+
+func (x *Message) GetPbBroadcast() *PbBroadcast {
+	if x != nil {
+		return x.PbBroadcast
+	}
+	return nil
+}
+
+func (x *Message) GetPbDeliver() *PbDeliver {
+	if x != nil {
+		return x.PbDeliver
+	}
+	return nil
+}
+
+func (x *Message) GetPbInternalGossip() *PbInternalGossip {
+	if x != nil {
+		return x.PbInternalGossip
+	}
+	return nil
+}
+
+func (x *Message) GetPbInternalRequest() *PbInternalRequest {
+	if x != nil {
+		return x.PbInternalRequest
+	}
+	return nil
+}
+
+func (x *Message) GetPbInternalData() *PbInternalData {
+	if x != nil {
+		return x.PbInternalData
+	}
+	return nil
+}
+
+func (x *Message) GetPbTimeout() *PbTimeout {
+	if x != nil {
+		return x.PbTimeout
+	}
+	return nil
+}
+
+func (x *Message) GetTobBroadcast() *TobBroadcast {
+	if x != nil {
+		return x.TobBroadcast
+	}
+	return nil
+}
+
+func (x *Message) GetTobDeliver() *TobDeliver {
+	if x != nil {
+		return x.TobDeliver
+	}
+	return nil
+}
+
+func (x *Message) GetTobInternalData() *TobInternalData {
+	if x != nil {
+		return x.TobInternalData
+	}
+	return nil
+}
+
+func (x *Message) GetTrbBroadcast() *TrbBroadcast {
+	if x != nil {
+		return x.TrbBroadcast
+	}
+	return nil
+}
+
+func (x *Message) GetTrbDeliver() *TrbDeliver {
+	if x != nil {
+		return x.TrbDeliver
+	}
+	return nil
+}
+
+func (x *Message) GetTrbInternalData() *TrbInternalData {
+	if x != nil {
+		return x.TrbInternalData
+	}
+	return nil
+}
+
+func (x *Message) GetNbacPropose() *NbacPropose {
+	if x != nil {
+		return x.NbacPropose
+	}
+	return nil
+}
+
+func (x *Message) GetNbacDecide() *NbacDecide {
+	if x != nil {
+		return x.NbacDecide
+	}
+	return nil
+}
+
+func (x *Message) GetNbacInternalVote() *NbacInternalVote {
+	if x != nil {
+		return x.NbacInternalVote
+	}
+	return nil
+}
+
+func (x *Message) GetCommitStart() *CommitStart {
+	if x != nil {
+		return x.CommitStart
+	}
+	return nil
+}
+
+func (x *Message) GetCommitOutcome() *CommitOutcome {
+	if x != nil {
+		return x.CommitOutcome
+	}
+	return nil
+}
+
+func (x *Message) GetCommitInternalPrepare() *CommitInternalPrepare {
+	if x != nil {
+		return x.CommitInternalPrepare
+	}
+	return nil
+}
+
+func (x *Message) GetCommitInternalVote() *CommitInternalVote {
+	if x != nil {
+		return x.CommitInternalVote
+	}
+	return nil
+}
+
+func (x *Message) GetCommitInternalPrecommit() *CommitInternalPrecommit {
+	if x != nil {
+		return x.CommitInternalPrecommit
+	}
+	return nil
+}
+
+func (x *Message) GetCommitInternalAck() *CommitInternalAck {
+	if x != nil {
+		return x.CommitInternalAck
+	}
+	return nil
+}
+
+func (x *Message) GetCommitInternalDecision() *CommitInternalDecision {
+	if x != nil {
+		return x.CommitInternalDecision
+	}
+	return nil
+}
+
+func (x *Message) GetCommitInternalState() *CommitInternalState {
+	if x != nil {
+		return x.CommitInternalState
+	}
+	return nil
+}
+
+func (x *Message) GetGmView() *GmView {
+	if x != nil {
+		return x.GmView
+	}
+	return nil
+}
+
+func (x *Message) GetGmReconfigure() *GmReconfigure {
+	if x != nil {
+		return x.GmReconfigure
+	}
+	return nil
+}
+
+func (x *Message) GetVsBroadcast() *VsBroadcast {
+	if x != nil {
+		return x.VsBroadcast
+	}
+	return nil
+}
+
+func (x *Message) GetVsDeliver() *VsDeliver {
+	if x != nil {
+		return x.VsDeliver
+	}
+	return nil
+}
+
+func (x *Message) GetVsView() *VsView {
+	if x != nil {
+		return x.VsView
+	}
+	return nil
+}
+
+func (x *Message) GetVsInternalData() *VsInternalData {
+	if x != nil {
+		return x.VsInternalData
+	}
+	return nil
+}
+
+func (x *Message) GetVsInternalPending() *VsInternalPending {
+	if x != nil {
+		return x.VsInternalPending
+	}
+	return nil
+}
+
+func (x *Message) GetProcReconfigureSystem() *ProcReconfigureSystem {
+	if x != nil {
+		return x.ProcReconfigureSystem
+	}
+	return nil
+}
+
+func (x *Message) GetReconfInternalState() *ReconfInternalState {
+	if x != nil {
+		return x.ReconfInternalState
+	}
+	return nil
+}
+
+func (x *Message) GetOnrrRead() *OnrrRead {
+	if x != nil {
+		return x.OnrrRead
+	}
+	return nil
+}
+
+func (x *Message) GetOnrrReadReturn() *OnrrReadReturn {
+	if x != nil {
+		return x.OnrrReadReturn
+	}
+	return nil
+}
+
+func (x *Message) GetOnrrWrite() *OnrrWrite {
+	if x != nil {
+		return x.OnrrWrite
+	}
+	return nil
+}
+
+func (x *Message) GetOnrrWriteReturn() *OnrrWriteReturn {
+	if x != nil {
+		return x.OnrrWriteReturn
+	}
+	return nil
+}
+
+func (x *Message) GetOnrrInternalRead() *OnrrInternalRead {
+	if x != nil {
+		return x.OnrrInternalRead
+	}
+	return nil
+}
+
+func (x *Message) GetOnrrInternalValue() *OnrrInternalValue {
+	if x != nil {
+		return x.OnrrInternalValue
+	}
+	return nil
+}
+
+func (x *Message) GetOnrrInternalWrite() *OnrrInternalWrite {
+	if x != nil {
+		return x.OnrrInternalWrite
+	}
+	return nil
+}
+
+func (x *Message) GetOnrrInternalAck() *OnrrInternalAck {
+	if x != nil {
+		return x.OnrrInternalAck
+	}
+	return nil
+}
+
+func (x *Message) GetOnarRead() *OnarRead {
+	if x != nil {
+		return x.OnarRead
+	}
+	return nil
+}
+
+func (x *Message) GetOnarReadReturn() *OnarReadReturn {
+	if x != nil {
+		return x.OnarReadReturn
+	}
+	return nil
+}
+
+func (x *Message) GetOnarWrite() *OnarWrite {
+	if x != nil {
+		return x.OnarWrite
+	}
+	return nil
+}
+
+func (x *Message) GetOnarWriteReturn() *OnarWriteReturn {
+	if x != nil {
+		return x.OnarWriteReturn
+	}
+	return nil
+}
+
+func (x *Message) GetAppLogAppend() *AppLogAppend {
+	if x != nil {
+		return x.AppLogAppend
+	}
+	return nil
+}
+
+func (x *Message) GetAppLogAppended() *AppLogAppended {
+	if x != nil {
+		return x.AppLogAppended
+	}
+	return nil
+}
+
+func (x *Message) GetAppLogCommitted() *AppLogCommitted {
+	if x != nil {
+		return x.AppLogCommitted
+	}
+	return nil
+}
+
+func (x *Message) GetLogAppend() *LogAppend {
+	if x != nil {
+		return x.LogAppend
+	}
+	return nil
+}
+
+func (x *Message) GetLogAppended() *LogAppended {
+	if x != nil {
+		return x.LogAppended
+	}
+	return nil
+}
+
+func (x *Message) GetLogCommitted() *LogCommitted {
+	if x != nil {
+		return x.LogCommitted
+	}
+	return nil
+}
+
+func (x *Message) GetLogInternalForward() *LogInternalForward {
+	if x != nil {
+		return x.LogInternalForward
+	}
+	return nil
+}
+
+func (x *Message) GetLogInternalPrepare() *LogInternalPrepare {
+	if x != nil {
+		return x.LogInternalPrepare
+	}
+	return nil
+}
+
+func (x *Message) GetLogInternalPromise() *LogInternalPromise {
+	if x != nil {
+		return x.LogInternalPromise
+	}
+	return nil
+}
+
+func (x *Message) GetLogInternalAccept() *LogInternalAccept {
+	if x != nil {
+		return x.LogInternalAccept
+	}
+	return nil
+}
+
+func (x *Message) GetLogInternalAccepted() *LogInternalAccepted {
+	if x != nil {
+		return x.LogInternalAccepted
+	}
+	return nil
+}
+
+func (x *Message) GetLogInternalCommit() *LogInternalCommit {
+	if x != nil {
+		return x.LogInternalCommit
+	}
+	return nil
+}
+
+func (x *Message) GetRaftTimeout() *RaftTimeout {
+	if x != nil {
+		return x.RaftTimeout
+	}
+	return nil
+}
+
+func (x *Message) GetRaftInternalRequestVote() *RaftInternalRequestVote {
+	if x != nil {
+		return x.RaftInternalRequestVote
+	}
+	return nil
+}
+
+func (x *Message) GetRaftInternalVote() *RaftInternalVote {
+	if x != nil {
+		return x.RaftInternalVote
+	}
+	return nil
+}
+
+func (x *Message) GetRaftInternalAppendEntries() *RaftInternalAppendEntries {
+	if x != nil {
+		return x.RaftInternalAppendEntries
+	}
+	return nil
+}
+
+func (x *Message) GetRaftInternalAppendResult() *RaftInternalAppendResult {
+	if x != nil {
+		return x.RaftInternalAppendResult
+	}
+	return nil
+}
+
+func (x *Message) GetRaftInternalForward() *RaftInternalForward {
+	if x != nil {
+		return x.RaftInternalForward
+	}
+	return nil
+}
+
+func (x *Message) GetAppRcPropose() *AppRcPropose {
+	if x != nil {
+		return x.AppRcPropose
+	}
+	return nil
+}
+
+func (x *Message) GetAppRcDecide() *AppRcDecide {
+	if x != nil {
+		return x.AppRcDecide
+	}
+	return nil
+}
+
+func (x *Message) GetRcInternalProposal() *RcInternalProposal {
+	if x != nil {
+		return x.RcInternalProposal
+	}
+	return nil
+}
+
+func (x *Message) GetBcInternalPhase() *BcInternalPhase {
+	if x != nil {
+		return x.BcInternalPhase
+	}
+	return nil
+}
+
+func (x *Message) GetBcInternalDecided() *BcInternalDecided {
+	if x != nil {
+		return x.BcInternalDecided
+	}
+	return nil
+}
+
+func (x *Message) GetFloodingInternalProposal() *FloodingInternalProposal {
+	if x != nil {
+		return x.FloodingInternalProposal
+	}
+	return nil
+}
+
+func (x *Message) GetFloodingInternalDecided() *FloodingInternalDecided {
+	if x != nil {
+		return x.FloodingInternalDecided
+	}
+	return nil
+}
+
+func (x *Message) GetHierarchicalInternalProposal() *HierarchicalInternalProposal {
+	if x != nil {
+		return x.HierarchicalInternalProposal
+	}
+	return nil
+}
+
+func (x *Message) GetHierarchicalInternalAck() *HierarchicalInternalAck {
+	if x != nil {
+		return x.HierarchicalInternalAck
+	}
+	return nil
+}
+
+func (x *Message) GetHierarchicalInternalDecided() *HierarchicalInternalDecided {
+	if x != nil {
+		return x.HierarchicalInternalDecided
+	}
+	return nil
+}
+
+func (x *Message) GetAppBcbBroadcast() *AppBcbBroadcast {
+	if x != nil {
+		return x.AppBcbBroadcast
+	}
+	return nil
+}
+
+func (x *Message) GetAppBrbBroadcast() *AppBrbBroadcast {
+	if x != nil {
+		return x.AppBrbBroadcast
+	}
+	return nil
+}
+
+func (x *Message) GetBcbBroadcast() *BcbBroadcast {
+	if x != nil {
+		return x.BcbBroadcast
+	}
+	return nil
+}
+
+func (x *Message) GetBcbDeliver() *BcbDeliver {
+	if x != nil {
+		return x.BcbDeliver
+	}
+	return nil
+}
+
+func (x *Message) GetBrbBroadcast() *BrbBroadcast {
+	if x != nil {
+		return x.BrbBroadcast
+	}
+	return nil
+}
+
+func (x *Message) GetBrbDeliver() *BrbDeliver {
+	if x != nil {
+		return x.BrbDeliver
+	}
+	return nil
+}
+
+func (x *Message) GetByzantineInternalSend() *ByzantineInternalSend {
+	if x != nil {
+		return x.ByzantineInternalSend
+	}
+	return nil
+}
+
+func (x *Message) GetByzantineInternalEcho() *ByzantineInternalEcho {
+	if x != nil {
+		return x.ByzantineInternalEcho
+	}
+	return nil
+}
+
+func (x *Message) GetByzantineInternalReady() *ByzantineInternalReady {
+	if x != nil {
+		return x.ByzantineInternalReady
+	}
+	return nil
+}
+
+var File_messages_proto protoreflect.FileDescriptor
+
+var file_messages_proto_rawDesc = []byte{
+	0x0a, 0x0e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x12, 0x02, 0x70, 0x62, 0x22, 0x73, 0x0a, 0x09, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x49,
+	0x64, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x68, 0x6f, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x77, 0x6e,
+	0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12,
+	0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05,
+	0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x22, 0xc0, 0x01, 0x0a, 0x05, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x65, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x65, 0x64, 0x12, 0x0c, 0x0a,
+	0x01, 0x76, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x01, 0x76, 0x12, 0x21, 0x0a, 0x04, 0x74,
+	0x79, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0d, 0x2e, 0x70, 0x62, 0x2e, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1e,
+	0x0a, 0x0a, 0x62, 0x79, 0x74, 0x65, 0x73, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x0a, 0x62, 0x79, 0x74, 0x65, 0x73, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x20,
+	0x0a, 0x0b, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x12, 0x1e, 0x0a, 0x0a, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x4a, 0x04, 0x08, 0x03, 0x10, 0x04, 0x4a, 0x04, 0x08, 0x04, 0x10, 0x05, 0x22, 0x3e, 0x0a, 0x10,
+	0x50, 0x72, 0x6f, 0x63, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x14, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x22, 0xc4, 0x01, 0x0a,
+	0x14, 0x50, 0x72, 0x6f, 0x63, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x53,
+	0x79, 0x73, 0x74, 0x65, 0x6d, 0x12, 0x2b, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73,
+	0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x72,
+	0x6f, 0x63, 0x65, 0x73, 0x73, 0x49, 0x64, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73,
+	0x65, 0x73, 0x12, 0x42, 0x0a, 0x08, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x49, 0x6e,
+	0x69, 0x74, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2e, 0x53,
+	0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x08, 0x73, 0x65,
+	0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x1a, 0x3b, 0x0a, 0x0d, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e,
+	0x67, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
+	0x02, 0x38, 0x01, 0x22, 0x13, 0x0a, 0x11, 0x50, 0x72, 0x6f, 0x63, 0x44, 0x65, 0x73, 0x74, 0x72,
+	0x6f, 0x79, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x22, 0xf1, 0x01, 0x0a, 0x15, 0x50, 0x72, 0x6f,
+	0x63, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x65, 0x53, 0x79, 0x73, 0x74,
+	0x65, 0x6d, 0x12, 0x2b, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x65, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65,
+	0x73, 0x73, 0x49, 0x64, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x65, 0x73, 0x12,
+	0x29, 0x0a, 0x08, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x0d, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x49, 0x64,
+	0x52, 0x08, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x12, 0x43, 0x0a, 0x08, 0x73, 0x65,
+	0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x70,
+	0x62, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72,
+	0x65, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2e, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x08, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x1a,
+	0x3b, 0x0a, 0x0d, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
+	0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x2f, 0x0a, 0x0c,
+	0x41, 0x70, 0x70, 0x42, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x70, 0x62,
+	0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x37, 0x0a,
+	0x14, 0x41, 0x70, 0x70, 0x52, 0x65, 0x6c, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x42, 0x72, 0x6f, 0x61,
+	0x64, 0x63, 0x61, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x70, 0x62, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x36, 0x0a, 0x13, 0x41, 0x70, 0x70, 0x55, 0x6e, 0x69,
+	0x66, 0x6f, 0x72, 0x6d, 0x42, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x12, 0x1f, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x70,
+	0x62, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x33,
+	0x0a, 0x10, 0x41, 0x70, 0x70, 0x46, 0x69, 0x66, 0x6f, 0x42, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61,
+	0x73, 0x74, 0x12, 0x1f, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x09, 0x2e, 0x70, 0x62, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x22, 0x35, 0x0a, 0x12, 0x41, 0x70, 0x70, 0x43, 0x61, 0x75, 0x73, 0x61, 0x6c,
+	0x42, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x70, 0x62, 0x2e, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x3c, 0x0a, 0x19, 0x41, 0x70,
+	0x70, 0x50, 0x72, 0x6f, 0x62, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x73, 0x74, 0x69, 0x63, 0x42, 0x72,
+	0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x70, 0x62, 0x2e, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x32, 0x0a, 0x0f, 0x41, 0x70, 0x70, 0x42,
+	0x63, 0x62, 0x42, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x70, 0x62, 0x2e,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x32, 0x0a, 0x0f,
+	0x41, 0x70, 0x70, 0x42, 0x72, 0x62, 0x42, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x12,
+	0x1f, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09,
+	0x2e, 0x70, 0x62, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x22, 0x32, 0x0a, 0x0f, 0x41, 0x70, 0x70, 0x54, 0x6f, 0x62, 0x42, 0x72, 0x6f, 0x61, 0x64, 0x63,
+	0x61, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x09, 0x2e, 0x70, 0x62, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x22, 0x7f, 0x0a, 0x0d, 0x41, 0x70, 0x70, 0x54, 0x6f, 0x62, 0x44, 0x65,
+	0x6c, 0x69, 0x76, 0x65, 0x72, 0x12, 0x26, 0x0a, 0x0e, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63,
+	0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x73,
+	0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x25, 0x0a,
+	0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e,
+	0x70, 0x62, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x49, 0x64, 0x52, 0x06, 0x73, 0x65,
+	0x6e, 0x64, 0x65, 0x72, 0x12, 0x1f, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x70, 0x62, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x66, 0x0a, 0x06, 0x41, 0x70, 0x70, 0x54, 0x72, 0x62, 0x12,
+	0x14, 0x0a, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x74, 0x6f, 0x70, 0x69, 0x63, 0x12, 0x25, 0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65,
+	0x73, 0x73, 0x49, 0x64, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x1f, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x70, 0x62,
+	0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x85, 0x01,
+	0x0a, 0x0d, 0x41, 0x70, 0x70, 0x54, 0x72, 0x62, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x12,
+	0x14, 0x0a, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x74, 0x6f, 0x70, 0x69, 0x63, 0x12, 0x25, 0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65,
+	0x73, 0x73, 0x49, 0x64, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x1f, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x70, 0x62,
+	0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x16, 0x0a,
+	0x06, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x66,
+	0x61, 0x69, 0x6c, 0x65, 0x64, 0x22, 0x3e, 0x0a, 0x0e, 0x41, 0x70, 0x70, 0x4e, 0x62, 0x61, 0x63,
+	0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x12, 0x16, 0x0a,
+	0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x63,
+	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x22, 0x3d, 0x0a, 0x0d, 0x41, 0x70, 0x70, 0x4e, 0x62, 0x61, 0x63,
+	0x44, 0x65, 0x63, 0x69, 0x64, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x12, 0x16, 0x0a, 0x06,
+	0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x63, 0x6f,
+	0x6d, 0x6d, 0x69, 0x74, 0x22, 0x86, 0x01, 0x0a, 0x09, 0x41, 0x70, 0x70, 0x43, 0x6f, 0x6d, 0x6d,
+	0x69, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x12, 0x14,
+	0x0a, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74,
+	0x6f, 0x70, 0x69, 0x63, 0x12, 0x2f, 0x0a, 0x0b, 0x63, 0x6f, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x61,
+	0x74, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x70, 0x62, 0x2e, 0x50,
+	0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x49, 0x64, 0x52, 0x0b, 0x63, 0x6f, 0x6f, 0x72, 0x64, 0x69,
+	0x6e, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x22, 0x96, 0x01,
+	0x0a, 0x10, 0x41, 0x70, 0x70, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x4f, 0x75, 0x74, 0x63, 0x6f,
+	0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x12, 0x14,
+	0x0a, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74,
+	0x6f, 0x70, 0x69, 0x63, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74,
+	0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x07, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07,
+	0x6c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x6c,
+	0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x22, 0x31, 0x0a, 0x0e, 0x41, 0x70, 0x70, 0x56, 0x73, 0x42,
+	0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x70, 0x62, 0x2e, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x6e, 0x0a, 0x0c, 0x41, 0x70, 0x70,
+	0x56, 0x73, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x12, 0x25, 0x0a, 0x06, 0x73, 0x65, 0x6e,
+	0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x70, 0x62, 0x2e, 0x50,
+	0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x49, 0x64, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72,
+	0x12, 0x1f, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x09, 0x2e, 0x70, 0x62, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x69, 0x65, 0x77, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x06, 0x76, 0x69, 0x65, 0x77, 0x49, 0x64, 0x22, 0x5a, 0x0a, 0x07, 0x41, 0x70, 0x70,
+	0x56, 0x69, 0x65, 0x77, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x27, 0x0a, 0x07,
+	0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e,
+	0x70, 0x62, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x49, 0x64, 0x52, 0x07, 0x6d, 0x65,
+	0x6d, 0x62, 0x65, 0x72, 0x73, 0x22, 0x2f, 0x0a, 0x0c, 0x41, 0x70, 0x70, 0x4c, 0x6f, 0x67, 0x41,
+	0x70, 0x70, 0x65, 0x6e, 0x64, 0x12, 0x1f, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x70, 0x62, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x45, 0x0a, 0x0e, 0x41, 0x70, 0x70, 0x4c, 0x6f, 0x67,
+	0x41, 0x70, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x6c, 0x6f, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x12, 0x1f, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x70, 0x62,
+	0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x46, 0x0a,
+	0x0f, 0x41, 0x70, 0x70, 0x4c, 0x6f, 0x67, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x64,
+	0x12, 0x12, 0x0a, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04,
+	0x73, 0x6c, 0x6f, 0x74, 0x12, 0x1f, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x70, 0x62, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x2b, 0x0a, 0x08, 0x41, 0x70, 0x70, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x12, 0x1f, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x09, 0x2e, 0x70, 0x62, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x22, 0x43, 0x0a, 0x0a, 0x41, 0x70, 0x70, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65,
+	0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x12, 0x1f, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x70, 0x62, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x2c, 0x0a, 0x09, 0x41, 0x70, 0x70, 0x44, 0x65,
+	0x63, 0x69, 0x64, 0x65, 0x12, 0x1f, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x70, 0x62, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x4b, 0x0a, 0x07, 0x41, 0x70, 0x70, 0x52, 0x65, 0x61, 0x64,
+	0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x12, 0x24, 0x0a, 0x04,
+	0x6b, 0x69, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x70, 0x62, 0x2e,
+	0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x4b, 0x69, 0x6e, 0x64, 0x52, 0x04, 0x6b, 0x69,
+	0x6e, 0x64, 0x22, 0x6d, 0x0a, 0x08, 0x41, 0x70, 0x70, 0x57, 0x72, 0x69, 0x74, 0x65, 0x12, 0x1a,
+	0x0a, 0x08, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x12, 0x1f, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x70, 0x62, 0x2e, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x24, 0x0a, 0x04, 0x6b,
+	0x69, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x70, 0x62, 0x2e, 0x52,
+	0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x4b, 0x69, 0x6e, 0x64, 0x52, 0x04, 0x6b, 0x69, 0x6e,
+	0x64, 0x22, 0x72, 0x0a, 0x0d, 0x41, 0x70, 0x70, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x74, 0x75,
+	0x72, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x12, 0x1f,
+	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e,
+	0x70, 0x62, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12,
+	0x24, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e,
+	0x70, 0x62, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x4b, 0x69, 0x6e, 0x64, 0x52,
+	0x04, 0x6b, 0x69, 0x6e, 0x64, 0x22, 0x68, 0x0a, 0x0e, 0x41, 0x70, 0x70, 0x57, 0x72, 0x69, 0x74,
+	0x65, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x67, 0x69, 0x73,
+	0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x67, 0x69, 0x73,
+	0x74, 0x65, 0x72, 0x12, 0x24, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x10, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x4b,
+	0x69, 0x6e, 0x64, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22,
+	0x2c, 0x0a, 0x09, 0x55, 0x63, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x70, 0x62,
+	0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x2b, 0x0a,
+	0x08, 0x55, 0x63, 0x44, 0x65, 0x63, 0x69, 0x64, 0x65, 0x12, 0x1f, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x70, 0x62, 0x2e, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x09, 0x0a, 0x07, 0x45, 0x70,
+	0x41, 0x62, 0x6f, 0x72, 0x74, 0x22, 0x66, 0x0a, 0x09, 0x45, 0x70, 0x41, 0x62, 0x6f, 0x72, 0x74,
+	0x65, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x65, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x03, 0x65, 0x74, 0x73, 0x12, 0x26, 0x0a, 0x0e, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x1f, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x70, 0x62,
+	0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x2c, 0x0a,
+	0x09, 0x45, 0x70, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x70, 0x62, 0x2e, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x3d, 0x0a, 0x08, 0x45,
+	0x70, 0x44, 0x65, 0x63, 0x69, 0x64, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x65, 0x74, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x65, 0x74, 0x73, 0x12, 0x1f, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x70, 0x62, 0x2e, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x10, 0x0a, 0x0e, 0x45, 0x70,
+	0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x52, 0x65, 0x61, 0x64, 0x22, 0x5a, 0x0a, 0x0f,
+	0x45, 0x70, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12,
+	0x26, 0x0a, 0x0e, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x1f, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x70, 0x62, 0x2e, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x32, 0x0a, 0x0f, 0x45, 0x70, 0x49, 0x6e,
+	0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x57, 0x72, 0x69, 0x74, 0x65, 0x12, 0x1f, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x70, 0x62, 0x2e,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x12, 0x0a, 0x10,
+	0x45, 0x70, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74,
+	0x22, 0x34, 0x0a, 0x11, 0x45, 0x70, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x44, 0x65,
+	0x63, 0x69, 0x64, 0x65, 0x64, 0x12, 0x1f, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x70, 0x62, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x2e, 0x0a, 0x0e, 0x45, 0x63, 0x49, 0x6e, 0x74, 0x65,
+	0x72, 0x6e, 0x61, 0x6c, 0x4e, 0x61, 0x63, 0x6b, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x74, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0x5f, 0x0a, 0x0c, 0x45, 0x63, 0x53, 0x74, 0x61, 0x72,
+	0x74, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x22, 0x0a, 0x0c, 0x6e, 0x65, 0x77, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x6e, 0x65,
+	0x77, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x2b, 0x0a, 0x09, 0x6e, 0x65,
+	0x77, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e,
+	0x70, 0x62, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x49, 0x64, 0x52, 0x09, 0x6e, 0x65,
+	0x77, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x22, 0x32, 0x0a, 0x12, 0x45, 0x63, 0x49, 0x6e, 0x74,
+	0x65, 0x72, 0x6e, 0x61, 0x6c, 0x4e, 0x65, 0x77, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x1c, 0x0a,
+	0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0x35, 0x0a, 0x0c, 0x42,
+	0x65, 0x62, 0x42, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70,
+	0x62, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x22, 0x5a, 0x0a, 0x0a, 0x42, 0x65, 0x62, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72,
+	0x12, 0x25, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x62, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x07,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x25, 0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65,
+	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x72, 0x6f,
+	0x63, 0x65, 0x73, 0x73, 0x49, 0x64, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x22, 0x34,
+	0x0a, 0x0b, 0x52, 0x62, 0x42, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x12, 0x25, 0x0a,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b,
+	0x2e, 0x70, 0x62, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x07, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x22, 0x59, 0x0a, 0x09, 0x52, 0x62, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65,
+	0x72, 0x12, 0x25, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x62, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x25, 0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64,
+	0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x72,
+	0x6f, 0x63, 0x65, 0x73, 0x73, 0x49, 0x64, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x22,
+	0x7c, 0x0a, 0x0e, 0x52, 0x62, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x44, 0x61, 0x74,
+	0x61, 0x12, 0x1c, 0x0a, 0x09, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x49, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x49, 0x64, 0x12,
+	0x25, 0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0d, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x49, 0x64, 0x52, 0x06,
+	0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x25, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x62, 0x2e, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x35, 0x0a,
+	0x0c, 0x55, 0x72, 0x62, 0x42, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x12, 0x25, 0x0a,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b,
+	0x2e, 0x70, 0x62, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x07, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x22, 0x5a, 0x0a, 0x0a, 0x55, 0x72, 0x62, 0x44, 0x65, 0x6c, 0x69, 0x76,
+	0x65, 0x72, 0x12, 0x25, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x62, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x25, 0x0a, 0x06, 0x73, 0x65, 0x6e,
+	0x64, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x70, 0x62, 0x2e, 0x50,
+	0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x49, 0x64, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72,
+	0x22, 0x7d, 0x0a, 0x0f, 0x55, 0x72, 0x62, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x44,
+	0x61, 0x74, 0x61, 0x12, 0x1c, 0x0a, 0x09, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x49, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x49,
+	0x64, 0x12, 0x25, 0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0d, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x49, 0x64,
+	0x52, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x25, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x62, 0x2e, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22,
+	0x35, 0x0a, 0x0c, 0x46, 0x72, 0x62, 0x42, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x12,
+	0x25, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0b, 0x2e, 0x70, 0x62, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x5a, 0x0a, 0x0a, 0x46, 0x72, 0x62, 0x44, 0x65, 0x6c,
+	0x69, 0x76, 0x65, 0x72, 0x12, 0x25, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x62, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x25, 0x0a, 0x06, 0x73,
+	0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x70, 0x62,
+	0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x49, 0x64, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x64,
+	0x65, 0x72, 0x22, 0x60, 0x0a, 0x0f, 0x46, 0x72, 0x62, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61,
+	0x6c, 0x44, 0x61, 0x74, 0x61, 0x12, 0x26, 0x0a, 0x0e, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63,
+	0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x73,
+	0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x25, 0x0a,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b,
+	0x2e, 0x70, 0x62, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x07, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x22, 0x35, 0x0a, 0x0c, 0x43, 0x72, 0x62, 0x42, 0x72, 0x6f, 0x61, 0x64,
+	0x63, 0x61, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x62, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x5a, 0x0a, 0x0a, 0x43,
+	0x72, 0x62, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x12, 0x25, 0x0a, 0x07, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x62, 0x2e,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x12, 0x25, 0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0d, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x49, 0x64, 0x52,
+	0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x22, 0x7a, 0x0a, 0x0c, 0x43, 0x72, 0x62, 0x50, 0x61,
+	0x73, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65,
+	0x73, 0x73, 0x49, 0x64, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x25, 0x0a, 0x07,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e,
+	0x70, 0x62, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x22, 0x9e, 0x01, 0x0a, 0x0f, 0x43, 0x72, 0x62, 0x49, 0x6e, 0x74, 0x65, 0x72,
+	0x6e, 0x61, 0x6c, 0x44, 0x61, 0x74, 0x61, 0x12, 0x1c, 0x0a, 0x09, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x49, 0x64, 0x12, 0x24, 0x0a, 0x04, 0x70, 0x61, 0x73, 0x74, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x62, 0x50, 0x61, 0x73, 0x74,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x04, 0x70, 0x61, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x76,
+	0x65, 0x63, 0x74, 0x6f, 0x72, 0x43, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x03, 0x20, 0x03, 0x28, 0x05,
+	0x52, 0x0b, 0x76, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x43, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x25, 0x0a,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b,
+	0x2e, 0x70, 0x62, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x07, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x22, 0x34, 0x0a, 0x0b, 0x50, 0x62, 0x42, 0x72, 0x6f, 0x61, 0x64, 0x63,
+	0x61, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x62, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x59, 0x0a, 0x09, 0x50, 0x62,
+	0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x12, 0x25, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x62, 0x2e, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x25,
+	0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d,
+	0x2e, 0x70, 0x62, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x49, 0x64, 0x52, 0x06, 0x73,
+	0x65, 0x6e, 0x64, 0x65, 0x72, 0x22, 0xa0, 0x01, 0x0a, 0x10, 0x50, 0x62, 0x49, 0x6e, 0x74, 0x65,
+	0x72, 0x6e, 0x61, 0x6c, 0x47, 0x6f, 0x73, 0x73, 0x69, 0x70, 0x12, 0x25, 0x0a, 0x06, 0x73, 0x65,
+	0x6e, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x70, 0x62, 0x2e,
+	0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x49, 0x64, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65,
+	0x72, 0x12, 0x26, 0x0a, 0x0e, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x4e, 0x75, 0x6d,
+	0x62, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x73, 0x65, 0x71, 0x75, 0x65,
+	0x6e, 0x63, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x6f, 0x75,
+	0x6e, 0x64, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x72, 0x6f, 0x75, 0x6e, 0x64,
+	0x73, 0x12, 0x25, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x62, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0xa7, 0x01, 0x0a, 0x11, 0x50, 0x62, 0x49,
+	0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2b,
+	0x0a, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0d, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x49, 0x64,
+	0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x72, 0x12, 0x25, 0x0a, 0x06, 0x73,
+	0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x70, 0x62,
+	0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x49, 0x64, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x64,
+	0x65, 0x72, 0x12, 0x26, 0x0a, 0x0e, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x4e, 0x75,
+	0x6d, 0x62, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x73, 0x65, 0x71, 0x75,
+	0x65, 0x6e, 0x63, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x6f,
+	0x75, 0x6e, 0x64, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x72, 0x6f, 0x75, 0x6e,
+	0x64, 0x73, 0x22, 0x86, 0x01, 0x0a, 0x0e, 0x50, 0x62, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61,
+	0x6c, 0x44, 0x61, 0x74, 0x61, 0x12, 0x25, 0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65,
+	0x73, 0x73, 0x49, 0x64, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x26, 0x0a, 0x0e,
+	0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x4e, 0x75,
+	0x6d, 0x62, 0x65, 0x72, 0x12, 0x25, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x62, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x5a, 0x0a, 0x09, 0x50,
+	0x62, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x25, 0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64,
+	0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x72,
+	0x6f, 0x63, 0x65, 0x73, 0x73, 0x49, 0x64, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12,
+	0x26, 0x0a, 0x0e, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65,
+	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63,
+	0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x22, 0x35, 0x0a, 0x0c, 0x54, 0x6f, 0x62, 0x42, 0x72,
+	0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x62, 0x2e, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x82,
+	0x01, 0x0a, 0x0a, 0x54, 0x6f, 0x62, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x12, 0x25, 0x0a,
+	0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e,
+	0x70, 0x62, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x49, 0x64, 0x52, 0x06, 0x73, 0x65,
+	0x6e, 0x64, 0x65, 0x72, 0x12, 0x25, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x62, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x26, 0x0a, 0x0e, 0x73,
+	0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0e, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x4e, 0x75, 0x6d,
+	0x62, 0x65, 0x72, 0x22, 0x56, 0x0a, 0x0f, 0x54, 0x6f, 0x62, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e,
+	0x61, 0x6c, 0x44, 0x61, 0x74, 0x61, 0x12, 0x1c, 0x0a, 0x09, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x62, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x76, 0x0a, 0x08, 0x54,
+	0x6f, 0x62, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65,
+	0x73, 0x73, 0x49, 0x64, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x25, 0x0a, 0x07,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e,
+	0x70, 0x62, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x22, 0x32, 0x0a, 0x08, 0x54, 0x6f, 0x62, 0x42, 0x61, 0x74, 0x63, 0x68, 0x12,
+	0x26, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x54, 0x6f, 0x62, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07,
+	0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x22, 0x5c, 0x0a, 0x0c, 0x54, 0x72, 0x62, 0x42, 0x72,
+	0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65,
+	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x72, 0x6f,
+	0x63, 0x65, 0x73, 0x73, 0x49, 0x64, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x25,
+	0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0b, 0x2e, 0x70, 0x62, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x07, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x72, 0x0a, 0x0a, 0x54, 0x72, 0x62, 0x44, 0x65, 0x6c, 0x69,
+	0x76, 0x65, 0x72, 0x12, 0x25, 0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73,
+	0x49, 0x64, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x25, 0x0a, 0x07, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x62,
+	0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x06, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x22, 0x38, 0x0a, 0x0f, 0x54, 0x72, 0x62,
+	0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x44, 0x61, 0x74, 0x61, 0x12, 0x25, 0x0a, 0x07,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e,
+	0x70, 0x62, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x22, 0x73, 0x0a, 0x0b, 0x54, 0x72, 0x62, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73,
+	0x61, 0x6c, 0x12, 0x25, 0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x49,
+	0x64, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x25, 0x0a, 0x07, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x62, 0x2e,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x12, 0x16, 0x0a, 0x06, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x06, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x22, 0x25, 0x0a, 0x0b, 0x4e, 0x62, 0x61, 0x63,
+	0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x69,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x22,
+	0x24, 0x0a, 0x0a, 0x4e, 0x62, 0x61, 0x63, 0x44, 0x65, 0x63, 0x69, 0x64, 0x65, 0x12, 0x16, 0x0a,
+	0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x63,
+	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x22, 0x2a, 0x0a, 0x10, 0x4e, 0x62, 0x61, 0x63, 0x49, 0x6e, 0x74,
+	0x65, 0x72, 0x6e, 0x61, 0x6c, 0x56, 0x6f, 0x74, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x6f, 0x6d,
+	0x6d, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x69,
+	0x74, 0x22, 0x56, 0x0a, 0x0b, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x53, 0x74, 0x61, 0x72, 0x74,
+	0x12, 0x2f, 0x0a, 0x0b, 0x63, 0x6f, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x74, 0x6f, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65,
+	0x73, 0x73, 0x49, 0x64, 0x52, 0x0b, 0x63, 0x6f, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x74, 0x6f,
+	0x72, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x22, 0x61, 0x0a, 0x0d, 0x43, 0x6f, 0x6d,
+	0x6d, 0x69, 0x74, 0x4f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f,
+	0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x63,
+	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x6c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x07, 0x6c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x22, 0x17, 0x0a, 0x15,
+	0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x50, 0x72,
+	0x65, 0x70, 0x61, 0x72, 0x65, 0x22, 0x2c, 0x0a, 0x12, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x49,
+	0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x56, 0x6f, 0x74, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x63,
+	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x63, 0x6f, 0x6d,
+	0x6d, 0x69, 0x74, 0x22, 0x19, 0x0a, 0x17, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x49, 0x6e, 0x74,
+	0x65, 0x72, 0x6e, 0x61, 0x6c, 0x50, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x22, 0x13,
+	0x0a, 0x11, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c,
+	0x41, 0x63, 0x6b, 0x22, 0x30, 0x0a, 0x16, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x49, 0x6e, 0x74,
+	0x65, 0x72, 0x6e, 0x61, 0x6c, 0x44, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a,
+	0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x63,
+	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x22, 0x6b, 0x0a, 0x13, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x49,
+	0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x22, 0x0a, 0x0c,
+	0x70, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0c, 0x70, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x64,
+	0x12, 0x18, 0x0a, 0x07, 0x64, 0x65, 0x63, 0x69, 0x64, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x07, 0x64, 0x65, 0x63, 0x69, 0x64, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x6f,
+	0x6d, 0x6d, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x63, 0x6f, 0x6d, 0x6d,
+	0x69, 0x74, 0x22, 0x41, 0x0a, 0x06, 0x47, 0x6d, 0x56, 0x69, 0x65, 0x77, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x27, 0x0a, 0x07,
+	0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e,
+	0x70, 0x62, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x49, 0x64, 0x52, 0x07, 0x6d, 0x65,
+	0x6d, 0x62, 0x65, 0x72, 0x73, 0x22, 0x38, 0x0a, 0x0d, 0x47, 0x6d, 0x52, 0x65, 0x63, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x75, 0x72, 0x65, 0x12, 0x27, 0x0a, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x72, 0x6f,
+	0x63, 0x65, 0x73, 0x73, 0x49, 0x64, 0x52, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x22,
+	0x34, 0x0a, 0x0b, 0x56, 0x73, 0x42, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x12, 0x25,
+	0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0b, 0x2e, 0x70, 0x62, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x07, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x71, 0x0a, 0x09, 0x56, 0x73, 0x44, 0x65, 0x6c, 0x69, 0x76,
+	0x65, 0x72, 0x12, 0x25, 0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x49,
+	0x64, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x25, 0x0a, 0x07, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x62, 0x2e,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x12, 0x16, 0x0a, 0x06, 0x76, 0x69, 0x65, 0x77, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x06, 0x76, 0x69, 0x65, 0x77, 0x49, 0x64, 0x22, 0x41, 0x0a, 0x06, 0x56, 0x73, 0x56, 0x69,
+	0x65, 0x77, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x27, 0x0a, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73,
+	0x49, 0x64, 0x52, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x22, 0x6d, 0x0a, 0x0e, 0x56,
+	0x73, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x44, 0x61, 0x74, 0x61, 0x12, 0x16, 0x0a,
+	0x06, 0x76, 0x69, 0x65, 0x77, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x76,
+	0x69, 0x65, 0x77, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x62, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x57, 0x0a, 0x11, 0x56, 0x73,
+	0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x12,
+	0x16, 0x0a, 0x06, 0x76, 0x69, 0x65, 0x77, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x06, 0x76, 0x69, 0x65, 0x77, 0x49, 0x64, 0x12, 0x2a, 0x0a, 0x09, 0x64, 0x65, 0x6c, 0x69, 0x76,
+	0x65, 0x72, 0x65, 0x64, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x62, 0x2e,
+	0x54, 0x6f, 0x62, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x09, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65,
+	0x72, 0x65, 0x64, 0x22, 0x58, 0x0a, 0x0a, 0x56, 0x73, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61,
+	0x6c, 0x12, 0x1e, 0x0a, 0x04, 0x76, 0x69, 0x65, 0x77, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0a, 0x2e, 0x70, 0x62, 0x2e, 0x56, 0x73, 0x56, 0x69, 0x65, 0x77, 0x52, 0x04, 0x76, 0x69, 0x65,
+	0x77, 0x12, 0x2a, 0x0a, 0x09, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x65, 0x64, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x54, 0x6f, 0x62, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x52, 0x09, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x65, 0x64, 0x22, 0x7c, 0x0a,
+	0x09, 0x4e, 0x6e, 0x61, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x1c, 0x0a, 0x09,
+	0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x1e, 0x0a, 0x0a, 0x77, 0x72,
+	0x69, 0x74, 0x65, 0x72, 0x52, 0x61, 0x6e, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a,
+	0x77, 0x72, 0x69, 0x74, 0x65, 0x72, 0x52, 0x61, 0x6e, 0x6b, 0x12, 0x1f, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x70, 0x62, 0x2e, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x7e, 0x0a, 0x13, 0x52,
+	0x65, 0x63, 0x6f, 0x6e, 0x66, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x53, 0x74, 0x61,
+	0x74, 0x65, 0x12, 0x1e, 0x0a, 0x04, 0x76, 0x69, 0x65, 0x77, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0a, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x6d, 0x56, 0x69, 0x65, 0x77, 0x52, 0x04, 0x76, 0x69,
+	0x65, 0x77, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x12, 0x2b,
+	0x0a, 0x09, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x0d, 0x2e, 0x70, 0x62, 0x2e, 0x4e, 0x6e, 0x61, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65,
+	0x52, 0x09, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x73, 0x22, 0x0c, 0x0a, 0x0a, 0x45,
+	0x6c, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x22, 0x33, 0x0a, 0x08, 0x45, 0x6c, 0x64,
+	0x54, 0x72, 0x75, 0x73, 0x74, 0x12, 0x27, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x72, 0x6f, 0x63,
+	0x65, 0x73, 0x73, 0x49, 0x64, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x22, 0x0a,
+	0x0a, 0x08, 0x4e, 0x6e, 0x61, 0x72, 0x52, 0x65, 0x61, 0x64, 0x22, 0x42, 0x0a, 0x10, 0x4e, 0x6e,
+	0x61, 0x72, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x52, 0x65, 0x61, 0x64, 0x12, 0x16,
+	0x0a, 0x06, 0x72, 0x65, 0x61, 0x64, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06,
+	0x72, 0x65, 0x61, 0x64, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x69, 0x65, 0x77, 0x49, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x76, 0x69, 0x65, 0x77, 0x49, 0x64, 0x22, 0xa2,
+	0x01, 0x0a, 0x11, 0x4e, 0x6e, 0x61, 0x72, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x64, 0x49, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x72, 0x65, 0x61, 0x64, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09,
+	0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x1e, 0x0a, 0x0a, 0x77, 0x72,
+	0x69, 0x74, 0x65, 0x72, 0x52, 0x61, 0x6e, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a,
+	0x77, 0x72, 0x69, 0x74, 0x65, 0x72, 0x52, 0x61, 0x6e, 0x6b, 0x12, 0x1f, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x70, 0x62, 0x2e, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x76,
+	0x69, 0x65, 0x77, 0x49, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x76, 0x69, 0x65,
+	0x77, 0x49, 0x64, 0x22, 0xa2, 0x01, 0x0a, 0x11, 0x4e, 0x6e, 0x61, 0x72, 0x49, 0x6e, 0x74, 0x65,
+	0x72, 0x6e, 0x61, 0x6c, 0x57, 0x72, 0x69, 0x74, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61,
+	0x64, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x72, 0x65, 0x61, 0x64, 0x49,
+	0x64, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12,
+	0x1e, 0x0a, 0x0a, 0x77, 0x72, 0x69, 0x74, 0x65, 0x72, 0x52, 0x61, 0x6e, 0x6b, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0a, 0x77, 0x72, 0x69, 0x74, 0x65, 0x72, 0x52, 0x61, 0x6e, 0x6b, 0x12,
+	0x1f, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09,
+	0x2e, 0x70, 0x62, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x12, 0x16, 0x0a, 0x06, 0x76, 0x69, 0x65, 0x77, 0x49, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x06, 0x76, 0x69, 0x65, 0x77, 0x49, 0x64, 0x22, 0x2c, 0x0a, 0x09, 0x4e, 0x6e, 0x61, 0x72,
+	0x57, 0x72, 0x69, 0x74, 0x65, 0x12, 0x1f, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x70, 0x62, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x41, 0x0a, 0x0f, 0x4e, 0x6e, 0x61, 0x72, 0x49, 0x6e,
+	0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x41, 0x63, 0x6b, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61,
+	0x64, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x72, 0x65, 0x61, 0x64, 0x49,
+	0x64, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x69, 0x65, 0x77, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x06, 0x76, 0x69, 0x65, 0x77, 0x49, 0x64, 0x22, 0x9f, 0x01, 0x0a, 0x07, 0x4e, 0x6e,
+	0x61, 0x72, 0x4c, 0x6f, 0x67, 0x12, 0x23, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x70, 0x62, 0x2e, 0x4e, 0x6e, 0x61, 0x72, 0x53, 0x74,
+	0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65,
+	0x61, 0x64, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x72, 0x65, 0x61, 0x64,
+	0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65,
+	0x61, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x72, 0x65, 0x61,
+	0x64, 0x69, 0x6e, 0x67, 0x12, 0x25, 0x0a, 0x08, 0x77, 0x72, 0x69, 0x74, 0x65, 0x56, 0x61, 0x6c,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x70, 0x62, 0x2e, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x52, 0x08, 0x77, 0x72, 0x69, 0x74, 0x65, 0x56, 0x61, 0x6c, 0x22, 0x31, 0x0a, 0x0e, 0x4e,
+	0x6e, 0x61, 0x72, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x12, 0x1f, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x70,
+	0x62, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x11,
+	0x0a, 0x0f, 0x4e, 0x6e, 0x61, 0x72, 0x57, 0x72, 0x69, 0x74, 0x65, 0x52, 0x65, 0x74, 0x75, 0x72,
+	0x6e, 0x22, 0x0a, 0x0a, 0x08, 0x4f, 0x6e, 0x72, 0x72, 0x52, 0x65, 0x61, 0x64, 0x22, 0x31, 0x0a,
+	0x0e, 0x4f, 0x6e, 0x72, 0x72, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x12,
+	0x1f, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09,
+	0x2e, 0x70, 0x62, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x22, 0x2c, 0x0a, 0x09, 0x4f, 0x6e, 0x72, 0x72, 0x57, 0x72, 0x69, 0x74, 0x65, 0x12, 0x1f, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x70,
+	0x62, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x11,
+	0x0a, 0x0f, 0x4f, 0x6e, 0x72, 0x72, 0x57, 0x72, 0x69, 0x74, 0x65, 0x52, 0x65, 0x74, 0x75, 0x72,
+	0x6e, 0x22, 0x2a, 0x0a, 0x10, 0x4f, 0x6e, 0x72, 0x72, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61,
+	0x6c, 0x52, 0x65, 0x61, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x64, 0x49, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x72, 0x65, 0x61, 0x64, 0x49, 0x64, 0x22, 0x6a, 0x0a,
+	0x11, 0x4f, 0x6e, 0x72, 0x72, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x64, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x06, 0x72, 0x65, 0x61, 0x64, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x74,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x1f, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x70, 0x62, 0x2e, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x52, 0x0a, 0x11, 0x4f, 0x6e, 0x72,
+	0x72, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x57, 0x72, 0x69, 0x74, 0x65, 0x12, 0x1c,
+	0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x1f, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x70, 0x62,
+	0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x2f, 0x0a,
+	0x0f, 0x4f, 0x6e, 0x72, 0x72, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x41, 0x63, 0x6b,
+	0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0x0a,
+	0x0a, 0x08, 0x4f, 0x6e, 0x61, 0x72, 0x52, 0x65, 0x61, 0x64, 0x22, 0x31, 0x0a, 0x0e, 0x4f, 0x6e,
+	0x61, 0x72, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x12, 0x1f, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x70, 0x62,
+	0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x2c, 0x0a,
+	0x09, 0x4f, 0x6e, 0x61, 0x72, 0x57, 0x72, 0x69, 0x74, 0x65, 0x12, 0x1f, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x70, 0x62, 0x2e, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x27, 0x0a, 0x0f, 0x4f,
+	0x6e, 0x61, 0x72, 0x57, 0x72, 0x69, 0x74, 0x65, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x12, 0x14,
+	0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x22, 0x2c, 0x0a, 0x09, 0x4c, 0x6f, 0x67, 0x41, 0x70, 0x70, 0x65, 0x6e,
+	0x64, 0x12, 0x1f, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x09, 0x2e, 0x70, 0x62, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x22, 0x42, 0x0a, 0x0b, 0x4c, 0x6f, 0x67, 0x41, 0x70, 0x70, 0x65, 0x6e, 0x64, 0x65,
+	0x64, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x04, 0x73, 0x6c, 0x6f, 0x74, 0x12, 0x1f, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x70, 0x62, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x43, 0x0a, 0x0c, 0x4c, 0x6f, 0x67, 0x43, 0x6f, 0x6d,
+	0x6d, 0x69, 0x74, 0x74, 0x65, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x12, 0x1f, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x70, 0x62, 0x2e, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x3b, 0x0a, 0x08, 0x4c,
+	0x6f, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1f, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x70, 0x62, 0x2e, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x77, 0x0a, 0x07, 0x4c, 0x6f, 0x67, 0x53,
+	0x6c, 0x6f, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x12, 0x22, 0x0a, 0x05, 0x65, 0x6e, 0x74, 0x72, 0x79,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x6f, 0x67, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x65,
+	0x70, 0x6f, 0x63, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x65, 0x70, 0x6f, 0x63,
+	0x68, 0x12, 0x1e, 0x0a, 0x0a, 0x6c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x61, 0x6e, 0x6b, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x6c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x61, 0x6e,
+	0x6b, 0x22, 0x3c, 0x0a, 0x12, 0x4c, 0x6f, 0x67, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c,
+	0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x12, 0x26, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69,
+	0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x6f,
+	0x67, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x22,
+	0x5e, 0x0a, 0x12, 0x4c, 0x6f, 0x67, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x50, 0x72,
+	0x65, 0x70, 0x61, 0x72, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x1e, 0x0a, 0x0a, 0x6c,
+	0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x61, 0x6e, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0a, 0x6c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x61, 0x6e, 0x6b, 0x12, 0x12, 0x0a, 0x04, 0x66,
+	0x72, 0x6f, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x22,
+	0x87, 0x01, 0x0a, 0x12, 0x4c, 0x6f, 0x67, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x50,
+	0x72, 0x6f, 0x6d, 0x69, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x1e, 0x0a, 0x0a,
+	0x6c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x61, 0x6e, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x0a, 0x6c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x61, 0x6e, 0x6b, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x65, 0x78, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x6e, 0x65, 0x78, 0x74,
+	0x12, 0x27, 0x0a, 0x08, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x65, 0x64, 0x18, 0x04, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x6f, 0x67, 0x53, 0x6c, 0x6f, 0x74, 0x52,
+	0x08, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x65, 0x64, 0x22, 0x6c, 0x0a, 0x11, 0x4c, 0x6f, 0x67,
+	0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x12, 0x14,
+	0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x65,
+	0x70, 0x6f, 0x63, 0x68, 0x12, 0x1e, 0x0a, 0x0a, 0x6c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x61,
+	0x6e, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x6c, 0x65, 0x61, 0x64, 0x65, 0x72,
+	0x52, 0x61, 0x6e, 0x6b, 0x12, 0x21, 0x0a, 0x05, 0x73, 0x6c, 0x6f, 0x74, 0x73, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x6f, 0x67, 0x53, 0x6c, 0x6f, 0x74,
+	0x52, 0x05, 0x73, 0x6c, 0x6f, 0x74, 0x73, 0x22, 0x61, 0x0a, 0x13, 0x4c, 0x6f, 0x67, 0x49, 0x6e,
+	0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x65, 0x64, 0x12, 0x14,
+	0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x65,
+	0x70, 0x6f, 0x63, 0x68, 0x12, 0x1e, 0x0a, 0x0a, 0x6c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x61,
+	0x6e, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x6c, 0x65, 0x61, 0x64, 0x65, 0x72,
+	0x52, 0x61, 0x6e, 0x6b, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x6c, 0x6f, 0x74, 0x73, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x05, 0x52, 0x05, 0x73, 0x6c, 0x6f, 0x74, 0x73, 0x22, 0x36, 0x0a, 0x11, 0x4c, 0x6f,
+	0x67, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x12,
+	0x21, 0x0a, 0x05, 0x73, 0x6c, 0x6f, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b,
+	0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x6f, 0x67, 0x53, 0x6c, 0x6f, 0x74, 0x52, 0x05, 0x73, 0x6c, 0x6f,
+	0x74, 0x73, 0x22, 0x40, 0x0a, 0x09, 0x52, 0x61, 0x66, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
+	0x12, 0x0a, 0x04, 0x74, 0x65, 0x72, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x74,
+	0x65, 0x72, 0x6d, 0x12, 0x1f, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x09, 0x2e, 0x70, 0x62, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x22, 0x23, 0x0a, 0x0b, 0x52, 0x61, 0x66, 0x74, 0x54, 0x69, 0x6d, 0x65,
+	0x6f, 0x75, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x6d, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x05, 0x74, 0x69, 0x6d, 0x65, 0x72, 0x22, 0x73, 0x0a, 0x17, 0x52, 0x61, 0x66,
+	0x74, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x56, 0x6f, 0x74, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x72, 0x6d, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x04, 0x74, 0x65, 0x72, 0x6d, 0x12, 0x22, 0x0a, 0x0c, 0x6c, 0x61, 0x73, 0x74,
+	0x4c, 0x6f, 0x67, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c,
+	0x6c, 0x61, 0x73, 0x74, 0x4c, 0x6f, 0x67, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x20, 0x0a, 0x0b,
+	0x6c, 0x61, 0x73, 0x74, 0x4c, 0x6f, 0x67, 0x54, 0x65, 0x72, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x0b, 0x6c, 0x61, 0x73, 0x74, 0x4c, 0x6f, 0x67, 0x54, 0x65, 0x72, 0x6d, 0x22, 0x40,
+	0x0a, 0x10, 0x52, 0x61, 0x66, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x56, 0x6f,
+	0x74, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x72, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x04, 0x74, 0x65, 0x72, 0x6d, 0x12, 0x18, 0x0a, 0x07, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x65,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x65, 0x64,
+	0x22, 0xc2, 0x01, 0x0a, 0x19, 0x52, 0x61, 0x66, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61,
+	0x6c, 0x41, 0x70, 0x70, 0x65, 0x6e, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x12,
+	0x0a, 0x04, 0x74, 0x65, 0x72, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x74, 0x65,
+	0x72, 0x6d, 0x12, 0x22, 0x0a, 0x0c, 0x70, 0x72, 0x65, 0x76, 0x4c, 0x6f, 0x67, 0x49, 0x6e, 0x64,
+	0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x70, 0x72, 0x65, 0x76, 0x4c, 0x6f,
+	0x67, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x72, 0x65, 0x76, 0x4c, 0x6f,
+	0x67, 0x54, 0x65, 0x72, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x70, 0x72, 0x65,
+	0x76, 0x4c, 0x6f, 0x67, 0x54, 0x65, 0x72, 0x6d, 0x12, 0x27, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72,
+	0x69, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x70, 0x62, 0x2e, 0x52,
+	0x61, 0x66, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65,
+	0x73, 0x12, 0x22, 0x0a, 0x0c, 0x6c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x43, 0x6f, 0x6d, 0x6d, 0x69,
+	0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x6c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x43,
+	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x22, 0x68, 0x0a, 0x18, 0x52, 0x61, 0x66, 0x74, 0x49, 0x6e, 0x74,
+	0x65, 0x72, 0x6e, 0x61, 0x6c, 0x41, 0x70, 0x70, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x72, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x04, 0x74, 0x65, 0x72, 0x6d, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12,
+	0x1e, 0x0a, 0x0a, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0a, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x22,
+	0x36, 0x0a, 0x13, 0x52, 0x61, 0x66, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x46,
+	0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x12, 0x1f, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x70, 0x62, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x45, 0x0a, 0x0c, 0x41, 0x70, 0x70, 0x52, 0x63,
+	0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x12, 0x1f, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x70,
+	0x62, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x44,
+	0x0a, 0x0b, 0x41, 0x70, 0x70, 0x52, 0x63, 0x44, 0x65, 0x63, 0x69, 0x64, 0x65, 0x12, 0x14, 0x0a,
+	0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f,
+	0x70, 0x69, 0x63, 0x12, 0x1f, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x09, 0x2e, 0x70, 0x62, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x22, 0x35, 0x0a, 0x12, 0x52, 0x63, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e,
+	0x61, 0x6c, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x12, 0x1f, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x70, 0x62, 0x2e, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x5e, 0x0a, 0x0f, 0x42,
+	0x63, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x50, 0x68, 0x61, 0x73, 0x65, 0x12, 0x14,
+	0x0a, 0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x72,
+	0x6f, 0x75, 0x6e, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x68, 0x61, 0x73, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x05, 0x70, 0x68, 0x61, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x70, 0x62, 0x2e, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x34, 0x0a, 0x11, 0x42,
+	0x63, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x44, 0x65, 0x63, 0x69, 0x64, 0x65, 0x64,
+	0x12, 0x1f, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x09, 0x2e, 0x70, 0x62, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x22, 0x59, 0x0a, 0x18, 0x46, 0x6c, 0x6f, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x74,
+	0x65, 0x72, 0x6e, 0x61, 0x6c, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x12, 0x14, 0x0a,
+	0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x72, 0x6f,
+	0x75, 0x6e, 0x64, 0x12, 0x27, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x70, 0x62, 0x2e, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x73, 0x22, 0x3a, 0x0a, 0x17,
+	0x46, 0x6c, 0x6f, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c,
+	0x44, 0x65, 0x63, 0x69, 0x64, 0x65, 0x64, 0x12, 0x1f, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x70, 0x62, 0x2e, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x3f, 0x0a, 0x1c, 0x48, 0x69, 0x65, 0x72,
+	0x61, 0x72, 0x63, 0x68, 0x69, 0x63, 0x61, 0x6c, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c,
+	0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x12, 0x1f, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x70, 0x62, 0x2e, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x19, 0x0a, 0x17, 0x48, 0x69, 0x65,
+	0x72, 0x61, 0x72, 0x63, 0x68, 0x69, 0x63, 0x61, 0x6c, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61,
+	0x6c, 0x41, 0x63, 0x6b, 0x22, 0x3e, 0x0a, 0x1b, 0x48, 0x69, 0x65, 0x72, 0x61, 0x72, 0x63, 0x68,
+	0x69, 0x63, 0x61, 0x6c, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x44, 0x65, 0x63, 0x69,
+	0x64, 0x65, 0x64, 0x12, 0x1f, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x09, 0x2e, 0x70, 0x62, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x22, 0x35, 0x0a, 0x0c, 0x42, 0x63, 0x62, 0x42, 0x72, 0x6f, 0x61, 0x64,
+	0x63, 0x61, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x62, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x5a, 0x0a, 0x0a, 0x42,
+	0x63, 0x62, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x12, 0x25, 0x0a, 0x07, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x62, 0x2e,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x12, 0x25, 0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0d, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x49, 0x64, 0x52,
+	0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x22, 0x35, 0x0a, 0x0c, 0x42, 0x72, 0x62, 0x42, 0x72,
+	0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x62, 0x2e, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x5a,
+	0x0a, 0x0a, 0x42, 0x72, 0x62, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x12, 0x25, 0x0a, 0x07,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e,
+	0x70, 0x62, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x12, 0x25, 0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73,
+	0x49, 0x64, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x22, 0x8d, 0x01, 0x0a, 0x15, 0x42,
+	0x79, 0x7a, 0x61, 0x6e, 0x74, 0x69, 0x6e, 0x65, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c,
+	0x53, 0x65, 0x6e, 0x64, 0x12, 0x25, 0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73,
+	0x73, 0x49, 0x64, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x26, 0x0a, 0x0e, 0x73,
+	0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0e, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x4e, 0x75, 0x6d,
+	0x62, 0x65, 0x72, 0x12, 0x25, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x62, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x8d, 0x01, 0x0a, 0x15, 0x42,
+	0x79, 0x7a, 0x61, 0x6e, 0x74, 0x69, 0x6e, 0x65, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c,
+	0x45, 0x63, 0x68, 0x6f, 0x12, 0x25, 0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73,
+	0x73, 0x49, 0x64, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x26, 0x0a, 0x0e, 0x73,
+	0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0e, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x4e, 0x75, 0x6d,
+	0x62, 0x65, 0x72, 0x12, 0x25, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x62, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x8e, 0x01, 0x0a, 0x16, 0x42,
+	0x79, 0x7a, 0x61, 0x6e, 0x74, 0x69, 0x6e, 0x65, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c,
+	0x52, 0x65, 0x61, 0x64, 0x79, 0x12, 0x25, 0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65,
+	0x73, 0x73, 0x49, 0x64, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x26, 0x0a, 0x0e,
+	0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x4e, 0x75,
+	0x6d, 0x62, 0x65, 0x72, 0x12, 0x25, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x62, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x0d, 0x0a, 0x0b, 0x45,
+	0x70, 0x66, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x22, 0x1e, 0x0a, 0x1c, 0x45, 0x70,
+	0x66, 0x64, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x48, 0x65, 0x61, 0x72, 0x74, 0x62,
+	0x65, 0x61, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x1c, 0x0a, 0x1a, 0x45, 0x70,
+	0x66, 0x64, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x48, 0x65, 0x61, 0x72, 0x74, 0x62,
+	0x65, 0x61, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x36, 0x0a, 0x0b, 0x45, 0x70, 0x66, 0x64,
+	0x53, 0x75, 0x73, 0x70, 0x65, 0x63, 0x74, 0x12, 0x27, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x63, 0x65,
+	0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x72,
+	0x6f, 0x63, 0x65, 0x73, 0x73, 0x49, 0x64, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73,
+	0x22, 0x36, 0x0a, 0x0b, 0x45, 0x70, 0x66, 0x64, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x12,
+	0x27, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0d, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x49, 0x64, 0x52,
+	0x07, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x22, 0x0c, 0x0a, 0x0a, 0x50, 0x66, 0x64, 0x54,
+	0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x22, 0x1d, 0x0a, 0x1b, 0x50, 0x66, 0x64, 0x49, 0x6e, 0x74,
+	0x65, 0x72, 0x6e, 0x61, 0x6c, 0x48, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x1b, 0x0a, 0x19, 0x50, 0x66, 0x64, 0x49, 0x6e, 0x74, 0x65,
+	0x72, 0x6e, 0x61, 0x6c, 0x48, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x22, 0x33, 0x0a, 0x08, 0x50, 0x66, 0x64, 0x43, 0x72, 0x61, 0x73, 0x68, 0x12, 0x27,
+	0x0a, 0x07, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0d, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x49, 0x64, 0x52, 0x07,
+	0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x22, 0x0a, 0x0a, 0x08, 0x41, 0x70, 0x70, 0x45, 0x6c,
+	0x65, 0x63, 0x74, 0x22, 0x32, 0x0a, 0x09, 0x41, 0x70, 0x70, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72,
+	0x12, 0x25, 0x0a, 0x06, 0x6c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0d, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x49, 0x64, 0x52,
+	0x06, 0x6c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x22, 0x09, 0x0a, 0x07, 0x4c, 0x65, 0x53, 0x74, 0x61,
+	0x72, 0x74, 0x22, 0x33, 0x0a, 0x08, 0x4c, 0x65, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x27,
+	0x0a, 0x07, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0d, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x49, 0x64, 0x52, 0x07,
+	0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x22, 0x60, 0x0a, 0x06, 0x50, 0x6c, 0x53, 0x65, 0x6e,
+	0x64, 0x12, 0x2f, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x72, 0x6f, 0x63,
+	0x65, 0x73, 0x73, 0x49, 0x64, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x25, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x62, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x59, 0x0a, 0x09, 0x50, 0x6c, 0x44,
+	0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x12, 0x25, 0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x72, 0x6f, 0x63,
+	0x65, 0x73, 0x73, 0x49, 0x64, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x25, 0x0a,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b,
+	0x2e, 0x70, 0x62, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x07, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x22, 0x89, 0x01, 0x0a, 0x0e, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x65, 0x6e, 0x64, 0x65,
+	0x72, 0x48, 0x6f, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x65, 0x6e,
+	0x64, 0x65, 0x72, 0x48, 0x6f, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x13, 0x73, 0x65, 0x6e, 0x64, 0x65,
+	0x72, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x72, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x13, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74,
+	0x65, 0x6e, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x25, 0x0a, 0x07, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x62, 0x2e,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x22, 0xf4, 0x6a, 0x0a, 0x07, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x24, 0x0a, 0x04,
+	0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x70, 0x62, 0x2e,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79,
+	0x70, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x55, 0x75, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x55, 0x75, 0x69, 0x64, 0x12, 0x2c, 0x0a, 0x11, 0x46, 0x72, 0x6f, 0x6d, 0x41, 0x62, 0x73, 0x74,
+	0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x11, 0x46, 0x72, 0x6f, 0x6d, 0x41, 0x62, 0x73, 0x74, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x49, 0x64, 0x12, 0x28, 0x0a, 0x0f, 0x54, 0x6f, 0x41, 0x62, 0x73, 0x74, 0x72, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x49, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x54, 0x6f, 0x41,
+	0x62, 0x73, 0x74, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08,
+	0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x49, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x49, 0x64, 0x12, 0x3a, 0x0a, 0x0e, 0x6e, 0x65, 0x74, 0x77,
+	0x6f, 0x72, 0x6b, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x12, 0x2e, 0x70, 0x62, 0x2e, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x52, 0x0e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x12, 0x40, 0x0a, 0x10, 0x70, 0x72, 0x6f, 0x63, 0x52, 0x65, 0x67, 0x69,
+	0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14,
+	0x2e, 0x70, 0x62, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x10, 0x70, 0x72, 0x6f, 0x63, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x4c, 0x0a, 0x14, 0x70, 0x72, 0x6f, 0x63, 0x49, 0x6e,
+	0x69, 0x74, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x49, 0x6e,
+	0x69, 0x74, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x52, 0x14,
+	0x70, 0x72, 0x6f, 0x63, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x53, 0x79,
+	0x73, 0x74, 0x65, 0x6d, 0x12, 0x43, 0x0a, 0x11, 0x70, 0x72, 0x6f, 0x63, 0x44, 0x65, 0x73, 0x74,
+	0x72, 0x6f, 0x79, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x15, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x44, 0x65, 0x73, 0x74, 0x72, 0x6f, 0x79,
+	0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x52, 0x11, 0x70, 0x72, 0x6f, 0x63, 0x44, 0x65, 0x73, 0x74,
+	0x72, 0x6f, 0x79, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x12, 0x34, 0x0a, 0x0c, 0x61, 0x70, 0x70,
+	0x42, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x10, 0x2e, 0x70, 0x62, 0x2e, 0x41, 0x70, 0x70, 0x42, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73,
+	0x74, 0x52, 0x0c, 0x61, 0x70, 0x70, 0x42, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x12,
+	0x28, 0x0a, 0x08, 0x61, 0x70, 0x70, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x41, 0x70, 0x70, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52,
+	0x08, 0x61, 0x70, 0x70, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x2e, 0x0a, 0x0a, 0x61, 0x70, 0x70,
+	0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e,
+	0x70, 0x62, 0x2e, 0x41, 0x70, 0x70, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x52, 0x0a, 0x61,
+	0x70, 0x70, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x12, 0x2b, 0x0a, 0x09, 0x61, 0x70, 0x70,
+	0x44, 0x65, 0x63, 0x69, 0x64, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x70,
+	0x62, 0x2e, 0x41, 0x70, 0x70, 0x44, 0x65, 0x63, 0x69, 0x64, 0x65, 0x52, 0x09, 0x61, 0x70, 0x70,
+	0x44, 0x65, 0x63, 0x69, 0x64, 0x65, 0x12, 0x25, 0x0a, 0x07, 0x61, 0x70, 0x70, 0x52, 0x65, 0x61,
+	0x64, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x62, 0x2e, 0x41, 0x70, 0x70,
+	0x52, 0x65, 0x61, 0x64, 0x52, 0x07, 0x61, 0x70, 0x70, 0x52, 0x65, 0x61, 0x64, 0x12, 0x28, 0x0a,
+	0x08, 0x61, 0x70, 0x70, 0x57, 0x72, 0x69, 0x74, 0x65, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x41, 0x70, 0x70, 0x57, 0x72, 0x69, 0x74, 0x65, 0x52, 0x08, 0x61,
+	0x70, 0x70, 0x57, 0x72, 0x69, 0x74, 0x65, 0x12, 0x37, 0x0a, 0x0d, 0x61, 0x70, 0x70, 0x52, 0x65,
+	0x61, 0x64, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11,
+	0x2e, 0x70, 0x62, 0x2e, 0x41, 0x70, 0x70, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x74, 0x75, 0x72,
+	0x6e, 0x52, 0x0d, 0x61, 0x70, 0x70, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e,
+	0x12, 0x3a, 0x0a, 0x0e, 0x61, 0x70, 0x70, 0x57, 0x72, 0x69, 0x74, 0x65, 0x52, 0x65, 0x74, 0x75,
+	0x72, 0x6e, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70, 0x62, 0x2e, 0x41, 0x70,
+	0x70, 0x57, 0x72, 0x69, 0x74, 0x65, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x52, 0x0e, 0x61, 0x70,
+	0x70, 0x57, 0x72, 0x69, 0x74, 0x65, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x12, 0x4c, 0x0a, 0x14,
+	0x61, 0x70, 0x70, 0x52, 0x65, 0x6c, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x42, 0x72, 0x6f, 0x61, 0x64,
+	0x63, 0x61, 0x73, 0x74, 0x18, 0x12, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x70, 0x62, 0x2e,
+	0x41, 0x70, 0x70, 0x52, 0x65, 0x6c, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x42, 0x72, 0x6f, 0x61, 0x64,
+	0x63, 0x61, 0x73, 0x74, 0x52, 0x14, 0x61, 0x70, 0x70, 0x52, 0x65, 0x6c, 0x69, 0x61, 0x62, 0x6c,
+	0x65, 0x42, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x12, 0x49, 0x0a, 0x13, 0x61, 0x70,
+	0x70, 0x55, 0x6e, 0x69, 0x66, 0x6f, 0x72, 0x6d, 0x42, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73,
+	0x74, 0x18, 0x13, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x62, 0x2e, 0x41, 0x70, 0x70,
+	0x55, 0x6e, 0x69, 0x66, 0x6f, 0x72, 0x6d, 0x42, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74,
+	0x52, 0x13, 0x61, 0x70, 0x70, 0x55, 0x6e, 0x69, 0x66, 0x6f, 0x72, 0x6d, 0x42, 0x72, 0x6f, 0x61,
+	0x64, 0x63, 0x61, 0x73, 0x74, 0x12, 0x40, 0x0a, 0x10, 0x61, 0x70, 0x70, 0x46, 0x69, 0x66, 0x6f,
+	0x42, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x18, 0x16, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x14, 0x2e, 0x70, 0x62, 0x2e, 0x41, 0x70, 0x70, 0x46, 0x69, 0x66, 0x6f, 0x42, 0x72, 0x6f, 0x61,
+	0x64, 0x63, 0x61, 0x73, 0x74, 0x52, 0x10, 0x61, 0x70, 0x70, 0x46, 0x69, 0x66, 0x6f, 0x42, 0x72,
+	0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x12, 0x46, 0x0a, 0x12, 0x61, 0x70, 0x70, 0x43, 0x61,
+	0x75, 0x73, 0x61, 0x6c, 0x42, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x18, 0x17, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70, 0x62, 0x2e, 0x41, 0x70, 0x70, 0x43, 0x61, 0x75, 0x73,
+	0x61, 0x6c, 0x42, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x52, 0x12, 0x61, 0x70, 0x70,
+	0x43, 0x61, 0x75, 0x73, 0x61, 0x6c, 0x42, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x12,
+	0x5b, 0x0a, 0x19, 0x61, 0x70, 0x70, 0x50, 0x72, 0x6f, 0x62, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x73,
+	0x74, 0x69, 0x63, 0x42, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x18, 0x18, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x70, 0x62, 0x2e, 0x41, 0x70, 0x70, 0x50, 0x72, 0x6f, 0x62, 0x61,
+	0x62, 0x69, 0x6c, 0x69, 0x73, 0x74, 0x69, 0x63, 0x42, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73,
+	0x74, 0x52, 0x19, 0x61, 0x70, 0x70, 0x50, 0x72, 0x6f, 0x62, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x73,
+	0x74, 0x69, 0x63, 0x42, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x12, 0x3d, 0x0a, 0x0f,
+	0x61, 0x70, 0x70, 0x54, 0x6f, 0x62, 0x42, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x18,
+	0x19, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x62, 0x2e, 0x41, 0x70, 0x70, 0x54, 0x6f,
+	0x62, 0x42, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x52, 0x0f, 0x61, 0x70, 0x70, 0x54,
+	0x6f, 0x62, 0x42, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x12, 0x37, 0x0a, 0x0d, 0x61,
+	0x70, 0x70, 0x54, 0x6f, 0x62, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x18, 0x1a, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x62, 0x2e, 0x41, 0x70, 0x70, 0x54, 0x6f, 0x62, 0x44, 0x65,
+	0x6c, 0x69, 0x76, 0x65, 0x72, 0x52, 0x0d, 0x61, 0x70, 0x70, 0x54, 0x6f, 0x62, 0x44, 0x65, 0x6c,
+	0x69, 0x76, 0x65, 0x72, 0x12, 0x22, 0x0a, 0x06, 0x61, 0x70, 0x70, 0x54, 0x72, 0x62, 0x18, 0x1b,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x70, 0x62, 0x2e, 0x41, 0x70, 0x70, 0x54, 0x72, 0x62,
+	0x52, 0x06, 0x61, 0x70, 0x70, 0x54, 0x72, 0x62, 0x12, 0x37, 0x0a, 0x0d, 0x61, 0x70, 0x70, 0x54,
+	0x72, 0x62, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x18, 0x1c, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x11, 0x2e, 0x70, 0x62, 0x2e, 0x41, 0x70, 0x70, 0x54, 0x72, 0x62, 0x44, 0x65, 0x6c, 0x69, 0x76,
+	0x65, 0x72, 0x52, 0x0d, 0x61, 0x70, 0x70, 0x54, 0x72, 0x62, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65,
+	0x72, 0x12, 0x3a, 0x0a, 0x0e, 0x61, 0x70, 0x70, 0x4e, 0x62, 0x61, 0x63, 0x50, 0x72, 0x6f, 0x70,
+	0x6f, 0x73, 0x65, 0x18, 0x1d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70, 0x62, 0x2e, 0x41,
+	0x70, 0x70, 0x4e, 0x62, 0x61, 0x63, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x52, 0x0e, 0x61,
+	0x70, 0x70, 0x4e, 0x62, 0x61, 0x63, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x12, 0x37, 0x0a,
+	0x0d, 0x61, 0x70, 0x70, 0x4e, 0x62, 0x61, 0x63, 0x44, 0x65, 0x63, 0x69, 0x64, 0x65, 0x18, 0x27,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x62, 0x2e, 0x41, 0x70, 0x70, 0x4e, 0x62, 0x61,
+	0x63, 0x44, 0x65, 0x63, 0x69, 0x64, 0x65, 0x52, 0x0d, 0x61, 0x70, 0x70, 0x4e, 0x62, 0x61, 0x63,
+	0x44, 0x65, 0x63, 0x69, 0x64, 0x65, 0x12, 0x2b, 0x0a, 0x09, 0x61, 0x70, 0x70, 0x43, 0x6f, 0x6d,
+	0x6d, 0x69, 0x74, 0x18, 0x2c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x70, 0x62, 0x2e, 0x41,
+	0x70, 0x70, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x52, 0x09, 0x61, 0x70, 0x70, 0x43, 0x6f, 0x6d,
+	0x6d, 0x69, 0x74, 0x12, 0x40, 0x0a, 0x10, 0x61, 0x70, 0x70, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74,
+	0x4f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x18, 0x2d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e,
+	0x70, 0x62, 0x2e, 0x41, 0x70, 0x70, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x4f, 0x75, 0x74, 0x63,
+	0x6f, 0x6d, 0x65, 0x52, 0x10, 0x61, 0x70, 0x70, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x4f, 0x75,
+	0x74, 0x63, 0x6f, 0x6d, 0x65, 0x12, 0x3a, 0x0a, 0x0e, 0x61, 0x70, 0x70, 0x56, 0x73, 0x42, 0x72,
+	0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x18, 0x2e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e,
+	0x70, 0x62, 0x2e, 0x41, 0x70, 0x70, 0x56, 0x73, 0x42, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73,
+	0x74, 0x52, 0x0e, 0x61, 0x70, 0x70, 0x56, 0x73, 0x42, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73,
+	0x74, 0x12, 0x34, 0x0a, 0x0c, 0x61, 0x70, 0x70, 0x56, 0x73, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65,
+	0x72, 0x18, 0x2f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x62, 0x2e, 0x41, 0x70, 0x70,
+	0x56, 0x73, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x52, 0x0c, 0x61, 0x70, 0x70, 0x56, 0x73,
+	0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x12, 0x25, 0x0a, 0x07, 0x61, 0x70, 0x70, 0x56, 0x69,
+	0x65, 0x77, 0x18, 0x30, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x62, 0x2e, 0x41, 0x70,
+	0x70, 0x56, 0x69, 0x65, 0x77, 0x52, 0x07, 0x61, 0x70, 0x70, 0x56, 0x69, 0x65, 0x77, 0x12, 0x28,
+	0x0a, 0x08, 0x75, 0x63, 0x44, 0x65, 0x63, 0x69, 0x64, 0x65, 0x18, 0x14, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x55, 0x63, 0x44, 0x65, 0x63, 0x69, 0x64, 0x65, 0x52, 0x08,
+	0x75, 0x63, 0x44, 0x65, 0x63, 0x69, 0x64, 0x65, 0x12, 0x2b, 0x0a, 0x09, 0x75, 0x63, 0x50, 0x72,
+	0x6f, 0x70, 0x6f, 0x73, 0x65, 0x18, 0x15, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x70, 0x62,
+	0x2e, 0x55, 0x63, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x52, 0x09, 0x75, 0x63, 0x50, 0x72,
+	0x6f, 0x70, 0x6f, 0x73, 0x65, 0x12, 0x25, 0x0a, 0x07, 0x65, 0x70, 0x41, 0x62, 0x6f, 0x72, 0x74,
+	0x18, 0x1e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x62, 0x2e, 0x45, 0x70, 0x41, 0x62,
+	0x6f, 0x72, 0x74, 0x52, 0x07, 0x65, 0x70, 0x41, 0x62, 0x6f, 0x72, 0x74, 0x12, 0x2b, 0x0a, 0x09,
+	0x65, 0x70, 0x41, 0x62, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x18, 0x1f, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0d, 0x2e, 0x70, 0x62, 0x2e, 0x45, 0x70, 0x41, 0x62, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x52, 0x09,
+	0x65, 0x70, 0x41, 0x62, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x12, 0x40, 0x0a, 0x10, 0x65, 0x70, 0x49,
+	0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x18, 0x20, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x62, 0x2e, 0x45, 0x70, 0x49, 0x6e, 0x74, 0x65, 0x72,
+	0x6e, 0x61, 0x6c, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x52, 0x10, 0x65, 0x70, 0x49, 0x6e, 0x74,
+	0x65, 0x72, 0x6e, 0x61, 0x6c, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x12, 0x28, 0x0a, 0x08, 0x65,
+	0x70, 0x44, 0x65, 0x63, 0x69, 0x64, 0x65, 0x18, 0x21, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e,
+	0x70, 0x62, 0x2e, 0x45, 0x70, 0x44, 0x65, 0x63, 0x69, 0x64, 0x65, 0x52, 0x08, 0x65, 0x70, 0x44,
+	0x65, 0x63, 0x69, 0x64, 0x65, 0x12, 0x43, 0x0a, 0x11, 0x65, 0x70, 0x49, 0x6e, 0x74, 0x65, 0x72,
+	0x6e, 0x61, 0x6c, 0x44, 0x65, 0x63, 0x69, 0x64, 0x65, 0x64, 0x18, 0x22, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x15, 0x2e, 0x70, 0x62, 0x2e, 0x45, 0x70, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c,
+	0x44, 0x65, 0x63, 0x69, 0x64, 0x65, 0x64, 0x52, 0x11, 0x65, 0x70, 0x49, 0x6e, 0x74, 0x65, 0x72,
+	0x6e, 0x61, 0x6c, 0x44, 0x65, 0x63, 0x69, 0x64, 0x65, 0x64, 0x12, 0x2b, 0x0a, 0x09, 0x65, 0x70,
+	0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x18, 0x23, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e,
+	0x70, 0x62, 0x2e, 0x45, 0x70, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x52, 0x09, 0x65, 0x70,
+	0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x12, 0x3a, 0x0a, 0x0e, 0x65, 0x70, 0x49, 0x6e, 0x74,
+	0x65, 0x72, 0x6e, 0x61, 0x6c, 0x52, 0x65, 0x61, 0x64, 0x18, 0x24, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x12, 0x2e, 0x70, 0x62, 0x2e, 0x45, 0x70, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x52,
+	0x65, 0x61, 0x64, 0x52, 0x0e, 0x65, 0x70, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x52,
+	0x65, 0x61, 0x64, 0x12, 0x3d, 0x0a, 0x0f, 0x65, 0x70, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61,
+	0x6c, 0x53, 0x74, 0x61, 0x74, 0x65, 0x18, 0x25, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70,
+	0x62, 0x2e, 0x45, 0x70, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x52, 0x0f, 0x65, 0x70, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x53, 0x74, 0x61,
+	0x74, 0x65, 0x12, 0x3d, 0x0a, 0x0f, 0x65, 0x70, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c,
+	0x57, 0x72, 0x69, 0x74, 0x65, 0x18, 0x26, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x62,
+	0x2e, 0x45, 0x70, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x57, 0x72, 0x69, 0x74, 0x65,
+	0x52, 0x0f, 0x65, 0x70, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x57, 0x72, 0x69, 0x74,
+	0x65, 0x12, 0x3a, 0x0a, 0x0e, 0x65, 0x63, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x4e,
+	0x61, 0x63, 0x6b, 0x18, 0x29, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70, 0x62, 0x2e, 0x45,
+	0x63, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x4e, 0x61, 0x63, 0x6b, 0x52, 0x0e, 0x65,
+	0x63, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x4e, 0x61, 0x63, 0x6b, 0x12, 0x46, 0x0a,
+	0x12, 0x65, 0x63, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x4e, 0x65, 0x77, 0x45, 0x70,
+	0x6f, 0x63, 0x68, 0x18, 0x2a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70, 0x62, 0x2e, 0x45,
+	0x63, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x4e, 0x65, 0x77, 0x45, 0x70, 0x6f, 0x63,
+	0x68, 0x52, 0x12, 0x65, 0x63, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x4e, 0x65, 0x77,
+	0x45, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x34, 0x0a, 0x0c, 0x65, 0x63, 0x53, 0x74, 0x61, 0x72, 0x74,
+	0x45, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x2b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x62,
+	0x2e, 0x45, 0x63, 0x53, 0x74, 0x61, 0x72, 0x74, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x52, 0x0c, 0x65,
+	0x63, 0x53, 0x74, 0x61, 0x72, 0x74, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x34, 0x0a, 0x0c, 0x62,
+	0x65, 0x62, 0x42, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x18, 0x32, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x10, 0x2e, 0x70, 0x62, 0x2e, 0x42, 0x65, 0x62, 0x42, 0x72, 0x6f, 0x61, 0x64, 0x63,
+	0x61, 0x73, 0x74, 0x52, 0x0c, 0x62, 0x65, 0x62, 0x42, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73,
+	0x74, 0x12, 0x2e, 0x0a, 0x0a, 0x62, 0x65, 0x62, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x18,
+	0x33, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x62, 0x2e, 0x42, 0x65, 0x62, 0x44, 0x65,
+	0x6c, 0x69, 0x76, 0x65, 0x72, 0x52, 0x0a, 0x62, 0x65, 0x62, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65,
+	0x72, 0x12, 0x2e, 0x0a, 0x0a, 0x65, 0x6c, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18,
+	0x3c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x62, 0x2e, 0x45, 0x6c, 0x64, 0x54, 0x69,
+	0x6d, 0x65, 0x6f, 0x75, 0x74, 0x52, 0x0a, 0x65, 0x6c, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75,
+	0x74, 0x12, 0x28, 0x0a, 0x08, 0x65, 0x6c, 0x64, 0x54, 0x72, 0x75, 0x73, 0x74, 0x18, 0x3d, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x45, 0x6c, 0x64, 0x54, 0x72, 0x75, 0x73,
+	0x74, 0x52, 0x08, 0x65, 0x6c, 0x64, 0x54, 0x72, 0x75, 0x73, 0x74, 0x12, 0x3d, 0x0a, 0x0f, 0x6e,
+	0x6e, 0x61, 0x72, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x41, 0x63, 0x6b, 0x18, 0x46,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x62, 0x2e, 0x4e, 0x6e, 0x61, 0x72, 0x49, 0x6e,
+	0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x41, 0x63, 0x6b, 0x52, 0x0f, 0x6e, 0x6e, 0x61, 0x72, 0x49,
+	0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x41, 0x63, 0x6b, 0x12, 0x40, 0x0a, 0x10, 0x6e, 0x6e,
+	0x61, 0x72, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x52, 0x65, 0x61, 0x64, 0x18, 0x47,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x62, 0x2e, 0x4e, 0x6e, 0x61, 0x72, 0x49, 0x6e,
+	0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x52, 0x65, 0x61, 0x64, 0x52, 0x10, 0x6e, 0x6e, 0x61, 0x72,
+	0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x52, 0x65, 0x61, 0x64, 0x12, 0x43, 0x0a, 0x11,
+	0x6e, 0x6e, 0x61, 0x72, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x48, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x70, 0x62, 0x2e, 0x4e, 0x6e, 0x61,
+	0x72, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x11,
+	0x6e, 0x6e, 0x61, 0x72, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x12, 0x43, 0x0a, 0x11, 0x6e, 0x6e, 0x61, 0x72, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61,
+	0x6c, 0x57, 0x72, 0x69, 0x74, 0x65, 0x18, 0x49, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x70,
+	0x62, 0x2e, 0x4e, 0x6e, 0x61, 0x72, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x57, 0x72,
+	0x69, 0x74, 0x65, 0x52, 0x11, 0x6e, 0x6e, 0x61, 0x72, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61,
+	0x6c, 0x57, 0x72, 0x69, 0x74, 0x65, 0x12, 0x28, 0x0a, 0x08, 0x6e, 0x6e, 0x61, 0x72, 0x52, 0x65,
+	0x61, 0x64, 0x18, 0x4a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x4e, 0x6e,
+	0x61, 0x72, 0x52, 0x65, 0x61, 0x64, 0x52, 0x08, 0x6e, 0x6e, 0x61, 0x72, 0x52, 0x65, 0x61, 0x64,
+	0x12, 0x3a, 0x0a, 0x0e, 0x6e, 0x6e, 0x61, 0x72, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x74, 0x75,
+	0x72, 0x6e, 0x18, 0x4b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70, 0x62, 0x2e, 0x4e, 0x6e,
+	0x61, 0x72, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x52, 0x0e, 0x6e, 0x6e,
+	0x61, 0x72, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x12, 0x2b, 0x0a, 0x09,
+	0x6e, 0x6e, 0x61, 0x72, 0x57, 0x72, 0x69, 0x74, 0x65, 0x18, 0x4c, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0d, 0x2e, 0x70, 0x62, 0x2e, 0x4e, 0x6e, 0x61, 0x72, 0x57, 0x72, 0x69, 0x74, 0x65, 0x52, 0x09,
+	0x6e, 0x6e, 0x61, 0x72, 0x57, 0x72, 0x69, 0x74, 0x65, 0x12, 0x3d, 0x0a, 0x0f, 0x6e, 0x6e, 0x61,
+	0x72, 0x57, 0x72, 0x69, 0x74, 0x65, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x18, 0x4d, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x62, 0x2e, 0x4e, 0x6e, 0x61, 0x72, 0x57, 0x72, 0x69, 0x74,
+	0x65, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x52, 0x0f, 0x6e, 0x6e, 0x61, 0x72, 0x57, 0x72, 0x69,
+	0x74, 0x65, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x12, 0x31, 0x0a, 0x0b, 0x65, 0x70, 0x66, 0x64,
+	0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x50, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e,
+	0x70, 0x62, 0x2e, 0x45, 0x70, 0x66, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x52, 0x0b,
+	0x65, 0x70, 0x66, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x64, 0x0a, 0x1c, 0x65,
+	0x70, 0x66, 0x64, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x48, 0x65, 0x61, 0x72, 0x74,
+	0x62, 0x65, 0x61, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x51, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x20, 0x2e, 0x70, 0x62, 0x2e, 0x45, 0x70, 0x66, 0x64, 0x49, 0x6e, 0x74, 0x65, 0x72,
+	0x6e, 0x61, 0x6c, 0x48, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x52, 0x1c, 0x65, 0x70, 0x66, 0x64, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61,
+	0x6c, 0x48, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x5e, 0x0a, 0x1a, 0x65, 0x70, 0x66, 0x64, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61,
+	0x6c, 0x48, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x18,
+	0x52, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x70, 0x62, 0x2e, 0x45, 0x70, 0x66, 0x64, 0x49,
+	0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x48, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74,
+	0x52, 0x65, 0x70, 0x6c, 0x79, 0x52, 0x1a, 0x65, 0x70, 0x66, 0x64, 0x49, 0x6e, 0x74, 0x65, 0x72,
+	0x6e, 0x61, 0x6c, 0x48, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x52, 0x65, 0x70, 0x6c,
+	0x79, 0x12, 0x31, 0x0a, 0x0b, 0x65, 0x70, 0x66, 0x64, 0x53, 0x75, 0x73, 0x70, 0x65, 0x63, 0x74,
+	0x18, 0x53, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x62, 0x2e, 0x45, 0x70, 0x66, 0x64,
+	0x53, 0x75, 0x73, 0x70, 0x65, 0x63, 0x74, 0x52, 0x0b, 0x65, 0x70, 0x66, 0x64, 0x53, 0x75, 0x73,
+	0x70, 0x65, 0x63, 0x74, 0x12, 0x31, 0x0a, 0x0b, 0x65, 0x70, 0x66, 0x64, 0x52, 0x65, 0x73, 0x74,
+	0x6f, 0x72, 0x65, 0x18, 0x54, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x62, 0x2e, 0x45,
+	0x70, 0x66, 0x64, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x52, 0x0b, 0x65, 0x70, 0x66, 0x64,
+	0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x12, 0x2b, 0x0a, 0x09, 0x70, 0x6c, 0x44, 0x65, 0x6c,
+	0x69, 0x76, 0x65, 0x72, 0x18, 0x5a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x70, 0x62, 0x2e,
+	0x50, 0x6c, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x52, 0x09, 0x70, 0x6c, 0x44, 0x65, 0x6c,
+	0x69, 0x76, 0x65, 0x72, 0x12, 0x22, 0x0a, 0x06, 0x70, 0x6c, 0x53, 0x65, 0x6e, 0x64, 0x18, 0x5b,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x6c, 0x53, 0x65, 0x6e, 0x64,
+	0x52, 0x06, 0x70, 0x6c, 0x53, 0x65, 0x6e, 0x64, 0x12, 0x2e, 0x0a, 0x0a, 0x70, 0x66, 0x64, 0x54,
+	0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x64, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70,
+	0x62, 0x2e, 0x50, 0x66, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x52, 0x0a, 0x70, 0x66,
+	0x64, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x61, 0x0a, 0x1b, 0x70, 0x66, 0x64, 0x49,
+	0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x48, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x65, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e,
+	0x70, 0x62, 0x2e, 0x50, 0x66, 0x64, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x48, 0x65,
+	0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x1b,
+	0x70, 0x66, 0x64, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x48, 0x65, 0x61, 0x72, 0x74,
+	0x62, 0x65, 0x61, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x5b, 0x0a, 0x19, 0x70,
+	0x66, 0x64, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x48, 0x65, 0x61, 0x72, 0x74, 0x62,
+	0x65, 0x61, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x18, 0x66, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d,
+	0x2e, 0x70, 0x62, 0x2e, 0x50, 0x66, 0x64, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x48,
+	0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x52, 0x19, 0x70,
+	0x66, 0x64, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x48, 0x65, 0x61, 0x72, 0x74, 0x62,
+	0x65, 0x61, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x28, 0x0a, 0x08, 0x70, 0x66, 0x64, 0x43,
+	0x72, 0x61, 0x73, 0x68, 0x18, 0x67, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x62, 0x2e,
+	0x50, 0x66, 0x64, 0x43, 0x72, 0x61, 0x73, 0x68, 0x52, 0x08, 0x70, 0x66, 0x64, 0x43, 0x72, 0x61,
+	0x73, 0x68, 0x12, 0x28, 0x0a, 0x08, 0x6c, 0x65, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x6e,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x65, 0x4c, 0x65, 0x61, 0x64,
+	0x65, 0x72, 0x52, 0x08, 0x6c, 0x65, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x28, 0x0a, 0x08,
+	0x61, 0x70, 0x70, 0x45, 0x6c, 0x65, 0x63, 0x74, 0x18, 0x6f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c,
+	0x2e, 0x70, 0x62, 0x2e, 0x41, 0x70, 0x70, 0x45, 0x6c, 0x65, 0x63, 0x74, 0x52, 0x08, 0x61, 0x70,
+	0x70, 0x45, 0x6c, 0x65, 0x63, 0x74, 0x12, 0x2b, 0x0a, 0x09, 0x61, 0x70, 0x70, 0x4c, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x18, 0x70, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x70, 0x62, 0x2e, 0x41,
+	0x70, 0x70, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x09, 0x61, 0x70, 0x70, 0x4c, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x12, 0x25, 0x0a, 0x07, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x72, 0x74, 0x18, 0x71,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x65, 0x53, 0x74, 0x61, 0x72,
+	0x74, 0x52, 0x07, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x72, 0x74, 0x12, 0x31, 0x0a, 0x0b, 0x72, 0x62,
+	0x42, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x18, 0x78, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0f, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x62, 0x42, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74,
+	0x52, 0x0b, 0x72, 0x62, 0x42, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x12, 0x2b, 0x0a,
+	0x09, 0x72, 0x62, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x18, 0x79, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0d, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x62, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x52,
+	0x09, 0x72, 0x62, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x12, 0x3a, 0x0a, 0x0e, 0x72, 0x62,
+	0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x44, 0x61, 0x74, 0x61, 0x18, 0x7a, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x62, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e,
+	0x61, 0x6c, 0x44, 0x61, 0x74, 0x61, 0x52, 0x0e, 0x72, 0x62, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e,
+	0x61, 0x6c, 0x44, 0x61, 0x74, 0x61, 0x12, 0x35, 0x0a, 0x0c, 0x75, 0x72, 0x62, 0x42, 0x72, 0x6f,
+	0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x18, 0x82, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e,
+	0x70, 0x62, 0x2e, 0x55, 0x72, 0x62, 0x42, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x52,
+	0x0c, 0x75, 0x72, 0x62, 0x42, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x12, 0x2f, 0x0a,
+	0x0a, 0x75, 0x72, 0x62, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x18, 0x83, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x62, 0x2e, 0x55, 0x72, 0x62, 0x44, 0x65, 0x6c, 0x69, 0x76,
+	0x65, 0x72, 0x52, 0x0a, 0x75, 0x72, 0x62, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x12, 0x3e,
+	0x0a, 0x0f, 0x75, 0x72, 0x62, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x44, 0x61, 0x74,
+	0x61, 0x18, 0x84, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x62, 0x2e, 0x55, 0x72,
+	0x62, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x44, 0x61, 0x74, 0x61, 0x52, 0x0f, 0x75,
+	0x72, 0x62, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x44, 0x61, 0x74, 0x61, 0x12, 0x35,
+	0x0a, 0x0c, 0x66, 0x72, 0x62, 0x42, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x18, 0x8c,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x62, 0x2e, 0x46, 0x72, 0x62, 0x42, 0x72,
+	0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x52, 0x0c, 0x66, 0x72, 0x62, 0x42, 0x72, 0x6f, 0x61,
+	0x64, 0x63, 0x61, 0x73, 0x74, 0x12, 0x2f, 0x0a, 0x0a, 0x66, 0x72, 0x62, 0x44, 0x65, 0x6c, 0x69,
+	0x76, 0x65, 0x72, 0x18, 0x8d, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x62, 0x2e,
+	0x46, 0x72, 0x62, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x52, 0x0a, 0x66, 0x72, 0x62, 0x44,
+	0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x12, 0x3e, 0x0a, 0x0f, 0x66, 0x72, 0x62, 0x49, 0x6e, 0x74,
+	0x65, 0x72, 0x6e, 0x61, 0x6c, 0x44, 0x61, 0x74, 0x61, 0x18, 0x8e, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x13, 0x2e, 0x70, 0x62, 0x2e, 0x46, 0x72, 0x62, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61,
+	0x6c, 0x44, 0x61, 0x74, 0x61, 0x52, 0x0f, 0x66, 0x72, 0x62, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e,
+	0x61, 0x6c, 0x44, 0x61, 0x74, 0x61, 0x12, 0x35, 0x0a, 0x0c, 0x63, 0x72, 0x62, 0x42, 0x72, 0x6f,
+	0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x18, 0x96, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e,
+	0x70, 0x62, 0x2e, 0x43, 0x72, 0x62, 0x42, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x52,
+	0x0c, 0x63, 0x72, 0x62, 0x42, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x12, 0x2f, 0x0a,
+	0x0a, 0x63, 0x72, 0x62, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x18, 0x97, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x62, 0x44, 0x65, 0x6c, 0x69, 0x76,
+	0x65, 0x72, 0x52, 0x0a, 0x63, 0x72, 0x62, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x12, 0x3e,
+	0x0a, 0x0f, 0x63, 0x72, 0x62, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x44, 0x61, 0x74,
+	0x61, 0x18, 0x98, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x72,
+	0x62, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x44, 0x61, 0x74, 0x61, 0x52, 0x0f, 0x63,
+	0x72, 0x62, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x44, 0x61, 0x74, 0x61, 0x12, 0x32,
+	0x0a, 0x0b, 0x70, 0x62, 0x42, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x18, 0xa0, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x62, 0x42, 0x72, 0x6f, 0x61,
+	0x64, 0x63, 0x61, 0x73, 0x74, 0x52, 0x0b, 0x70, 0x62, 0x42, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61,
+	0x73, 0x74, 0x12, 0x2c, 0x0a, 0x09, 0x70, 0x62, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x18,
+	0xa1, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x62, 0x44, 0x65,
+	0x6c, 0x69, 0x76, 0x65, 0x72, 0x52, 0x09, 0x70, 0x62, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72,
+	0x12, 0x41, 0x0a, 0x10, 0x70, 0x62, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x47, 0x6f,
+	0x73, 0x73, 0x69, 0x70, 0x18, 0xa2, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x62,
+	0x2e, 0x50, 0x62, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x47, 0x6f, 0x73, 0x73, 0x69,
+	0x70, 0x52, 0x10, 0x70, 0x62, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x47, 0x6f, 0x73,
+	0x73, 0x69, 0x70, 0x12, 0x44, 0x0a, 0x11, 0x70, 0x62, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61,
+	0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0xa3, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x15, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x62, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x11, 0x70, 0x62, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e,
+	0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3b, 0x0a, 0x0e, 0x70, 0x62, 0x49,
+	0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x44, 0x61, 0x74, 0x61, 0x18, 0xa4, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x62, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e,
+	0x61, 0x6c, 0x44, 0x61, 0x74, 0x61, 0x52, 0x0e, 0x70, 0x62, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e,
+	0x61, 0x6c, 0x44, 0x61, 0x74, 0x61, 0x12, 0x2c, 0x0a, 0x09, 0x70, 0x62, 0x54, 0x69, 0x6d, 0x65,
+	0x6f, 0x75, 0x74, 0x18, 0xa5, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x70, 0x62, 0x2e,
+	0x50, 0x62, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x52, 0x09, 0x70, 0x62, 0x54, 0x69, 0x6d,
+	0x65, 0x6f, 0x75, 0x74, 0x12, 0x35, 0x0a, 0x0c, 0x74, 0x6f, 0x62, 0x42, 0x72, 0x6f, 0x61, 0x64,
+	0x63, 0x61, 0x73, 0x74, 0x18, 0xaa, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x62,
+	0x2e, 0x54, 0x6f, 0x62, 0x42, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x52, 0x0c, 0x74,
+	0x6f, 0x62, 0x42, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x12, 0x2f, 0x0a, 0x0a, 0x74,
+	0x6f, 0x62, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x18, 0xab, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0e, 0x2e, 0x70, 0x62, 0x2e, 0x54, 0x6f, 0x62, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72,
+	0x52, 0x0a, 0x74, 0x6f, 0x62, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x12, 0x3e, 0x0a, 0x0f,
+	0x74, 0x6f, 0x62, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x44, 0x61, 0x74, 0x61, 0x18,
+	0xac, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x62, 0x2e, 0x54, 0x6f, 0x62, 0x49,
+	0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x44, 0x61, 0x74, 0x61, 0x52, 0x0f, 0x74, 0x6f, 0x62,
+	0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x44, 0x61, 0x74, 0x61, 0x12, 0x35, 0x0a, 0x0c,
+	0x74, 0x72, 0x62, 0x42, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x18, 0xb4, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x62, 0x2e, 0x54, 0x72, 0x62, 0x42, 0x72, 0x6f, 0x61,
+	0x64, 0x63, 0x61, 0x73, 0x74, 0x52, 0x0c, 0x74, 0x72, 0x62, 0x42, 0x72, 0x6f, 0x61, 0x64, 0x63,
+	0x61, 0x73, 0x74, 0x12, 0x2f, 0x0a, 0x0a, 0x74, 0x72, 0x62, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65,
+	0x72, 0x18, 0xb5, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x62, 0x2e, 0x54, 0x72,
+	0x62, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x52, 0x0a, 0x74, 0x72, 0x62, 0x44, 0x65, 0x6c,
+	0x69, 0x76, 0x65, 0x72, 0x12, 0x3e, 0x0a, 0x0f, 0x74, 0x72, 0x62, 0x49, 0x6e, 0x74, 0x65, 0x72,
+	0x6e, 0x61, 0x6c, 0x44, 0x61, 0x74, 0x61, 0x18, 0xb6, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13,
+	0x2e, 0x70, 0x62, 0x2e, 0x54, 0x72, 0x62, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x44,
+	0x61, 0x74, 0x61, 0x52, 0x0f, 0x74, 0x72, 0x62, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c,
+	0x44, 0x61, 0x74, 0x61, 0x12, 0x32, 0x0a, 0x0b, 0x6e, 0x62, 0x61, 0x63, 0x50, 0x72, 0x6f, 0x70,
+	0x6f, 0x73, 0x65, 0x18, 0xbe, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x62, 0x2e,
+	0x4e, 0x62, 0x61, 0x63, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x52, 0x0b, 0x6e, 0x62, 0x61,
+	0x63, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x12, 0x2f, 0x0a, 0x0a, 0x6e, 0x62, 0x61, 0x63,
+	0x44, 0x65, 0x63, 0x69, 0x64, 0x65, 0x18, 0xbf, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e,
+	0x70, 0x62, 0x2e, 0x4e, 0x62, 0x61, 0x63, 0x44, 0x65, 0x63, 0x69, 0x64, 0x65, 0x52, 0x0a, 0x6e,
+	0x62, 0x61, 0x63, 0x44, 0x65, 0x63, 0x69, 0x64, 0x65, 0x12, 0x41, 0x0a, 0x10, 0x6e, 0x62, 0x61,
+	0x63, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x56, 0x6f, 0x74, 0x65, 0x18, 0xc0, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x62, 0x2e, 0x4e, 0x62, 0x61, 0x63, 0x49, 0x6e,
+	0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x56, 0x6f, 0x74, 0x65, 0x52, 0x10, 0x6e, 0x62, 0x61, 0x63,
+	0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x56, 0x6f, 0x74, 0x65, 0x12, 0x32, 0x0a, 0x0b,
+	0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x53, 0x74, 0x61, 0x72, 0x74, 0x18, 0xc8, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x53, 0x74,
+	0x61, 0x72, 0x74, 0x52, 0x0b, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x53, 0x74, 0x61, 0x72, 0x74,
+	0x12, 0x38, 0x0a, 0x0d, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x4f, 0x75, 0x74, 0x63, 0x6f, 0x6d,
+	0x65, 0x18, 0xc9, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x6f,
+	0x6d, 0x6d, 0x69, 0x74, 0x4f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x52, 0x0d, 0x63, 0x6f, 0x6d,
+	0x6d, 0x69, 0x74, 0x4f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x12, 0x50, 0x0a, 0x15, 0x63, 0x6f,
+	0x6d, 0x6d, 0x69, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x50, 0x72, 0x65, 0x70,
+	0x61, 0x72, 0x65, 0x18, 0xca, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x70, 0x62, 0x2e,
+	0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x50, 0x72,
+	0x65, 0x70, 0x61, 0x72, 0x65, 0x52, 0x15, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x49, 0x6e, 0x74,
+	0x65, 0x72, 0x6e, 0x61, 0x6c, 0x50, 0x72, 0x65, 0x70, 0x61, 0x72, 0x65, 0x12, 0x47, 0x0a, 0x12,
+	0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x56, 0x6f,
+	0x74, 0x65, 0x18, 0xcb, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70, 0x62, 0x2e, 0x43,
+	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x56, 0x6f, 0x74,
+	0x65, 0x52, 0x12, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61,
+	0x6c, 0x56, 0x6f, 0x74, 0x65, 0x12, 0x56, 0x0a, 0x17, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x49,
+	0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x50, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74,
+	0x18, 0xcc, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x6d,
+	0x6d, 0x69, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x50, 0x72, 0x65, 0x63, 0x6f,
+	0x6d, 0x6d, 0x69, 0x74, 0x52, 0x17, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x49, 0x6e, 0x74, 0x65,
+	0x72, 0x6e, 0x61, 0x6c, 0x50, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x12, 0x44, 0x0a,
+	0x11, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x41,
+	0x63, 0x6b, 0x18, 0xcd, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x70, 0x62, 0x2e, 0x43,
+	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x41, 0x63, 0x6b,
+	0x52, 0x11, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c,
+	0x41, 0x63, 0x6b, 0x12, 0x53, 0x0a, 0x16, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x49, 0x6e, 0x74,
+	0x65, 0x72, 0x6e, 0x61, 0x6c, 0x44, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0xce, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74,
+	0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x44, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e,
+	0x52, 0x16, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c,
+	0x44, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x4a, 0x0a, 0x13, 0x63, 0x6f, 0x6d, 0x6d,
+	0x69, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x65, 0x18,
+	0xcf, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x6d, 0x6d,
+	0x69, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52,
+	0x13, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x53,
+	0x74, 0x61, 0x74, 0x65, 0x12, 0x23, 0x0a, 0x06, 0x67, 0x6d, 0x56, 0x69, 0x65, 0x77, 0x18, 0xd2,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x6d, 0x56, 0x69, 0x65,
+	0x77, 0x52, 0x06, 0x67, 0x6d, 0x56, 0x69, 0x65, 0x77, 0x12, 0x38, 0x0a, 0x0d, 0x67, 0x6d, 0x52,
+	0x65, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x65, 0x18, 0xd3, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x11, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x6d, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x75, 0x72, 0x65, 0x52, 0x0d, 0x67, 0x6d, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x75, 0x72, 0x65, 0x12, 0x32, 0x0a, 0x0b, 0x76, 0x73, 0x42, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61,
+	0x73, 0x74, 0x18, 0xdc, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x62, 0x2e, 0x56,
+	0x73, 0x42, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x52, 0x0b, 0x76, 0x73, 0x42, 0x72,
+	0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x12, 0x2c, 0x0a, 0x09, 0x76, 0x73, 0x44, 0x65, 0x6c,
+	0x69, 0x76, 0x65, 0x72, 0x18, 0xdd, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x70, 0x62,
+	0x2e, 0x56, 0x73, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x52, 0x09, 0x76, 0x73, 0x44, 0x65,
+	0x6c, 0x69, 0x76, 0x65, 0x72, 0x12, 0x23, 0x0a, 0x06, 0x76, 0x73, 0x56, 0x69, 0x65, 0x77, 0x18,
+	0xde, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x70, 0x62, 0x2e, 0x56, 0x73, 0x56, 0x69,
+	0x65, 0x77, 0x52, 0x06, 0x76, 0x73, 0x56, 0x69, 0x65, 0x77, 0x12, 0x3b, 0x0a, 0x0e, 0x76, 0x73,
+	0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x44, 0x61, 0x74, 0x61, 0x18, 0xdf, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70, 0x62, 0x2e, 0x56, 0x73, 0x49, 0x6e, 0x74, 0x65, 0x72,
+	0x6e, 0x61, 0x6c, 0x44, 0x61, 0x74, 0x61, 0x52, 0x0e, 0x76, 0x73, 0x49, 0x6e, 0x74, 0x65, 0x72,
+	0x6e, 0x61, 0x6c, 0x44, 0x61, 0x74, 0x61, 0x12, 0x44, 0x0a, 0x11, 0x76, 0x73, 0x49, 0x6e, 0x74,
+	0x65, 0x72, 0x6e, 0x61, 0x6c, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x18, 0xe0, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x70, 0x62, 0x2e, 0x56, 0x73, 0x49, 0x6e, 0x74, 0x65, 0x72,
+	0x6e, 0x61, 0x6c, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x11, 0x76, 0x73, 0x49, 0x6e,
+	0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x50, 0x0a,
+	0x15, 0x70, 0x72, 0x6f, 0x63, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x65,
+	0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x18, 0xe6, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e,
+	0x70, 0x62, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75,
+	0x72, 0x65, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x52, 0x15, 0x70, 0x72, 0x6f, 0x63, 0x52, 0x65,
+	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x65, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x12,
+	0x4a, 0x0a, 0x13, 0x72, 0x65, 0x63, 0x6f, 0x6e, 0x66, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61,
+	0x6c, 0x53, 0x74, 0x61, 0x74, 0x65, 0x18, 0xe7, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e,
+	0x70, 0x62, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x66, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61,
+	0x6c, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x13, 0x72, 0x65, 0x63, 0x6f, 0x6e, 0x66, 0x49, 0x6e,
+	0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x29, 0x0a, 0x08, 0x6f,
+	0x6e, 0x72, 0x72, 0x52, 0x65, 0x61, 0x64, 0x18, 0xf0, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c,
+	0x2e, 0x70, 0x62, 0x2e, 0x4f, 0x6e, 0x72, 0x72, 0x52, 0x65, 0x61, 0x64, 0x52, 0x08, 0x6f, 0x6e,
+	0x72, 0x72, 0x52, 0x65, 0x61, 0x64, 0x12, 0x3b, 0x0a, 0x0e, 0x6f, 0x6e, 0x72, 0x72, 0x52, 0x65,
+	0x61, 0x64, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x18, 0xf1, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x12, 0x2e, 0x70, 0x62, 0x2e, 0x4f, 0x6e, 0x72, 0x72, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x74,
+	0x75, 0x72, 0x6e, 0x52, 0x0e, 0x6f, 0x6e, 0x72, 0x72, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x74,
+	0x75, 0x72, 0x6e, 0x12, 0x2c, 0x0a, 0x09, 0x6f, 0x6e, 0x72, 0x72, 0x57, 0x72, 0x69, 0x74, 0x65,
+	0x18, 0xf2, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x70, 0x62, 0x2e, 0x4f, 0x6e, 0x72,
+	0x72, 0x57, 0x72, 0x69, 0x74, 0x65, 0x52, 0x09, 0x6f, 0x6e, 0x72, 0x72, 0x57, 0x72, 0x69, 0x74,
+	0x65, 0x12, 0x3e, 0x0a, 0x0f, 0x6f, 0x6e, 0x72, 0x72, 0x57, 0x72, 0x69, 0x74, 0x65, 0x52, 0x65,
+	0x74, 0x75, 0x72, 0x6e, 0x18, 0xf3, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x62,
+	0x2e, 0x4f, 0x6e, 0x72, 0x72, 0x57, 0x72, 0x69, 0x74, 0x65, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e,
+	0x52, 0x0f, 0x6f, 0x6e, 0x72, 0x72, 0x57, 0x72, 0x69, 0x74, 0x65, 0x52, 0x65, 0x74, 0x75, 0x72,
+	0x6e, 0x12, 0x41, 0x0a, 0x10, 0x6f, 0x6e, 0x72, 0x72, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61,
+	0x6c, 0x52, 0x65, 0x61, 0x64, 0x18, 0xf4, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70,
+	0x62, 0x2e, 0x4f, 0x6e, 0x72, 0x72, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x52, 0x65,
+	0x61, 0x64, 0x52, 0x10, 0x6f, 0x6e, 0x72, 0x72, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c,
+	0x52, 0x65, 0x61, 0x64, 0x12, 0x44, 0x0a, 0x11, 0x6f, 0x6e, 0x72, 0x72, 0x49, 0x6e, 0x74, 0x65,
+	0x72, 0x6e, 0x61, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x18, 0xf5, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x15, 0x2e, 0x70, 0x62, 0x2e, 0x4f, 0x6e, 0x72, 0x72, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e,
+	0x61, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x11, 0x6f, 0x6e, 0x72, 0x72, 0x49, 0x6e, 0x74,
+	0x65, 0x72, 0x6e, 0x61, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x44, 0x0a, 0x11, 0x6f, 0x6e,
+	0x72, 0x72, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x57, 0x72, 0x69, 0x74, 0x65, 0x18,
+	0xf6, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x70, 0x62, 0x2e, 0x4f, 0x6e, 0x72, 0x72,
+	0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x57, 0x72, 0x69, 0x74, 0x65, 0x52, 0x11, 0x6f,
+	0x6e, 0x72, 0x72, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x57, 0x72, 0x69, 0x74, 0x65,
+	0x12, 0x3e, 0x0a, 0x0f, 0x6f, 0x6e, 0x72, 0x72, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c,
+	0x41, 0x63, 0x6b, 0x18, 0xf7, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x62, 0x2e,
+	0x4f, 0x6e, 0x72, 0x72, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x41, 0x63, 0x6b, 0x52,
+	0x0f, 0x6f, 0x6e, 0x72, 0x72, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x41, 0x63, 0x6b,
+	0x12, 0x29, 0x0a, 0x08, 0x6f, 0x6e, 0x61, 0x72, 0x52, 0x65, 0x61, 0x64, 0x18, 0xfa, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x4f, 0x6e, 0x61, 0x72, 0x52, 0x65, 0x61,
+	0x64, 0x52, 0x08, 0x6f, 0x6e, 0x61, 0x72, 0x52, 0x65, 0x61, 0x64, 0x12, 0x3b, 0x0a, 0x0e, 0x6f,
+	0x6e, 0x61, 0x72, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x18, 0xfb, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70, 0x62, 0x2e, 0x4f, 0x6e, 0x61, 0x72, 0x52, 0x65,
+	0x61, 0x64, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x52, 0x0e, 0x6f, 0x6e, 0x61, 0x72, 0x52, 0x65,
+	0x61, 0x64, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x12, 0x2c, 0x0a, 0x09, 0x6f, 0x6e, 0x61, 0x72,
+	0x57, 0x72, 0x69, 0x74, 0x65, 0x18, 0xfc, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x70,
+	0x62, 0x2e, 0x4f, 0x6e, 0x61, 0x72, 0x57, 0x72, 0x69, 0x74, 0x65, 0x52, 0x09, 0x6f, 0x6e, 0x61,
+	0x72, 0x57, 0x72, 0x69, 0x74, 0x65, 0x12, 0x3e, 0x0a, 0x0f, 0x6f, 0x6e, 0x61, 0x72, 0x57, 0x72,
+	0x69, 0x74, 0x65, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x18, 0xfd, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x13, 0x2e, 0x70, 0x62, 0x2e, 0x4f, 0x6e, 0x61, 0x72, 0x57, 0x72, 0x69, 0x74, 0x65, 0x52,
+	0x65, 0x74, 0x75, 0x72, 0x6e, 0x52, 0x0f, 0x6f, 0x6e, 0x61, 0x72, 0x57, 0x72, 0x69, 0x74, 0x65,
+	0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x12, 0x35, 0x0a, 0x0c, 0x61, 0x70, 0x70, 0x4c, 0x6f, 0x67,
+	0x41, 0x70, 0x70, 0x65, 0x6e, 0x64, 0x18, 0x84, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e,
+	0x70, 0x62, 0x2e, 0x41, 0x70, 0x70, 0x4c, 0x6f, 0x67, 0x41, 0x70, 0x70, 0x65, 0x6e, 0x64, 0x52,
+	0x0c, 0x61, 0x70, 0x70, 0x4c, 0x6f, 0x67, 0x41, 0x70, 0x70, 0x65, 0x6e, 0x64, 0x12, 0x3b, 0x0a,
+	0x0e, 0x61, 0x70, 0x70, 0x4c, 0x6f, 0x67, 0x41, 0x70, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x18,
+	0x85, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70, 0x62, 0x2e, 0x41, 0x70, 0x70, 0x4c,
+	0x6f, 0x67, 0x41, 0x70, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x52, 0x0e, 0x61, 0x70, 0x70, 0x4c,
+	0x6f, 0x67, 0x41, 0x70, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x12, 0x3e, 0x0a, 0x0f, 0x61, 0x70,
+	0x70, 0x4c, 0x6f, 0x67, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x64, 0x18, 0x86, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x62, 0x2e, 0x41, 0x70, 0x70, 0x4c, 0x6f, 0x67,
+	0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x64, 0x52, 0x0f, 0x61, 0x70, 0x70, 0x4c, 0x6f,
+	0x67, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x64, 0x12, 0x2c, 0x0a, 0x09, 0x6c, 0x6f,
+	0x67, 0x41, 0x70, 0x70, 0x65, 0x6e, 0x64, 0x18, 0x8e, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d,
+	0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x6f, 0x67, 0x41, 0x70, 0x70, 0x65, 0x6e, 0x64, 0x52, 0x09, 0x6c,
+	0x6f, 0x67, 0x41, 0x70, 0x70, 0x65, 0x6e, 0x64, 0x12, 0x32, 0x0a, 0x0b, 0x6c, 0x6f, 0x67, 0x41,
+	0x70, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x18, 0x8f, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f,
+	0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x6f, 0x67, 0x41, 0x70, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x52,
+	0x0b, 0x6c, 0x6f, 0x67, 0x41, 0x70, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x12, 0x35, 0x0a, 0x0c,
+	0x6c, 0x6f, 0x67, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x64, 0x18, 0x90, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x6f, 0x67, 0x43, 0x6f, 0x6d, 0x6d,
+	0x69, 0x74, 0x74, 0x65, 0x64, 0x52, 0x0c, 0x6c, 0x6f, 0x67, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74,
+	0x74, 0x65, 0x64, 0x12, 0x47, 0x0a, 0x12, 0x6c, 0x6f, 0x67, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e,
+	0x61, 0x6c, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x18, 0x91, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x16, 0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x6f, 0x67, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61,
+	0x6c, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x52, 0x12, 0x6c, 0x6f, 0x67, 0x49, 0x6e, 0x74,
+	0x65, 0x72, 0x6e, 0x61, 0x6c, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x12, 0x47, 0x0a, 0x12,
+	0x6c, 0x6f, 0x67, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x50, 0x72, 0x65, 0x70, 0x61,
+	0x72, 0x65, 0x18, 0x92, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70, 0x62, 0x2e, 0x4c,
+	0x6f, 0x67, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x50, 0x72, 0x65, 0x70, 0x61, 0x72,
+	0x65, 0x52, 0x12, 0x6c, 0x6f, 0x67, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x50, 0x72,
+	0x65, 0x70, 0x61, 0x72, 0x65, 0x12, 0x47, 0x0a, 0x12, 0x6c, 0x6f, 0x67, 0x49, 0x6e, 0x74, 0x65,
+	0x72, 0x6e, 0x61, 0x6c, 0x50, 0x72, 0x6f, 0x6d, 0x69, 0x73, 0x65, 0x18, 0x93, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x6f, 0x67, 0x49, 0x6e, 0x74, 0x65, 0x72,
+	0x6e, 0x61, 0x6c, 0x50, 0x72, 0x6f, 0x6d, 0x69, 0x73, 0x65, 0x52, 0x12, 0x6c, 0x6f, 0x67, 0x49,
+	0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x50, 0x72, 0x6f, 0x6d, 0x69, 0x73, 0x65, 0x12, 0x44,
+	0x0a, 0x11, 0x6c, 0x6f, 0x67, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x41, 0x63, 0x63,
+	0x65, 0x70, 0x74, 0x18, 0x94, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x70, 0x62, 0x2e,
+	0x4c, 0x6f, 0x67, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x41, 0x63, 0x63, 0x65, 0x70,
+	0x74, 0x52, 0x11, 0x6c, 0x6f, 0x67, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x41, 0x63,
+	0x63, 0x65, 0x70, 0x74, 0x12, 0x4a, 0x0a, 0x13, 0x6c, 0x6f, 0x67, 0x49, 0x6e, 0x74, 0x65, 0x72,
+	0x6e, 0x61, 0x6c, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x65, 0x64, 0x18, 0x95, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x6f, 0x67, 0x49, 0x6e, 0x74, 0x65, 0x72,
+	0x6e, 0x61, 0x6c, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x65, 0x64, 0x52, 0x13, 0x6c, 0x6f, 0x67,
+	0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x65, 0x64,
+	0x12, 0x44, 0x0a, 0x11, 0x6c, 0x6f, 0x67, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x43,
+	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x18, 0x96, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x70,
+	0x62, 0x2e, 0x4c, 0x6f, 0x67, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x43, 0x6f, 0x6d,
+	0x6d, 0x69, 0x74, 0x52, 0x11, 0x6c, 0x6f, 0x67, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c,
+	0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x12, 0x32, 0x0a, 0x0b, 0x72, 0x61, 0x66, 0x74, 0x54, 0x69,
+	0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x98, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70,
+	0x62, 0x2e, 0x52, 0x61, 0x66, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x52, 0x0b, 0x72,
+	0x61, 0x66, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x56, 0x0a, 0x17, 0x72, 0x61,
+	0x66, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x56, 0x6f, 0x74, 0x65, 0x18, 0x99, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x70,
+	0x62, 0x2e, 0x52, 0x61, 0x66, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x6f, 0x74, 0x65, 0x52, 0x17, 0x72, 0x61, 0x66, 0x74, 0x49,
+	0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x6f,
+	0x74, 0x65, 0x12, 0x41, 0x0a, 0x10, 0x72, 0x61, 0x66, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e,
+	0x61, 0x6c, 0x56, 0x6f, 0x74, 0x65, 0x18, 0x9a, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e,
+	0x70, 0x62, 0x2e, 0x52, 0x61, 0x66, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x56,
+	0x6f, 0x74, 0x65, 0x52, 0x10, 0x72, 0x61, 0x66, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61,
+	0x6c, 0x56, 0x6f, 0x74, 0x65, 0x12, 0x5c, 0x0a, 0x19, 0x72, 0x61, 0x66, 0x74, 0x49, 0x6e, 0x74,
+	0x65, 0x72, 0x6e, 0x61, 0x6c, 0x41, 0x70, 0x70, 0x65, 0x6e, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x69,
+	0x65, 0x73, 0x18, 0x9b, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x70, 0x62, 0x2e, 0x52,
+	0x61, 0x66, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x41, 0x70, 0x70, 0x65, 0x6e,
+	0x64, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x52, 0x19, 0x72, 0x61, 0x66, 0x74, 0x49, 0x6e,
+	0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x41, 0x70, 0x70, 0x65, 0x6e, 0x64, 0x45, 0x6e, 0x74, 0x72,
+	0x69, 0x65, 0x73, 0x12, 0x59, 0x0a, 0x18, 0x72, 0x61, 0x66, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x72,
+	0x6e, 0x61, 0x6c, 0x41, 0x70, 0x70, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18,
+	0x9c, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x61, 0x66, 0x74,
+	0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x41, 0x70, 0x70, 0x65, 0x6e, 0x64, 0x52, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x52, 0x18, 0x72, 0x61, 0x66, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e,
+	0x61, 0x6c, 0x41, 0x70, 0x70, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x4a,
+	0x0a, 0x13, 0x72, 0x61, 0x66, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x46, 0x6f,
+	0x72, 0x77, 0x61, 0x72, 0x64, 0x18, 0x9d, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70,
+	0x62, 0x2e, 0x52, 0x61, 0x66, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x46, 0x6f,
+	0x72, 0x77, 0x61, 0x72, 0x64, 0x52, 0x13, 0x72, 0x61, 0x66, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x72,
+	0x6e, 0x61, 0x6c, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x12, 0x35, 0x0a, 0x0c, 0x61, 0x70,
+	0x70, 0x52, 0x63, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x18, 0xa2, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x10, 0x2e, 0x70, 0x62, 0x2e, 0x41, 0x70, 0x70, 0x52, 0x63, 0x50, 0x72, 0x6f, 0x70,
+	0x6f, 0x73, 0x65, 0x52, 0x0c, 0x61, 0x70, 0x70, 0x52, 0x63, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73,
+	0x65, 0x12, 0x32, 0x0a, 0x0b, 0x61, 0x70, 0x70, 0x52, 0x63, 0x44, 0x65, 0x63, 0x69, 0x64, 0x65,
+	0x18, 0xa3, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x62, 0x2e, 0x41, 0x70, 0x70,
+	0x52, 0x63, 0x44, 0x65, 0x63, 0x69, 0x64, 0x65, 0x52, 0x0b, 0x61, 0x70, 0x70, 0x52, 0x63, 0x44,
+	0x65, 0x63, 0x69, 0x64, 0x65, 0x12, 0x47, 0x0a, 0x12, 0x72, 0x63, 0x49, 0x6e, 0x74, 0x65, 0x72,
+	0x6e, 0x61, 0x6c, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x18, 0xa4, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x63, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e,
+	0x61, 0x6c, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x52, 0x12, 0x72, 0x63, 0x49, 0x6e,
+	0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x12, 0x3e,
+	0x0a, 0x0f, 0x62, 0x63, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x50, 0x68, 0x61, 0x73,
+	0x65, 0x18, 0xa5, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x62, 0x2e, 0x42, 0x63,
+	0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x50, 0x68, 0x61, 0x73, 0x65, 0x52, 0x0f, 0x62,
+	0x63, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x50, 0x68, 0x61, 0x73, 0x65, 0x12, 0x44,
+	0x0a, 0x11, 0x62, 0x63, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x44, 0x65, 0x63, 0x69,
+	0x64, 0x65, 0x64, 0x18, 0xa6, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x70, 0x62, 0x2e,
+	0x42, 0x63, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x44, 0x65, 0x63, 0x69, 0x64, 0x65,
+	0x64, 0x52, 0x11, 0x62, 0x63, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x44, 0x65, 0x63,
+	0x69, 0x64, 0x65, 0x64, 0x12, 0x59, 0x0a, 0x18, 0x66, 0x6c, 0x6f, 0x6f, 0x64, 0x69, 0x6e, 0x67,
+	0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c,
+	0x18, 0xac, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x70, 0x62, 0x2e, 0x46, 0x6c, 0x6f,
+	0x6f, 0x64, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x50, 0x72, 0x6f,
+	0x70, 0x6f, 0x73, 0x61, 0x6c, 0x52, 0x18, 0x66, 0x6c, 0x6f, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x49,
+	0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x12,
+	0x56, 0x0a, 0x17, 0x66, 0x6c, 0x6f, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x74, 0x65, 0x72,
+	0x6e, 0x61, 0x6c, 0x44, 0x65, 0x63, 0x69, 0x64, 0x65, 0x64, 0x18, 0xad, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1b, 0x2e, 0x70, 0x62, 0x2e, 0x46, 0x6c, 0x6f, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x49,
+	0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x44, 0x65, 0x63, 0x69, 0x64, 0x65, 0x64, 0x52, 0x17,
+	0x66, 0x6c, 0x6f, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c,
+	0x44, 0x65, 0x63, 0x69, 0x64, 0x65, 0x64, 0x12, 0x65, 0x0a, 0x1c, 0x68, 0x69, 0x65, 0x72, 0x61,
+	0x72, 0x63, 0x68, 0x69, 0x63, 0x61, 0x6c, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x50,
+	0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x18, 0xae, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20,
+	0x2e, 0x70, 0x62, 0x2e, 0x48, 0x69, 0x65, 0x72, 0x61, 0x72, 0x63, 0x68, 0x69, 0x63, 0x61, 0x6c,
+	0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c,
+	0x52, 0x1c, 0x68, 0x69, 0x65, 0x72, 0x61, 0x72, 0x63, 0x68, 0x69, 0x63, 0x61, 0x6c, 0x49, 0x6e,
+	0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x12, 0x56,
+	0x0a, 0x17, 0x68, 0x69, 0x65, 0x72, 0x61, 0x72, 0x63, 0x68, 0x69, 0x63, 0x61, 0x6c, 0x49, 0x6e,
+	0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x41, 0x63, 0x6b, 0x18, 0xaf, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1b, 0x2e, 0x70, 0x62, 0x2e, 0x48, 0x69, 0x65, 0x72, 0x61, 0x72, 0x63, 0x68, 0x69, 0x63,
+	0x61, 0x6c, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x41, 0x63, 0x6b, 0x52, 0x17, 0x68,
+	0x69, 0x65, 0x72, 0x61, 0x72, 0x63, 0x68, 0x69, 0x63, 0x61, 0x6c, 0x49, 0x6e, 0x74, 0x65, 0x72,
+	0x6e, 0x61, 0x6c, 0x41, 0x63, 0x6b, 0x12, 0x62, 0x0a, 0x1b, 0x68, 0x69, 0x65, 0x72, 0x61, 0x72,
+	0x63, 0x68, 0x69, 0x63, 0x61, 0x6c, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x44, 0x65,
+	0x63, 0x69, 0x64, 0x65, 0x64, 0x18, 0xb0, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x70,
+	0x62, 0x2e, 0x48, 0x69, 0x65, 0x72, 0x61, 0x72, 0x63, 0x68, 0x69, 0x63, 0x61, 0x6c, 0x49, 0x6e,
+	0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x44, 0x65, 0x63, 0x69, 0x64, 0x65, 0x64, 0x52, 0x1b, 0x68,
+	0x69, 0x65, 0x72, 0x61, 0x72, 0x63, 0x68, 0x69, 0x63, 0x61, 0x6c, 0x49, 0x6e, 0x74, 0x65, 0x72,
+	0x6e, 0x61, 0x6c, 0x44, 0x65, 0x63, 0x69, 0x64, 0x65, 0x64, 0x12, 0x3e, 0x0a, 0x0f, 0x61, 0x70,
+	0x70, 0x42, 0x63, 0x62, 0x42, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x18, 0xb6, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x62, 0x2e, 0x41, 0x70, 0x70, 0x42, 0x63, 0x62,
+	0x42, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x52, 0x0f, 0x61, 0x70, 0x70, 0x42, 0x63,
+	0x62, 0x42, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x12, 0x3e, 0x0a, 0x0f, 0x61, 0x70,
+	0x70, 0x42, 0x72, 0x62, 0x42, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x18, 0xb7, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x62, 0x2e, 0x41, 0x70, 0x70, 0x42, 0x72, 0x62,
+	0x42, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x52, 0x0f, 0x61, 0x70, 0x70, 0x42, 0x72,
+	0x62, 0x42, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x12, 0x35, 0x0a, 0x0c, 0x62, 0x63,
+	0x62, 0x42, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x18, 0xb8, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x10, 0x2e, 0x70, 0x62, 0x2e, 0x42, 0x63, 0x62, 0x42, 0x72, 0x6f, 0x61, 0x64, 0x63,
+	0x61, 0x73, 0x74, 0x52, 0x0c, 0x62, 0x63, 0x62, 0x42, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73,
+	0x74, 0x12, 0x2f, 0x0a, 0x0a, 0x62, 0x63, 0x62, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x18,
+	0xb9, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x62, 0x2e, 0x42, 0x63, 0x62, 0x44,
+	0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x52, 0x0a, 0x62, 0x63, 0x62, 0x44, 0x65, 0x6c, 0x69, 0x76,
+	0x65, 0x72, 0x12, 0x35, 0x0a, 0x0c, 0x62, 0x72, 0x62, 0x42, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61,
+	0x73, 0x74, 0x18, 0xba, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x62, 0x2e, 0x42,
+	0x72, 0x62, 0x42, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x52, 0x0c, 0x62, 0x72, 0x62,
+	0x42, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x12, 0x2f, 0x0a, 0x0a, 0x62, 0x72, 0x62,
+	0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x18, 0xbb, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e,
+	0x2e, 0x70, 0x62, 0x2e, 0x42, 0x72, 0x62, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x52, 0x0a,
+	0x62, 0x72, 0x62, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x12, 0x50, 0x0a, 0x15, 0x62, 0x79,
+	0x7a, 0x61, 0x6e, 0x74, 0x69, 0x6e, 0x65, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x53,
+	0x65, 0x6e, 0x64, 0x18, 0xbc, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x70, 0x62, 0x2e,
+	0x42, 0x79, 0x7a, 0x61, 0x6e, 0x74, 0x69, 0x6e, 0x65, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61,
+	0x6c, 0x53, 0x65, 0x6e, 0x64, 0x52, 0x15, 0x62, 0x79, 0x7a, 0x61, 0x6e, 0x74, 0x69, 0x6e, 0x65,
+	0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x53, 0x65, 0x6e, 0x64, 0x12, 0x50, 0x0a, 0x15,
+	0x62, 0x79, 0x7a, 0x61, 0x6e, 0x74, 0x69, 0x6e, 0x65, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61,
+	0x6c, 0x45, 0x63, 0x68, 0x6f, 0x18, 0xbd, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x70,
+	0x62, 0x2e, 0x42, 0x79, 0x7a, 0x61, 0x6e, 0x74, 0x69, 0x6e, 0x65, 0x49, 0x6e, 0x74, 0x65, 0x72,
+	0x6e, 0x61, 0x6c, 0x45, 0x63, 0x68, 0x6f, 0x52, 0x15, 0x62, 0x79, 0x7a, 0x61, 0x6e, 0x74, 0x69,
+	0x6e, 0x65, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x45, 0x63, 0x68, 0x6f, 0x12, 0x53,
+	0x0a, 0x16, 0x62, 0x79, 0x7a, 0x61, 0x6e, 0x74, 0x69, 0x6e, 0x65, 0x49, 0x6e, 0x74, 0x65, 0x72,
+	0x6e, 0x61, 0x6c, 0x52, 0x65, 0x61, 0x64, 0x79, 0x18, 0xbe, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x70, 0x62, 0x2e, 0x42, 0x79, 0x7a, 0x61, 0x6e, 0x74, 0x69, 0x6e, 0x65, 0x49, 0x6e,
+	0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x52, 0x65, 0x61, 0x64, 0x79, 0x52, 0x16, 0x62, 0x79, 0x7a,
+	0x61, 0x6e, 0x74, 0x69, 0x6e, 0x65, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x52, 0x65,
+	0x61, 0x64, 0x79, 0x22, 0xa1, 0x1c, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x13, 0x0a, 0x0f,
+	0x4e, 0x45, 0x54, 0x57, 0x4f, 0x52, 0x4b, 0x5f, 0x4d, 0x45, 0x53, 0x53, 0x41, 0x47, 0x45, 0x10,
+	0x00, 0x12, 0x15, 0x0a, 0x11, 0x50, 0x52, 0x4f, 0x43, 0x5f, 0x52, 0x45, 0x47, 0x49, 0x53, 0x54,
+	0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x01, 0x12, 0x1a, 0x0a, 0x16, 0x50, 0x52, 0x4f, 0x43,
+	0x5f, 0x49, 0x4e, 0x49, 0x54, 0x49, 0x41, 0x4c, 0x49, 0x5a, 0x45, 0x5f, 0x53, 0x59, 0x53, 0x54,
+	0x45, 0x4d, 0x10, 0x02, 0x12, 0x17, 0x0a, 0x13, 0x50, 0x52, 0x4f, 0x43, 0x5f, 0x44, 0x45, 0x53,
+	0x54, 0x52, 0x4f, 0x59, 0x5f, 0x53, 0x59, 0x53, 0x54, 0x45, 0x4d, 0x10, 0x03, 0x12, 0x11, 0x0a,
+	0x0d, 0x41, 0x50, 0x50, 0x5f, 0x42, 0x52, 0x4f, 0x41, 0x44, 0x43, 0x41, 0x53, 0x54, 0x10, 0x04,
+	0x12, 0x0d, 0x0a, 0x09, 0x41, 0x50, 0x50, 0x5f, 0x56, 0x41, 0x4c, 0x55, 0x45, 0x10, 0x05, 0x12,
+	0x0e, 0x0a, 0x0a, 0x41, 0x50, 0x50, 0x5f, 0x44, 0x45, 0x43, 0x49, 0x44, 0x45, 0x10, 0x06, 0x12,
+	0x0f, 0x0a, 0x0b, 0x41, 0x50, 0x50, 0x5f, 0x50, 0x52, 0x4f, 0x50, 0x4f, 0x53, 0x45, 0x10, 0x07,
+	0x12, 0x0c, 0x0a, 0x08, 0x41, 0x50, 0x50, 0x5f, 0x52, 0x45, 0x41, 0x44, 0x10, 0x08, 0x12, 0x0d,
+	0x0a, 0x09, 0x41, 0x50, 0x50, 0x5f, 0x57, 0x52, 0x49, 0x54, 0x45, 0x10, 0x09, 0x12, 0x13, 0x0a,
+	0x0f, 0x41, 0x50, 0x50, 0x5f, 0x52, 0x45, 0x41, 0x44, 0x5f, 0x52, 0x45, 0x54, 0x55, 0x52, 0x4e,
+	0x10, 0x0a, 0x12, 0x14, 0x0a, 0x10, 0x41, 0x50, 0x50, 0x5f, 0x57, 0x52, 0x49, 0x54, 0x45, 0x5f,
+	0x52, 0x45, 0x54, 0x55, 0x52, 0x4e, 0x10, 0x0b, 0x12, 0x1a, 0x0a, 0x16, 0x41, 0x50, 0x50, 0x5f,
+	0x52, 0x45, 0x4c, 0x49, 0x41, 0x42, 0x4c, 0x45, 0x5f, 0x42, 0x52, 0x4f, 0x41, 0x44, 0x43, 0x41,
+	0x53, 0x54, 0x10, 0x0c, 0x12, 0x19, 0x0a, 0x15, 0x41, 0x50, 0x50, 0x5f, 0x55, 0x4e, 0x49, 0x46,
+	0x4f, 0x52, 0x4d, 0x5f, 0x42, 0x52, 0x4f, 0x41, 0x44, 0x43, 0x41, 0x53, 0x54, 0x10, 0x0d, 0x12,
+	0x16, 0x0a, 0x12, 0x41, 0x50, 0x50, 0x5f, 0x46, 0x49, 0x46, 0x4f, 0x5f, 0x42, 0x52, 0x4f, 0x41,
+	0x44, 0x43, 0x41, 0x53, 0x54, 0x10, 0x0e, 0x12, 0x18, 0x0a, 0x14, 0x41, 0x50, 0x50, 0x5f, 0x43,
+	0x41, 0x55, 0x53, 0x41, 0x4c, 0x5f, 0x42, 0x52, 0x4f, 0x41, 0x44, 0x43, 0x41, 0x53, 0x54, 0x10,
+	0x0f, 0x12, 0x1f, 0x0a, 0x1b, 0x41, 0x50, 0x50, 0x5f, 0x50, 0x52, 0x4f, 0x42, 0x41, 0x42, 0x49,
+	0x4c, 0x49, 0x53, 0x54, 0x49, 0x43, 0x5f, 0x42, 0x52, 0x4f, 0x41, 0x44, 0x43, 0x41, 0x53, 0x54,
+	0x10, 0x10, 0x12, 0x15, 0x0a, 0x11, 0x41, 0x50, 0x50, 0x5f, 0x54, 0x4f, 0x42, 0x5f, 0x42, 0x52,
+	0x4f, 0x41, 0x44, 0x43, 0x41, 0x53, 0x54, 0x10, 0x11, 0x12, 0x13, 0x0a, 0x0f, 0x41, 0x50, 0x50,
+	0x5f, 0x54, 0x4f, 0x42, 0x5f, 0x44, 0x45, 0x4c, 0x49, 0x56, 0x45, 0x52, 0x10, 0x12, 0x12, 0x0b,
+	0x0a, 0x07, 0x41, 0x50, 0x50, 0x5f, 0x54, 0x52, 0x42, 0x10, 0x13, 0x12, 0x13, 0x0a, 0x0f, 0x41,
+	0x50, 0x50, 0x5f, 0x54, 0x52, 0x42, 0x5f, 0x44, 0x45, 0x4c, 0x49, 0x56, 0x45, 0x52, 0x10, 0x16,
+	0x12, 0x14, 0x0a, 0x10, 0x41, 0x50, 0x50, 0x5f, 0x4e, 0x42, 0x41, 0x43, 0x5f, 0x50, 0x52, 0x4f,
+	0x50, 0x4f, 0x53, 0x45, 0x10, 0x17, 0x12, 0x13, 0x0a, 0x0f, 0x41, 0x50, 0x50, 0x5f, 0x4e, 0x42,
+	0x41, 0x43, 0x5f, 0x44, 0x45, 0x43, 0x49, 0x44, 0x45, 0x10, 0x18, 0x12, 0x0e, 0x0a, 0x0a, 0x41,
+	0x50, 0x50, 0x5f, 0x43, 0x4f, 0x4d, 0x4d, 0x49, 0x54, 0x10, 0x19, 0x12, 0x16, 0x0a, 0x12, 0x41,
+	0x50, 0x50, 0x5f, 0x43, 0x4f, 0x4d, 0x4d, 0x49, 0x54, 0x5f, 0x4f, 0x55, 0x54, 0x43, 0x4f, 0x4d,
+	0x45, 0x10, 0x1a, 0x12, 0x14, 0x0a, 0x10, 0x41, 0x50, 0x50, 0x5f, 0x56, 0x53, 0x5f, 0x42, 0x52,
+	0x4f, 0x41, 0x44, 0x43, 0x41, 0x53, 0x54, 0x10, 0x1b, 0x12, 0x12, 0x0a, 0x0e, 0x41, 0x50, 0x50,
+	0x5f, 0x56, 0x53, 0x5f, 0x44, 0x45, 0x4c, 0x49, 0x56, 0x45, 0x52, 0x10, 0x1c, 0x12, 0x0c, 0x0a,
+	0x08, 0x41, 0x50, 0x50, 0x5f, 0x56, 0x49, 0x45, 0x57, 0x10, 0x1d, 0x12, 0x0d, 0x0a, 0x09, 0x55,
+	0x43, 0x5f, 0x44, 0x45, 0x43, 0x49, 0x44, 0x45, 0x10, 0x14, 0x12, 0x0e, 0x0a, 0x0a, 0x55, 0x43,
+	0x5f, 0x50, 0x52, 0x4f, 0x50, 0x4f, 0x53, 0x45, 0x10, 0x15, 0x12, 0x0c, 0x0a, 0x08, 0x45, 0x50,
+	0x5f, 0x41, 0x42, 0x4f, 0x52, 0x54, 0x10, 0x1e, 0x12, 0x0e, 0x0a, 0x0a, 0x45, 0x50, 0x5f, 0x41,
+	0x42, 0x4f, 0x52, 0x54, 0x45, 0x44, 0x10, 0x1f, 0x12, 0x0d, 0x0a, 0x09, 0x45, 0x50, 0x5f, 0x44,
+	0x45, 0x43, 0x49, 0x44, 0x45, 0x10, 0x20, 0x12, 0x16, 0x0a, 0x12, 0x45, 0x50, 0x5f, 0x49, 0x4e,
+	0x54, 0x45, 0x52, 0x4e, 0x41, 0x4c, 0x5f, 0x41, 0x43, 0x43, 0x45, 0x50, 0x54, 0x10, 0x21, 0x12,
+	0x17, 0x0a, 0x13, 0x45, 0x50, 0x5f, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x4e, 0x41, 0x4c, 0x5f, 0x44,
+	0x45, 0x43, 0x49, 0x44, 0x45, 0x44, 0x10, 0x22, 0x12, 0x14, 0x0a, 0x10, 0x45, 0x50, 0x5f, 0x49,
+	0x4e, 0x54, 0x45, 0x52, 0x4e, 0x41, 0x4c, 0x5f, 0x52, 0x45, 0x41, 0x44, 0x10, 0x23, 0x12, 0x15,
+	0x0a, 0x11, 0x45, 0x50, 0x5f, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x4e, 0x41, 0x4c, 0x5f, 0x53, 0x54,
+	0x41, 0x54, 0x45, 0x10, 0x24, 0x12, 0x15, 0x0a, 0x11, 0x45, 0x50, 0x5f, 0x49, 0x4e, 0x54, 0x45,
+	0x52, 0x4e, 0x41, 0x4c, 0x5f, 0x57, 0x52, 0x49, 0x54, 0x45, 0x10, 0x25, 0x12, 0x0e, 0x0a, 0x0a,
+	0x45, 0x50, 0x5f, 0x50, 0x52, 0x4f, 0x50, 0x4f, 0x53, 0x45, 0x10, 0x26, 0x12, 0x14, 0x0a, 0x10,
+	0x45, 0x43, 0x5f, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x4e, 0x41, 0x4c, 0x5f, 0x4e, 0x41, 0x43, 0x4b,
+	0x10, 0x28, 0x12, 0x19, 0x0a, 0x15, 0x45, 0x43, 0x5f, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x4e, 0x41,
+	0x4c, 0x5f, 0x4e, 0x45, 0x57, 0x5f, 0x45, 0x50, 0x4f, 0x43, 0x48, 0x10, 0x29, 0x12, 0x12, 0x0a,
+	0x0e, 0x45, 0x43, 0x5f, 0x53, 0x54, 0x41, 0x52, 0x54, 0x5f, 0x45, 0x50, 0x4f, 0x43, 0x48, 0x10,
+	0x2a, 0x12, 0x11, 0x0a, 0x0d, 0x42, 0x45, 0x42, 0x5f, 0x42, 0x52, 0x4f, 0x41, 0x44, 0x43, 0x41,
+	0x53, 0x54, 0x10, 0x32, 0x12, 0x0f, 0x0a, 0x0b, 0x42, 0x45, 0x42, 0x5f, 0x44, 0x45, 0x4c, 0x49,
+	0x56, 0x45, 0x52, 0x10, 0x33, 0x12, 0x0f, 0x0a, 0x0b, 0x45, 0x4c, 0x44, 0x5f, 0x54, 0x49, 0x4d,
+	0x45, 0x4f, 0x55, 0x54, 0x10, 0x3c, 0x12, 0x0d, 0x0a, 0x09, 0x45, 0x4c, 0x44, 0x5f, 0x54, 0x52,
+	0x55, 0x53, 0x54, 0x10, 0x3d, 0x12, 0x15, 0x0a, 0x11, 0x4e, 0x4e, 0x41, 0x52, 0x5f, 0x49, 0x4e,
+	0x54, 0x45, 0x52, 0x4e, 0x41, 0x4c, 0x5f, 0x41, 0x43, 0x4b, 0x10, 0x46, 0x12, 0x16, 0x0a, 0x12,
+	0x4e, 0x4e, 0x41, 0x52, 0x5f, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x4e, 0x41, 0x4c, 0x5f, 0x52, 0x45,
+	0x41, 0x44, 0x10, 0x47, 0x12, 0x17, 0x0a, 0x13, 0x4e, 0x4e, 0x41, 0x52, 0x5f, 0x49, 0x4e, 0x54,
+	0x45, 0x52, 0x4e, 0x41, 0x4c, 0x5f, 0x56, 0x41, 0x4c, 0x55, 0x45, 0x10, 0x48, 0x12, 0x17, 0x0a,
+	0x13, 0x4e, 0x4e, 0x41, 0x52, 0x5f, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x4e, 0x41, 0x4c, 0x5f, 0x57,
+	0x52, 0x49, 0x54, 0x45, 0x10, 0x49, 0x12, 0x0d, 0x0a, 0x09, 0x4e, 0x4e, 0x41, 0x52, 0x5f, 0x52,
+	0x45, 0x41, 0x44, 0x10, 0x4a, 0x12, 0x14, 0x0a, 0x10, 0x4e, 0x4e, 0x41, 0x52, 0x5f, 0x52, 0x45,
+	0x41, 0x44, 0x5f, 0x52, 0x45, 0x54, 0x55, 0x52, 0x4e, 0x10, 0x4b, 0x12, 0x0e, 0x0a, 0x0a, 0x4e,
+	0x4e, 0x41, 0x52, 0x5f, 0x57, 0x52, 0x49, 0x54, 0x45, 0x10, 0x4c, 0x12, 0x15, 0x0a, 0x11, 0x4e,
+	0x4e, 0x41, 0x52, 0x5f, 0x57, 0x52, 0x49, 0x54, 0x45, 0x5f, 0x52, 0x45, 0x54, 0x55, 0x52, 0x4e,
+	0x10, 0x4d, 0x12, 0x21, 0x0a, 0x1d, 0x45, 0x50, 0x46, 0x44, 0x5f, 0x49, 0x4e, 0x54, 0x45, 0x52,
+	0x4e, 0x41, 0x4c, 0x5f, 0x48, 0x45, 0x41, 0x52, 0x54, 0x42, 0x45, 0x41, 0x54, 0x5f, 0x52, 0x45,
+	0x50, 0x4c, 0x59, 0x10, 0x50, 0x12, 0x23, 0x0a, 0x1f, 0x45, 0x50, 0x46, 0x44, 0x5f, 0x49, 0x4e,
+	0x54, 0x45, 0x52, 0x4e, 0x41, 0x4c, 0x5f, 0x48, 0x45, 0x41, 0x52, 0x54, 0x42, 0x45, 0x41, 0x54,
+	0x5f, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x10, 0x51, 0x12, 0x10, 0x0a, 0x0c, 0x45, 0x50,
+	0x46, 0x44, 0x5f, 0x52, 0x45, 0x53, 0x54, 0x4f, 0x52, 0x45, 0x10, 0x52, 0x12, 0x10, 0x0a, 0x0c,
+	0x45, 0x50, 0x46, 0x44, 0x5f, 0x53, 0x55, 0x53, 0x50, 0x45, 0x43, 0x54, 0x10, 0x53, 0x12, 0x10,
+	0x0a, 0x0c, 0x45, 0x50, 0x46, 0x44, 0x5f, 0x54, 0x49, 0x4d, 0x45, 0x4f, 0x55, 0x54, 0x10, 0x54,
+	0x12, 0x0e, 0x0a, 0x0a, 0x50, 0x4c, 0x5f, 0x44, 0x45, 0x4c, 0x49, 0x56, 0x45, 0x52, 0x10, 0x5a,
+	0x12, 0x0b, 0x0a, 0x07, 0x50, 0x4c, 0x5f, 0x53, 0x45, 0x4e, 0x44, 0x10, 0x5b, 0x12, 0x0d, 0x0a,
+	0x09, 0x50, 0x46, 0x44, 0x5f, 0x43, 0x52, 0x41, 0x53, 0x48, 0x10, 0x64, 0x12, 0x20, 0x0a, 0x1c,
+	0x50, 0x46, 0x44, 0x5f, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x4e, 0x41, 0x4c, 0x5f, 0x48, 0x45, 0x41,
+	0x52, 0x54, 0x42, 0x45, 0x41, 0x54, 0x5f, 0x52, 0x45, 0x50, 0x4c, 0x59, 0x10, 0x65, 0x12, 0x22,
+	0x0a, 0x1e, 0x50, 0x46, 0x44, 0x5f, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x4e, 0x41, 0x4c, 0x5f, 0x48,
+	0x45, 0x41, 0x52, 0x54, 0x42, 0x45, 0x41, 0x54, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54,
+	0x10, 0x66, 0x12, 0x0f, 0x0a, 0x0b, 0x50, 0x46, 0x44, 0x5f, 0x54, 0x49, 0x4d, 0x45, 0x4f, 0x55,
+	0x54, 0x10, 0x67, 0x12, 0x0d, 0x0a, 0x09, 0x4c, 0x45, 0x5f, 0x4c, 0x45, 0x41, 0x44, 0x45, 0x52,
+	0x10, 0x6e, 0x12, 0x0d, 0x0a, 0x09, 0x41, 0x50, 0x50, 0x5f, 0x45, 0x4c, 0x45, 0x43, 0x54, 0x10,
+	0x6f, 0x12, 0x0e, 0x0a, 0x0a, 0x41, 0x50, 0x50, 0x5f, 0x4c, 0x45, 0x41, 0x44, 0x45, 0x52, 0x10,
+	0x70, 0x12, 0x0c, 0x0a, 0x08, 0x4c, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x52, 0x54, 0x10, 0x71, 0x12,
+	0x10, 0x0a, 0x0c, 0x52, 0x42, 0x5f, 0x42, 0x52, 0x4f, 0x41, 0x44, 0x43, 0x41, 0x53, 0x54, 0x10,
+	0x78, 0x12, 0x0e, 0x0a, 0x0a, 0x52, 0x42, 0x5f, 0x44, 0x45, 0x4c, 0x49, 0x56, 0x45, 0x52, 0x10,
+	0x79, 0x12, 0x14, 0x0a, 0x10, 0x52, 0x42, 0x5f, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x4e, 0x41, 0x4c,
+	0x5f, 0x44, 0x41, 0x54, 0x41, 0x10, 0x7a, 0x12, 0x12, 0x0a, 0x0d, 0x55, 0x52, 0x42, 0x5f, 0x42,
+	0x52, 0x4f, 0x41, 0x44, 0x43, 0x41, 0x53, 0x54, 0x10, 0x82, 0x01, 0x12, 0x10, 0x0a, 0x0b, 0x55,
+	0x52, 0x42, 0x5f, 0x44, 0x45, 0x4c, 0x49, 0x56, 0x45, 0x52, 0x10, 0x83, 0x01, 0x12, 0x16, 0x0a,
+	0x11, 0x55, 0x52, 0x42, 0x5f, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x4e, 0x41, 0x4c, 0x5f, 0x44, 0x41,
+	0x54, 0x41, 0x10, 0x84, 0x01, 0x12, 0x12, 0x0a, 0x0d, 0x46, 0x52, 0x42, 0x5f, 0x42, 0x52, 0x4f,
+	0x41, 0x44, 0x43, 0x41, 0x53, 0x54, 0x10, 0x8c, 0x01, 0x12, 0x10, 0x0a, 0x0b, 0x46, 0x52, 0x42,
+	0x5f, 0x44, 0x45, 0x4c, 0x49, 0x56, 0x45, 0x52, 0x10, 0x8d, 0x01, 0x12, 0x16, 0x0a, 0x11, 0x46,
+	0x52, 0x42, 0x5f, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x4e, 0x41, 0x4c, 0x5f, 0x44, 0x41, 0x54, 0x41,
+	0x10, 0x8e, 0x01, 0x12, 0x12, 0x0a, 0x0d, 0x43, 0x52, 0x42, 0x5f, 0x42, 0x52, 0x4f, 0x41, 0x44,
+	0x43, 0x41, 0x53, 0x54, 0x10, 0x96, 0x01, 0x12, 0x10, 0x0a, 0x0b, 0x43, 0x52, 0x42, 0x5f, 0x44,
+	0x45, 0x4c, 0x49, 0x56, 0x45, 0x52, 0x10, 0x97, 0x01, 0x12, 0x16, 0x0a, 0x11, 0x43, 0x52, 0x42,
+	0x5f, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x4e, 0x41, 0x4c, 0x5f, 0x44, 0x41, 0x54, 0x41, 0x10, 0x98,
+	0x01, 0x12, 0x11, 0x0a, 0x0c, 0x50, 0x42, 0x5f, 0x42, 0x52, 0x4f, 0x41, 0x44, 0x43, 0x41, 0x53,
+	0x54, 0x10, 0xa0, 0x01, 0x12, 0x0f, 0x0a, 0x0a, 0x50, 0x42, 0x5f, 0x44, 0x45, 0x4c, 0x49, 0x56,
+	0x45, 0x52, 0x10, 0xa1, 0x01, 0x12, 0x17, 0x0a, 0x12, 0x50, 0x42, 0x5f, 0x49, 0x4e, 0x54, 0x45,
+	0x52, 0x4e, 0x41, 0x4c, 0x5f, 0x47, 0x4f, 0x53, 0x53, 0x49, 0x50, 0x10, 0xa2, 0x01, 0x12, 0x18,
+	0x0a, 0x13, 0x50, 0x42, 0x5f, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x4e, 0x41, 0x4c, 0x5f, 0x52, 0x45,
+	0x51, 0x55, 0x45, 0x53, 0x54, 0x10, 0xa3, 0x01, 0x12, 0x15, 0x0a, 0x10, 0x50, 0x42, 0x5f, 0x49,
+	0x4e, 0x54, 0x45, 0x52, 0x4e, 0x41, 0x4c, 0x5f, 0x44, 0x41, 0x54, 0x41, 0x10, 0xa4, 0x01, 0x12,
+	0x0f, 0x0a, 0x0a, 0x50, 0x42, 0x5f, 0x54, 0x49, 0x4d, 0x45, 0x4f, 0x55, 0x54, 0x10, 0xa5, 0x01,
+	0x12, 0x12, 0x0a, 0x0d, 0x54, 0x4f, 0x42, 0x5f, 0x42, 0x52, 0x4f, 0x41, 0x44, 0x43, 0x41, 0x53,
+	0x54, 0x10, 0xaa, 0x01, 0x12, 0x10, 0x0a, 0x0b, 0x54, 0x4f, 0x42, 0x5f, 0x44, 0x45, 0x4c, 0x49,
+	0x56, 0x45, 0x52, 0x10, 0xab, 0x01, 0x12, 0x16, 0x0a, 0x11, 0x54, 0x4f, 0x42, 0x5f, 0x49, 0x4e,
+	0x54, 0x45, 0x52, 0x4e, 0x41, 0x4c, 0x5f, 0x44, 0x41, 0x54, 0x41, 0x10, 0xac, 0x01, 0x12, 0x12,
+	0x0a, 0x0d, 0x54, 0x52, 0x42, 0x5f, 0x42, 0x52, 0x4f, 0x41, 0x44, 0x43, 0x41, 0x53, 0x54, 0x10,
+	0xb4, 0x01, 0x12, 0x10, 0x0a, 0x0b, 0x54, 0x52, 0x42, 0x5f, 0x44, 0x45, 0x4c, 0x49, 0x56, 0x45,
+	0x52, 0x10, 0xb5, 0x01, 0x12, 0x16, 0x0a, 0x11, 0x54, 0x52, 0x42, 0x5f, 0x49, 0x4e, 0x54, 0x45,
+	0x52, 0x4e, 0x41, 0x4c, 0x5f, 0x44, 0x41, 0x54, 0x41, 0x10, 0xb6, 0x01, 0x12, 0x11, 0x0a, 0x0c,
+	0x4e, 0x42, 0x41, 0x43, 0x5f, 0x50, 0x52, 0x4f, 0x50, 0x4f, 0x53, 0x45, 0x10, 0xbe, 0x01, 0x12,
+	0x10, 0x0a, 0x0b, 0x4e, 0x42, 0x41, 0x43, 0x5f, 0x44, 0x45, 0x43, 0x49, 0x44, 0x45, 0x10, 0xbf,
+	0x01, 0x12, 0x17, 0x0a, 0x12, 0x4e, 0x42, 0x41, 0x43, 0x5f, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x4e,
+	0x41, 0x4c, 0x5f, 0x56, 0x4f, 0x54, 0x45, 0x10, 0xc0, 0x01, 0x12, 0x11, 0x0a, 0x0c, 0x43, 0x4f,
+	0x4d, 0x4d, 0x49, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x52, 0x54, 0x10, 0xc8, 0x01, 0x12, 0x13, 0x0a,
+	0x0e, 0x43, 0x4f, 0x4d, 0x4d, 0x49, 0x54, 0x5f, 0x4f, 0x55, 0x54, 0x43, 0x4f, 0x4d, 0x45, 0x10,
+	0xc9, 0x01, 0x12, 0x1c, 0x0a, 0x17, 0x43, 0x4f, 0x4d, 0x4d, 0x49, 0x54, 0x5f, 0x49, 0x4e, 0x54,
+	0x45, 0x52, 0x4e, 0x41, 0x4c, 0x5f, 0x50, 0x52, 0x45, 0x50, 0x41, 0x52, 0x45, 0x10, 0xca, 0x01,
+	0x12, 0x19, 0x0a, 0x14, 0x43, 0x4f, 0x4d, 0x4d, 0x49, 0x54, 0x5f, 0x49, 0x4e, 0x54, 0x45, 0x52,
+	0x4e, 0x41, 0x4c, 0x5f, 0x56, 0x4f, 0x54, 0x45, 0x10, 0xcb, 0x01, 0x12, 0x1e, 0x0a, 0x19, 0x43,
+	0x4f, 0x4d, 0x4d, 0x49, 0x54, 0x5f, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x4e, 0x41, 0x4c, 0x5f, 0x50,
+	0x52, 0x45, 0x43, 0x4f, 0x4d, 0x4d, 0x49, 0x54, 0x10, 0xcc, 0x01, 0x12, 0x18, 0x0a, 0x13, 0x43,
+	0x4f, 0x4d, 0x4d, 0x49, 0x54, 0x5f, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x4e, 0x41, 0x4c, 0x5f, 0x41,
+	0x43, 0x4b, 0x10, 0xcd, 0x01, 0x12, 0x1d, 0x0a, 0x18, 0x43, 0x4f, 0x4d, 0x4d, 0x49, 0x54, 0x5f,
+	0x49, 0x4e, 0x54, 0x45, 0x52, 0x4e, 0x41, 0x4c, 0x5f, 0x44, 0x45, 0x43, 0x49, 0x53, 0x49, 0x4f,
+	0x4e, 0x10, 0xce, 0x01, 0x12, 0x1a, 0x0a, 0x15, 0x43, 0x4f, 0x4d, 0x4d, 0x49, 0x54, 0x5f, 0x49,
+	0x4e, 0x54, 0x45, 0x52, 0x4e, 0x41, 0x4c, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x10, 0xcf, 0x01,
+	0x12, 0x0c, 0x0a, 0x07, 0x47, 0x4d, 0x5f, 0x56, 0x49, 0x45, 0x57, 0x10, 0xd2, 0x01, 0x12, 0x13,
+	0x0a, 0x0e, 0x47, 0x4d, 0x5f, 0x52, 0x45, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x55, 0x52, 0x45,
+	0x10, 0xd3, 0x01, 0x12, 0x11, 0x0a, 0x0c, 0x56, 0x53, 0x5f, 0x42, 0x52, 0x4f, 0x41, 0x44, 0x43,
+	0x41, 0x53, 0x54, 0x10, 0xdc, 0x01, 0x12, 0x0f, 0x0a, 0x0a, 0x56, 0x53, 0x5f, 0x44, 0x45, 0x4c,
+	0x49, 0x56, 0x45, 0x52, 0x10, 0xdd, 0x01, 0x12, 0x0c, 0x0a, 0x07, 0x56, 0x53, 0x5f, 0x56, 0x49,
+	0x45, 0x57, 0x10, 0xde, 0x01, 0x12, 0x15, 0x0a, 0x10, 0x56, 0x53, 0x5f, 0x49, 0x4e, 0x54, 0x45,
+	0x52, 0x4e, 0x41, 0x4c, 0x5f, 0x44, 0x41, 0x54, 0x41, 0x10, 0xdf, 0x01, 0x12, 0x18, 0x0a, 0x13,
+	0x56, 0x53, 0x5f, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x4e, 0x41, 0x4c, 0x5f, 0x50, 0x45, 0x4e, 0x44,
+	0x49, 0x4e, 0x47, 0x10, 0xe0, 0x01, 0x12, 0x1c, 0x0a, 0x17, 0x50, 0x52, 0x4f, 0x43, 0x5f, 0x52,
+	0x45, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x55, 0x52, 0x45, 0x5f, 0x53, 0x59, 0x53, 0x54, 0x45,
+	0x4d, 0x10, 0xe6, 0x01, 0x12, 0x1a, 0x0a, 0x15, 0x52, 0x45, 0x43, 0x4f, 0x4e, 0x46, 0x5f, 0x49,
+	0x4e, 0x54, 0x45, 0x52, 0x4e, 0x41, 0x4c, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x10, 0xe7, 0x01,
+	0x12, 0x0e, 0x0a, 0x09, 0x4f, 0x4e, 0x52, 0x52, 0x5f, 0x52, 0x45, 0x41, 0x44, 0x10, 0xf0, 0x01,
+	0x12, 0x15, 0x0a, 0x10, 0x4f, 0x4e, 0x52, 0x52, 0x5f, 0x52, 0x45, 0x41, 0x44, 0x5f, 0x52, 0x45,
+	0x54, 0x55, 0x52, 0x4e, 0x10, 0xf1, 0x01, 0x12, 0x0f, 0x0a, 0x0a, 0x4f, 0x4e, 0x52, 0x52, 0x5f,
+	0x57, 0x52, 0x49, 0x54, 0x45, 0x10, 0xf2, 0x01, 0x12, 0x16, 0x0a, 0x11, 0x4f, 0x4e, 0x52, 0x52,
+	0x5f, 0x57, 0x52, 0x49, 0x54, 0x45, 0x5f, 0x52, 0x45, 0x54, 0x55, 0x52, 0x4e, 0x10, 0xf3, 0x01,
+	0x12, 0x17, 0x0a, 0x12, 0x4f, 0x4e, 0x52, 0x52, 0x5f, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x4e, 0x41,
+	0x4c, 0x5f, 0x52, 0x45, 0x41, 0x44, 0x10, 0xf4, 0x01, 0x12, 0x18, 0x0a, 0x13, 0x4f, 0x4e, 0x52,
+	0x52, 0x5f, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x4e, 0x41, 0x4c, 0x5f, 0x56, 0x41, 0x4c, 0x55, 0x45,
+	0x10, 0xf5, 0x01, 0x12, 0x18, 0x0a, 0x13, 0x4f, 0x4e, 0x52, 0x52, 0x5f, 0x49, 0x4e, 0x54, 0x45,
+	0x52, 0x4e, 0x41, 0x4c, 0x5f, 0x57, 0x52, 0x49, 0x54, 0x45, 0x10, 0xf6, 0x01, 0x12, 0x16, 0x0a,
+	0x11, 0x4f, 0x4e, 0x52, 0x52, 0x5f, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x4e, 0x41, 0x4c, 0x5f, 0x41,
+	0x43, 0x4b, 0x10, 0xf7, 0x01, 0x12, 0x0e, 0x0a, 0x09, 0x4f, 0x4e, 0x41, 0x52, 0x5f, 0x52, 0x45,
+	0x41, 0x44, 0x10, 0xfa, 0x01, 0x12, 0x15, 0x0a, 0x10, 0x4f, 0x4e, 0x41, 0x52, 0x5f, 0x52, 0x45,
+	0x41, 0x44, 0x5f, 0x52, 0x45, 0x54, 0x55, 0x52, 0x4e, 0x10, 0xfb, 0x01, 0x12, 0x0f, 0x0a, 0x0a,
+	0x4f, 0x4e, 0x41, 0x52, 0x5f, 0x57, 0x52, 0x49, 0x54, 0x45, 0x10, 0xfc, 0x01, 0x12, 0x16, 0x0a,
+	0x11, 0x4f, 0x4e, 0x41, 0x52, 0x5f, 0x57, 0x52, 0x49, 0x54, 0x45, 0x5f, 0x52, 0x45, 0x54, 0x55,
+	0x52, 0x4e, 0x10, 0xfd, 0x01, 0x12, 0x13, 0x0a, 0x0e, 0x41, 0x50, 0x50, 0x5f, 0x4c, 0x4f, 0x47,
+	0x5f, 0x41, 0x50, 0x50, 0x45, 0x4e, 0x44, 0x10, 0x84, 0x02, 0x12, 0x15, 0x0a, 0x10, 0x41, 0x50,
+	0x50, 0x5f, 0x4c, 0x4f, 0x47, 0x5f, 0x41, 0x50, 0x50, 0x45, 0x4e, 0x44, 0x45, 0x44, 0x10, 0x85,
+	0x02, 0x12, 0x16, 0x0a, 0x11, 0x41, 0x50, 0x50, 0x5f, 0x4c, 0x4f, 0x47, 0x5f, 0x43, 0x4f, 0x4d,
+	0x4d, 0x49, 0x54, 0x54, 0x45, 0x44, 0x10, 0x86, 0x02, 0x12, 0x0f, 0x0a, 0x0a, 0x4c, 0x4f, 0x47,
+	0x5f, 0x41, 0x50, 0x50, 0x45, 0x4e, 0x44, 0x10, 0x8e, 0x02, 0x12, 0x11, 0x0a, 0x0c, 0x4c, 0x4f,
+	0x47, 0x5f, 0x41, 0x50, 0x50, 0x45, 0x4e, 0x44, 0x45, 0x44, 0x10, 0x8f, 0x02, 0x12, 0x12, 0x0a,
+	0x0d, 0x4c, 0x4f, 0x47, 0x5f, 0x43, 0x4f, 0x4d, 0x4d, 0x49, 0x54, 0x54, 0x45, 0x44, 0x10, 0x90,
+	0x02, 0x12, 0x19, 0x0a, 0x14, 0x4c, 0x4f, 0x47, 0x5f, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x4e, 0x41,
+	0x4c, 0x5f, 0x46, 0x4f, 0x52, 0x57, 0x41, 0x52, 0x44, 0x10, 0x91, 0x02, 0x12, 0x19, 0x0a, 0x14,
+	0x4c, 0x4f, 0x47, 0x5f, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x4e, 0x41, 0x4c, 0x5f, 0x50, 0x52, 0x45,
+	0x50, 0x41, 0x52, 0x45, 0x10, 0x92, 0x02, 0x12, 0x19, 0x0a, 0x14, 0x4c, 0x4f, 0x47, 0x5f, 0x49,
+	0x4e, 0x54, 0x45, 0x52, 0x4e, 0x41, 0x4c, 0x5f, 0x50, 0x52, 0x4f, 0x4d, 0x49, 0x53, 0x45, 0x10,
+	0x93, 0x02, 0x12, 0x18, 0x0a, 0x13, 0x4c, 0x4f, 0x47, 0x5f, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x4e,
+	0x41, 0x4c, 0x5f, 0x41, 0x43, 0x43, 0x45, 0x50, 0x54, 0x10, 0x94, 0x02, 0x12, 0x1a, 0x0a, 0x15,
+	0x4c, 0x4f, 0x47, 0x5f, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x4e, 0x41, 0x4c, 0x5f, 0x41, 0x43, 0x43,
+	0x45, 0x50, 0x54, 0x45, 0x44, 0x10, 0x95, 0x02, 0x12, 0x18, 0x0a, 0x13, 0x4c, 0x4f, 0x47, 0x5f,
+	0x49, 0x4e, 0x54, 0x45, 0x52, 0x4e, 0x41, 0x4c, 0x5f, 0x43, 0x4f, 0x4d, 0x4d, 0x49, 0x54, 0x10,
+	0x96, 0x02, 0x12, 0x11, 0x0a, 0x0c, 0x52, 0x41, 0x46, 0x54, 0x5f, 0x54, 0x49, 0x4d, 0x45, 0x4f,
+	0x55, 0x54, 0x10, 0x98, 0x02, 0x12, 0x1f, 0x0a, 0x1a, 0x52, 0x41, 0x46, 0x54, 0x5f, 0x49, 0x4e,
+	0x54, 0x45, 0x52, 0x4e, 0x41, 0x4c, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x5f, 0x56,
+	0x4f, 0x54, 0x45, 0x10, 0x99, 0x02, 0x12, 0x17, 0x0a, 0x12, 0x52, 0x41, 0x46, 0x54, 0x5f, 0x49,
+	0x4e, 0x54, 0x45, 0x52, 0x4e, 0x41, 0x4c, 0x5f, 0x56, 0x4f, 0x54, 0x45, 0x10, 0x9a, 0x02, 0x12,
+	0x21, 0x0a, 0x1c, 0x52, 0x41, 0x46, 0x54, 0x5f, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x4e, 0x41, 0x4c,
+	0x5f, 0x41, 0x50, 0x50, 0x45, 0x4e, 0x44, 0x5f, 0x45, 0x4e, 0x54, 0x52, 0x49, 0x45, 0x53, 0x10,
+	0x9b, 0x02, 0x12, 0x20, 0x0a, 0x1b, 0x52, 0x41, 0x46, 0x54, 0x5f, 0x49, 0x4e, 0x54, 0x45, 0x52,
+	0x4e, 0x41, 0x4c, 0x5f, 0x41, 0x50, 0x50, 0x45, 0x4e, 0x44, 0x5f, 0x52, 0x45, 0x53, 0x55, 0x4c,
+	0x54, 0x10, 0x9c, 0x02, 0x12, 0x1a, 0x0a, 0x15, 0x52, 0x41, 0x46, 0x54, 0x5f, 0x49, 0x4e, 0x54,
+	0x45, 0x52, 0x4e, 0x41, 0x4c, 0x5f, 0x46, 0x4f, 0x52, 0x57, 0x41, 0x52, 0x44, 0x10, 0x9d, 0x02,
+	0x12, 0x13, 0x0a, 0x0e, 0x41, 0x50, 0x50, 0x5f, 0x52, 0x43, 0x5f, 0x50, 0x52, 0x4f, 0x50, 0x4f,
+	0x53, 0x45, 0x10, 0xa2, 0x02, 0x12, 0x12, 0x0a, 0x0d, 0x41, 0x50, 0x50, 0x5f, 0x52, 0x43, 0x5f,
+	0x44, 0x45, 0x43, 0x49, 0x44, 0x45, 0x10, 0xa3, 0x02, 0x12, 0x19, 0x0a, 0x14, 0x52, 0x43, 0x5f,
+	0x49, 0x4e, 0x54, 0x45, 0x52, 0x4e, 0x41, 0x4c, 0x5f, 0x50, 0x52, 0x4f, 0x50, 0x4f, 0x53, 0x41,
+	0x4c, 0x10, 0xa4, 0x02, 0x12, 0x16, 0x0a, 0x11, 0x42, 0x43, 0x5f, 0x49, 0x4e, 0x54, 0x45, 0x52,
+	0x4e, 0x41, 0x4c, 0x5f, 0x50, 0x48, 0x41, 0x53, 0x45, 0x10, 0xa5, 0x02, 0x12, 0x18, 0x0a, 0x13,
+	0x42, 0x43, 0x5f, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x4e, 0x41, 0x4c, 0x5f, 0x44, 0x45, 0x43, 0x49,
+	0x44, 0x45, 0x44, 0x10, 0xa6, 0x02, 0x12, 0x1f, 0x0a, 0x1a, 0x46, 0x4c, 0x4f, 0x4f, 0x44, 0x49,
+	0x4e, 0x47, 0x5f, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x4e, 0x41, 0x4c, 0x5f, 0x50, 0x52, 0x4f, 0x50,
+	0x4f, 0x53, 0x41, 0x4c, 0x10, 0xac, 0x02, 0x12, 0x1e, 0x0a, 0x19, 0x46, 0x4c, 0x4f, 0x4f, 0x44,
+	0x49, 0x4e, 0x47, 0x5f, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x4e, 0x41, 0x4c, 0x5f, 0x44, 0x45, 0x43,
+	0x49, 0x44, 0x45, 0x44, 0x10, 0xad, 0x02, 0x12, 0x23, 0x0a, 0x1e, 0x48, 0x49, 0x45, 0x52, 0x41,
+	0x52, 0x43, 0x48, 0x49, 0x43, 0x41, 0x4c, 0x5f, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x4e, 0x41, 0x4c,
+	0x5f, 0x50, 0x52, 0x4f, 0x50, 0x4f, 0x53, 0x41, 0x4c, 0x10, 0xae, 0x02, 0x12, 0x1e, 0x0a, 0x19,
+	0x48, 0x49, 0x45, 0x52, 0x41, 0x52, 0x43, 0x48, 0x49, 0x43, 0x41, 0x4c, 0x5f, 0x49, 0x4e, 0x54,
+	0x45, 0x52, 0x4e, 0x41, 0x4c, 0x5f, 0x41, 0x43, 0x4b, 0x10, 0xaf, 0x02, 0x12, 0x22, 0x0a, 0x1d,
+	0x48, 0x49, 0x45, 0x52, 0x41, 0x52, 0x43, 0x48, 0x49, 0x43, 0x41, 0x4c, 0x5f, 0x49, 0x4e, 0x54,
+	0x45, 0x52, 0x4e, 0x41, 0x4c, 0x5f, 0x44, 0x45, 0x43, 0x49, 0x44, 0x45, 0x44, 0x10, 0xb0, 0x02,
+	0x12, 0x16, 0x0a, 0x11, 0x41, 0x50, 0x50, 0x5f, 0x42, 0x43, 0x42, 0x5f, 0x42, 0x52, 0x4f, 0x41,
+	0x44, 0x43, 0x41, 0x53, 0x54, 0x10, 0xb6, 0x02, 0x12, 0x16, 0x0a, 0x11, 0x41, 0x50, 0x50, 0x5f,
+	0x42, 0x52, 0x42, 0x5f, 0x42, 0x52, 0x4f, 0x41, 0x44, 0x43, 0x41, 0x53, 0x54, 0x10, 0xb7, 0x02,
+	0x12, 0x12, 0x0a, 0x0d, 0x42, 0x43, 0x42, 0x5f, 0x42, 0x52, 0x4f, 0x41, 0x44, 0x43, 0x41, 0x53,
+	0x54, 0x10, 0xb8, 0x02, 0x12, 0x10, 0x0a, 0x0b, 0x42, 0x43, 0x42, 0x5f, 0x44, 0x45, 0x4c, 0x49,
+	0x56, 0x45, 0x52, 0x10, 0xb9, 0x02, 0x12, 0x12, 0x0a, 0x0d, 0x42, 0x52, 0x42, 0x5f, 0x42, 0x52,
+	0x4f, 0x41, 0x44, 0x43, 0x41, 0x53, 0x54, 0x10, 0xba, 0x02, 0x12, 0x10, 0x0a, 0x0b, 0x42, 0x52,
+	0x42, 0x5f, 0x44, 0x45, 0x4c, 0x49, 0x56, 0x45, 0x52, 0x10, 0xbb, 0x02, 0x12, 0x1c, 0x0a, 0x17,
+	0x42, 0x59, 0x5a, 0x41, 0x4e, 0x54, 0x49, 0x4e, 0x45, 0x5f, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x4e,
+	0x41, 0x4c, 0x5f, 0x53, 0x45, 0x4e, 0x44, 0x10, 0xbc, 0x02, 0x12, 0x1c, 0x0a, 0x17, 0x42, 0x59,
+	0x5a, 0x41, 0x4e, 0x54, 0x49, 0x4e, 0x45, 0x5f, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x4e, 0x41, 0x4c,
+	0x5f, 0x45, 0x43, 0x48, 0x4f, 0x10, 0xbd, 0x02, 0x12, 0x1d, 0x0a, 0x18, 0x42, 0x59, 0x5a, 0x41,
+	0x4e, 0x54, 0x49, 0x4e, 0x45, 0x5f, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x4e, 0x41, 0x4c, 0x5f, 0x52,
+	0x45, 0x41, 0x44, 0x59, 0x10, 0xbe, 0x02, 0x2a, 0x38, 0x0a, 0x09, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x54, 0x79, 0x70, 0x65, 0x12, 0x09, 0x0a, 0x05, 0x49, 0x4e, 0x54, 0x33, 0x32, 0x10, 0x00, 0x12,
+	0x09, 0x0a, 0x05, 0x42, 0x59, 0x54, 0x45, 0x53, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x53, 0x54,
+	0x52, 0x49, 0x4e, 0x47, 0x10, 0x02, 0x12, 0x09, 0x0a, 0x05, 0x49, 0x4e, 0x54, 0x36, 0x34, 0x10,
+	0x03, 0x2a, 0x2c, 0x0a, 0x0c, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x4b, 0x69, 0x6e,
+	0x64, 0x12, 0x08, 0x0a, 0x04, 0x4e, 0x4e, 0x41, 0x52, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x4f,
+	0x4e, 0x52, 0x52, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04, 0x4f, 0x4e, 0x41, 0x52, 0x10, 0x02, 0x42,
+	0x06, 0x5a, 0x04, 0x2e, 0x3b, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_messages_proto_rawDescOnce sync.Once
+	file_messages_proto_rawDescData = file_messages_proto_rawDesc
+)
+
+func file_messages_proto_rawDescGZIP() []byte {
+	file_messages_proto_rawDescOnce.Do(func() {
+		file_messages_proto_rawDescData = protoimpl.X.CompressGZIP(file_messages_proto_rawDescData)
+	})
+	return file_messages_proto_rawDescData
+}
+
+var file_messages_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_messages_proto_msgTypes = make([]protoimpl.MessageInfo, 177)
+var file_messages_proto_goTypes = []interface{}{
+	(ValueType)(0),                       // 0: pb.ValueType
+	(RegisterKind)(0),                    // 1: pb.RegisterKind
+	(Message_Type)(0),                    // 2: pb.Message.Type
+	(*ProcessId)(nil),                    // 3: pb.ProcessId
+	(*Value)(nil),                        // 4: pb.Value
+	(*ProcRegistration)(nil),             // 5: pb.ProcRegistration
+	(*ProcInitializeSystem)(nil),         // 6: pb.ProcInitializeSystem
+	(*ProcDestroySystem)(nil),            // 7: pb.ProcDestroySystem
+	(*ProcReconfigureSystem)(nil),        // 8: pb.ProcReconfigureSystem
+	(*AppBroadcast)(nil),                 // 9: pb.AppBroadcast
+	(*AppReliableBroadcast)(nil),         // 10: pb.AppReliableBroadcast
+	(*AppUniformBroadcast)(nil),          // 11: pb.AppUniformBroadcast
+	(*AppFifoBroadcast)(nil),             // 12: pb.AppFifoBroadcast
+	(*AppCausalBroadcast)(nil),           // 13: pb.AppCausalBroadcast
+	(*AppProbabilisticBroadcast)(nil),    // 14: pb.AppProbabilisticBroadcast
+	(*AppBcbBroadcast)(nil),              // 15: pb.AppBcbBroadcast
+	(*AppBrbBroadcast)(nil),              // 16: pb.AppBrbBroadcast
+	(*AppTobBroadcast)(nil),              // 17: pb.AppTobBroadcast
+	(*AppTobDeliver)(nil),                // 18: pb.AppTobDeliver
+	(*AppTrb)(nil),                       // 19: pb.AppTrb
+	(*AppTrbDeliver)(nil),                // 20: pb.AppTrbDeliver
+	(*AppNbacPropose)(nil),               // 21: pb.AppNbacPropose
+	(*AppNbacDecide)(nil),                // 22: pb.AppNbacDecide
+	(*AppCommit)(nil),                    // 23: pb.AppCommit
+	(*AppCommitOutcome)(nil),             // 24: pb.AppCommitOutcome
+	(*AppVsBroadcast)(nil),               // 25: pb.AppVsBroadcast
+	(*AppVsDeliver)(nil),                 // 26: pb.AppVsDeliver
+	(*AppView)(nil),                      // 27: pb.AppView
+	(*AppLogAppend)(nil),                 // 28: pb.AppLogAppend
+	(*AppLogAppended)(nil),               // 29: pb.AppLogAppended
+	(*AppLogCommitted)(nil),              // 30: pb.AppLogCommitted
+	(*AppValue)(nil),                     // 31: pb.AppValue
+	(*AppPropose)(nil),                   // 32: pb.AppPropose
+	(*AppDecide)(nil),                    // 33: pb.AppDecide
+	(*AppRead)(nil),                      // 34: pb.AppRead
+	(*AppWrite)(nil),                     // 35: pb.AppWrite
+	(*AppReadReturn)(nil),                // 36: pb.AppReadReturn
+	(*AppWriteReturn)(nil),               // 37: pb.AppWriteReturn
+	(*UcPropose)(nil),                    // 38: pb.UcPropose
+	(*UcDecide)(nil),                     // 39: pb.UcDecide
+	(*EpAbort)(nil),                      // 40: pb.EpAbort
+	(*EpAborted)(nil),                    // 41: pb.EpAborted
+	(*EpPropose)(nil),                    // 42: pb.EpPropose
+	(*EpDecide)(nil),                     // 43: pb.EpDecide
+	(*EpInternalRead)(nil),               // 44: pb.EpInternalRead
+	(*EpInternalState)(nil),              // 45: pb.EpInternalState
+	(*EpInternalWrite)(nil),              // 46: pb.EpInternalWrite
+	(*EpInternalAccept)(nil),             // 47: pb.EpInternalAccept
+	(*EpInternalDecided)(nil),            // 48: pb.EpInternalDecided
+	(*EcInternalNack)(nil),               // 49: pb.EcInternalNack
+	(*EcStartEpoch)(nil),                 // 50: pb.EcStartEpoch
+	(*EcInternalNewEpoch)(nil),           // 51: pb.EcInternalNewEpoch
+	(*BebBroadcast)(nil),                 // 52: pb.BebBroadcast
+	(*BebDeliver)(nil),                   // 53: pb.BebDeliver
+	(*RbBroadcast)(nil),                  // 54: pb.RbBroadcast
+	(*RbDeliver)(nil),                    // 55: pb.RbDeliver
+	(*RbInternalData)(nil),               // 56: pb.RbInternalData
+	(*UrbBroadcast)(nil),                 // 57: pb.UrbBroadcast
+	(*UrbDeliver)(nil),                   // 58: pb.UrbDeliver
+	(*UrbInternalData)(nil),              // 59: pb.UrbInternalData
+	(*FrbBroadcast)(nil),                 // 60: pb.FrbBroadcast
+	(*FrbDeliver)(nil),                   // 61: pb.FrbDeliver
+	(*FrbInternalData)(nil),              // 62: pb.FrbInternalData
+	(*CrbBroadcast)(nil),                 // 63: pb.CrbBroadcast
+	(*CrbDeliver)(nil),                   // 64: pb.CrbDeliver
+	(*CrbPastEntry)(nil),                 // 65: pb.CrbPastEntry
+	(*CrbInternalData)(nil),              // 66: pb.CrbInternalData
+	(*PbBroadcast)(nil),                  // 67: pb.PbBroadcast
+	(*PbDeliver)(nil),                    // 68: pb.PbDeliver
+	(*PbInternalGossip)(nil),             // 69: pb.PbInternalGossip
+	(*PbInternalRequest)(nil),            // 70: pb.PbInternalRequest
+	(*PbInternalData)(nil),               // 71: pb.PbInternalData
+	(*PbTimeout)(nil),                    // 72: pb.PbTimeout
+	(*TobBroadcast)(nil),                 // 73: pb.TobBroadcast
+	(*TobDeliver)(nil),                   // 74: pb.TobDeliver
+	(*TobInternalData)(nil),              // 75: pb.TobInternalData
+	(*TobEntry)(nil),                     // 76: pb.TobEntry
+	(*TobBatch)(nil),                     // 77: pb.TobBatch
+	(*TrbBroadcast)(nil),                 // 78: pb.TrbBroadcast
+	(*TrbDeliver)(nil),                   // 79: pb.TrbDeliver
+	(*TrbInternalData)(nil),              // 80: pb.TrbInternalData
+	(*TrbProposal)(nil),                  // 81: pb.TrbProposal
+	(*NbacPropose)(nil),                  // 82: pb.NbacPropose
+	(*NbacDecide)(nil),                   // 83: pb.NbacDecide
+	(*NbacInternalVote)(nil),             // 84: pb.NbacInternalVote
+	(*CommitStart)(nil),                  // 85: pb.CommitStart
+	(*CommitOutcome)(nil),                // 86: pb.CommitOutcome
+	(*CommitInternalPrepare)(nil),        // 87: pb.CommitInternalPrepare
+	(*CommitInternalVote)(nil),           // 88: pb.CommitInternalVote
+	(*CommitInternalPrecommit)(nil),      // 89: pb.CommitInternalPrecommit
+	(*CommitInternalAck)(nil),            // 90: pb.CommitInternalAck
+	(*CommitInternalDecision)(nil),       // 91: pb.CommitInternalDecision
+	(*CommitInternalState)(nil),          // 92: pb.CommitInternalState
+	(*GmView)(nil),                       // 93: pb.GmView
+	(*GmReconfigure)(nil),                // 94: pb.GmReconfigure
+	(*VsBroadcast)(nil),                  // 95: pb.VsBroadcast
+	(*VsDeliver)(nil),                    // 96: pb.VsDeliver
+	(*VsView)(nil),                       // 97: pb.VsView
+	(*VsInternalData)(nil),               // 98: pb.VsInternalData
+	(*VsInternalPending)(nil),            // 99: pb.VsInternalPending
+	(*VsProposal)(nil),                   // 100: pb.VsProposal
+	(*NnarState)(nil),                    // 101: pb.NnarState
+	(*ReconfInternalState)(nil),          // 102: pb.ReconfInternalState
+	(*EldTimeout)(nil),                   // 103: pb.EldTimeout
+	(*EldTrust)(nil),                     // 104: pb.EldTrust
+	(*NnarRead)(nil),                     // 105: pb.NnarRead
+	(*NnarInternalRead)(nil),             // 106: pb.NnarInternalRead
+	(*NnarInternalValue)(nil),            // 107: pb.NnarInternalValue
+	(*NnarInternalWrite)(nil),            // 108: pb.NnarInternalWrite
+	(*NnarWrite)(nil),                    // 109: pb.NnarWrite
+	(*NnarInternalAck)(nil),              // 110: pb.NnarInternalAck
+	(*NnarLog)(nil),                      // 111: pb.NnarLog
+	(*NnarReadReturn)(nil),               // 112: pb.NnarReadReturn
+	(*NnarWriteReturn)(nil),              // 113: pb.NnarWriteReturn
+	(*OnrrRead)(nil),                     // 114: pb.OnrrRead
+	(*OnrrReadReturn)(nil),               // 115: pb.OnrrReadReturn
+	(*OnrrWrite)(nil),                    // 116: pb.OnrrWrite
+	(*OnrrWriteReturn)(nil),              // 117: pb.OnrrWriteReturn
+	(*OnrrInternalRead)(nil),             // 118: pb.OnrrInternalRead
+	(*OnrrInternalValue)(nil),            // 119: pb.OnrrInternalValue
+	(*OnrrInternalWrite)(nil),            // 120: pb.OnrrInternalWrite
+	(*OnrrInternalAck)(nil),              // 121: pb.OnrrInternalAck
+	(*OnarRead)(nil),                     // 122: pb.OnarRead
+	(*OnarReadReturn)(nil),               // 123: pb.OnarReadReturn
+	(*OnarWrite)(nil),                    // 124: pb.OnarWrite
+	(*OnarWriteReturn)(nil),              // 125: pb.OnarWriteReturn
+	(*LogAppend)(nil),                    // 126: pb.LogAppend
+	(*LogAppended)(nil),                  // 127: pb.LogAppended
+	(*LogCommitted)(nil),                 // 128: pb.LogCommitted
+	(*LogEntry)(nil),                     // 129: pb.LogEntry
+	(*LogSlot)(nil),                      // 130: pb.LogSlot
+	(*LogInternalForward)(nil),           // 131: pb.LogInternalForward
+	(*LogInternalPrepare)(nil),           // 132: pb.LogInternalPrepare
+	(*LogInternalPromise)(nil),           // 133: pb.LogInternalPromise
+	(*LogInternalAccept)(nil),            // 134: pb.LogInternalAccept
+	(*LogInternalAccepted)(nil),          // 135: pb.LogInternalAccepted
+	(*LogInternalCommit)(nil),            // 136: pb.LogInternalCommit
+	(*RaftEntry)(nil),                    // 137: pb.RaftEntry
+	(*RaftTimeout)(nil),                  // 138: pb.RaftTimeout
+	(*RaftInternalRequestVote)(nil),      // 139: pb.RaftInternalRequestVote
+	(*RaftInternalVote)(nil),             // 140: pb.RaftInternalVote
+	(*RaftInternalAppendEntries)(nil),    // 141: pb.RaftInternalAppendEntries
+	(*RaftInternalAppendResult)(nil),     // 142: pb.RaftInternalAppendResult
+	(*RaftInternalForward)(nil),          // 143: pb.RaftInternalForward
+	(*AppRcPropose)(nil),                 // 144: pb.AppRcPropose
+	(*AppRcDecide)(nil),                  // 145: pb.AppRcDecide
+	(*RcInternalProposal)(nil),           // 146: pb.RcInternalProposal
+	(*BcInternalPhase)(nil),              // 147: pb.BcInternalPhase
+	(*BcInternalDecided)(nil),            // 148: pb.BcInternalDecided
+	(*FloodingInternalProposal)(nil),     // 149: pb.FloodingInternalProposal
+	(*FloodingInternalDecided)(nil),      // 150: pb.FloodingInternalDecided
+	(*HierarchicalInternalProposal)(nil), // 151: pb.HierarchicalInternalProposal
+	(*HierarchicalInternalAck)(nil),      // 152: pb.HierarchicalInternalAck
+	(*HierarchicalInternalDecided)(nil),  // 153: pb.HierarchicalInternalDecided
+	(*BcbBroadcast)(nil),                 // 154: pb.BcbBroadcast
+	(*BcbDeliver)(nil),                   // 155: pb.BcbDeliver
+	(*BrbBroadcast)(nil),                 // 156: pb.BrbBroadcast
+	(*BrbDeliver)(nil),                   // 157: pb.BrbDeliver
+	(*ByzantineInternalSend)(nil),        // 158: pb.ByzantineInternalSend
+	(*ByzantineInternalEcho)(nil),        // 159: pb.ByzantineInternalEcho
+	(*ByzantineInternalReady)(nil),       // 160: pb.ByzantineInternalReady
+	(*EpfdTimeout)(nil),                  // 161: pb.EpfdTimeout
+	(*EpfdInternalHeartbeatRequest)(nil), // 162: pb.EpfdInternalHeartbeatRequest
+	(*EpfdInternalHeartbeatReply)(nil),   // 163: pb.EpfdInternalHeartbeatReply
+	(*EpfdSuspect)(nil),                  // 164: pb.EpfdSuspect
+	(*EpfdRestore)(nil),                  // 165: pb.EpfdRestore
+	(*PfdTimeout)(nil),                   // 166: pb.PfdTimeout
+	(*PfdInternalHeartbeatRequest)(nil),  // 167: pb.PfdInternalHeartbeatRequest
+	(*PfdInternalHeartbeatReply)(nil),    // 168: pb.PfdInternalHeartbeatReply
+	(*PfdCrash)(nil),                     // 169: pb.PfdCrash
+	(*AppElect)(nil),                     // 170: pb.AppElect
+	(*AppLeader)(nil),                    // 171: pb.AppLeader
+	(*LeStart)(nil),                      // 172: pb.LeStart
+	(*LeLeader)(nil),                     // 173: pb.LeLeader
+	(*PlSend)(nil),                       // 174: pb.PlSend
+	(*PlDeliver)(nil),                    // 175: pb.PlDeliver
+	(*NetworkMessage)(nil),               // 176: pb.NetworkMessage
+	(*Message)(nil),                      // 177: pb.Message
+	nil,                                  // 178: pb.ProcInitializeSystem.SettingsEntry
+	nil,                                  // 179: pb.ProcReconfigureSystem.SettingsEntry
+}
+var file_messages_proto_depIdxs = []int32{
+	0,   // 0: pb.Value.type:type_name -> pb.ValueType
+	3,   // 1: pb.ProcInitializeSystem.processes:type_name -> pb.ProcessId
+	178, // 2: pb.ProcInitializeSystem.settings:type_name -> pb.ProcInitializeSystem.SettingsEntry
+	3,   // 3: pb.ProcReconfigureSystem.processes:type_name -> pb.ProcessId
+	3,   // 4: pb.ProcReconfigureSystem.previous:type_name -> pb.ProcessId
+	179, // 5: pb.ProcReconfigureSystem.settings:type_name -> pb.ProcReconfigureSystem.SettingsEntry
+	4,   // 6: pb.AppBroadcast.value:type_name -> pb.Value
+	4,   // 7: pb.AppReliableBroadcast.value:type_name -> pb.Value
+	4,   // 8: pb.AppUniformBroadcast.value:type_name -> pb.Value
+	4,   // 9: pb.AppFifoBroadcast.value:type_name -> pb.Value
+	4,   // 10: pb.AppCausalBroadcast.value:type_name -> pb.Value
+	4,   // 11: pb.AppProbabilisticBroadcast.value:type_name -> pb.Value
+	4,   // 12: pb.AppBcbBroadcast.value:type_name -> pb.Value
+	4,   // 13: pb.AppBrbBroadcast.value:type_name -> pb.Value
+	4,   // 14: pb.AppTobBroadcast.value:type_name -> pb.Value
+	3,   // 15: pb.AppTobDeliver.sender:type_name -> pb.ProcessId
+	4,   // 16: pb.AppTobDeliver.value:type_name -> pb.Value
+	3,   // 17: pb.AppTrb.sender:type_name -> pb.ProcessId
+	4,   // 18: pb.AppTrb.value:type_name -> pb.Value
+	3,   // 19: pb.AppTrbDeliver.sender:type_name -> pb.ProcessId
+	4,   // 20: pb.AppTrbDeliver.value:type_name -> pb.Value
+	3,   // 21: pb.AppCommit.coordinator:type_name -> pb.ProcessId
+	4,   // 22: pb.AppVsBroadcast.value:type_name -> pb.Value
+	3,   // 23: pb.AppVsDeliver.sender:type_name -> pb.ProcessId
+	4,   // 24: pb.AppVsDeliver.value:type_name -> pb.Value
+	3,   // 25: pb.AppView.members:type_name -> pb.ProcessId
+	4,   // 26: pb.AppLogAppend.value:type_name -> pb.Value
+	4,   // 27: pb.AppLogAppended.value:type_name -> pb.Value
+	4,   // 28: pb.AppLogCommitted.value:type_name -> pb.Value
+	4,   // 29: pb.AppValue.value:type_name -> pb.Value
+	4,   // 30: pb.AppPropose.value:type_name -> pb.Value
+	4,   // 31: pb.AppDecide.value:type_name -> pb.Value
+	1,   // 32: pb.AppRead.kind:type_name -> pb.RegisterKind
+	4,   // 33: pb.AppWrite.value:type_name -> pb.Value
+	1,   // 34: pb.AppWrite.kind:type_name -> pb.RegisterKind
+	4,   // 35: pb.AppReadReturn.value:type_name -> pb.Value
+	1,   // 36: pb.AppReadReturn.kind:type_name -> pb.RegisterKind
+	1,   // 37: pb.AppWriteReturn.kind:type_name -> pb.RegisterKind
+	4,   // 38: pb.UcPropose.value:type_name -> pb.Value
+	4,   // 39: pb.UcDecide.value:type_name -> pb.Value
+	4,   // 40: pb.EpAborted.value:type_name -> pb.Value
+	4,   // 41: pb.EpPropose.value:type_name -> pb.Value
+	4,   // 42: pb.EpDecide.value:type_name -> pb.Value
+	4,   // 43: pb.EpInternalState.value:type_name -> pb.Value
+	4,   // 44: pb.EpInternalWrite.value:type_name -> pb.Value
+	4,   // 45: pb.EpInternalDecided.value:type_name -> pb.Value
+	3,   // 46: pb.EcStartEpoch.newLeader:type_name -> pb.ProcessId
+	177, // 47: pb.BebBroadcast.message:type_name -> pb.Message
+	177, // 48: pb.BebDeliver.message:type_name -> pb.Message
+	3,   // 49: pb.BebDeliver.sender:type_name -> pb.ProcessId
+	177, // 50: pb.RbBroadcast.message:type_name -> pb.Message
+	177, // 51: pb.RbDeliver.message:type_name -> pb.Message
+	3,   // 52: pb.RbDeliver.sender:type_name -> pb.ProcessId
+	3,   // 53: pb.RbInternalData.sender:type_name -> pb.ProcessId
+	177, // 54: pb.RbInternalData.message:type_name -> pb.Message
+	177, // 55: pb.UrbBroadcast.message:type_name -> pb.Message
+	177, // 56: pb.UrbDeliver.message:type_name -> pb.Message
+	3,   // 57: pb.UrbDeliver.sender:type_name -> pb.ProcessId
+	3,   // 58: pb.UrbInternalData.sender:type_name -> pb.ProcessId
+	177, // 59: pb.UrbInternalData.message:type_name -> pb.Message
+	177, // 60: pb.FrbBroadcast.message:type_name -> pb.Message
+	177, // 61: pb.FrbDeliver.message:type_name -> pb.Message
+	3,   // 62: pb.FrbDeliver.sender:type_name -> pb.ProcessId
+	177, // 63: pb.FrbInternalData.message:type_name -> pb.Message
+	177, // 64: pb.CrbBroadcast.message:type_name -> pb.Message
+	177, // 65: pb.CrbDeliver.message:type_name -> pb.Message
+	3,   // 66: pb.CrbDeliver.sender:type_name -> pb.ProcessId
+	3,   // 67: pb.CrbPastEntry.sender:type_name -> pb.ProcessId
+	177, // 68: pb.CrbPastEntry.message:type_name -> pb.Message
+	65,  // 69: pb.CrbInternalData.past:type_name -> pb.CrbPastEntry
+	177, // 70: pb.CrbInternalData.message:type_name -> pb.Message
+	177, // 71: pb.PbBroadcast.message:type_name -> pb.Message
+	177, // 72: pb.PbDeliver.message:type_name -> pb.Message
+	3,   // 73: pb.PbDeliver.sender:type_name -> pb.ProcessId
+	3,   // 74: pb.PbInternalGossip.sender:type_name -> pb.ProcessId
+	177, // 75: pb.PbInternalGossip.message:type_name -> pb.Message
+	3,   // 76: pb.PbInternalRequest.requester:type_name -> pb.ProcessId
+	3,   // 77: pb.PbInternalRequest.sender:type_name -> pb.ProcessId
+	3,   // 78: pb.PbInternalData.sender:type_name -> pb.ProcessId
+	177, // 79: pb.PbInternalData.message:type_name -> pb.Message
+	3,   // 80: pb.PbTimeout.sender:type_name -> pb.ProcessId
+	177, // 81: pb.TobBroadcast.message:type_name -> pb.Message
+	3,   // 82: pb.TobDeliver.sender:type_name -> pb.ProcessId
+	177, // 83: pb.TobDeliver.message:type_name -> pb.Message
+	177, // 84: pb.TobInternalData.message:type_name -> pb.Message
+	3,   // 85: pb.TobEntry.sender:type_name -> pb.ProcessId
+	177, // 86: pb.TobEntry.message:type_name -> pb.Message
+	76,  // 87: pb.TobBatch.entries:type_name -> pb.TobEntry
+	3,   // 88: pb.TrbBroadcast.sender:type_name -> pb.ProcessId
+	177, // 89: pb.TrbBroadcast.message:type_name -> pb.Message
+	3,   // 90: pb.TrbDeliver.sender:type_name -> pb.ProcessId
+	177, // 91: pb.TrbDeliver.message:type_name -> pb.Message
+	177, // 92: pb.TrbInternalData.message:type_name -> pb.Message
+	3,   // 93: pb.TrbProposal.sender:type_name -> pb.ProcessId
+	177, // 94: pb.TrbProposal.message:type_name -> pb.Message
+	3,   // 95: pb.CommitStart.coordinator:type_name -> pb.ProcessId
+	3,   // 96: pb.GmView.members:type_name -> pb.ProcessId
+	3,   // 97: pb.GmReconfigure.members:type_name -> pb.ProcessId
+	177, // 98: pb.VsBroadcast.message:type_name -> pb.Message
+	3,   // 99: pb.VsDeliver.sender:type_name -> pb.ProcessId
+	177, // 100: pb.VsDeliver.message:type_name -> pb.Message
+	3,   // 101: pb.VsView.members:type_name -> pb.ProcessId
+	177, // 102: pb.VsInternalData.message:type_name -> pb.Message
+	76,  // 103: pb.VsInternalPending.delivered:type_name -> pb.TobEntry
+	97,  // 104: pb.VsProposal.view:type_name -> pb.VsView
+	76,  // 105: pb.VsProposal.delivered:type_name -> pb.TobEntry
+	4,   // 106: pb.NnarState.value:type_name -> pb.Value
+	93,  // 107: pb.ReconfInternalState.view:type_name -> pb.GmView
+	101, // 108: pb.ReconfInternalState.registers:type_name -> pb.NnarState
+	3,   // 109: pb.EldTrust.process:type_name -> pb.ProcessId
+	4,   // 110: pb.NnarInternalValue.value:type_name -> pb.Value
+	4,   // 111: pb.NnarInternalWrite.value:type_name -> pb.Value
+	4,   // 112: pb.NnarWrite.value:type_name -> pb.Value
+	101, // 113: pb.NnarLog.state:type_name -> pb.NnarState
+	4,   // 114: pb.NnarLog.writeVal:type_name -> pb.Value
+	4,   // 115: pb.NnarReadReturn.value:type_name -> pb.Value
+	4,   // 116: pb.OnrrReadReturn.value:type_name -> pb.Value
+	4,   // 117: pb.OnrrWrite.value:type_name -> pb.Value
+	4,   // 118: pb.OnrrInternalValue.value:type_name -> pb.Value
+	4,   // 119: pb.OnrrInternalWrite.value:type_name -> pb.Value
+	4,   // 120: pb.OnarReadReturn.value:type_name -> pb.Value
+	4,   // 121: pb.OnarWrite.value:type_name -> pb.Value
+	4,   // 122: pb.LogAppend.value:type_name -> pb.Value
+	4,   // 123: pb.LogAppended.value:type_name -> pb.Value
+	4,   // 124: pb.LogCommitted.value:type_name -> pb.Value
+	4,   // 125: pb.LogEntry.value:type_name -> pb.Value
+	129, // 126: pb.LogSlot.entry:type_name -> pb.LogEntry
+	129, // 127: pb.LogInternalForward.entries:type_name -> pb.LogEntry
+	130, // 128: pb.LogInternalPromise.accepted:type_name -> pb.LogSlot
+	130, // 129: pb.LogInternalAccept.slots:type_name -> pb.LogSlot
+	130, // 130: pb.LogInternalCommit.slots:type_name -> pb.LogSlot
+	4,   // 131: pb.RaftEntry.value:type_name -> pb.Value
+	137, // 132: pb.RaftInternalAppendEntries.entries:type_name -> pb.RaftEntry
+	4,   // 133: pb.RaftInternalForward.value:type_name -> pb.Value
+	4,   // 134: pb.AppRcPropose.value:type_name -> pb.Value
+	4,   // 135: pb.AppRcDecide.value:type_name -> pb.Value
+	4,   // 136: pb.RcInternalProposal.value:type_name -> pb.Value
+	4,   // 137: pb.BcInternalPhase.value:type_name -> pb.Value
+	4,   // 138: pb.BcInternalDecided.value:type_name -> pb.Value
+	4,   // 139: pb.FloodingInternalProposal.proposals:type_name -> pb.Value
+	4,   // 140: pb.FloodingInternalDecided.value:type_name -> pb.Value
+	4,   // 141: pb.HierarchicalInternalProposal.value:type_name -> pb.Value
+	4,   // 142: pb.HierarchicalInternalDecided.value:type_name -> pb.Value
+	177, // 143: pb.BcbBroadcast.message:type_name -> pb.Message
+	177, // 144: pb.BcbDeliver.message:type_name -> pb.Message
+	3,   // 145: pb.BcbDeliver.sender:type_name -> pb.ProcessId
+	177, // 146: pb.BrbBroadcast.message:type_name -> pb.Message
+	177, // 147: pb.BrbDeliver.message:type_name -> pb.Message
+	3,   // 148: pb.BrbDeliver.sender:type_name -> pb.ProcessId
+	3,   // 149: pb.ByzantineInternalSend.sender:type_name -> pb.ProcessId
+	177, // 150: pb.ByzantineInternalSend.message:type_name -> pb.Message
+	3,   // 151: pb.ByzantineInternalEcho.sender:type_name -> pb.ProcessId
+	177, // 152: pb.ByzantineInternalEcho.message:type_name -> pb.Message
+	3,   // 153: pb.ByzantineInternalReady.sender:type_name -> pb.ProcessId
+	177, // 154: pb.ByzantineInternalReady.message:type_name -> pb.Message
+	3,   // 155: pb.EpfdSuspect.process:type_name -> pb.ProcessId
+	3,   // 156: pb.EpfdRestore.process:type_name -> pb.ProcessId
+	3,   // 157: pb.PfdCrash.process:type_name -> pb.ProcessId
+	3,   // 158: pb.AppLeader.leader:type_name -> pb.ProcessId
+	3,   // 159: pb.LeLeader.process:type_name -> pb.ProcessId
+	3,   // 160: pb.PlSend.destination:type_name -> pb.ProcessId
+	177, // 161: pb.PlSend.message:type_name -> pb.Message
+	3,   // 162: pb.PlDeliver.sender:type_name -> pb.ProcessId
+	177, // 163: pb.PlDeliver.message:type_name -> pb.Message
+	177, // 164: pb.NetworkMessage.message:type_name -> pb.Message
+	2,   // 165: pb.Message.type:type_name -> pb.Message.Type
+	176, // 166: pb.Message.networkMessage:type_name -> pb.NetworkMessage
+	5,   // 167: pb.Message.procRegistration:type_name -> pb.ProcRegistration
+	6,   // 168: pb.Message.procInitializeSystem:type_name -> pb.ProcInitializeSystem
+	7,   // 169: pb.Message.procDestroySystem:type_name -> pb.ProcDestroySystem
+	9,   // 170: pb.Message.appBroadcast:type_name -> pb.AppBroadcast
+	31,  // 171: pb.Message.appValue:type_name -> pb.AppValue
+	32,  // 172: pb.Message.appPropose:type_name -> pb.AppPropose
+	33,  // 173: pb.Message.appDecide:type_name -> pb.AppDecide
+	34,  // 174: pb.Message.appRead:type_name -> pb.AppRead
+	35,  // 175: pb.Message.appWrite:type_name -> pb.AppWrite
+	36,  // 176: pb.Message.appReadReturn:type_name -> pb.AppReadReturn
+	37,  // 177: pb.Message.appWriteReturn:type_name -> pb.AppWriteReturn
+	10,  // 178: pb.Message.appReliableBroadcast:type_name -> pb.AppReliableBroadcast
+	11,  // 179: pb.Message.appUniformBroadcast:type_name -> pb.AppUniformBroadcast
+	12,  // 180: pb.Message.appFifoBroadcast:type_name -> pb.AppFifoBroadcast
+	13,  // 181: pb.Message.appCausalBroadcast:type_name -> pb.AppCausalBroadcast
+	14,  // 182: pb.Message.appProbabilisticBroadcast:type_name -> pb.AppProbabilisticBroadcast
+	17,  // 183: pb.Message.appTobBroadcast:type_name -> pb.AppTobBroadcast
+	18,  // 184: pb.Message.appTobDeliver:type_name -> pb.AppTobDeliver
+	19,  // 185: pb.Message.appTrb:type_name -> pb.AppTrb
+	20,  // 186: pb.Message.appTrbDeliver:type_name -> pb.AppTrbDeliver
+	21,  // 187: pb.Message.appNbacPropose:type_name -> pb.AppNbacPropose
+	22,  // 188: pb.Message.appNbacDecide:type_name -> pb.AppNbacDecide
+	23,  // 189: pb.Message.appCommit:type_name -> pb.AppCommit
+	24,  // 190: pb.Message.appCommitOutcome:type_name -> pb.AppCommitOutcome
+	25,  // 191: pb.Message.appVsBroadcast:type_name -> pb.AppVsBroadcast
+	26,  // 192: pb.Message.appVsDeliver:type_name -> pb.AppVsDeliver
+	27,  // 193: pb.Message.appView:type_name -> pb.AppView
+	39,  // 194: pb.Message.ucDecide:type_name -> pb.UcDecide
+	38,  // 195: pb.Message.ucPropose:type_name -> pb.UcPropose
+	40,  // 196: pb.Message.epAbort:type_name -> pb.EpAbort
+	41,  // 197: pb.Message.epAborted:type_name -> pb.EpAborted
+	47,  // 198: pb.Message.epInternalAccept:type_name -> pb.EpInternalAccept
+	43,  // 199: pb.Message.epDecide:type_name -> pb.EpDecide
+	48,  // 200: pb.Message.epInternalDecided:type_name -> pb.EpInternalDecided
+	42,  // 201: pb.Message.epPropose:type_name -> pb.EpPropose
+	44,  // 202: pb.Message.epInternalRead:type_name -> pb.EpInternalRead
+	45,  // 203: pb.Message.epInternalState:type_name -> pb.EpInternalState
+	46,  // 204: pb.Message.epInternalWrite:type_name -> pb.EpInternalWrite
+	49,  // 205: pb.Message.ecInternalNack:type_name -> pb.EcInternalNack
+	51,  // 206: pb.Message.ecInternalNewEpoch:type_name -> pb.EcInternalNewEpoch
+	50,  // 207: pb.Message.ecStartEpoch:type_name -> pb.EcStartEpoch
+	52,  // 208: pb.Message.bebBroadcast:type_name -> pb.BebBroadcast
+	53,  // 209: pb.Message.bebDeliver:type_name -> pb.BebDeliver
+	103, // 210: pb.Message.eldTimeout:type_name -> pb.EldTimeout
+	104, // 211: pb.Message.eldTrust:type_name -> pb.EldTrust
+	110, // 212: pb.Message.nnarInternalAck:type_name -> pb.NnarInternalAck
+	106, // 213: pb.Message.nnarInternalRead:type_name -> pb.NnarInternalRead
+	107, // 214: pb.Message.nnarInternalValue:type_name -> pb.NnarInternalValue
+	108, // 215: pb.Message.nnarInternalWrite:type_name -> pb.NnarInternalWrite
+	105, // 216: pb.Message.nnarRead:type_name -> pb.NnarRead
+	112, // 217: pb.Message.nnarReadReturn:type_name -> pb.NnarReadReturn
+	109, // 218: pb.Message.nnarWrite:type_name -> pb.NnarWrite
+	113, // 219: pb.Message.nnarWriteReturn:type_name -> pb.NnarWriteReturn
+	161, // 220: pb.Message.epfdTimeout:type_name -> pb.EpfdTimeout
+	162, // 221: pb.Message.epfdInternalHeartbeatRequest:type_name -> pb.EpfdInternalHeartbeatRequest
+	163, // 222: pb.Message.epfdInternalHeartbeatReply:type_name -> pb.EpfdInternalHeartbeatReply
+	164, // 223: pb.Message.epfdSuspect:type_name -> pb.EpfdSuspect
+	165, // 224: pb.Message.epfdRestore:type_name -> pb.EpfdRestore
+	175, // 225: pb.Message.plDeliver:type_name -> pb.PlDeliver
+	174, // 226: pb.Message.plSend:type_name -> pb.PlSend
+	166, // 227: pb.Message.pfdTimeout:type_name -> pb.PfdTimeout
+	167, // 228: pb.Message.pfdInternalHeartbeatRequest:type_name -> pb.PfdInternalHeartbeatRequest
+	168, // 229: pb.Message.pfdInternalHeartbeatReply:type_name -> pb.PfdInternalHeartbeatReply
+	169, // 230: pb.Message.pfdCrash:type_name -> pb.PfdCrash
+	173, // 231: pb.Message.leLeader:type_name -> pb.LeLeader
+	170, // 232: pb.Message.appElect:type_name -> pb.AppElect
+	171, // 233: pb.Message.appLeader:type_name -> pb.AppLeader
+	172, // 234: pb.Message.leStart:type_name -> pb.LeStart
+	54,  // 235: pb.Message.rbBroadcast:type_name -> pb.RbBroadcast
+	55,  // 236: pb.Message.rbDeliver:type_name -> pb.RbDeliver
+	56,  // 237: pb.Message.rbInternalData:type_name -> pb.RbInternalData
+	57,  // 238: pb.Message.urbBroadcast:type_name -> pb.UrbBroadcast
+	58,  // 239: pb.Message.urbDeliver:type_name -> pb.UrbDeliver
+	59,  // 240: pb.Message.urbInternalData:type_name -> pb.UrbInternalData
+	60,  // 241: pb.Message.frbBroadcast:type_name -> pb.FrbBroadcast
+	61,  // 242: pb.Message.frbDeliver:type_name -> pb.FrbDeliver
+	62,  // 243: pb.Message.frbInternalData:type_name -> pb.FrbInternalData
+	63,  // 244: pb.Message.crbBroadcast:type_name -> pb.CrbBroadcast
+	64,  // 245: pb.Message.crbDeliver:type_name -> pb.CrbDeliver
+	66,  // 246: pb.Message.crbInternalData:type_name -> pb.CrbInternalData
+	67,  // 247: pb.Message.pbBroadcast:type_name -> pb.PbBroadcast
+	68,  // 248: pb.Message.pbDeliver:type_name -> pb.PbDeliver
+	69,  // 249: pb.Message.pbInternalGossip:type_name -> pb.PbInternalGossip
+	70,  // 250: pb.Message.pbInternalRequest:type_name -> pb.PbInternalRequest
+	71,  // 251: pb.Message.pbInternalData:type_name -> pb.PbInternalData
+	72,  // 252: pb.Message.pbTimeout:type_name -> pb.PbTimeout
+	73,  // 253: pb.Message.tobBroadcast:type_name -> pb.TobBroadcast
+	74,  // 254: pb.Message.tobDeliver:type_name -> pb.TobDeliver
+	75,  // 255: pb.Message.tobInternalData:type_name -> pb.TobInternalData
+	78,  // 256: pb.Message.trbBroadcast:type_name -> pb.TrbBroadcast
+	79,  // 257: pb.Message.trbDeliver:type_name -> pb.TrbDeliver
+	80,  // 258: pb.Message.trbInternalData:type_name -> pb.TrbInternalData
+	82,  // 259: pb.Message.nbacPropose:type_name -> pb.NbacPropose
+	83,  // 260: pb.Message.nbacDecide:type_name -> pb.NbacDecide
+	84,  // 261: pb.Message.nbacInternalVote:type_name -> pb.NbacInternalVote
+	85,  // 262: pb.Message.commitStart:type_name -> pb.CommitStart
+	86,  // 263: pb.Message.commitOutcome:type_name -> pb.CommitOutcome
+	87,  // 264: pb.Message.commitInternalPrepare:type_name -> pb.CommitInternalPrepare
+	88,  // 265: pb.Message.commitInternalVote:type_name -> pb.CommitInternalVote
+	89,  // 266: pb.Message.commitInternalPrecommit:type_name -> pb.CommitInternalPrecommit
+	90,  // 267: pb.Message.commitInternalAck:type_name -> pb.CommitInternalAck
+	91,  // 268: pb.Message.commitInternalDecision:type_name -> pb.CommitInternalDecision
+	92,  // 269: pb.Message.commitInternalState:type_name -> pb.CommitInternalState
+	93,  // 270: pb.Message.gmView:type_name -> pb.GmView
+	94,  // 271: pb.Message.gmReconfigure:type_name -> pb.GmReconfigure
+	95,  // 272: pb.Message.vsBroadcast:type_name -> pb.VsBroadcast
+	96,  // 273: pb.Message.vsDeliver:type_name -> pb.VsDeliver
+	97,  // 274: pb.Message.vsView:type_name -> pb.VsView
+	98,  // 275: pb.Message.vsInternalData:type_name -> pb.VsInternalData
+	99,  // 276: pb.Message.vsInternalPending:type_name -> pb.VsInternalPending
+	8,   // 277: pb.Message.procReconfigureSystem:type_name -> pb.ProcReconfigureSystem
+	102, // 278: pb.Message.reconfInternalState:type_name -> pb.ReconfInternalState
+	114, // 279: pb.Message.onrrRead:type_name -> pb.OnrrRead
+	115, // 280: pb.Message.onrrReadReturn:type_name -> pb.OnrrReadReturn
+	116, // 281: pb.Message.onrrWrite:type_name -> pb.OnrrWrite
+	117, // 282: pb.Message.onrrWriteReturn:type_name -> pb.OnrrWriteReturn
+	118, // 283: pb.Message.onrrInternalRead:type_name -> pb.OnrrInternalRead
+	119, // 284: pb.Message.onrrInternalValue:type_name -> pb.OnrrInternalValue
+	120, // 285: pb.Message.onrrInternalWrite:type_name -> pb.OnrrInternalWrite
+	121, // 286: pb.Message.onrrInternalAck:type_name -> pb.OnrrInternalAck
+	122, // 287: pb.Message.onarRead:type_name -> pb.OnarRead
+	123, // 288: pb.Message.onarReadReturn:type_name -> pb.OnarReadReturn
+	124, // 289: pb.Message.onarWrite:type_name -> pb.OnarWrite
+	125, // 290: pb.Message.onarWriteReturn:type_name -> pb.OnarWriteReturn
+	28,  // 291: pb.Message.appLogAppend:type_name -> pb.AppLogAppend
+	29,  // 292: pb.Message.appLogAppended:type_name -> pb.AppLogAppended
+	30,  // 293: pb.Message.appLogCommitted:type_name -> pb.AppLogCommitted
+	126, // 294: pb.Message.logAppend:type_name -> pb.LogAppend
+	127, // 295: pb.Message.logAppended:type_name -> pb.LogAppended
+	128, // 296: pb.Message.logCommitted:type_name -> pb.LogCommitted
+	131, // 297: pb.Message.logInternalForward:type_name -> pb.LogInternalForward
+	132, // 298: pb.Message.logInternalPrepare:type_name -> pb.LogInternalPrepare
+	133, // 299: pb.Message.logInternalPromise:type_name -> pb.LogInternalPromise
+	134, // 300: pb.Message.logInternalAccept:type_name -> pb.LogInternalAccept
+	135, // 301: pb.Message.logInternalAccepted:type_name -> pb.LogInternalAccepted
+	136, // 302: pb.Message.logInternalCommit:type_name -> pb.LogInternalCommit
+	138, // 303: pb.Message.raftTimeout:type_name -> pb.RaftTimeout
+	139, // 304: pb.Message.raftInternalRequestVote:type_name -> pb.RaftInternalRequestVote
+	140, // 305: pb.Message.raftInternalVote:type_name -> pb.RaftInternalVote
+	141, // 306: pb.Message.raftInternalAppendEntries:type_name -> pb.RaftInternalAppendEntries
+	142, // 307: pb.Message.raftInternalAppendResult:type_name -> pb.RaftInternalAppendResult
+	143, // 308: pb.Message.raftInternalForward:type_name -> pb.RaftInternalForward
+	144, // 309: pb.Message.appRcPropose:type_name -> pb.AppRcPropose
+	145, // 310: pb.Message.appRcDecide:type_name -> pb.AppRcDecide
+	146, // 311: pb.Message.rcInternalProposal:type_name -> pb.RcInternalProposal
+	147, // 312: pb.Message.bcInternalPhase:type_name -> pb.BcInternalPhase
+	148, // 313: pb.Message.bcInternalDecided:type_name -> pb.BcInternalDecided
+	149, // 314: pb.Message.floodingInternalProposal:type_name -> pb.FloodingInternalProposal
+	150, // 315: pb.Message.floodingInternalDecided:type_name -> pb.FloodingInternalDecided
+	151, // 316: pb.Message.hierarchicalInternalProposal:type_name -> pb.HierarchicalInternalProposal
+	152, // 317: pb.Message.hierarchicalInternalAck:type_name -> pb.HierarchicalInternalAck
+	153, // 318: pb.Message.hierarchicalInternalDecided:type_name -> pb.HierarchicalInternalDecided
+	15,  // 319: pb.Message.appBcbBroadcast:type_name -> pb.AppBcbBroadcast
+	16,  // 320: pb.Message.appBrbBroadcast:type_name -> pb.AppBrbBroadcast
+	154, // 321: pb.Message.bcbBroadcast:type_name -> pb.BcbBroadcast
+	155, // 322: pb.Message.bcbDeliver:type_name -> pb.BcbDeliver
+	156, // 323: pb.Message.brbBroadcast:type_name -> pb.BrbBroadcast
+	157, // 324: pb.Message.brbDeliver:type_name -> pb.BrbDeliver
+	158, // 325: pb.Message.byzantineInternalSend:type_name -> pb.ByzantineInternalSend
+	159, // 326: pb.Message.byzantineInternalEcho:type_name -> pb.ByzantineInternalEcho
+	160, // 327: pb.Message.byzantineInternalReady:type_name -> pb.ByzantineInternalReady
+	328, // [328:328] is the sub-list for method output_type
+	328, // [328:328] is the sub-list for method input_type
+	328, // [328:328] is the sub-list for extension type_name
+	328, // [328:328] is the sub-list for extension extendee
+	0,   // [0:328] is the sub-list for field type_name
+}
+
+func init() { file_messages_proto_init() }
+func file_messages_proto_init() {
+	if File_messages_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_messages_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProcessId); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_messages_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Value); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_messages_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProcRegistration); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_messages_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProcInitializeSystem); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_messages_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProcDestroySystem); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_messages_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProcReconfigureSystem); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_messages_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AppBroadcast); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_messages_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AppReliableBroadcast); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_messages_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AppUniformBroadcast); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_messages_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AppFifoBroadcast); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_messages_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AppCausalBroadcast); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_messages_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AppProbabilisticBroadcast); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_messages_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AppBcbBroadcast); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_messages_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AppBrbBroadcast); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_messages_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AppTobBroadcast); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_messages_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AppTobDeliver); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_messages_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AppTrb); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_messages_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AppTrbDeliver); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_messages_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AppNbacPropose); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_messages_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AppNbacDecide); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_messages_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AppCommit); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_messages_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AppCommitOutcome); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_messages_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AppVsBroadcast); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_messages_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AppVsDeliver); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_messages_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AppView); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_messages_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AppLogAppend); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_messages_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AppLogAppended); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_messages_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AppLogCommitted); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_messages_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AppValue); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_messages_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AppPropose); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_messages_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AppDecide); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_messages_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AppRead); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_messages_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AppWrite); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_messages_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AppReadReturn); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_messages_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AppWriteReturn); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_messages_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UcPropose); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_messages_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UcDecide); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_messages_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EpAbort); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_messages_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EpAborted); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_messages_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EpPropose); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_messages_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EpDecide); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_messages_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EpInternalRead); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_messages_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EpInternalState); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_messages_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EpInternalWrite); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_messages_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EpInternalAccept); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_messages_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EpInternalDecided); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_messages_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EcInternalNack); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_messages_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EcStartEpoch); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_messages_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EcInternalNewEpoch); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_messages_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BebBroadcast); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_messages_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BebDeliver); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_messages_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RbBroadcast); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_messages_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RbDeliver); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_messages_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RbInternalData); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_messages_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UrbBroadcast); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_messages_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UrbDeliver); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_messages_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UrbInternalData); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_messages_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FrbBroadcast); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_messages_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FrbDeliver); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_messages_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FrbInternalData); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_messages_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CrbBroadcast); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_messages_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CrbDeliver); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_messages_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CrbPastEntry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_messages_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CrbInternalData); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_messages_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PbBroadcast); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_messages_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PbDeliver); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_messages_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PbInternalGossip); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_messages_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PbInternalRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_messages_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PbInternalData); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_messages_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PbTimeout); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_messages_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TobBroadcast); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_messages_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TobDeliver); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_messages_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TobInternalData); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_messages_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TobEntry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_messages_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TobBatch); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_messages_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TrbBroadcast); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_messages_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TrbDeliver); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_messages_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TrbInternalData); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_messages_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TrbProposal); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_messages_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NbacPropose); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_messages_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NbacDecide); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_messages_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NbacInternalVote); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_messages_proto_msgTypes[82].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CommitStart); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_messages_proto_msgTypes[83].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CommitOutcome); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_messages_proto_msgTypes[84].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CommitInternalPrepare); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_messages_proto_msgTypes[85].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CommitInternalVote); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_messages_proto_msgTypes[86].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CommitInternalPrecommit); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_messages_proto_msgTypes[87].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CommitInternalAck); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_messages_proto_msgTypes[88].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CommitInternalDecision); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_messages_proto_msgTypes[89].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CommitInternalState); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_messages_proto_msgTypes[90].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GmView); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_messages_proto_msgTypes[91].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GmReconfigure); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_messages_proto_msgTypes[92].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VsBroadcast); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_messages_proto_msgTypes[93].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VsDeliver); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_messages_proto_msgTypes[94].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VsView); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_messages_proto_msgTypes[95].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VsInternalData); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_messages_proto_msgTypes[96].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VsInternalPending); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_messages_proto_msgTypes[97].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VsProposal); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_messages_proto_msgTypes[98].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NnarState); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_messages_proto_msgTypes[99].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReconfInternalState); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_messages_proto_msgTypes[100].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EldTimeout); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_messages_proto_msgTypes[101].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EldTrust); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_messages_proto_msgTypes[102].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NnarRead); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_messages_proto_msgTypes[103].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NnarInternalRead); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_messages_proto_msgTypes[104].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NnarInternalValue); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_messages_proto_msgTypes[105].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NnarInternalWrite); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_messages_proto_msgTypes[106].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NnarWrite); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_messages_proto_msgTypes[107].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NnarInternalAck); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_messages_proto_msgTypes[108].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NnarLog); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_messages_proto_msgTypes[109].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NnarReadReturn); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_messages_proto_msgTypes[110].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NnarWriteReturn); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3554,8 +15006,8 @@ func file_messages_proto_init() {
 				return nil
 			}
 		}
-		file_messages_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Value); i {
+		file_messages_proto_msgTypes[111].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OnrrRead); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3566,8 +15018,8 @@ func file_messages_proto_init() {
 				return nil
 			}
 		}
-		file_messages_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProcRegistration); i {
+		file_messages_proto_msgTypes[112].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OnrrReadReturn); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3578,8 +15030,8 @@ func file_messages_proto_init() {
 				return nil
 			}
 		}
-		file_messages_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProcInitializeSystem); i {
+		file_messages_proto_msgTypes[113].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OnrrWrite); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3590,8 +15042,8 @@ func file_messages_proto_init() {
 				return nil
 			}
 		}
-		file_messages_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProcDestroySystem); i {
+		file_messages_proto_msgTypes[114].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OnrrWriteReturn); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3602,8 +15054,8 @@ func file_messages_proto_init() {
 				return nil
 			}
 		}
-		file_messages_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AppBroadcast); i {
+		file_messages_proto_msgTypes[115].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OnrrInternalRead); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3614,8 +15066,8 @@ func file_messages_proto_init() {
 				return nil
 			}
 		}
-		file_messages_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AppValue); i {
+		file_messages_proto_msgTypes[116].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OnrrInternalValue); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3626,8 +15078,8 @@ func file_messages_proto_init() {
 				return nil
 			}
 		}
-		file_messages_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AppPropose); i {
+		file_messages_proto_msgTypes[117].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OnrrInternalWrite); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3638,8 +15090,8 @@ func file_messages_proto_init() {
 				return nil
 			}
 		}
-		file_messages_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AppDecide); i {
+		file_messages_proto_msgTypes[118].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OnrrInternalAck); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3650,8 +15102,8 @@ func file_messages_proto_init() {
 				return nil
 			}
 		}
-		file_messages_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AppRead); i {
+		file_messages_proto_msgTypes[119].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OnarRead); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3662,8 +15114,8 @@ func file_messages_proto_init() {
 				return nil
 			}
 		}
-		file_messages_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AppWrite); i {
+		file_messages_proto_msgTypes[120].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OnarReadReturn); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3674,8 +15126,8 @@ func file_messages_proto_init() {
 				return nil
 			}
 		}
-		file_messages_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AppReadReturn); i {
+		file_messages_proto_msgTypes[121].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OnarWrite); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3686,8 +15138,8 @@ func file_messages_proto_init() {
 				return nil
 			}
 		}
-		file_messages_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AppWriteReturn); i {
+		file_messages_proto_msgTypes[122].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OnarWriteReturn); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3698,8 +15150,8 @@ func file_messages_proto_init() {
 				return nil
 			}
 		}
-		file_messages_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UcPropose); i {
+		file_messages_proto_msgTypes[123].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LogAppend); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3710,8 +15162,8 @@ func file_messages_proto_init() {
 				return nil
 			}
 		}
-		file_messages_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UcDecide); i {
+		file_messages_proto_msgTypes[124].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LogAppended); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3722,8 +15174,8 @@ func file_messages_proto_init() {
 				return nil
 			}
 		}
-		file_messages_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EpAbort); i {
+		file_messages_proto_msgTypes[125].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LogCommitted); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3734,8 +15186,8 @@ func file_messages_proto_init() {
 				return nil
 			}
 		}
-		file_messages_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EpAborted); i {
+		file_messages_proto_msgTypes[126].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LogEntry); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3746,8 +15198,8 @@ func file_messages_proto_init() {
 				return nil
 			}
 		}
-		file_messages_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EpPropose); i {
+		file_messages_proto_msgTypes[127].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LogSlot); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3758,8 +15210,8 @@ func file_messages_proto_init() {
 				return nil
 			}
 		}
-		file_messages_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EpDecide); i {
+		file_messages_proto_msgTypes[128].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LogInternalForward); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3770,8 +15222,8 @@ func file_messages_proto_init() {
 				return nil
 			}
 		}
-		file_messages_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EpInternalRead); i {
+		file_messages_proto_msgTypes[129].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LogInternalPrepare); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3782,8 +15234,8 @@ func file_messages_proto_init() {
 				return nil
 			}
 		}
-		file_messages_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EpInternalState); i {
+		file_messages_proto_msgTypes[130].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LogInternalPromise); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3794,8 +15246,8 @@ func file_messages_proto_init() {
 				return nil
 			}
 		}
-		file_messages_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EpInternalWrite); i {
+		file_messages_proto_msgTypes[131].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LogInternalAccept); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3806,8 +15258,8 @@ func file_messages_proto_init() {
 				return nil
 			}
 		}
-		file_messages_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EpInternalAccept); i {
+		file_messages_proto_msgTypes[132].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LogInternalAccepted); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3818,8 +15270,8 @@ func file_messages_proto_init() {
 				return nil
 			}
 		}
-		file_messages_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EpInternalDecided); i {
+		file_messages_proto_msgTypes[133].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LogInternalCommit); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3830,8 +15282,8 @@ func file_messages_proto_init() {
 				return nil
 			}
 		}
-		file_messages_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EcInternalNack); i {
+		file_messages_proto_msgTypes[134].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RaftEntry); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3842,8 +15294,8 @@ func file_messages_proto_init() {
 				return nil
 			}
 		}
-		file_messages_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EcStartEpoch); i {
+		file_messages_proto_msgTypes[135].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RaftTimeout); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3854,8 +15306,8 @@ func file_messages_proto_init() {
 				return nil
 			}
 		}
-		file_messages_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EcInternalNewEpoch); i {
+		file_messages_proto_msgTypes[136].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RaftInternalRequestVote); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3866,8 +15318,8 @@ func file_messages_proto_init() {
 				return nil
 			}
 		}
-		file_messages_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BebBroadcast); i {
+		file_messages_proto_msgTypes[137].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RaftInternalVote); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3878,8 +15330,8 @@ func file_messages_proto_init() {
 				return nil
 			}
 		}
-		file_messages_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BebDeliver); i {
+		file_messages_proto_msgTypes[138].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RaftInternalAppendEntries); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3890,8 +15342,8 @@ func file_messages_proto_init() {
 				return nil
 			}
 		}
-		file_messages_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EldTimeout); i {
+		file_messages_proto_msgTypes[139].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RaftInternalAppendResult); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3902,8 +15354,8 @@ func file_messages_proto_init() {
 				return nil
 			}
 		}
-		file_messages_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EldTrust); i {
+		file_messages_proto_msgTypes[140].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RaftInternalForward); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3914,8 +15366,8 @@ func file_messages_proto_init() {
 				return nil
 			}
 		}
-		file_messages_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NnarRead); i {
+		file_messages_proto_msgTypes[141].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AppRcPropose); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3926,8 +15378,8 @@ func file_messages_proto_init() {
 				return nil
 			}
 		}
-		file_messages_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NnarInternalRead); i {
+		file_messages_proto_msgTypes[142].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AppRcDecide); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3938,8 +15390,8 @@ func file_messages_proto_init() {
 				return nil
 			}
 		}
-		file_messages_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NnarInternalValue); i {
+		file_messages_proto_msgTypes[143].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RcInternalProposal); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3950,8 +15402,8 @@ func file_messages_proto_init() {
 				return nil
 			}
 		}
-		file_messages_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NnarInternalWrite); i {
+		file_messages_proto_msgTypes[144].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BcInternalPhase); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3962,8 +15414,8 @@ func file_messages_proto_init() {
 				return nil
 			}
 		}
-		file_messages_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NnarWrite); i {
+		file_messages_proto_msgTypes[145].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BcInternalDecided); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3974,8 +15426,8 @@ func file_messages_proto_init() {
 				return nil
 			}
 		}
-		file_messages_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NnarInternalAck); i {
+		file_messages_proto_msgTypes[146].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FloodingInternalProposal); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3986,8 +15438,8 @@ func file_messages_proto_init() {
 				return nil
 			}
 		}
-		file_messages_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NnarReadReturn); i {
+		file_messages_proto_msgTypes[147].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FloodingInternalDecided); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3998,8 +15450,8 @@ func file_messages_proto_init() {
 				return nil
 			}
 		}
-		file_messages_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NnarWriteReturn); i {
+		file_messages_proto_msgTypes[148].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HierarchicalInternalProposal); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4010,7 +15462,115 @@ func file_messages_proto_init() {
 				return nil
 			}
 		}
-		file_messages_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+		file_messages_proto_msgTypes[149].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HierarchicalInternalAck); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_messages_proto_msgTypes[150].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HierarchicalInternalDecided); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_messages_proto_msgTypes[151].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BcbBroadcast); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_messages_proto_msgTypes[152].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BcbDeliver); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_messages_proto_msgTypes[153].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BrbBroadcast); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_messages_proto_msgTypes[154].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BrbDeliver); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_messages_proto_msgTypes[155].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ByzantineInternalSend); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_messages_proto_msgTypes[156].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ByzantineInternalEcho); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_messages_proto_msgTypes[157].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ByzantineInternalReady); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_messages_proto_msgTypes[158].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*EpfdTimeout); i {
 			case 0:
 				return &v.state
@@ -4022,7 +15582,7 @@ func file_messages_proto_init() {
 				return nil
 			}
 		}
-		file_messages_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+		file_messages_proto_msgTypes[159].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*EpfdInternalHeartbeatRequest); i {
 			case 0:
 				return &v.state
@@ -4034,7 +15594,7 @@ func file_messages_proto_init() {
 				return nil
 			}
 		}
-		file_messages_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+		file_messages_proto_msgTypes[160].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*EpfdInternalHeartbeatReply); i {
 			case 0:
 				return &v.state
@@ -4046,7 +15606,7 @@ func file_messages_proto_init() {
 				return nil
 			}
 		}
-		file_messages_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+		file_messages_proto_msgTypes[161].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*EpfdSuspect); i {
 			case 0:
 				return &v.state
@@ -4058,7 +15618,7 @@ func file_messages_proto_init() {
 				return nil
 			}
 		}
-		file_messages_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+		file_messages_proto_msgTypes[162].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*EpfdRestore); i {
 			case 0:
 				return &v.state
@@ -4070,7 +15630,103 @@ func file_messages_proto_init() {
 				return nil
 			}
 		}
-		file_messages_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+		file_messages_proto_msgTypes[163].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PfdTimeout); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_messages_proto_msgTypes[164].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PfdInternalHeartbeatRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_messages_proto_msgTypes[165].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PfdInternalHeartbeatReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_messages_proto_msgTypes[166].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PfdCrash); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_messages_proto_msgTypes[167].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AppElect); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_messages_proto_msgTypes[168].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AppLeader); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_messages_proto_msgTypes[169].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LeStart); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_messages_proto_msgTypes[170].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LeLeader); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_messages_proto_msgTypes[171].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*PlSend); i {
 			case 0:
 				return &v.state
@@ -4082,7 +15738,7 @@ func file_messages_proto_init() {
 				return nil
 			}
 		}
-		file_messages_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+		file_messages_proto_msgTypes[172].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*PlDeliver); i {
 			case 0:
 				return &v.state
@@ -4094,7 +15750,7 @@ func file_messages_proto_init() {
 				return nil
 			}
 		}
-		file_messages_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+		file_messages_proto_msgTypes[173].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*NetworkMessage); i {
 			case 0:
 				return &v.state
@@ -4106,7 +15762,7 @@ func file_messages_proto_init() {
 				return nil
 			}
 		}
-		file_messages_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+		file_messages_proto_msgTypes[174].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Message); i {
 			case 0:
 				return &v.state
@@ -4124,8 +15780,8 @@ func file_messages_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_messages_proto_rawDesc,
-			NumEnums:      1,
-			NumMessages:   48,
+			NumEnums:      3,
+			NumMessages:   177,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -0,0 +1,154 @@
+package fault
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// LoadConfig reads the fault injection settings from the environment, returning
+// false when none of them is set:
+//
+//	FAULT_SEED=7
+//	FAULT_DROP=0.05
+//	FAULT_DUPLICATE=0.01
+//	FAULT_REORDER=0.1
+//	FAULT_REORDER_WINDOW=50ms
+//	FAULT_LATENCY=fixed:10ms | uniform:5ms:50ms | normal:20ms:5ms | exp:10ms
+//	FAULT_LINK_LATENCY=abc-2=fixed:300ms;abc-3=exp:50ms
+//	FAULT_PARTITION=abc-1,abc-2|abc-3@2s-10s;abc-1|abc-2
+func LoadConfig() (Config, bool, error) {
+	cfg := Config{}
+	enabled := false
+
+	lookup := func(key string) (string, bool) {
+		v, ok := os.LookupEnv(key)
+		if ok && v != "" {
+			enabled = true
+			return v, true
+		}
+		return "", false
+	}
+
+	var err error
+	if v, ok := lookup("FAULT_SEED"); ok {
+		if cfg.Seed, err = strconv.ParseInt(v, 10, 64); err != nil {
+			return cfg, false, fmt.Errorf("invalid FAULT_SEED %v: %w", v, err)
+		}
+	}
+
+	probabilities := map[string]*float64{
+		"FAULT_DROP":      &cfg.Drop,
+		"FAULT_DUPLICATE": &cfg.Duplicate,
+		"FAULT_REORDER":   &cfg.Reorder,
+	}
+	for key, p := range probabilities {
+		if v, ok := lookup(key); ok {
+			if *p, err = strconv.ParseFloat(v, 64); err != nil || *p < 0 || *p > 1 {
+				return cfg, false, fmt.Errorf("invalid %v %v, expected a probability between 0 and 1", key, v)
+			}
+		}
+	}
+
+	if v, ok := lookup("FAULT_REORDER_WINDOW"); ok {
+		if cfg.ReorderWindow, err = time.ParseDuration(v); err != nil {
+			return cfg, false, fmt.Errorf("invalid FAULT_REORDER_WINDOW %v: %w", v, err)
+		}
+	}
+
+	if v, ok := lookup("FAULT_LATENCY"); ok {
+		if cfg.Latency, err = ParseLatency(v); err != nil {
+			return cfg, false, err
+		}
+	}
+
+	if v, ok := lookup("FAULT_LINK_LATENCY"); ok {
+		cfg.Links = make(map[string]Latency)
+		for _, s := range strings.Split(v, ";") {
+			dest, l, ok := strings.Cut(s, "=")
+			if !ok {
+				return cfg, false, fmt.Errorf("invalid link latency %v, expected process=latency", s)
+			}
+			if cfg.Links[dest], err = ParseLatency(l); err != nil {
+				return cfg, false, err
+			}
+		}
+	}
+
+	if v, ok := lookup("FAULT_PARTITION"); ok {
+		for _, s := range strings.Split(v, ";") {
+			p, err := ParsePartition(s)
+			if err != nil {
+				return cfg, false, err
+			}
+			cfg.Partitions = append(cfg.Partitions, p)
+		}
+	}
+
+	return cfg, enabled, nil
+}
+
+// ParseLatency parses kind:duration[:duration], see LoadConfig
+func ParseLatency(s string) (Latency, error) {
+	parts := strings.Split(s, ":")
+	durations := make([]time.Duration, len(parts)-1)
+	for i, p := range parts[1:] {
+		d, err := time.ParseDuration(p)
+		if err != nil {
+			return Latency{}, fmt.Errorf("invalid latency %v: %w", s, err)
+		}
+		durations[i] = d
+	}
+
+	kind := LatencyKind(parts[0])
+	switch {
+	case (kind == Fixed || kind == Exponential) && len(durations) == 1:
+		return Latency{Kind: kind, Mean: durations[0]}, nil
+	case kind == Uniform && len(durations) == 2:
+		return Latency{Kind: kind, Min: durations[0], Max: durations[1]}, nil
+	case kind == Normal && len(durations) == 2:
+		return Latency{Kind: kind, Mean: durations[0], StdDev: durations[1]}, nil
+	}
+
+	return Latency{}, fmt.Errorf("invalid latency %v, expected fixed:d, uniform:min:max, normal:mean:stddev or exp:mean", s)
+}
+
+// ParsePartition parses groups separated by | made of comma separated process
+// names, optionally followed by @from-to, see LoadConfig
+func ParsePartition(s string) (Partition, error) {
+	p := Partition{}
+
+	groups, window, timed := strings.Cut(s, "@")
+	if timed {
+		from, to, ok := strings.Cut(window, "-")
+		if !ok {
+			return p, fmt.Errorf("invalid partition window %v, expected from-to", window)
+		}
+
+		var err error
+		if p.From, err = time.ParseDuration(from); err != nil {
+			return p, fmt.Errorf("invalid partition window %v: %w", window, err)
+		}
+		if p.To, err = time.ParseDuration(to); err != nil {
+			return p, fmt.Errorf("invalid partition window %v: %w", window, err)
+		}
+	}
+
+	for _, g := range strings.Split(groups, "|") {
+		names := make([]string, 0)
+		for _, name := range strings.Split(g, ",") {
+			if name = strings.TrimSpace(name); name != "" {
+				names = append(names, name)
+			}
+		}
+		p.Groups = append(p.Groups, names)
+	}
+
+	if len(p.Groups) < 2 {
+		return p, fmt.Errorf("invalid partition %v, expected at least two groups separated by |", s)
+	}
+
+	return p, nil
+}
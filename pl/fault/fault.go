@@ -0,0 +1,288 @@
+package fault
+
+import (
+	"amcds/pb"
+	"amcds/pl"
+	"amcds/utils"
+	"amcds/utils/clock"
+	"amcds/utils/log"
+	"math"
+	"math/rand"
+	"net"
+	"sync"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+)
+
+type LatencyKind string
+
+const (
+	NoLatency   LatencyKind = ""
+	Fixed       LatencyKind = "fixed"
+	Uniform     LatencyKind = "uniform"
+	Normal      LatencyKind = "normal"
+	Exponential LatencyKind = "exp"
+)
+
+// Latency is the distribution of the delay added to every frame. Fixed and
+// Exponential use Mean, Uniform draws from [Min, Max], Normal uses Mean and
+// StdDev (negative draws are clamped to zero)
+type Latency struct {
+	Kind   LatencyKind
+	Min    time.Duration
+	Max    time.Duration
+	Mean   time.Duration
+	StdDev time.Duration
+}
+
+// Partition separates the processes (owner-index names) of the groups from
+// each other between From and To, measured from the creation of the injector.
+// Processes not listed in any group are not affected. A zero To means forever
+type Partition struct {
+	Groups [][]string
+	From   time.Duration
+	To     time.Duration
+}
+
+type Config struct {
+	Seed int64
+	// probabilities, between 0 and 1, applied to every frame
+	Drop      float64
+	Duplicate float64
+	Reorder   float64
+	// a reordered frame is held back by a random extra delay up to ReorderWindow
+	ReorderWindow time.Duration
+	Latency       Latency
+	// latency of the frames sent to some processes, by name, instead of Latency
+	Links      map[string]Latency
+	Partitions []Partition
+}
+
+// Injector is a transport that misbehaves on purpose before handing the
+// frames over to the wrapped transport. Only the frames sent to the processes
+// it learned about are affected, so the hub always gets the results
+type Injector struct {
+	mu      sync.Mutex
+	self    string
+	inner   pl.Transport
+	clock   clock.Clock
+	rng     *rand.Rand
+	cfg     Config
+	started time.Time
+	names   map[string]string
+}
+
+func Create(self string, inner pl.Transport, c clock.Clock, cfg Config) *Injector {
+	if cfg.ReorderWindow == 0 {
+		cfg.ReorderWindow = 50 * time.Millisecond
+	}
+
+	// the same config may be given to several injectors
+	links := make(map[string]Latency)
+	for dest, l := range cfg.Links {
+		links[dest] = l
+	}
+	cfg.Links = links
+
+	return &Injector{
+		self:    self,
+		inner:   inner,
+		clock:   c,
+		rng:     rand.New(rand.NewSource(cfg.Seed)),
+		cfg:     cfg,
+		started: c.Now(),
+		names:   make(map[string]string),
+	}
+}
+
+// Learn maps the addresses of the processes to their names, used to apply the partitions
+func (f *Injector) Learn(processes []*pb.ProcessId) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	for _, p := range processes {
+		f.names[net.JoinHostPort(p.Host, utils.Int32ToString(p.Port))] = p.Owner + "-" + utils.Int32ToString(p.Index)
+	}
+}
+
+func (f *Injector) SetDrop(p float64) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.cfg.Drop = p
+}
+
+func (f *Injector) SetDuplicate(p float64) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.cfg.Duplicate = p
+}
+
+func (f *Injector) SetReorder(p float64, window time.Duration) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.cfg.Reorder = p
+	f.cfg.ReorderWindow = window
+}
+
+func (f *Injector) SetLatency(l Latency) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.cfg.Latency = l
+}
+
+// SetLinkLatency changes the latency of the frames sent to one process
+func (f *Injector) SetLinkLatency(dest string, l Latency) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.cfg.Links[dest] = l
+}
+
+// Partition separates the groups starting now, for the given duration (forever if zero)
+func (f *Injector) Partition(groups [][]string, d time.Duration) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	from := f.clock.Now().Sub(f.started)
+	to := time.Duration(0)
+	if d > 0 {
+		to = from + d
+	}
+	f.cfg.Partitions = append(f.cfg.Partitions, Partition{Groups: groups, From: from, To: to})
+}
+
+// Heal removes all the partitions
+func (f *Injector) Heal() {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.cfg.Partitions = nil
+}
+
+func (f *Injector) Send(address string, data []byte) error {
+	f.mu.Lock()
+	dest, ok := f.names[address]
+	if !ok {
+		f.mu.Unlock()
+		return f.inner.Send(address, data)
+	}
+
+	if f.partitioned(dest) {
+		f.mu.Unlock()
+		f.report("partitioned", dest, data)
+		return nil
+	}
+
+	if f.rng.Float64() < f.cfg.Drop {
+		f.mu.Unlock()
+		f.report("dropped", dest, data)
+		return nil
+	}
+
+	copies := 1
+	if f.rng.Float64() < f.cfg.Duplicate {
+		copies = 2
+	}
+
+	delays := make([]time.Duration, copies)
+	reordered := false
+	for i := range delays {
+		delays[i] = f.latency(dest)
+		if f.rng.Float64() < f.cfg.Reorder {
+			delays[i] += time.Duration(f.rng.Int63n(int64(f.cfg.ReorderWindow) + 1))
+			reordered = true
+		}
+	}
+	f.mu.Unlock()
+
+	if copies > 1 {
+		f.report("duplicated", dest, data)
+	}
+	if reordered {
+		f.report("reordered", dest, data)
+	}
+
+	var err error
+	for _, d := range delays {
+		if d == 0 {
+			err = f.inner.Send(address, data)
+			continue
+		}
+
+		f.clock.AfterFunc(d, func() {
+			if err := f.inner.Send(address, data); err != nil {
+				log.Warn("[fault] delayed frame to %v failed: %v", dest, err)
+			}
+		})
+	}
+
+	return err
+}
+
+func (f *Injector) partitioned(dest string) bool {
+	elapsed := f.clock.Now().Sub(f.started)
+
+	for _, p := range f.cfg.Partitions {
+		if elapsed < p.From || (p.To != 0 && elapsed >= p.To) {
+			continue
+		}
+
+		own, other := -1, -1
+		for i, g := range p.Groups {
+			for _, name := range g {
+				if name == f.self {
+					own = i
+				}
+				if name == dest {
+					other = i
+				}
+			}
+		}
+
+		if own != -1 && other != -1 && own != other {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (f *Injector) latency(dest string) time.Duration {
+	l, ok := f.cfg.Links[dest]
+	if !ok {
+		l = f.cfg.Latency
+	}
+
+	switch l.Kind {
+	case Fixed:
+		return l.Mean
+	case Uniform:
+		if l.Max <= l.Min {
+			return l.Min
+		}
+		return l.Min + time.Duration(f.rng.Int63n(int64(l.Max-l.Min)+1))
+	case Normal:
+		return time.Duration(math.Max(0, float64(l.Mean)+f.rng.NormFloat64()*float64(l.StdDev)))
+	case Exponential:
+		return time.Duration(f.rng.ExpFloat64() * float64(l.Mean))
+	default:
+		return 0
+	}
+}
+
+// report logs an injected fault with enough context (system, abstraction and
+// message type) to diagnose a run afterwards
+func (f *Injector) report(fault, dest string, data []byte) {
+	m := &pb.Message{}
+	if err := proto.Unmarshal(data, m); err != nil || m.NetworkMessage == nil || m.NetworkMessage.Message == nil {
+		log.Info("[fault] %v %v frame %v -> %v", m.SystemId, fault, f.self, dest)
+		return
+	}
+
+	log.Info("[fault] %v %v %v %v -> %v (%v)", m.SystemId, m.ToAbstractionId, fault, f.self, dest, m.NetworkMessage.Message.Type)
+}
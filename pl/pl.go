@@ -2,7 +2,6 @@ package pl
 
 import (
 	"amcds/pb"
-	"amcds/tcp"
 	"amcds/utils"
 	"amcds/utils/log"
 	"errors"
@@ -19,7 +18,8 @@ type PerfectLink struct {
 	msgQueue   chan *pb.Message
 	systemId   string
 	parentId   string
-	processes  []*pb.ProcessId
+	processes  *[]*pb.ProcessId // shared with the system, which adds the processes joining it
+	transport  Transport
 }
 
 func Create(host string, port int32, hubAddress string) *PerfectLink {
@@ -27,10 +27,17 @@ func Create(host string, port int32, hubAddress string) *PerfectLink {
 		host:       host,
 		port:       port,
 		hubAddress: hubAddress,
+		transport:  TcpTransport{},
 	}
 }
 
-func (pl *PerfectLink) CreateWithProps(systemId string, msgQueue chan *pb.Message, ps []*pb.ProcessId) *PerfectLink {
+func (pl *PerfectLink) CreateWithTransport(t Transport) *PerfectLink {
+	pl.transport = t
+
+	return pl
+}
+
+func (pl *PerfectLink) CreateWithProps(systemId string, msgQueue chan *pb.Message, ps *[]*pb.ProcessId) *PerfectLink {
 	pl.systemId = systemId
 	pl.msgQueue = msgQueue
 	pl.processes = ps
@@ -49,7 +56,7 @@ func (pl *PerfectLink) Handle(m *pb.Message) error {
 	switch m.Type {
 	case pb.Message_NETWORK_MESSAGE:
 		var sender *pb.ProcessId
-		for _, p := range pl.processes {
+		for _, p := range *pl.processes {
 			if p.Host == m.NetworkMessage.SenderHost && p.Port == m.NetworkMessage.SenderListeningPort {
 				sender = p
 			}
@@ -99,7 +106,7 @@ func (pl *PerfectLink) Send(m *pb.Message) error {
 		address = net.JoinHostPort(m.PlSend.Destination.Host, utils.Int32ToString(m.PlSend.Destination.Port))
 	}
 
-	return tcp.Send(address, data)
+	return pl.transport.Send(address, data)
 }
 
 func (pl *PerfectLink) Parse(date []byte) (*pb.Message, error) {
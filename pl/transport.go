@@ -0,0 +1,14 @@
+package pl
+
+import "amcds/tcp"
+
+// Transport moves marshalled frames to the process listening on address
+type Transport interface {
+	Send(address string, data []byte) error
+}
+
+type TcpTransport struct{}
+
+func (TcpTransport) Send(address string, data []byte) error {
+	return tcp.Send(address, data)
+}
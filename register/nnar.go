@@ -1,25 +1,50 @@
 package register
 
 import (
+	"amcds/lin"
 	"amcds/pb"
 	"amcds/utils/log"
 	"errors"
+
+	"google.golang.org/protobuf/proto"
 )
 
 type NnAtomicRegister struct {
 	MsgQueue chan *pb.Message
 	N        int32
 	Key      string
+	Rank     int32
+	// abstraction id of the register and of the one getting the returns,
+	// app.nnar[key] and app unless set
+	Id       string
+	ParentId string
 
 	Timestamp  int32
 	WriterRank int32
-	Value      int32
+	// the value with its type, not defined until the first write
+	Value *pb.Value
 
 	Acks     int32
 	WriteVal *pb.Value
 	ReadId   int32
 	ReadList map[string]*pb.NnarInternalValue
 	Reading  bool
+	// whether a read or a write of this process is in progress
+	Active bool
+
+	// view of gm the quorums are taken in, the internal messages of older
+	// views being ignored and the ones of newer views handled once there
+	ViewId int32
+	Future []*pb.Message
+
+	// optional history of the NnarRead/NnarWrite operations of this process
+	Recorder *lin.Recorder
+	Process  string
+	OpId     int
+
+	// optional store where the register logs its state, recovered when the
+	// process restarts
+	Store Store
 }
 
 func (nnar *NnAtomicRegister) Handle(m *pb.Message) error {
@@ -31,10 +56,10 @@ func (nnar *NnAtomicRegister) Handle(m *pb.Message) error {
 	case pb.Message_BEB_DELIVER:
 		switch m.BebDeliver.Message.Type {
 		case pb.Message_NNAR_INTERNAL_READ:
-			incomingReadId := m.BebDeliver.Message.NnarInternalRead.ReadId
-			if nnar.ReadId == 0 {
-				nnar.ReadId = incomingReadId
+			if !nnar.inView(m, m.BebDeliver.Message.NnarInternalRead.ViewId) {
+				return nil
 			}
+			incomingReadId := m.BebDeliver.Message.NnarInternalRead.ReadId
 
 			log.Info("Internal read from %v", m.BebDeliver.Message.NnarInternalRead.ReadId)
 
@@ -57,6 +82,9 @@ func (nnar *NnAtomicRegister) Handle(m *pb.Message) error {
 			log.Debug("Broadcasting INTERNAL READ %v", msgToSend)
 
 		case pb.Message_NNAR_INTERNAL_WRITE:
+			if !nnar.inView(m, m.BebDeliver.Message.NnarInternalWrite.ViewId) {
+				return nil
+			}
 			log.Info("Internal write from %v", m.BebDeliver.Message.NnarInternalWrite.ReadId)
 			// update the value
 			writerMsg := m.BebDeliver.Message.NnarInternalWrite
@@ -74,6 +102,10 @@ func (nnar *NnAtomicRegister) Handle(m *pb.Message) error {
 				nnar.Timestamp = writerMsg.Timestamp
 				nnar.WriterRank = writerMsg.WriterRank
 				nnar.updateValue(writerMsg.Value)
+				// the value is acknowledged only once it survives a crash
+				if err := nnar.persist(); err != nil {
+					return err
+				}
 			}
 
 			// acknowledge the new val
@@ -90,7 +122,8 @@ func (nnar *NnAtomicRegister) Handle(m *pb.Message) error {
 						ToAbstractionId:   aId,
 						SystemId:          m.SystemId,
 						NnarInternalAck: &pb.NnarInternalAck{
-							ReadId: nnar.ReadId,
+							ReadId: writerMsg.ReadId,
+							ViewId: nnar.ViewId,
 						},
 					},
 				},
@@ -104,50 +137,53 @@ func (nnar *NnAtomicRegister) Handle(m *pb.Message) error {
 		nnar.Acks = 0
 		nnar.Reading = false
 		nnar.ReadList = make(map[string]*pb.NnarInternalValue)
+		nnar.Active = true
 		log.Info("Init write %v with readid %v", nnar.WriteVal, nnar.ReadId)
+		nnar.recordInvoke(m.SystemId, lin.Write, nnar.WriteVal)
+		if err := nnar.persist(); err != nil {
+			return err
+		}
 
 		// broadcast internal read
-		msgToSend = &pb.Message{
-			Type:              pb.Message_BEB_BROADCAST,
-			FromAbstractionId: aId,
-			ToAbstractionId:   aId + ".beb",
-			SystemId:          m.SystemId,
-			BebBroadcast: &pb.BebBroadcast{
-				Message: &pb.Message{
-					Type:              pb.Message_NNAR_INTERNAL_READ,
-					FromAbstractionId: aId,
-					ToAbstractionId:   aId,
-					SystemId:          m.SystemId,
-					NnarInternalRead: &pb.NnarInternalRead{
-						ReadId: nnar.ReadId,
-					},
-				},
-			},
-		}
+		msgToSend = nnar.buildInternalRead(m.SystemId)
 
 	case pb.Message_NNAR_READ:
 		nnar.ReadId = nnar.ReadId + 1
 		nnar.Acks = 0
 		nnar.ReadList = make(map[string]*pb.NnarInternalValue)
 		nnar.Reading = true
+		nnar.Active = true
 		log.Info("Init read with readid %v", nnar.ReadId)
+		nnar.recordInvoke(m.SystemId, lin.Read, nil)
+		if err := nnar.persist(); err != nil {
+			return err
+		}
 
-		msgToSend = &pb.Message{
-			Type:              pb.Message_BEB_BROADCAST,
-			FromAbstractionId: aId,
-			ToAbstractionId:   aId + ".beb",
-			SystemId:          m.SystemId,
-			BebBroadcast: &pb.BebBroadcast{
-				Message: &pb.Message{
-					Type:              pb.Message_NNAR_INTERNAL_READ,
-					FromAbstractionId: aId,
-					ToAbstractionId:   aId,
-					SystemId:          m.SystemId,
-					NnarInternalRead: &pb.NnarInternalRead{
-						ReadId: nnar.ReadId,
-					},
-				},
-			},
+		msgToSend = nnar.buildInternalRead(m.SystemId)
+
+	case pb.Message_GM_VIEW:
+		if m.GmView.Id <= nnar.ViewId {
+			return nil
+		}
+		// majorities are taken among the members of the current view, so the
+		// operation in progress starts over in the new one
+		nnar.N = int32(len(m.GmView.Members))
+		nnar.ViewId = m.GmView.Id
+		if nnar.Active {
+			nnar.ReadId = nnar.ReadId + 1
+			nnar.Acks = 0
+			nnar.ReadList = make(map[string]*pb.NnarInternalValue)
+			log.Info("Restarting operation in view %v with readid %v", nnar.ViewId, nnar.ReadId)
+			if err := nnar.persist(); err != nil {
+				return err
+			}
+			msgToSend = nnar.buildInternalRead(m.SystemId)
+		}
+
+		future := nnar.Future
+		nnar.Future = nil
+		for _, f := range future {
+			nnar.MsgQueue <- f
 		}
 
 	case pb.Message_PL_DELIVER:
@@ -157,10 +193,9 @@ func (nnar *NnAtomicRegister) Handle(m *pb.Message) error {
 			incomingReadId := msgValue.ReadId
 			log.Info("NNAR Internal value for read %v reading (%v)", msgValue.ReadId, nnar.Reading)
 
-			if incomingReadId == nnar.ReadId {
+			if incomingReadId == nnar.ReadId && msgValue.ViewId == nnar.ViewId {
 				senderId := string(m.PlDeliver.Sender.Owner) + string(m.PlDeliver.Sender.Index)
 				nnar.ReadList[senderId] = msgValue
-				nnar.ReadList[senderId].WriterRank = m.PlDeliver.Sender.Rank
 
 				if int32(len(nnar.ReadList)) > nnar.N/2 {
 					h := nnar.highest()
@@ -168,7 +203,7 @@ func (nnar *NnAtomicRegister) Handle(m *pb.Message) error {
 
 					if !nnar.Reading {
 						h.Timestamp += 1
-						h.WriterRank = nnar.WriterRank
+						h.WriterRank = nnar.Rank
 						h.Value = nnar.WriteVal
 					}
 
@@ -188,6 +223,7 @@ func (nnar *NnAtomicRegister) Handle(m *pb.Message) error {
 									Timestamp:  h.Timestamp,
 									WriterRank: h.WriterRank,
 									Value:      h.Value,
+									ViewId:     nnar.ViewId,
 								},
 							},
 						},
@@ -200,29 +236,36 @@ func (nnar *NnAtomicRegister) Handle(m *pb.Message) error {
 			incomingReadId := msgValue.ReadId
 			log.Info("NNAR Internal ack for read %v, reading (%v)", msgValue.ReadId, nnar.Reading)
 
-			if incomingReadId == nnar.ReadId {
+			if incomingReadId == nnar.ReadId && msgValue.ViewId == nnar.ViewId {
 				nnar.Acks = nnar.Acks + 1
 				if nnar.Acks > nnar.N/2 {
 					nnar.Acks = 0
+					nnar.Active = false
+					// at worst the operation runs again after a restart
+					if err := nnar.persist(); err != nil {
+						log.Error("Failed to log the end of the operation on %v: %v", nnar.Key, err)
+					}
 					if nnar.Reading {
 						nnar.Reading = false
 						msgToSend = &pb.Message{
 							Type:              pb.Message_NNAR_READ_RETURN,
 							FromAbstractionId: aId,
-							ToAbstractionId:   "app",
+							ToAbstractionId:   nnar.getParentId(),
 							SystemId:          m.SystemId,
 							NnarReadReturn: &pb.NnarReadReturn{
 								Value: nnar.buildInternalValue(incomingReadId).Value,
 							},
 						}
+						nnar.recordReturn(m.SystemId, lin.Read, msgToSend.NnarReadReturn.Value)
 					} else {
 						msgToSend = &pb.Message{
 							Type:              pb.Message_NNAR_WRITE_RETURN,
 							FromAbstractionId: aId,
 							SystemId:          m.SystemId,
-							ToAbstractionId:   "app",
+							ToAbstractionId:   nnar.getParentId(),
 							NnarWriteReturn:   &pb.NnarWriteReturn{},
 						}
+						nnar.recordReturn(m.SystemId, lin.Write, nil)
 					}
 				}
 			}
@@ -259,24 +302,131 @@ func (nnar *NnAtomicRegister) highest() *pb.NnarInternalValue {
 	return highest
 }
 
+// inView tells whether the internal message of the view should be handled
+// now, keeping the ones of newer views for later
+func (nnar *NnAtomicRegister) inView(m *pb.Message, viewId int32) bool {
+	if viewId > nnar.ViewId {
+		nnar.Future = append(nnar.Future, m)
+	}
+
+	return viewId == nnar.ViewId
+}
+
+func (nnar *NnAtomicRegister) buildInternalRead(systemId string) *pb.Message {
+	aId := nnar.getAbstractionId()
+
+	return &pb.Message{
+		Type:              pb.Message_BEB_BROADCAST,
+		FromAbstractionId: aId,
+		ToAbstractionId:   aId + ".beb",
+		SystemId:          systemId,
+		BebBroadcast: &pb.BebBroadcast{
+			Message: &pb.Message{
+				Type:              pb.Message_NNAR_INTERNAL_READ,
+				FromAbstractionId: aId,
+				ToAbstractionId:   aId,
+				SystemId:          systemId,
+				NnarInternalRead: &pb.NnarInternalRead{
+					ReadId: nnar.ReadId,
+					ViewId: nnar.ViewId,
+				},
+			},
+		},
+	}
+}
+
+// State returns the value of the register with its timestamp
+func (nnar *NnAtomicRegister) State() *pb.NnarState {
+	v := nnar.buildInternalValue(0)
+
+	return &pb.NnarState{
+		Key:        nnar.Key,
+		Timestamp:  v.Timestamp,
+		WriterRank: v.WriterRank,
+		Value:      v.Value,
+	}
+}
+
+// Restore adopts the state if its timestamp is higher than the current one
+func (nnar *NnAtomicRegister) Restore(st *pb.NnarState) {
+	incoming := &pb.NnarInternalValue{Timestamp: st.Timestamp, WriterRank: st.WriterRank}
+	current := &pb.NnarInternalValue{Timestamp: nnar.Timestamp, WriterRank: nnar.WriterRank}
+	if compare(incoming, current) == 1 {
+		nnar.Timestamp = st.Timestamp
+		nnar.WriterRank = st.WriterRank
+		nnar.updateValue(st.Value)
+		if err := nnar.persist(); err != nil {
+			log.Error("Failed to log the state of %v: %v", nnar.Key, err)
+		}
+	}
+}
+
+// Recover adopts the state logged before the process restarted and starts over
+// the operation it was running, with a new read id so that the answers to the
+// messages sent before the crash are ignored
+func (nnar *NnAtomicRegister) Recover(systemId string, l *pb.NnarLog) error {
+	nnar.Timestamp = l.State.GetTimestamp()
+	nnar.WriterRank = l.State.GetWriterRank()
+	nnar.updateValue(l.State.GetValue())
+	nnar.ReadId = l.ReadId
+	nnar.Active = l.Active
+	nnar.Reading = l.Reading
+	nnar.WriteVal = l.WriteVal
+	if !nnar.Active {
+		return nil
+	}
+
+	nnar.ReadId = nnar.ReadId + 1
+	nnar.Acks = 0
+	nnar.ReadList = make(map[string]*pb.NnarInternalValue)
+	log.Info("Recovering operation on %v with readid %v, reading (%v)", nnar.Key, nnar.ReadId, nnar.Reading)
+	if err := nnar.persist(); err != nil {
+		return err
+	}
+	nnar.MsgQueue <- nnar.buildInternalRead(systemId)
+
+	return nil
+}
+
+// persist logs the state of the register and of its operation in the store,
+// if any
+func (nnar *NnAtomicRegister) persist() error {
+	if nnar.Store == nil {
+		return nil
+	}
+
+	return nnar.Store.Save(nnar.getAbstractionId(), &pb.NnarLog{
+		State:    nnar.State(),
+		ReadId:   nnar.ReadId,
+		Active:   nnar.Active,
+		Reading:  nnar.Reading,
+		WriteVal: nnar.WriteVal,
+	})
+}
+
 func (nnar *NnAtomicRegister) getAbstractionId() string {
+	if nnar.Id != "" {
+		return nnar.Id
+	}
+
 	return "app.nnar[" + nnar.Key + "]"
 }
 
-func (nnar *NnAtomicRegister) buildInternalValue(incomingReadId int32) *pb.NnarInternalValue {
-	defined := false
-	if nnar.Value != -1 {
-		defined = true
+func (nnar *NnAtomicRegister) getParentId() string {
+	if nnar.ParentId != "" {
+		return nnar.ParentId
 	}
 
+	return "app"
+}
+
+func (nnar *NnAtomicRegister) buildInternalValue(incomingReadId int32) *pb.NnarInternalValue {
 	return &pb.NnarInternalValue{
 		ReadId:     incomingReadId,
 		Timestamp:  nnar.Timestamp,
 		WriterRank: nnar.WriterRank,
-		Value: &pb.Value{
-			V:       nnar.Value,
-			Defined: defined,
-		},
+		ViewId:     nnar.ViewId,
+		Value:      nnar.Value,
 	}
 }
 
@@ -302,11 +452,26 @@ func compare(v1, v2 *pb.NnarInternalValue) int {
 	return 0
 }
 
+// updateValue adopts the value, every value of the type being writable since
+// the definedness is kept apart
 func (nnar *NnAtomicRegister) updateValue(v *pb.Value) {
-	if v.Defined {
-		nnar.Value = v.V
+	if v.GetDefined() {
+		nnar.Value = proto.Clone(v).(*pb.Value)
 	} else {
-		nnar.Value = -1
+		nnar.Value = &pb.Value{}
+	}
+}
+
+func (nnar *NnAtomicRegister) recordInvoke(systemId string, op lin.Op, v *pb.Value) {
+	if nnar.Recorder != nil {
+		nnar.OpId = nnar.Recorder.Invoke(systemId, nnar.Process, nnar.Key, op, v)
+	}
+}
+
+func (nnar *NnAtomicRegister) recordReturn(systemId string, op lin.Op, v *pb.Value) {
+	if nnar.Recorder != nil && nnar.OpId != 0 {
+		nnar.Recorder.Return(nnar.OpId, systemId, nnar.Process, nnar.Key, op, v)
+		nnar.OpId = 0
 	}
 }
 
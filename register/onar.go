@@ -0,0 +1,283 @@
+package register
+
+import (
+	"amcds/pb"
+	"amcds/utils"
+	"errors"
+	"fmt"
+)
+
+// OnAtomicRegister is a (1,N) atomic register: once a read returned a value, no
+// later read returns an older one. With read-impose write-all (algorithm 4.5)
+// writes and reads impose their value on all the processes not detected as
+// crashed by the perfect failure detector, so a read does not need to ask the
+// others. With read-impose write-majority (algorithm 4.6) a write waits for a
+// majority and a read imposes the highest value of a majority on a majority.
+// Only the writer may write, the others getting an error back. The internal
+// messages are the ones of NnAtomicRegister, always carrying the writer rank
+type OnAtomicRegister struct {
+	id        string
+	parentId  string
+	msgQueue  chan *pb.Message
+	processes []*pb.ProcessId
+	self      *pb.ProcessId
+	writer    *pb.ProcessId
+	majority  bool
+
+	// value of the register with its timestamp
+	current *pb.NnarInternalValue
+	// timestamp of the last write of the writer
+	wts int32
+
+	// operation in progress, its acks and for a read the value it returns
+	active   bool
+	reading  bool
+	readId   int32
+	readVal  *pb.Value
+	acks     utils.ProcessMap
+	correct  utils.ProcessMap
+	readList map[string]*pb.NnarInternalValue
+}
+
+func CreateRowaOnar(parentAbstraction, abstractionId string, mQ chan *pb.Message, processes []*pb.ProcessId, self, writer *pb.ProcessId) *OnAtomicRegister {
+	return createOnar(parentAbstraction, abstractionId, mQ, processes, self, writer, false)
+}
+
+func CreateMajorityOnar(parentAbstraction, abstractionId string, mQ chan *pb.Message, processes []*pb.ProcessId, self, writer *pb.ProcessId) *OnAtomicRegister {
+	return createOnar(parentAbstraction, abstractionId, mQ, processes, self, writer, true)
+}
+
+func createOnar(parentAbstraction, abstractionId string, mQ chan *pb.Message, processes []*pb.ProcessId, self, writer *pb.ProcessId, majority bool) *OnAtomicRegister {
+	onar := &OnAtomicRegister{
+		id:        abstractionId,
+		parentId:  parentAbstraction,
+		msgQueue:  mQ,
+		processes: processes,
+		self:      self,
+		writer:    writer,
+		majority:  majority,
+		current:   &pb.NnarInternalValue{WriterRank: writer.Rank, Value: &pb.Value{}},
+		acks:      make(utils.ProcessMap),
+		correct:   make(utils.ProcessMap),
+		readList:  make(map[string]*pb.NnarInternalValue),
+	}
+
+	for _, p := range processes {
+		onar.correct[utils.GetProcessKey(p)] = p
+	}
+
+	return onar
+}
+
+func (onar *OnAtomicRegister) Handle(m *pb.Message) error {
+	switch m.Type {
+	case pb.Message_ONAR_WRITE:
+		if utils.GetProcessKey(onar.self) != utils.GetProcessKey(onar.writer) {
+			onar.writeReturn(m.SystemId, fmt.Sprintf("%v-%v is not the writer of %v, only %v-%v may write it",
+				onar.self.Owner, onar.self.Index, onar.id, onar.writer.Owner, onar.writer.Index))
+			return nil
+		}
+
+		onar.wts++
+		onar.start(false)
+		onar.impose(m.SystemId, onar.wts, m.OnarWrite.Value)
+	case pb.Message_ONAR_READ:
+		onar.start(true)
+		if !onar.majority {
+			// the local value is the latest one any process may have returned,
+			// so imposing it on the others is enough
+			onar.readVal = onar.current.Value
+			onar.impose(m.SystemId, onar.current.Timestamp, onar.current.Value)
+			break
+		}
+
+		onar.broadcast(m.SystemId, &pb.Message{
+			Type:              pb.Message_NNAR_INTERNAL_READ,
+			FromAbstractionId: onar.id,
+			ToAbstractionId:   onar.id,
+			SystemId:          m.SystemId,
+			NnarInternalRead: &pb.NnarInternalRead{
+				ReadId: onar.readId,
+			},
+		})
+	case pb.Message_PFD_CRASH:
+		delete(onar.correct, utils.GetProcessKey(m.PfdCrash.Process))
+		onar.checkAcks(m.SystemId)
+	case pb.Message_BEB_DELIVER:
+		inner := m.BebDeliver.Message
+		switch inner.Type {
+		case pb.Message_NNAR_INTERNAL_READ:
+			v := onar.buildInternalValue(inner.NnarInternalRead.ReadId)
+			onar.send(m.SystemId, m.BebDeliver.Sender, &pb.Message{
+				Type:              pb.Message_NNAR_INTERNAL_VALUE,
+				FromAbstractionId: onar.id,
+				ToAbstractionId:   onar.id,
+				SystemId:          m.SystemId,
+				NnarInternalValue: v,
+			})
+		case pb.Message_NNAR_INTERNAL_WRITE:
+			w := inner.NnarInternalWrite
+			incoming := &pb.NnarInternalValue{Timestamp: w.Timestamp, WriterRank: w.WriterRank, Value: w.Value}
+			if compare(incoming, onar.current) == 1 {
+				onar.current = incoming
+			}
+			onar.send(m.SystemId, m.BebDeliver.Sender, &pb.Message{
+				Type:              pb.Message_NNAR_INTERNAL_ACK,
+				FromAbstractionId: onar.id,
+				ToAbstractionId:   onar.id,
+				SystemId:          m.SystemId,
+				NnarInternalAck: &pb.NnarInternalAck{
+					ReadId: w.ReadId,
+				},
+			})
+		default:
+			return errors.New("onar beb deliver message type not supported")
+		}
+	case pb.Message_PL_DELIVER:
+		inner := m.PlDeliver.Message
+		switch inner.Type {
+		case pb.Message_NNAR_INTERNAL_VALUE:
+			v := inner.NnarInternalValue
+			if !onar.active || !onar.majority || onar.readVal != nil || v.ReadId != onar.readId {
+				break
+			}
+
+			onar.readList[utils.GetProcessKey(m.PlDeliver.Sender)] = v
+			if len(onar.readList) > len(onar.processes)/2 {
+				highest := v
+				for _, other := range onar.readList {
+					if compare(other, highest) == 1 {
+						highest = other
+					}
+				}
+				onar.readList = make(map[string]*pb.NnarInternalValue)
+				onar.readVal = highest.Value
+				onar.impose(m.SystemId, highest.Timestamp, highest.Value)
+			}
+		case pb.Message_NNAR_INTERNAL_ACK:
+			if onar.active && inner.NnarInternalAck.ReadId == onar.readId {
+				onar.acks[utils.GetProcessKey(m.PlDeliver.Sender)] = m.PlDeliver.Sender
+				onar.checkAcks(m.SystemId)
+			}
+		default:
+			return errors.New("onar pl deliver message type not supported")
+		}
+	default:
+		return errors.New("onar message type not supported")
+	}
+
+	return nil
+}
+
+func (onar *OnAtomicRegister) Destroy() {}
+
+// start begins an operation, with a new read id telling its messages apart
+// from the ones of the previous operations
+func (onar *OnAtomicRegister) start(reading bool) {
+	onar.readId++
+	onar.active = true
+	onar.reading = reading
+	onar.readVal = nil
+	onar.acks = make(utils.ProcessMap)
+	onar.readList = make(map[string]*pb.NnarInternalValue)
+}
+
+// impose broadcasts the value with its timestamp, for the processes to adopt it
+// if newer and acknowledge it
+func (onar *OnAtomicRegister) impose(systemId string, ts int32, v *pb.Value) {
+	onar.broadcast(systemId, &pb.Message{
+		Type:              pb.Message_NNAR_INTERNAL_WRITE,
+		FromAbstractionId: onar.id,
+		ToAbstractionId:   onar.id,
+		SystemId:          systemId,
+		NnarInternalWrite: &pb.NnarInternalWrite{
+			ReadId:     onar.readId,
+			Timestamp:  ts,
+			WriterRank: onar.writer.Rank,
+			Value:      v,
+		},
+	})
+}
+
+// checkAcks completes the operation in progress once a majority acknowledged
+// its value or, for read-impose write-all, all the processes not detected as
+// crashed
+func (onar *OnAtomicRegister) checkAcks(systemId string) {
+	if !onar.active {
+		return
+	}
+
+	if onar.majority {
+		if len(onar.acks) <= len(onar.processes)/2 {
+			return
+		}
+	} else {
+		for key := range onar.correct {
+			if _, ok := onar.acks[key]; !ok {
+				return
+			}
+		}
+	}
+
+	onar.active = false
+	if !onar.reading {
+		onar.writeReturn(systemId, "")
+		return
+	}
+
+	onar.msgQueue <- &pb.Message{
+		Type:              pb.Message_ONAR_READ_RETURN,
+		FromAbstractionId: onar.id,
+		ToAbstractionId:   onar.parentId,
+		SystemId:          systemId,
+		OnarReadReturn: &pb.OnarReadReturn{
+			Value: onar.readVal,
+		},
+	}
+}
+
+func (onar *OnAtomicRegister) writeReturn(systemId, err string) {
+	onar.msgQueue <- &pb.Message{
+		Type:              pb.Message_ONAR_WRITE_RETURN,
+		FromAbstractionId: onar.id,
+		ToAbstractionId:   onar.parentId,
+		SystemId:          systemId,
+		OnarWriteReturn: &pb.OnarWriteReturn{
+			Error: err,
+		},
+	}
+}
+
+func (onar *OnAtomicRegister) buildInternalValue(readId int32) *pb.NnarInternalValue {
+	return &pb.NnarInternalValue{
+		ReadId:     readId,
+		Timestamp:  onar.current.Timestamp,
+		WriterRank: onar.current.WriterRank,
+		Value:      onar.current.Value,
+	}
+}
+
+func (onar *OnAtomicRegister) broadcast(systemId string, m *pb.Message) {
+	onar.msgQueue <- &pb.Message{
+		Type:              pb.Message_BEB_BROADCAST,
+		FromAbstractionId: onar.id,
+		ToAbstractionId:   onar.id + ".beb",
+		SystemId:          systemId,
+		BebBroadcast: &pb.BebBroadcast{
+			Message: m,
+		},
+	}
+}
+
+func (onar *OnAtomicRegister) send(systemId string, to *pb.ProcessId, m *pb.Message) {
+	onar.msgQueue <- &pb.Message{
+		Type:              pb.Message_PL_SEND,
+		FromAbstractionId: onar.id,
+		ToAbstractionId:   onar.id + ".pl",
+		SystemId:          systemId,
+		PlSend: &pb.PlSend{
+			Destination: to,
+			Message:     m,
+		},
+	}
+}
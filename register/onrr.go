@@ -0,0 +1,235 @@
+package register
+
+import (
+	"amcds/pb"
+	"amcds/utils"
+	"errors"
+)
+
+// OnRegularRegister is a (1,N) regular register: a read returns the last value
+// written or one being written. With read-one-write-all (algorithm 4.1) a
+// write waits for the acks of all the processes not detected as crashed by the
+// perfect failure detector, so a read just returns the local value. With
+// majority voting (algorithm 4.2) writes and reads wait for a majority, the
+// read returning the value with the highest timestamp. A single process is
+// expected to write the register
+type OnRegularRegister struct {
+	id        string
+	parentId  string
+	msgQueue  chan *pb.Message
+	processes []*pb.ProcessId
+	majority  bool
+
+	value     *pb.Value
+	timestamp int32
+
+	// write in progress: its timestamp and the processes which acknowledged it
+	writing bool
+	wts     int32
+	acks    utils.ProcessMap
+	correct utils.ProcessMap
+
+	readId   int32
+	readList map[string]*pb.OnrrInternalValue
+}
+
+func CreateRowaOnrr(parentAbstraction, abstractionId string, mQ chan *pb.Message, processes []*pb.ProcessId) *OnRegularRegister {
+	return createOnrr(parentAbstraction, abstractionId, mQ, processes, false)
+}
+
+func CreateMajorityOnrr(parentAbstraction, abstractionId string, mQ chan *pb.Message, processes []*pb.ProcessId) *OnRegularRegister {
+	return createOnrr(parentAbstraction, abstractionId, mQ, processes, true)
+}
+
+func createOnrr(parentAbstraction, abstractionId string, mQ chan *pb.Message, processes []*pb.ProcessId, majority bool) *OnRegularRegister {
+	onrr := &OnRegularRegister{
+		id:        abstractionId,
+		parentId:  parentAbstraction,
+		msgQueue:  mQ,
+		processes: processes,
+		majority:  majority,
+		value:     &pb.Value{},
+		acks:      make(utils.ProcessMap),
+		correct:   make(utils.ProcessMap),
+		readList:  make(map[string]*pb.OnrrInternalValue),
+	}
+
+	for _, p := range processes {
+		onrr.correct[utils.GetProcessKey(p)] = p
+	}
+
+	return onrr
+}
+
+func (onrr *OnRegularRegister) Handle(m *pb.Message) error {
+	switch m.Type {
+	case pb.Message_ONRR_WRITE:
+		onrr.wts++
+		onrr.writing = true
+		onrr.acks = make(utils.ProcessMap)
+		onrr.broadcast(m.SystemId, &pb.Message{
+			Type:              pb.Message_ONRR_INTERNAL_WRITE,
+			FromAbstractionId: onrr.id,
+			ToAbstractionId:   onrr.id,
+			SystemId:          m.SystemId,
+			OnrrInternalWrite: &pb.OnrrInternalWrite{
+				Timestamp: onrr.wts,
+				Value:     m.OnrrWrite.Value,
+			},
+		})
+	case pb.Message_ONRR_READ:
+		if !onrr.majority {
+			onrr.readReturn(m.SystemId, onrr.value)
+			break
+		}
+
+		onrr.readId++
+		onrr.readList = make(map[string]*pb.OnrrInternalValue)
+		onrr.broadcast(m.SystemId, &pb.Message{
+			Type:              pb.Message_ONRR_INTERNAL_READ,
+			FromAbstractionId: onrr.id,
+			ToAbstractionId:   onrr.id,
+			SystemId:          m.SystemId,
+			OnrrInternalRead: &pb.OnrrInternalRead{
+				ReadId: onrr.readId,
+			},
+		})
+	case pb.Message_PFD_CRASH:
+		delete(onrr.correct, utils.GetProcessKey(m.PfdCrash.Process))
+		onrr.checkWrite(m.SystemId)
+	case pb.Message_BEB_DELIVER:
+		inner := m.BebDeliver.Message
+		switch inner.Type {
+		case pb.Message_ONRR_INTERNAL_WRITE:
+			w := inner.OnrrInternalWrite
+			if !onrr.majority || w.Timestamp > onrr.timestamp {
+				onrr.timestamp = w.Timestamp
+				onrr.value = w.Value
+			}
+			onrr.send(m.SystemId, m.BebDeliver.Sender, &pb.Message{
+				Type:              pb.Message_ONRR_INTERNAL_ACK,
+				FromAbstractionId: onrr.id,
+				ToAbstractionId:   onrr.id,
+				SystemId:          m.SystemId,
+				OnrrInternalAck: &pb.OnrrInternalAck{
+					Timestamp: w.Timestamp,
+				},
+			})
+		case pb.Message_ONRR_INTERNAL_READ:
+			onrr.send(m.SystemId, m.BebDeliver.Sender, &pb.Message{
+				Type:              pb.Message_ONRR_INTERNAL_VALUE,
+				FromAbstractionId: onrr.id,
+				ToAbstractionId:   onrr.id,
+				SystemId:          m.SystemId,
+				OnrrInternalValue: &pb.OnrrInternalValue{
+					ReadId:    inner.OnrrInternalRead.ReadId,
+					Timestamp: onrr.timestamp,
+					Value:     onrr.value,
+				},
+			})
+		default:
+			return errors.New("onrr beb deliver message type not supported")
+		}
+	case pb.Message_PL_DELIVER:
+		inner := m.PlDeliver.Message
+		switch inner.Type {
+		case pb.Message_ONRR_INTERNAL_ACK:
+			if onrr.writing && inner.OnrrInternalAck.Timestamp == onrr.wts {
+				onrr.acks[utils.GetProcessKey(m.PlDeliver.Sender)] = m.PlDeliver.Sender
+				onrr.checkWrite(m.SystemId)
+			}
+		case pb.Message_ONRR_INTERNAL_VALUE:
+			v := inner.OnrrInternalValue
+			if v.ReadId != onrr.readId {
+				break
+			}
+
+			onrr.readList[utils.GetProcessKey(m.PlDeliver.Sender)] = v
+			if len(onrr.readList) > len(onrr.processes)/2 {
+				highest := v
+				for _, other := range onrr.readList {
+					if other.Timestamp > highest.Timestamp {
+						highest = other
+					}
+				}
+				onrr.readList = make(map[string]*pb.OnrrInternalValue)
+				onrr.readId++
+				onrr.readReturn(m.SystemId, highest.Value)
+			}
+		default:
+			return errors.New("onrr pl deliver message type not supported")
+		}
+	default:
+		return errors.New("onrr message type not supported")
+	}
+
+	return nil
+}
+
+func (onrr *OnRegularRegister) Destroy() {}
+
+// checkWrite completes the write in progress once a majority acknowledged it
+// or, for read-one-write-all, all the processes not detected as crashed
+func (onrr *OnRegularRegister) checkWrite(systemId string) {
+	if !onrr.writing {
+		return
+	}
+
+	if onrr.majority {
+		if len(onrr.acks) <= len(onrr.processes)/2 {
+			return
+		}
+	} else {
+		for key := range onrr.correct {
+			if _, ok := onrr.acks[key]; !ok {
+				return
+			}
+		}
+	}
+
+	onrr.writing = false
+	onrr.msgQueue <- &pb.Message{
+		Type:              pb.Message_ONRR_WRITE_RETURN,
+		FromAbstractionId: onrr.id,
+		ToAbstractionId:   onrr.parentId,
+		SystemId:          systemId,
+		OnrrWriteReturn:   &pb.OnrrWriteReturn{},
+	}
+}
+
+func (onrr *OnRegularRegister) readReturn(systemId string, v *pb.Value) {
+	onrr.msgQueue <- &pb.Message{
+		Type:              pb.Message_ONRR_READ_RETURN,
+		FromAbstractionId: onrr.id,
+		ToAbstractionId:   onrr.parentId,
+		SystemId:          systemId,
+		OnrrReadReturn: &pb.OnrrReadReturn{
+			Value: v,
+		},
+	}
+}
+
+func (onrr *OnRegularRegister) broadcast(systemId string, m *pb.Message) {
+	onrr.msgQueue <- &pb.Message{
+		Type:              pb.Message_BEB_BROADCAST,
+		FromAbstractionId: onrr.id,
+		ToAbstractionId:   onrr.id + ".beb",
+		SystemId:          systemId,
+		BebBroadcast: &pb.BebBroadcast{
+			Message: m,
+		},
+	}
+}
+
+func (onrr *OnRegularRegister) send(systemId string, to *pb.ProcessId, m *pb.Message) {
+	onrr.msgQueue <- &pb.Message{
+		Type:              pb.Message_PL_SEND,
+		FromAbstractionId: onrr.id,
+		ToAbstractionId:   onrr.id + ".pl",
+		SystemId:          systemId,
+		PlSend: &pb.PlSend{
+			Destination: to,
+			Message:     m,
+		},
+	}
+}
@@ -0,0 +1,135 @@
+package register
+
+import (
+	"amcds/pb"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// Store keeps the logs of the registers of one process in one system, so that
+// the process recovers them when it restarts
+type Store interface {
+	Save(id string, l *pb.NnarLog) error
+	// Load returns the logs of all the registers saved so far, by abstraction
+	// id (e.g. app.nnar[x])
+	Load() (map[string]*pb.NnarLog, error)
+}
+
+// FileStore saves every register in its own file of the directory, replacing
+// it atomically
+type FileStore struct {
+	dir string
+}
+
+func CreateFileStore(dir string) (*FileStore, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, err
+	}
+
+	return &FileStore{dir: dir}, nil
+}
+
+func (fs *FileStore) Save(id string, l *pb.NnarLog) error {
+	data, err := proto.Marshal(l)
+	if err != nil {
+		return err
+	}
+
+	// the register keeps its previous log if the process crashes while saving
+	path := filepath.Join(fs.dir, url.PathEscape(id)+".log")
+	tmp, err := os.CreateTemp(fs.dir, ".log-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp.Name(), path)
+}
+
+func (fs *FileStore) Load() (map[string]*pb.NnarLog, error) {
+	entries, err := os.ReadDir(fs.dir)
+	if err != nil {
+		return nil, err
+	}
+
+	logs := make(map[string]*pb.NnarLog)
+	for _, e := range entries {
+		name, ok := strings.CutSuffix(e.Name(), ".log")
+		if e.IsDir() || !ok {
+			continue
+		}
+		id, err := url.PathUnescape(name)
+		if err != nil {
+			return nil, err
+		}
+
+		data, err := os.ReadFile(filepath.Join(fs.dir, e.Name()))
+		if err != nil {
+			return nil, err
+		}
+		l := &pb.NnarLog{}
+		if err := proto.Unmarshal(data, l); err != nil {
+			return nil, err
+		}
+		logs[id] = l
+	}
+
+	return logs, nil
+}
+
+// MemoryStore keeps the logs in memory, for the simulated processes whose
+// stores outlive their crashes
+type MemoryStore struct {
+	mu   sync.Mutex
+	logs map[string][]byte
+}
+
+func CreateMemoryStore() *MemoryStore {
+	return &MemoryStore{logs: make(map[string][]byte)}
+}
+
+func (ms *MemoryStore) Save(id string, l *pb.NnarLog) error {
+	data, err := proto.Marshal(l)
+	if err != nil {
+		return err
+	}
+
+	ms.mu.Lock()
+	defer ms.mu.Unlock()
+	ms.logs[id] = data
+
+	return nil
+}
+
+func (ms *MemoryStore) Load() (map[string]*pb.NnarLog, error) {
+	ms.mu.Lock()
+	defer ms.mu.Unlock()
+
+	logs := make(map[string]*pb.NnarLog)
+	for id, data := range ms.logs {
+		l := &pb.NnarLog{}
+		if err := proto.Unmarshal(data, l); err != nil {
+			return nil, err
+		}
+		logs[id] = l
+	}
+
+	return logs, nil
+}
@@ -0,0 +1,300 @@
+package sim
+
+import (
+	"amcds/kv"
+	"amcds/pb"
+	"amcds/pl"
+	"amcds/pl/fault"
+	"amcds/register"
+	"amcds/system"
+	"amcds/utils"
+	"amcds/utils/log"
+	"math/rand"
+	"net"
+	"sort"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// Process plays the role of main.go for one simulated process: it routes the
+// frames it receives to the systems it participates in
+type Process struct {
+	sim     *Simulation
+	Id      *pb.ProcessId
+	systems map[string]*system.System
+	crashed bool
+	// sits between the systems and the network when faults are injected
+	injector *fault.Injector
+	// a malicious process sends conflicting byzantine broadcasts to its peers
+	malicious bool
+	// stores of the registers by system, which survive the crashes like disks
+	stores map[string]*register.MemoryStore
+}
+
+// AddProcess starts a process listening on the next free port and registers
+// it to the hub
+func (s *Simulation) AddProcess(owner string, index int32) *Process {
+	p := &Process{
+		sim: s,
+		Id: &pb.ProcessId{
+			Host:  host,
+			Port:  hubPort + int32(len(s.processes)) + 1,
+			Owner: owner,
+			Index: index,
+		},
+		systems: make(map[string]*system.System),
+		stores:  make(map[string]*register.MemoryStore),
+	}
+	s.processes = append(s.processes, p)
+	s.endpoints[p.address()] = p.handle
+	p.register()
+
+	return p
+}
+
+// register announces the process to the hub
+func (p *Process) register() {
+	link := pl.Create(p.Id.Host, p.Id.Port, p.sim.HubAddress()).CreateWithTransport(p.sim)
+	err := link.Send(&pb.Message{
+		Type: pb.Message_PL_SEND,
+		PlSend: &pb.PlSend{
+			Message: &pb.Message{
+				Type: pb.Message_PROC_REGISTRATION,
+				ProcRegistration: &pb.ProcRegistration{
+					Owner: p.Id.Owner,
+					Index: p.Id.Index,
+				},
+			},
+		},
+	})
+	if err != nil {
+		log.Error("Failed to register %v: %v", p.Name(), err)
+	}
+}
+
+// Process returns the simulated process with the given owner-index name
+func (s *Simulation) Process(name string) *Process {
+	for _, p := range s.processes {
+		if p.Name() == name {
+			return p
+		}
+	}
+
+	return nil
+}
+
+// InjectFaults puts a fault injector between the process and the network for
+// the systems initialized afterwards. The seed of the injector is drawn from
+// the simulation so that a run still replays the same
+func (s *Simulation) InjectFaults(name string, cfg fault.Config) *fault.Injector {
+	p := s.Process(name)
+	if p == nil {
+		return nil
+	}
+
+	cfg.Seed = s.rng.Int63()
+	p.injector = fault.Create(name, s, s, cfg)
+
+	return p.injector
+}
+
+// MakeMalicious has the process equivocate in the byzantine broadcasts of the
+// systems initialized afterwards: the value it sends, echoes or is ready for
+// is changed by Equivocation for the peers of odd index
+func (s *Simulation) MakeMalicious(name string) {
+	if p := s.Process(name); p != nil {
+		p.malicious = true
+	}
+}
+
+// Crash stops the process for good: it does not handle anything anymore and
+// frames sent to it are lost
+func (s *Simulation) Crash(name string) {
+	p := s.Process(name)
+	if p == nil || p.crashed {
+		return
+	}
+
+	log.Info("Crashing process %v at %v", name, s.now)
+	p.crashed = true
+	// the frames are silently lost rather than refused, otherwise the failure
+	// detectors of the others would fill the log with errors
+	s.endpoints[p.address()] = func(data []byte) {}
+}
+
+// Restart brings a crashed process back with empty memory but the stores of
+// its registers. It registers to the hub again, which sends it the current
+// system for the registers to recover
+func (s *Simulation) Restart(name string) {
+	p := s.Process(name)
+	if p == nil || !p.crashed {
+		return
+	}
+
+	log.Info("Restarting process %v at %v", name, s.now)
+	for _, sys := range p.systems {
+		sys.Destroy()
+	}
+	p.systems = make(map[string]*system.System)
+	p.crashed = false
+	s.endpoints[p.address()] = p.handle
+	p.register()
+}
+
+// Kv returns the key-value store of the process in the current system of the
+// hub, nil when it does not run it
+func (p *Process) Kv() *kv.Service {
+	s, ok := p.systems[p.sim.hub.SystemId()]
+	if !ok || p.crashed {
+		return nil
+	}
+
+	return s.Kv()
+}
+
+func (p *Process) Name() string {
+	return p.Id.Owner + "-" + utils.Int32ToString(p.Id.Index)
+}
+
+func (p *Process) Crashed() bool {
+	return p.crashed
+}
+
+// transport returns what the systems of the process send their frames through
+func (p *Process) transport() pl.Transport {
+	var t pl.Transport = p.sim
+	if p.injector != nil {
+		t = p.injector
+	}
+	if p.malicious {
+		t = &equivocator{sim: p.sim, inner: t}
+	}
+
+	return t
+}
+
+func (p *Process) address() string {
+	return net.JoinHostPort(p.Id.Host, utils.Int32ToString(p.Id.Port))
+}
+
+func (p *Process) handle(data []byte) {
+	link := pl.Create(p.Id.Host, p.Id.Port, p.sim.HubAddress())
+	m, err := link.Parse(data)
+	if err != nil || m.NetworkMessage == nil || m.NetworkMessage.Message == nil {
+		log.Warn("%v failed to parse incoming frame %v", p.Name(), err)
+		return
+	}
+
+	switch m.NetworkMessage.Message.Type {
+	case pb.Message_PROC_DESTROY_SYSTEM:
+		if s, ok := p.systems[m.SystemId]; ok {
+			s.Destroy()
+			delete(p.systems, m.SystemId)
+		}
+	case pb.Message_PROC_INITIALIZE_SYSTEM:
+		if old, ok := p.systems[m.SystemId]; ok {
+			old.Destroy()
+		}
+		if _, ok := p.stores[m.SystemId]; !ok {
+			p.stores[m.SystemId] = register.CreateMemoryStore()
+		}
+		s := system.CreateSystem(m.NetworkMessage.Message, p.Id.Host, p.Id.Owner, p.sim.HubAddress(), p.Id.Port, p.Id.Index).CreateWithRuntime(p.transport(), p.sim).CreateWithStore(p.stores[m.SystemId])
+		if p.injector != nil {
+			p.injector.Learn(m.NetworkMessage.Message.ProcInitializeSystem.Processes)
+		}
+		s.RegisterAbstractions()
+		p.systems[m.SystemId] = s
+	case pb.Message_PROC_RECONFIGURE_SYSTEM:
+		if p.injector != nil {
+			p.injector.Learn(m.NetworkMessage.Message.ProcReconfigureSystem.Processes)
+		}
+		if s, ok := p.systems[m.SystemId]; ok {
+			s.AddMessage(m)
+			break
+		}
+		// the process joins a running system
+		s := system.CreateSystem(m.NetworkMessage.Message, p.Id.Host, p.Id.Owner, p.sim.HubAddress(), p.Id.Port, p.Id.Index).CreateWithRuntime(p.transport(), p.sim)
+		s.RegisterAbstractions()
+		p.systems[m.SystemId] = s
+	default:
+		if s, ok := p.systems[m.SystemId]; ok {
+			s.AddMessage(m)
+		} else {
+			log.Warn("System %v not initialized on %v", m.SystemId, p.Name())
+		}
+	}
+}
+
+func (p *Process) pending() bool {
+	if p.crashed {
+		return false
+	}
+
+	for _, s := range p.systems {
+		if s.Pending() > 0 {
+			return true
+		}
+	}
+
+	return false
+}
+
+// step lets one of the systems with queued messages handle the next one. The
+// systems are sorted first since map iteration order is random
+func (p *Process) step(rng *rand.Rand) {
+	ids := make([]string, 0, len(p.systems))
+	for id, s := range p.systems {
+		if s.Pending() > 0 {
+			ids = append(ids, id)
+		}
+	}
+	sort.Strings(ids)
+
+	p.systems[ids[rng.Intn(len(ids))]].Step()
+}
+
+// Equivocation is added to the values a malicious process sends to half of
+// its peers
+const Equivocation = 1000
+
+// equivocator changes the values of the byzantine broadcast frames of a
+// malicious process depending on their destination, the other frames going
+// through untouched
+type equivocator struct {
+	sim   *Simulation
+	inner pl.Transport
+}
+
+func (e *equivocator) Send(address string, data []byte) error {
+	m := &pb.Message{}
+	if err := proto.Unmarshal(data, m); err != nil || m.NetworkMessage == nil || m.NetworkMessage.Message == nil {
+		return e.inner.Send(address, data)
+	}
+
+	var payload *pb.Message
+	switch inner := m.NetworkMessage.Message; inner.Type {
+	case pb.Message_BYZANTINE_INTERNAL_SEND:
+		payload = inner.ByzantineInternalSend.Message
+	case pb.Message_BYZANTINE_INTERNAL_ECHO:
+		payload = inner.ByzantineInternalEcho.Message
+	case pb.Message_BYZANTINE_INTERNAL_READY:
+		payload = inner.ByzantineInternalReady.Message
+	}
+	if payload.GetAppValue().GetValue() == nil {
+		return e.inner.Send(address, data)
+	}
+
+	for _, p := range e.sim.processes {
+		if p.address() == address && p.Id.Index%2 == 1 {
+			v := payload.AppValue.Value
+			payload.AppValue.Value = &pb.Value{Defined: true, V: v.V + Equivocation}
+			altered, err := proto.Marshal(m)
+			if err != nil {
+				return err
+			}
+			data = altered
+		}
+	}
+
+	return e.inner.Send(address, data)
+}
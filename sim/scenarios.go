@@ -0,0 +1,2417 @@
+package sim
+
+import (
+	"amcds/hub"
+	"amcds/kv"
+	"amcds/lin"
+	"amcds/pb"
+	"amcds/pl/fault"
+	"amcds/utils"
+	"amcds/utils/abstraction"
+	"amcds/utils/log"
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+)
+
+// how long a scenario may wait for the processes to react to a hub command
+const patience = 10 * time.Second
+
+type Scenario struct {
+	Name        string
+	Description string
+	Run         func(s *Simulation) error
+}
+
+var Scenarios = []Scenario{
+	{
+		Name:        "beb",
+		Description: "best-effort broadcast from one process reaches every process",
+		Run:         bebScenario,
+	},
+	{
+		Name:        "le",
+		Description: "leader election has every process trust the max-rank one, then the same next one once it crashed",
+		Run:         leScenario,
+	},
+	{
+		Name:        "rb-eager",
+		Description: "eager reliable broadcast reaches every correct process although the sender crashed half way",
+		Run:         reliableScenario(pb.Message_RB_INTERNAL_DATA, map[string]string{"rb_algorithm": "eager"}),
+	},
+	{
+		Name:        "rb-lazy",
+		Description: "lazy reliable broadcast relays the message of a sender detected as crashed",
+		Run:         reliableScenario(pb.Message_RB_INTERNAL_DATA, map[string]string{"rb_algorithm": "lazy"}),
+	},
+	{
+		Name:        "urb-majority-ack",
+		Description: "majority-ack uniform broadcast reaches every correct process although the sender crashed half way",
+		Run:         reliableScenario(pb.Message_URB_INTERNAL_DATA, map[string]string{"urb_algorithm": "majority-ack"}),
+	},
+	{
+		Name:        "urb-all-ack",
+		Description: "all-ack uniform broadcast delivers once every process not detected as crashed relayed",
+		Run:         reliableScenario(pb.Message_URB_INTERNAL_DATA, map[string]string{"urb_algorithm": "all-ack"}),
+	},
+	{
+		Name:        "frb",
+		Description: "fifo broadcast delivers the messages of a sender in order over links reordering frames",
+		Run:         frbScenario,
+	},
+	{
+		Name:        "crb-waiting",
+		Description: "waiting causal broadcast holds back a message until its cause arrives over a slow link",
+		Run:         crbScenario("waiting"),
+	},
+	{
+		Name:        "crb-no-waiting",
+		Description: "no-waiting causal broadcast delivers the cause of a message it got first from its past",
+		Run:         crbScenario("no-waiting"),
+	},
+	{
+		Name:        "crb-rank-gaps",
+		Description: "waiting causal broadcast orders the messages of a reconfigured system whose ranks go beyond its size",
+		Run:         crbRankGapsScenario,
+	},
+	{
+		Name:        "pb-eager",
+		Description: "eager gossip reaches most of ten processes over lossy links, each delivering a message at most once",
+		Run:         pbScenario("eager"),
+	},
+	{
+		Name:        "pb-lazy",
+		Description: "lazy gossip recovers messages lost by lossy links and delivers the ones of a sender in order",
+		Run:         pbScenario("lazy"),
+	},
+	{
+		Name:        "tob",
+		Description: "concurrent total-order broadcasts over reordering links are delivered in the same order everywhere",
+		Run:         tobScenario(false),
+	},
+	{
+		Name:        "tob-crash",
+		Description: "total-order broadcast keeps ordering the messages of the correct processes after one crashed",
+		Run:         tobScenario(true),
+	},
+	{
+		Name:        "trb",
+		Description: "terminating reliable broadcast from a correct sender delivers its value everywhere",
+		Run:         trbScenario("correct"),
+	},
+	{
+		Name:        "trb-crash",
+		Description: "terminating reliable broadcast from a sender crashing half way delivers the same outcome everywhere",
+		Run:         trbScenario("crash"),
+	},
+	{
+		Name:        "trb-silent",
+		Description: "terminating reliable broadcast from a sender crashing before sending delivers its failure everywhere",
+		Run:         trbScenario("silent"),
+	},
+	{
+		Name:        "nbac",
+		Description: "atomic commit decides commit everywhere when every process votes commit",
+		Run:         nbacScenario("commit"),
+	},
+	{
+		Name:        "nbac-abort",
+		Description: "atomic commit decides abort everywhere when one process votes abort",
+		Run:         nbacScenario("abort"),
+	},
+	{
+		Name:        "nbac-crash",
+		Description: "atomic commit decides abort everywhere when a voter crashes before voting",
+		Run:         nbacScenario("crash"),
+	},
+	{
+		Name:        "2pc",
+		Description: "two phase commit commits everywhere when every process votes commit",
+		Run:         commitScenario("2pc", "commit"),
+	},
+	{
+		Name:        "2pc-abort",
+		Description: "two phase commit aborts everywhere when one process votes abort",
+		Run:         commitScenario("2pc", "abort"),
+	},
+	{
+		Name:        "2pc-crash",
+		Description: "two phase commit blocks the participants when the coordinator crashes once they voted",
+		Run:         commitScenario("2pc", "crash"),
+	},
+	{
+		Name:        "3pc",
+		Description: "three phase commit commits everywhere when every process votes commit",
+		Run:         commitScenario("3pc", "commit"),
+	},
+	{
+		Name:        "3pc-abort",
+		Description: "three phase commit aborts everywhere when one process votes abort",
+		Run:         commitScenario("3pc", "abort"),
+	},
+	{
+		Name:        "3pc-crash",
+		Description: "three phase commit terminates consistently when the coordinator crashes once the participants voted",
+		Run:         commitScenario("3pc", "crash"),
+	},
+	{
+		Name:        "3pc-precommit-crash",
+		Description: "three phase commit terminates consistently when the coordinator crashes while sending the precommits",
+		Run:         commitScenario("3pc", "precommit-crash"),
+	},
+	{
+		Name:        "gm",
+		Description: "group membership removes crashed processes from the view, nnar quorums follow it",
+		Run:         gmScenario,
+	},
+	{
+		Name:        "vs",
+		Description: "view-synchronous broadcast delivers the same messages in a view at every process moving to the next one",
+		Run:         vsScenario,
+	},
+	{
+		Name:        "reconfigure",
+		Description: "three processes join a running system and two leave it during a storm, the new members read the value written before",
+		Run:         reconfigureScenario,
+	},
+	{
+		Name:        "nnar",
+		Description: "write, read and storm on (N,N) atomic registers, checked for linearizability",
+		Run:         nnarScenario,
+	},
+	{
+		Name:        "nnar-partition",
+		Description: "a majority keeps reading and writing atomically while a minority is partitioned away by a slow, reordering network",
+		Run:         nnarPartitionScenario,
+	},
+	{
+		Name:        "nnar-recovery",
+		Description: "processes killed and restarted recover the logged register and the write in progress",
+		Run:         nnarRecoveryScenario,
+	},
+	{
+		Name:        "nnar-typed",
+		Description: "nnar stores bytes, strings, int64 and -1 values, and consensus decides on typed proposals",
+		Run:         typedValuesScenario,
+	},
+	{
+		Name:        "kv",
+		Description: "the key-value stores queue the puts and gets on a key, run the ones on different keys concurrently and fail the queued ones when destroyed",
+		Run:         kvScenario,
+	},
+	{
+		Name:        "kv-timeout",
+		Description: "the key-value stores reject invalid keys and time out the operations of a register without a majority, running the queued ones",
+		Run:         kvTimeoutScenario,
+	},
+	{
+		Name:        "onrr-rowa",
+		Description: "read-one-write-all regular register, writes complete once the perfect failure detector reports a crashed reader",
+		Run:         onrrScenario("rowa"),
+	},
+	{
+		Name:        "onrr-majority",
+		Description: "majority voting regular register, writes and reads complete with a crashed minority",
+		Run:         onrrScenario("majority"),
+	},
+	{
+		Name:        "onar-rowa",
+		Description: "read-impose write-all atomic register rejects other writers and keeps working once a reader crashed",
+		Run:         onarScenario("rowa"),
+	},
+	{
+		Name:        "onar-majority",
+		Description: "read-impose write-majority atomic register rejects other writers and keeps working with a crashed minority",
+		Run:         onarScenario("majority"),
+	},
+	{
+		Name:        "onar-cost",
+		Description: "the single-writer atomic registers send fewer messages than nnar for the same writes and reads",
+		Run:         onarCostScenario,
+	},
+	{
+		Name:        "log",
+		Description: "the replicated log commits the values appended on every process in the same slots, without electing a leader again",
+		Run:         logScenario,
+	},
+	{
+		Name:        "log-leader-crash",
+		Description: "the replicated log elects a new leader once the leader crashed, which commits the values appended before and after",
+		Run:         logLeaderCrashScenario,
+	},
+	{
+		Name:        "uc",
+		Description: "every process proposes and all of them decide the same value",
+		Run:         ucScenario,
+	},
+	{
+		Name:        "uc-leader-crash",
+		Description: "the leader-driven consensus decides in a later epoch once the leader crashed while the processes propose",
+		Run:         ucLeaderCrashScenario,
+	},
+	{
+		Name:        "uc-leader-crash-slow",
+		Description: "the leader-driven consensus decides once the leader crashed over slow links, the processes disagreeing on the next leader for a while",
+		Run:         ucSlowLeaderCrashScenario,
+	},
+	{
+		Name:        "uc-raft",
+		Description: "every process proposes and all of them decide the same value, with raft instead of the leader-driven consensus",
+		Run:         raftScenario,
+	},
+	{
+		Name:        "raft-split-vote",
+		Description: "raft splits the votes while the processes are partitioned in halves and decides once the partition heals",
+		Run:         raftSplitVoteScenario,
+	},
+	{
+		Name:        "raft-leader-crash",
+		Description: "the raft leader crashes right after the proposals, the others elect a new leader and decide the same value",
+		Run:         raftLeaderCrashScenario,
+	},
+	{
+		Name:        "rc",
+		Description: "the randomized consensus with a common coin decides one of the proposed values on reordered, slow links",
+		Run:         rcScenario(5, "common", 0),
+	},
+	{
+		Name:        "rc-local-coin",
+		Description: "the randomized consensus also decides with a local coin, in more rounds",
+		Run:         rcScenario(3, "local", 0),
+	},
+	{
+		Name:        "rc-crash",
+		Description: "the randomized consensus decides once 2 of 5 processes crashed, one of them right after proposing",
+		Run:         rcScenario(5, "common", 2),
+	},
+	{
+		Name:        "uc-flooding",
+		Description: "every process proposes and all of them decide the same value, with the flooding consensus",
+		Run:         failStopScenario("flooding", false, false),
+	},
+	{
+		Name:        "flooding-crash",
+		Description: "the flooding consensus decides once the first two processes by rank crashed in the middle of a round",
+		Run:         failStopScenario("flooding", false, true),
+	},
+	{
+		Name:        "uc-flooding-uniform",
+		Description: "every process proposes and all of them decide the same value, with the flooding uniform consensus",
+		Run:         failStopScenario("flooding-uniform", true, false),
+	},
+	{
+		Name:        "flooding-uniform-crash",
+		Description: "the flooding uniform consensus decides once the first two processes by rank crashed in the middle of a round",
+		Run:         failStopScenario("flooding-uniform", true, true),
+	},
+	{
+		Name:        "uc-hierarchical",
+		Description: "every process proposes and all of them decide the same value, with the hierarchical consensus",
+		Run:         failStopScenario("hierarchical", false, false),
+	},
+	{
+		Name:        "hierarchical-crash",
+		Description: "the hierarchical consensus decides once the first two processes by rank crashed in the middle of a round",
+		Run:         failStopScenario("hierarchical", false, true),
+	},
+	{
+		Name:        "uc-hierarchical-uniform",
+		Description: "every process proposes and all of them decide the same value, with the hierarchical uniform consensus",
+		Run:         failStopScenario("hierarchical-uniform", true, false),
+	},
+	{
+		Name:        "hierarchical-uniform-crash",
+		Description: "the hierarchical uniform consensus decides once the first two processes by rank crashed in the middle of a round",
+		Run:         failStopScenario("hierarchical-uniform", true, true),
+	},
+	{
+		Name:        "bcb",
+		Description: "byzantine consistent broadcast from two of four processes reaches every process, once",
+		Run:         byzantineScenario(false, false),
+	},
+	{
+		Name:        "bcb-equivocate",
+		Description: "byzantine consistent broadcast keeps the correct processes from delivering different values of a sender equivocating to half of them",
+		Run:         byzantineScenario(false, true),
+	},
+	{
+		Name:        "brb",
+		Description: "byzantine reliable broadcast from two of four processes reaches every process, once",
+		Run:         byzantineScenario(true, false),
+	},
+	{
+		Name:        "brb-equivocate",
+		Description: "byzantine reliable broadcast has all the correct processes deliver the same value of a sender equivocating to half of them",
+		Run:         byzantineScenario(true, true),
+	},
+}
+
+func FindScenario(name string) (Scenario, bool) {
+	for _, sc := range Scenarios {
+		if sc.Name == name {
+			return sc, true
+		}
+	}
+
+	return Scenario{}, false
+}
+
+// Options change how Setup creates the processes and their system
+type Options struct {
+	// settings of the system sent by the hub, e.g. rb_algorithm=lazy
+	Settings map[string]string
+	// faults injected on the links of every process
+	Faults *fault.Config
+	// processes equivocating in the byzantine broadcasts
+	Malicious []string
+}
+
+// Setup registers n processes of the owner and initializes a system with them
+func (s *Simulation) Setup(owner string, n int) error {
+	return s.SetupWith(owner, n, Options{})
+}
+
+// SetupWith is Setup with options
+func (s *Simulation) SetupWith(owner string, n int, opts Options) error {
+	for i := 1; i <= n; i++ {
+		p := s.AddProcess(owner, int32(i))
+		if opts.Faults != nil {
+			s.InjectFaults(p.Name(), *opts.Faults)
+		}
+		if slices.Contains(opts.Malicious, p.Name()) {
+			s.MakeMalicious(p.Name())
+		}
+	}
+	s.RunFor(100 * time.Millisecond)
+
+	if err := s.hub.System([]string{owner}, opts.Settings); err != nil {
+		return err
+	}
+	s.RunFor(100 * time.Millisecond)
+
+	return nil
+}
+
+// Alive returns the names of the processes that did not crash
+func (s *Simulation) Alive() []string {
+	names := make([]string, 0)
+	for _, p := range s.processes {
+		if !p.crashed {
+			names = append(names, p.Name())
+		}
+	}
+
+	return names
+}
+
+// WaitDeliveries runs until the hub got n messages of the given type since
+// the previous count, returning them
+func (s *Simulation) WaitDeliveries(t pb.Message_Type, from, n int) ([]*Delivery, error) {
+	ok := s.RunUntil(func() bool { return len(s.Deliveries(t)) >= from+n }, patience)
+	ds := s.Deliveries(t)[from:]
+	if !ok {
+		return ds, fmt.Errorf("expected %v %v messages, got %v after %v", n, t, len(ds), s.now)
+	}
+
+	return ds, nil
+}
+
+func bebScenario(s *Simulation) error {
+	if err := s.Setup("abc", 3); err != nil {
+		return err
+	}
+
+	if err := s.hub.Broadcast("abc-2", 52); err != nil {
+		return err
+	}
+
+	ds, err := s.WaitDeliveries(pb.Message_APP_VALUE, 0, 3)
+	if err != nil {
+		return err
+	}
+
+	seen := make(map[string]bool)
+	for _, d := range ds {
+		if d.Message.AppValue.Value.V != 52 {
+			return fmt.Errorf("%v delivered %v instead of 52", d.Process, d.Message.AppValue.Value.V)
+		}
+		if seen[d.Process] {
+			return fmt.Errorf("%v delivered twice", d.Process)
+		}
+		seen[d.Process] = true
+	}
+
+	return nil
+}
+
+// leScenario starts the leader election on abc-1 only, the others creating
+// theirs with the heartbeats of its pfd, then crashes the leader they trust
+func leScenario(s *Simulation) error {
+	if err := s.Setup("abc", 4); err != nil {
+		return err
+	}
+
+	ranks := s.hub.Ranks()
+	names := s.Alive()
+	sort.Slice(names, func(i, j int) bool { return ranks[names[i]] > ranks[names[j]] })
+
+	leaders := func(name string) []string {
+		ls := make([]string, 0)
+		for _, d := range s.Reports(pb.Message_APP_LEADER) {
+			if d.Process == name {
+				l := d.Message.AppLeader.Leader
+				ls = append(ls, l.Owner+"-"+utils.Int32ToString(l.Index))
+			}
+		}
+		return ls
+	}
+	// waits for every process alive to trust the expected leader, the n-th one
+	trusted := func(n int, expected string) error {
+		ok := s.RunUntil(func() bool {
+			for _, name := range s.Alive() {
+				if len(leaders(name)) < n {
+					return false
+				}
+			}
+			return true
+		}, patience)
+		if !ok {
+			return fmt.Errorf("not every process trusted %v leaders after %v", n, s.now)
+		}
+		for _, name := range s.Alive() {
+			if ls := leaders(name); len(ls) != n || ls[n-1] != expected {
+				return fmt.Errorf("%v trusted %v instead of %v", name, strings.Join(ls, " then "), expected)
+			}
+		}
+		return nil
+	}
+
+	if err := s.hub.Elect([]string{"abc-1"}); err != nil {
+		return err
+	}
+	if err := trusted(1, names[0]); err != nil {
+		return err
+	}
+
+	s.Crash(names[0])
+	if err := trusted(2, names[1]); err != nil {
+		return err
+	}
+
+	// the others keep trusting it
+	s.RunFor(time.Second)
+
+	return trusted(2, names[1])
+}
+
+// reliableScenario cuts abc-3 off from abc-1, makes it broadcast and crashes it
+// right after it sent the message (t) to the others, like a crash in the middle
+// of the beb loop. abc-1 and abc-2 must still deliver it, exactly once
+func reliableScenario(t pb.Message_Type, settings map[string]string) func(s *Simulation) error {
+	return func(s *Simulation) error {
+		if err := s.SetupWith("abc", 3, Options{Settings: settings, Faults: &fault.Config{}}); err != nil {
+			return err
+		}
+		s.Process("abc-3").injector.Partition([][]string{{"abc-3"}, {"abc-1"}}, 0)
+
+		broadcast := s.hub.ReliableBroadcast
+		if t == pb.Message_URB_INTERNAL_DATA {
+			broadcast = s.hub.UniformBroadcast
+		}
+		if err := broadcast("abc-3", 52); err != nil {
+			return err
+		}
+
+		// abc-3 sends to itself and abc-2, abc-1 is partitioned away
+		if !s.RunUntil(func() bool { return s.Sent("abc-3", t) >= 2 }, patience) {
+			return fmt.Errorf("abc-3 did not broadcast after %v", s.now)
+		}
+		s.Crash("abc-3")
+
+		delivered := func(name string) int {
+			n := 0
+			for _, d := range s.Deliveries(pb.Message_APP_VALUE) {
+				if d.Process == name {
+					n++
+				}
+			}
+			return n
+		}
+		ok := s.RunUntil(func() bool { return delivered("abc-1") > 0 && delivered("abc-2") > 0 }, patience)
+		if !ok {
+			return fmt.Errorf("abc-1 delivered %v times and abc-2 %v times after %v", delivered("abc-1"), delivered("abc-2"), s.now)
+		}
+
+		// leave time for duplicates to show up
+		s.RunFor(time.Second)
+		for _, d := range s.Deliveries(pb.Message_APP_VALUE) {
+			if d.Message.AppValue.Value.V != 52 {
+				return fmt.Errorf("%v delivered %v instead of 52", d.Process, d.Message.AppValue.Value.V)
+			}
+			if n := delivered(d.Process); n > 1 {
+				return fmt.Errorf("%v delivered %v times", d.Process, n)
+			}
+		}
+
+		return nil
+	}
+}
+
+// deliveredValues returns the values delivered by the process, in order
+func (s *Simulation) deliveredValues(name string) []int32 {
+	vs := make([]int32, 0)
+	for _, d := range s.Reports(pb.Message_APP_VALUE) {
+		if d.Process == name {
+			vs = append(vs, d.Message.AppValue.Value.V)
+		}
+	}
+
+	return vs
+}
+
+// Buffered returns the buffer stats of the abstraction in the systems of the process
+func (p *Process) Buffered(abstractionId string) abstraction.BufferStats {
+	stats := abstraction.BufferStats{}
+	for _, s := range p.systems {
+		if b, ok := s.Buffered()[abstractionId]; ok {
+			stats = b
+		}
+	}
+
+	return stats
+}
+
+func frbScenario(s *Simulation) error {
+	faults := &fault.Config{Reorder: 0.5, ReorderWindow: 50 * time.Millisecond}
+	if err := s.SetupWith("abc", 3, Options{Faults: faults}); err != nil {
+		return err
+	}
+
+	const n = 5
+	for v := int32(1); v <= n; v++ {
+		if err := s.hub.FifoBroadcast("abc-1", v); err != nil {
+			return err
+		}
+		// the commands of the hub are not reordered, but they may be when sent together
+		s.RunFor(20 * time.Millisecond)
+	}
+
+	if _, err := s.WaitDeliveries(pb.Message_APP_VALUE, 0, 3*n); err != nil {
+		return err
+	}
+
+	for _, name := range s.Alive() {
+		vs := s.deliveredValues(name)
+		for i, v := range vs {
+			if v != int32(i+1) {
+				return fmt.Errorf("%v delivered %v out of order, max %v held back", name, vs, s.Process(name).Buffered("app.frb").Max)
+			}
+		}
+	}
+
+	return nil
+}
+
+// crbScenario makes abc-2 broadcast a message after delivering the one of abc-1,
+// which reaches abc-3 late over a slow link. abc-3 must deliver them in order
+func crbScenario(algorithm string) func(s *Simulation) error {
+	return func(s *Simulation) error {
+		// lazy rb does not relay, so the first message only reaches abc-3 from abc-1
+		settings := map[string]string{
+			"crb_algorithm": algorithm,
+			"rb_algorithm":  "lazy",
+			"pfd_timeout":   "1s",
+		}
+		if err := s.SetupWith("abc", 3, Options{Settings: settings, Faults: &fault.Config{}}); err != nil {
+			return err
+		}
+		s.Process("abc-1").injector.SetLinkLatency("abc-3", fault.Latency{Kind: fault.Fixed, Mean: 300 * time.Millisecond})
+
+		if err := s.hub.CausalBroadcast("abc-1", 1); err != nil {
+			return err
+		}
+		if !s.RunUntil(func() bool { return len(s.deliveredValues("abc-2")) > 0 }, patience) {
+			return fmt.Errorf("abc-2 did not deliver after %v", s.now)
+		}
+		if err := s.hub.CausalBroadcast("abc-2", 2); err != nil {
+			return err
+		}
+
+		if _, err := s.WaitDeliveries(pb.Message_APP_VALUE, 0, 6); err != nil {
+			return err
+		}
+		s.RunFor(time.Second)
+
+		for _, name := range s.Alive() {
+			if vs := s.deliveredValues(name); len(vs) != 2 || vs[0] != 1 || vs[1] != 2 {
+				return fmt.Errorf("%v delivered %v instead of [1 2]", name, vs)
+			}
+		}
+		if algorithm == "waiting" && s.Process("abc-3").Buffered("app.crb").Max == 0 {
+			return errors.New("abc-3 did not hold back the second message")
+		}
+
+		return nil
+	}
+}
+
+// crbRankGapsScenario reconfigures 3 processes into abc-3, abc-4 and abc-5,
+// the ones joining getting ranks 4 and 5, and has abc-5 then abc-4 causally
+// broadcast over a slow link from abc-5 to abc-3
+func crbRankGapsScenario(s *Simulation) error {
+	settings := map[string]string{"crb_algorithm": "waiting", "rb_algorithm": "lazy", "pfd_timeout": "1s"}
+	if err := s.SetupWith("abc", 3, Options{Settings: settings}); err != nil {
+		return err
+	}
+
+	joining := []string{"abc-4", "abc-5"}
+	for i := range joining {
+		s.AddProcess("abc", int32(4+i))
+	}
+	s.InjectFaults("abc-5", fault.Config{})
+	s.RunFor(100 * time.Millisecond)
+	if err := s.hub.Reconfigure(joining, []string{"abc-1", "abc-2"}); err != nil {
+		return err
+	}
+
+	members := []string{"abc-3", "abc-4", "abc-5"}
+	ok := s.RunUntil(func() bool {
+		for _, name := range members {
+			if s.latestView(name, "gm") != strings.Join(members, " ") {
+				return false
+			}
+		}
+		return true
+	}, patience)
+	if !ok {
+		return fmt.Errorf("processes did not install a view with %v after %v", members, s.now)
+	}
+	if ranks := s.hub.Ranks(); ranks["abc-5"] <= int32(len(members)) {
+		return fmt.Errorf("abc-5 got rank %v, within the size of the system", ranks["abc-5"])
+	}
+
+	s.Process("abc-5").injector.SetLinkLatency("abc-3", fault.Latency{Kind: fault.Fixed, Mean: 300 * time.Millisecond})
+	if err := s.hub.CausalBroadcast("abc-5", 1); err != nil {
+		return err
+	}
+	if !s.RunUntil(func() bool { return len(s.deliveredValues("abc-4")) > 0 }, patience) {
+		return fmt.Errorf("abc-4 did not deliver after %v", s.now)
+	}
+	if err := s.hub.CausalBroadcast("abc-4", 2); err != nil {
+		return err
+	}
+	s.RunFor(2 * time.Second)
+
+	for _, name := range members {
+		if vs := s.deliveredValues(name); len(vs) != 2 || vs[0] != 1 || vs[1] != 2 {
+			return fmt.Errorf("%v delivered %v instead of [1 2]", name, vs)
+		}
+	}
+	if s.Process("abc-3").Buffered("app.crb").Max == 0 {
+		return errors.New("abc-3 did not hold back the second message")
+	}
+
+	return nil
+}
+
+// pbScenario makes every process of a system of ten gossip a few messages over
+// links dropping some frames. Delivery is probabilistic, so only a loose ratio
+// is expected, but no process may deliver a message twice and the lazy
+// variant must deliver the messages of a sender in order
+func pbScenario(algorithm string) func(s *Simulation) error {
+	return func(s *Simulation) error {
+		const n, m = 10, 3
+		settings := map[string]string{
+			"pb_algorithm": algorithm,
+			"pb_fanout":    "3",
+			"pb_rounds":    "3",
+			"pb_timeout":   "500ms",
+			"pb_seed":      fmt.Sprint(s.rng.Int63()),
+		}
+		if err := s.SetupWith("abc", n, Options{Settings: settings, Faults: &fault.Config{Drop: 0.05}}); err != nil {
+			return err
+		}
+
+		for i := int32(1); i <= m; i++ {
+			for p := int32(1); p <= n; p++ {
+				if err := s.hub.ProbabilisticBroadcast(fmt.Sprintf("abc-%v", p), 100*p+i); err != nil {
+					return err
+				}
+			}
+			s.RunFor(20 * time.Millisecond)
+		}
+		s.RunFor(3 * time.Second)
+
+		delivered := 0
+		for _, name := range s.Alive() {
+			seen := make(map[int32]bool)
+			last := make(map[int32]int32)
+			for _, v := range s.deliveredValues(name) {
+				if seen[v] {
+					return fmt.Errorf("%v delivered %v twice", name, v)
+				}
+				seen[v] = true
+
+				if algorithm == "lazy" && v%100 <= last[v/100] {
+					return fmt.Errorf("%v delivered %v after %v", name, v, 100*(v/100)+last[v/100])
+				}
+				last[v/100] = v % 100
+			}
+			delivered += len(seen)
+		}
+
+		if ratio := float64(delivered) / float64(n*n*m); ratio < 0.8 {
+			return fmt.Errorf("only %.0f%% of the messages were delivered", 100*ratio)
+		}
+
+		return nil
+	}
+}
+
+// tobScenario makes every process broadcast a few values at the same time, in
+// two waves, crashing abc-3 in between when asked to. The hub must see the same
+// sequence from every process, without duplicates
+func tobScenario(crash bool) func(s *Simulation) error {
+	return func(s *Simulation) error {
+		const n, m = 3, 3
+		faults := &fault.Config{Reorder: 0.3, ReorderWindow: 20 * time.Millisecond}
+		if err := s.SetupWith("abc", n, Options{Faults: faults}); err != nil {
+			return err
+		}
+
+		broadcast := func(wave int32) error {
+			for i := int32(1); i <= m; i++ {
+				for _, name := range s.Alive() {
+					if err := s.hub.TobBroadcast(name, 100*wave+10*s.Process(name).Id.Index+i); err != nil {
+						return err
+					}
+				}
+			}
+			return nil
+		}
+		delivered := func(total int) bool {
+			for _, name := range s.Alive() {
+				if len(s.deliveredInOrder(name)) < total {
+					return false
+				}
+			}
+			return true
+		}
+
+		if err := broadcast(1); err != nil {
+			return err
+		}
+		if !s.RunUntil(func() bool { return delivered(n * m) }, patience) {
+			out, _ := s.hub.Order()
+			return fmt.Errorf("the first wave was not delivered after %v\n%v", s.now, out)
+		}
+
+		if crash {
+			s.Crash("abc-3")
+		}
+		if err := broadcast(2); err != nil {
+			return err
+		}
+		total := n*m + len(s.Alive())*m
+		if !s.RunUntil(func() bool { return delivered(total) }, patience) {
+			out, _ := s.hub.Order()
+			return fmt.Errorf("the second wave was not delivered after %v\n%v", s.now, out)
+		}
+		s.RunFor(time.Second)
+
+		for _, name := range s.Alive() {
+			seen := make(map[int32]bool)
+			for _, v := range s.deliveredInOrder(name) {
+				if seen[v] {
+					return fmt.Errorf("%v delivered %v twice", name, v)
+				}
+				seen[v] = true
+			}
+			if len(seen) != total {
+				return fmt.Errorf("%v delivered %v values instead of %v", name, len(seen), total)
+			}
+		}
+
+		out, err := s.hub.Order()
+		if err != nil {
+			return fmt.Errorf("%v\n%v", err, out)
+		}
+
+		return nil
+	}
+}
+
+// trbScenario runs a terminating reliable broadcast from abc-3, which is either
+// correct, crashes after its message reached abc-2 only, or crashes before
+// getting the command of the hub. The correct processes must deliver the same
+// outcome, once: the value when abc-3 is correct, its failure when it is silent
+func trbScenario(sender string) func(s *Simulation) error {
+	return func(s *Simulation) error {
+		if err := s.SetupWith("abc", 3, Options{Faults: &fault.Config{}}); err != nil {
+			return err
+		}
+		if err := s.hub.Trb("t", "abc-3", 52); err != nil {
+			return err
+		}
+		switch sender {
+		case "crash":
+			// its message only reaches abc-2 before it crashes
+			s.Process("abc-3").injector.Partition([][]string{{"abc-3"}, {"abc-1"}}, 0)
+			if !s.RunUntil(func() bool { return s.Sent("abc-3", pb.Message_TRB_INTERNAL_DATA) >= 2 }, patience) {
+				return fmt.Errorf("abc-3 did not broadcast after %v", s.now)
+			}
+			s.Crash("abc-3")
+		case "silent":
+			s.Crash("abc-3")
+		}
+
+		n := len(s.Alive())
+		ds, err := s.WaitDeliveries(pb.Message_APP_TRB_DELIVER, 0, n)
+		if err != nil {
+			return err
+		}
+		s.RunFor(time.Second)
+		if len(s.Deliveries(pb.Message_APP_TRB_DELIVER)) != n {
+			return fmt.Errorf("expected %v deliveries, got %v", n, len(s.Deliveries(pb.Message_APP_TRB_DELIVER)))
+		}
+
+		outcome := func(d *Delivery) string {
+			if d.Message.AppTrbDeliver.Failed {
+				return "failed"
+			}
+			return fmt.Sprint(d.Message.AppTrbDeliver.Value.V)
+		}
+		for _, d := range ds[1:] {
+			if outcome(d) != outcome(ds[0]) {
+				return fmt.Errorf("%v delivered %v but %v delivered %v", d.Process, outcome(d), ds[0].Process, outcome(ds[0]))
+			}
+		}
+
+		if sender == "correct" && outcome(ds[0]) != "52" {
+			return fmt.Errorf("the processes delivered %v instead of 52", outcome(ds[0]))
+		}
+		if sender == "silent" && outcome(ds[0]) != "failed" {
+			return fmt.Errorf("the processes delivered %v instead of the failure of abc-3", outcome(ds[0]))
+		}
+
+		return nil
+	}
+}
+
+// nbacScenario runs an atomic commit where every process votes commit, except
+// abc-2 which votes abort or abc-3 which crashes before getting the command of
+// the hub. The correct processes must decide once, to commit only when nobody
+// voted abort or crashed
+func nbacScenario(mode string) func(s *Simulation) error {
+	return func(s *Simulation) error {
+		if err := s.Setup("abc", 3); err != nil {
+			return err
+		}
+
+		aborting := []string{}
+		if mode == "abort" {
+			aborting = append(aborting, "abc-2")
+		}
+		if err := s.hub.Nbac("tx", aborting); err != nil {
+			return err
+		}
+		if mode == "crash" {
+			s.Crash("abc-3")
+		}
+
+		n := len(s.Alive())
+		if _, err := s.WaitDeliveries(pb.Message_APP_NBAC_DECIDE, 0, n); err != nil {
+			return err
+		}
+		s.RunFor(time.Second)
+
+		ds := s.Deliveries(pb.Message_APP_NBAC_DECIDE)
+		if len(ds) != n {
+			return fmt.Errorf("expected %v decisions, got %v", n, len(ds))
+		}
+		for _, d := range ds {
+			if d.Message.AppNbacDecide.Commit != (mode == "commit") {
+				return fmt.Errorf("%v decided commit=%v", d.Process, d.Message.AppNbacDecide.Commit)
+			}
+		}
+
+		return nil
+	}
+}
+
+// commitScenario runs a transaction coordinated by abc-1 with the 2pc or 3pc
+// protocol. Every process votes commit, except abc-2 in abort mode. In crash
+// mode the coordinator crashes as soon as every participant sent its vote, in
+// precommit-crash mode once it sent its first precommit
+func commitScenario(protocol, mode string) func(s *Simulation) error {
+	return func(s *Simulation) error {
+		if err := s.Setup("abc", 4); err != nil {
+			return err
+		}
+
+		aborting := []string{}
+		if mode == "abort" {
+			aborting = append(aborting, "abc-2")
+		}
+		if err := s.hub.Commit(protocol, "tx", "abc-1", aborting); err != nil {
+			return err
+		}
+
+		switch mode {
+		case "crash":
+			s.RunUntil(func() bool {
+				for _, name := range []string{"abc-2", "abc-3", "abc-4"} {
+					if s.Sent(name, pb.Message_COMMIT_INTERNAL_VOTE) == 0 {
+						return false
+					}
+				}
+				return true
+			}, patience)
+			s.Crash("abc-1")
+		case "precommit-crash":
+			s.RunUntil(func() bool {
+				return s.Sent("abc-1", pb.Message_COMMIT_INTERNAL_PRECOMMIT) > 0
+			}, patience)
+			s.Crash("abc-1")
+		}
+
+		n := len(s.Alive())
+		if _, err := s.WaitDeliveries(pb.Message_APP_COMMIT_OUTCOME, 0, n); err != nil {
+			return err
+		}
+		s.RunFor(time.Second)
+
+		ds := s.Deliveries(pb.Message_APP_COMMIT_OUTCOME)
+		if len(ds) != n {
+			return fmt.Errorf("expected %v outcomes, got %v", n, len(ds))
+		}
+		first := ds[0].Message.AppCommitOutcome
+		for _, d := range ds {
+			o := d.Message.AppCommitOutcome
+			log.Info("%v committed=%v blocked=%v after %v", d.Process, o.Committed, o.Blocked, time.Duration(o.Latency))
+
+			// only 2pc blocks, and only when its coordinator crashes
+			blocked := protocol == "2pc" && mode == "crash"
+			if o.Blocked != blocked {
+				return fmt.Errorf("%v reported blocked=%v", d.Process, o.Blocked)
+			}
+			if blocked {
+				continue
+			}
+
+			if o.Committed != first.Committed {
+				return fmt.Errorf("%v decided commit=%v while %v decided commit=%v", d.Process, o.Committed, ds[0].Process, first.Committed)
+			}
+			if mode != "precommit-crash" && o.Committed != (mode == "commit") {
+				return fmt.Errorf("%v decided commit=%v", d.Process, o.Committed)
+			}
+		}
+
+		return nil
+	}
+}
+
+// gmScenario crashes half of the processes one after the other, which blocks
+// the nnar registers with the static membership since they wait for a majority
+// of all processes
+func gmScenario(s *Simulation) error {
+	if err := s.SetupWith("abc", 4, Options{Settings: map[string]string{"membership": "gm"}}); err != nil {
+		return err
+	}
+
+	var alive []string
+	for _, name := range []string{"abc-4", "abc-3"} {
+		s.Crash(name)
+		alive = s.Alive()
+		members := strings.Join(alive, " ")
+		ok := s.RunUntil(func() bool {
+			for _, name := range alive {
+				if s.latestView(name, "gm") != members {
+					return false
+				}
+			}
+			return true
+		}, patience)
+		if !ok {
+			return fmt.Errorf("processes did not install a view with %v after %v", members, s.now)
+		}
+	}
+	if err := s.sameViews("gm"); err != nil {
+		return err
+	}
+
+	if err := s.hub.Write("x", 7, []string{"abc-1"}); err != nil {
+		return err
+	}
+	if _, err := s.WaitDeliveries(pb.Message_APP_WRITE_RETURN, 0, 1); err != nil {
+		return err
+	}
+	if err := s.hub.Read("x", nil); err != nil {
+		return err
+	}
+	ds, err := s.WaitDeliveries(pb.Message_APP_READ_RETURN, 0, len(alive))
+	if err != nil {
+		return err
+	}
+	for _, d := range ds {
+		if v := d.Message.AppReadReturn.Value; !v.Defined || v.V != 7 {
+			return fmt.Errorf("%v read %v instead of 7", d.Process, v)
+		}
+	}
+
+	return nil
+}
+
+// vsScenario makes every process broadcast, crashing abc-4 right after it
+// sent its second message to some of the others only, then broadcasts again
+// while the survivors move to the next view
+func vsScenario(s *Simulation) error {
+	if err := s.Setup("abc", 4); err != nil {
+		return err
+	}
+
+	v := int32(0)
+	wave := func(names []string) error {
+		for _, name := range names {
+			for i := 0; i < 2; i++ {
+				v++
+				if err := s.hub.VsBroadcast(name, v); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	}
+
+	if err := wave([]string{"abc-1", "abc-2", "abc-3", "abc-4"}); err != nil {
+		return err
+	}
+	s.RunUntil(func() bool { return s.Sent("abc-4", pb.Message_VS_INTERNAL_DATA) > 5 }, patience)
+	s.Crash("abc-4")
+	alive := s.Alive()
+	if err := wave(alive); err != nil {
+		return err
+	}
+
+	members := strings.Join(alive, " ")
+	ok := s.RunUntil(func() bool {
+		for _, name := range alive {
+			if s.latestView(name, "vs") != members {
+				return false
+			}
+		}
+		return len(s.Deliveries(pb.Message_APP_VS_DELIVER)) >= len(alive)*(2*len(alive)+6)
+	}, patience)
+	if !ok {
+		return fmt.Errorf("processes did not deliver every message and install a view with %v after %v", members, s.now)
+	}
+	s.RunFor(time.Second)
+	if err := s.sameViews("vs"); err != nil {
+		return err
+	}
+
+	// the messages delivered by every process in each view
+	delivered := make(map[string]map[int32][]string)
+	for _, d := range s.Deliveries(pb.Message_APP_VS_DELIVER) {
+		if s.Process(d.Process).Crashed() {
+			continue
+		}
+		if _, ok := delivered[d.Process]; !ok {
+			delivered[d.Process] = make(map[int32][]string)
+		}
+		vd := d.Message.AppVsDeliver
+		delivered[d.Process][vd.ViewId] = append(delivered[d.Process][vd.ViewId], fmt.Sprint(vd.Value.V))
+	}
+
+	reference := delivered[alive[0]]
+	for _, name := range alive {
+		seen := make(map[string]bool)
+		for id, vs := range delivered[name] {
+			for _, v := range vs {
+				if seen[v] {
+					return fmt.Errorf("%v delivered %v twice", name, v)
+				}
+				seen[v] = true
+			}
+
+			sort.Strings(vs)
+			ref := append([]string{}, reference[id]...)
+			sort.Strings(ref)
+			if strings.Join(vs, " ") != strings.Join(ref, " ") {
+				return fmt.Errorf("%v delivered %v in view %v while %v delivered %v", name, vs, id, alive[0], ref)
+			}
+		}
+		if len(delivered[name]) != len(reference) {
+			return fmt.Errorf("%v delivered in %v views while %v delivered in %v", name, len(delivered[name]), alive[0], len(reference))
+		}
+	}
+
+	return nil
+}
+
+// reconfigureScenario writes a register, then moves the system from abc-1..3
+// to abc-3..6 while running a storm on another register. The processes
+// joining make a majority of the new view and only know the written value
+// through the state transferred by the others
+func reconfigureScenario(s *Simulation) error {
+	if err := s.Setup("abc", 3); err != nil {
+		return err
+	}
+
+	if err := s.hub.Write("x", 11, []string{"abc-2"}); err != nil {
+		return err
+	}
+	if _, err := s.WaitDeliveries(pb.Message_APP_WRITE_RETURN, 0, 1); err != nil {
+		return err
+	}
+
+	joining := []string{"abc-4", "abc-5", "abc-6"}
+	for i := range joining {
+		s.AddProcess("abc", int32(4+i))
+	}
+	s.RunFor(100 * time.Millisecond)
+
+	if err := s.hub.Reconfigure(joining, []string{"abc-1", "abc-2"}); err != nil {
+		return err
+	}
+	// the processes joining need the system before being asked to operate
+	s.RunFor(20 * time.Millisecond)
+	writes, reads := len(s.Deliveries(pb.Message_APP_WRITE_RETURN)), len(s.Deliveries(pb.Message_APP_READ_RETURN))
+	if err := s.hub.Storm("w"); err != nil {
+		return err
+	}
+
+	members := []string{"abc-3", "abc-4", "abc-5", "abc-6"}
+	ok := s.RunUntil(func() bool {
+		for _, name := range members {
+			if s.latestView(name, "gm") != strings.Join(members, " ") {
+				return false
+			}
+		}
+		done := len(s.Deliveries(pb.Message_APP_WRITE_RETURN)) - writes + len(s.Deliveries(pb.Message_APP_READ_RETURN)) - reads
+		return done >= len(members)*hub.StormOps
+	}, patience)
+	if !ok {
+		return fmt.Errorf("processes did not install a view with %v and complete the storm after %v", members, s.now)
+	}
+	if err := s.sameViews("gm"); err != nil {
+		return err
+	}
+	if err := s.CheckLinearizable("w"); err != nil {
+		return err
+	}
+
+	reads = len(s.Deliveries(pb.Message_APP_READ_RETURN))
+	if err := s.hub.Read("x", nil); err != nil {
+		return err
+	}
+	ds, err := s.WaitDeliveries(pb.Message_APP_READ_RETURN, reads, len(members))
+	if err != nil {
+		return err
+	}
+	for _, d := range ds {
+		if v := d.Message.AppReadReturn.Value; !v.Defined || v.V != 11 {
+			return fmt.Errorf("%v read %v instead of 11", d.Process, v)
+		}
+	}
+
+	return nil
+}
+
+// latestView returns the members of the latest view of the given source (gm
+// or vs) reported by the process
+func (s *Simulation) latestView(name, source string) string {
+	latest := ""
+	id := int32(-1)
+	for _, d := range s.Reports(pb.Message_APP_VIEW) {
+		v := d.Message.AppView
+		if d.Process == name && v.Source == source && v.Id > id {
+			latest, id = memberNames(v.Members), v.Id
+		}
+	}
+
+	return latest
+}
+
+// memberNames returns the sorted names of the processes of a view
+func memberNames(members []*pb.ProcessId) string {
+	names := make([]string, 0, len(members))
+	for _, p := range members {
+		names = append(names, p.Owner+"-"+fmt.Sprint(p.Index))
+	}
+	sort.Strings(names)
+
+	return strings.Join(names, " ")
+}
+
+// sameViews checks that the processes reported the same members for the views
+// of the given source (gm or vs) with the same id
+func (s *Simulation) sameViews(source string) error {
+	views := make(map[int32]string)
+	for _, d := range s.Reports(pb.Message_APP_VIEW) {
+		v := d.Message.AppView
+		if v.Source != source {
+			continue
+		}
+
+		m := memberNames(v.Members)
+		if other, ok := views[v.Id]; ok && other != m {
+			return fmt.Errorf("%v installed %v view %v with %v, another process with %v", d.Process, source, v.Id, m, other)
+		}
+		views[v.Id] = m
+	}
+
+	return nil
+}
+
+// deliveredInOrder returns the values delivered by the process in total order
+func (s *Simulation) deliveredInOrder(name string) []int32 {
+	vs := make([]int32, 0)
+	for _, d := range s.Reports(pb.Message_APP_TOB_DELIVER) {
+		if d.Process == name {
+			vs = append(vs, d.Message.AppTobDeliver.Value.V)
+		}
+	}
+
+	return vs
+}
+
+func nnarScenario(s *Simulation) error {
+	if err := s.Setup("abc", 3); err != nil {
+		return err
+	}
+
+	if err := s.hub.Write("x", 89, []string{"abc-2"}); err != nil {
+		return err
+	}
+	if _, err := s.WaitDeliveries(pb.Message_APP_WRITE_RETURN, 0, 1); err != nil {
+		return err
+	}
+
+	if err := s.hub.Read("x", nil); err != nil {
+		return err
+	}
+	ds, err := s.WaitDeliveries(pb.Message_APP_READ_RETURN, 0, 3)
+	if err != nil {
+		return err
+	}
+	for _, d := range ds {
+		if v := d.Message.AppReadReturn.Value; !v.Defined || v.V != 89 {
+			return fmt.Errorf("%v read %v instead of 89", d.Process, v)
+		}
+	}
+
+	writes, reads := len(s.Deliveries(pb.Message_APP_WRITE_RETURN)), len(s.Deliveries(pb.Message_APP_READ_RETURN))
+	if err := s.hub.Storm("w"); err != nil {
+		return err
+	}
+	ok := s.RunUntil(func() bool {
+		done := len(s.Deliveries(pb.Message_APP_WRITE_RETURN)) - writes + len(s.Deliveries(pb.Message_APP_READ_RETURN)) - reads
+		return done >= 3*hub.StormOps
+	}, patience)
+	if !ok {
+		return fmt.Errorf("storm did not complete after %v", s.now)
+	}
+
+	return s.CheckLinearizable("w")
+}
+
+func nnarPartitionScenario(s *Simulation) error {
+	cfg := &fault.Config{
+		Reorder:       0.3,
+		ReorderWindow: 50 * time.Millisecond,
+		Latency:       fault.Latency{Kind: fault.Normal, Mean: 20 * time.Millisecond, StdDev: 10 * time.Millisecond},
+		Partitions:    []fault.Partition{{Groups: [][]string{{"abc-1", "abc-2"}, {"abc-3"}}}},
+	}
+	if err := s.SetupWith("abc", 3, Options{Faults: cfg}); err != nil {
+		return err
+	}
+
+	// abc-3 would block forever, so only the majority is asked to operate
+	majority := []string{"abc-1", "abc-2"}
+	for i := 0; i < 2*hub.StormOps; i++ {
+		writer, reader := majority[i%2], majority[(i+1)%2]
+		writes, reads := len(s.Deliveries(pb.Message_APP_WRITE_RETURN)), len(s.Deliveries(pb.Message_APP_READ_RETURN))
+
+		if err := s.hub.Write("x", int32(i+1), []string{writer}); err != nil {
+			return err
+		}
+		if err := s.hub.Read("x", []string{reader}); err != nil {
+			return err
+		}
+
+		if _, err := s.WaitDeliveries(pb.Message_APP_WRITE_RETURN, writes, 1); err != nil {
+			return err
+		}
+		if _, err := s.WaitDeliveries(pb.Message_APP_READ_RETURN, reads, 1); err != nil {
+			return err
+		}
+	}
+
+	return s.CheckLinearizable("x")
+}
+
+// nnarRecoveryScenario writes the register, kills and restarts abc-1 and abc-2
+// then kills abc-3 for good, so that a majority only exists among processes
+// that lost their memory. A read must still return the value, and a write
+// interrupted by a restart must complete once the writer recovers
+func nnarRecoveryScenario(s *Simulation) error {
+	if err := s.Setup("abc", 3); err != nil {
+		return err
+	}
+
+	if err := s.hub.Write("x", 10, []string{"abc-1"}); err != nil {
+		return err
+	}
+	if _, err := s.WaitDeliveries(pb.Message_APP_WRITE_RETURN, 0, 1); err != nil {
+		return err
+	}
+
+	for _, name := range []string{"abc-1", "abc-2"} {
+		s.Crash(name)
+	}
+	s.RunFor(50 * time.Millisecond)
+	for _, name := range []string{"abc-1", "abc-2"} {
+		s.Restart(name)
+	}
+	s.RunFor(100 * time.Millisecond)
+	s.Crash("abc-3")
+
+	if err := s.hub.Read("x", []string{"abc-2"}); err != nil {
+		return err
+	}
+	ds, err := s.WaitDeliveries(pb.Message_APP_READ_RETURN, 0, 1)
+	if err != nil {
+		return err
+	}
+	if v := ds[0].Message.AppReadReturn.Value; !v.Defined || v.V != 10 {
+		return fmt.Errorf("abc-2 read %v instead of 10 after restarting", v)
+	}
+
+	// abc-1 dies as soon as it starts writing
+	sent := s.Sent("abc-1", pb.Message_NNAR_INTERNAL_READ)
+	if err := s.hub.Write("x", 30, []string{"abc-1"}); err != nil {
+		return err
+	}
+	if !s.RunUntil(func() bool { return s.Sent("abc-1", pb.Message_NNAR_INTERNAL_READ) > sent }, patience) {
+		return fmt.Errorf("abc-1 did not start writing after %v", s.now)
+	}
+	s.Crash("abc-1")
+	s.RunFor(50 * time.Millisecond)
+	s.Restart("abc-1")
+
+	if _, err := s.WaitDeliveries(pb.Message_APP_WRITE_RETURN, 1, 1); err != nil {
+		return err
+	}
+	if err := s.hub.Read("x", []string{"abc-2"}); err != nil {
+		return err
+	}
+	ds, err = s.WaitDeliveries(pb.Message_APP_READ_RETURN, 1, 1)
+	if err != nil {
+		return err
+	}
+	if v := ds[0].Message.AppReadReturn.Value; !v.Defined || v.V != 30 {
+		return fmt.Errorf("abc-2 read %v instead of 30", v)
+	}
+
+	return s.CheckLinearizable("x")
+}
+
+// typedValuesScenario writes values of every type in the register, each one
+// read back by every process, then makes the processes propose strings
+func typedValuesScenario(s *Simulation) error {
+	if err := s.Setup("abc", 3); err != nil {
+		return err
+	}
+
+	values := []*pb.Value{
+		utils.Int32Value(-1),
+		{Defined: true, Type: pb.ValueType_BYTES, BytesValue: []byte{0, 0xff, 0x2a}},
+		{Defined: true, Type: pb.ValueType_STRING, StringValue: "hello"},
+		{Defined: true, Type: pb.ValueType_INT64, Int64Value: 1 << 40},
+		{Defined: true, Type: pb.ValueType_INT64, Int64Value: -1},
+	}
+	for i, v := range values {
+		writer := s.Alive()[i%3]
+		if err := s.hub.WriteValue("x", v, []string{writer}); err != nil {
+			return err
+		}
+		if _, err := s.WaitDeliveries(pb.Message_APP_WRITE_RETURN, i, 1); err != nil {
+			return err
+		}
+
+		if err := s.hub.Read("x", nil); err != nil {
+			return err
+		}
+		ds, err := s.WaitDeliveries(pb.Message_APP_READ_RETURN, 3*i, 3)
+		if err != nil {
+			return err
+		}
+		for _, d := range ds {
+			if read := d.Message.AppReadReturn.Value; !utils.EqualValues(read, v) {
+				return fmt.Errorf("%v read %v instead of %v", d.Process, utils.ValueString(read), utils.ValueString(v))
+			}
+		}
+	}
+	if err := s.CheckLinearizable("x"); err != nil {
+		return err
+	}
+
+	proposals := make(map[string]bool)
+	for _, name := range s.Alive() {
+		v := &pb.Value{Defined: true, Type: pb.ValueType_STRING, StringValue: "from " + name}
+		proposals[utils.ValueString(v)] = true
+		if err := s.hub.Propose("t", v, []string{name}); err != nil {
+			return err
+		}
+	}
+	ds, err := s.WaitDeliveries(pb.Message_APP_DECIDE, 0, 3)
+	if err != nil {
+		return err
+	}
+	for _, d := range ds {
+		decided := d.Message.AppDecide.Value
+		if !utils.EqualValues(decided, ds[0].Message.AppDecide.Value) || !proposals[utils.ValueString(decided)] {
+			return fmt.Errorf("%v decided %v, %v decided %v", d.Process, utils.ValueString(decided), ds[0].Process, utils.ValueString(ds[0].Message.AppDecide.Value))
+		}
+	}
+
+	return nil
+}
+
+// kvScenario makes every process put its own value on x then get it back
+// right away, while abc-1 also puts y: the get waits in the queue of x behind
+// the put, so it returns the put value or a later one. Once all returned
+// every process gets the same x. Finally abc-3 queues operations and restarts
+// before they run, which must fail them
+func kvScenario(s *Simulation) error {
+	if err := s.Setup("abc", 3); err != nil {
+		return err
+	}
+
+	type result struct {
+		name string
+		put  bool
+		v    *pb.Value
+		err  error
+	}
+	results := make([]result, 0)
+	record := func(name string, put bool) func(*pb.Value, error) {
+		return func(v *pb.Value, err error) { results = append(results, result{name, put, v, err}) }
+	}
+
+	puts := make(map[string]*pb.Value)
+	for i, name := range s.Alive() {
+		v := &pb.Value{Defined: true, Type: pb.ValueType_STRING, StringValue: fmt.Sprint("from ", name)}
+		puts[name] = v
+		s.Process(name).Kv().Put("x", v, record(name, true))
+		s.Process(name).Kv().Get("x", record(name, false))
+		if i == 0 {
+			s.Process(name).Kv().Put("y", utils.Int32Value(7), record(name, true))
+		}
+	}
+	if !s.RunUntil(func() bool { return len(results) == 7 }, patience) {
+		return fmt.Errorf("expected 7 kv operations to return, got %v after %v", len(results), s.now)
+	}
+
+	written := make(map[string]bool)
+	for _, v := range puts {
+		written[utils.ValueString(v)] = true
+	}
+	putDone := make(map[string]bool)
+	for _, r := range results {
+		if r.err != nil {
+			return fmt.Errorf("%v failed: %v", r.name, r.err)
+		}
+		if r.put {
+			putDone[r.name] = true
+			continue
+		}
+		if !putDone[r.name] {
+			return fmt.Errorf("the get of %v returned before its put", r.name)
+		}
+		if !written[utils.ValueString(r.v)] {
+			return fmt.Errorf("%v got %v which was never put", r.name, utils.ValueString(r.v))
+		}
+	}
+
+	results = results[:0]
+	for _, name := range s.Alive() {
+		s.Process(name).Kv().Get("x", record(name, false))
+	}
+	var y *pb.Value
+	s.Process("abc-2").Kv().Get("y", func(v *pb.Value, err error) { y = v })
+	if !s.RunUntil(func() bool { return len(results) == 3 && y != nil }, patience) {
+		return fmt.Errorf("expected 4 kv gets to return, got %v after %v", len(results), s.now)
+	}
+	for _, r := range results {
+		if !utils.EqualValues(r.v, results[0].v) {
+			return fmt.Errorf("%v got %v, %v got %v", r.name, utils.ValueString(r.v), results[0].name, utils.ValueString(results[0].v))
+		}
+	}
+	if !utils.EqualValues(y, utils.Int32Value(7)) {
+		return fmt.Errorf("abc-2 got %v for y instead of 7", utils.ValueString(y))
+	}
+
+	results = results[:0]
+	store := s.Process("abc-3").Kv()
+	store.Put("z", utils.Int32Value(1), record("abc-3", true))
+	store.Get("z", record("abc-3", false))
+	s.Crash("abc-3")
+	s.Restart("abc-3")
+	if len(results) != 2 || !errors.Is(results[0].err, kv.ErrClosed) || !errors.Is(results[1].err, kv.ErrClosed) {
+		return fmt.Errorf("expected the queued operations of abc-3 to fail once restarted, got %v", results)
+	}
+	store.Get("z", record("abc-3", false))
+	if len(results) != 3 || !errors.Is(results[2].err, kv.ErrClosed) {
+		return errors.New("expected a get on the destroyed store of abc-3 to fail")
+	}
+
+	return nil
+}
+
+// kvTimeoutScenario makes abc-1 use keys which cannot name a register, then
+// crashes the others so that its register of x has no majority: the put on x
+// must time out, and the get queued behind it must run and time out as well
+func kvTimeoutScenario(s *Simulation) error {
+	if err := s.SetupWith("abc", 3, Options{Settings: map[string]string{"kv_timeout": "1s"}}); err != nil {
+		return err
+	}
+
+	type result struct {
+		v   *pb.Value
+		err error
+	}
+	results := make([]result, 0)
+	record := func(v *pb.Value, err error) { results = append(results, result{v, err}) }
+
+	store := s.Process("abc-1").Kv()
+	for _, key := range []string{"", "x]", "x.y"} {
+		store.Get(key, record)
+	}
+	if len(results) != 3 {
+		return fmt.Errorf("expected the operations on invalid keys to fail right away, got %v", results)
+	}
+	for _, r := range results {
+		if !errors.Is(r.err, kv.ErrInvalidKey) {
+			return fmt.Errorf("expected an invalid key error, got %v", r.err)
+		}
+	}
+
+	results = results[:0]
+	s.Crash("abc-2")
+	s.Crash("abc-3")
+	store.Put("x", utils.Int32Value(1), record)
+	store.Get("x", record)
+	if !s.RunUntil(func() bool { return len(results) == 2 }, patience) {
+		return fmt.Errorf("expected both kv operations to time out, got %v after %v", len(results), s.now)
+	}
+	for _, r := range results {
+		if !errors.Is(r.err, kv.ErrTimeout) {
+			return fmt.Errorf("expected a timeout, got %v", r.err)
+		}
+	}
+
+	return nil
+}
+
+// onrrScenario makes abc-1 write the regular register and every process read
+// it, then crashes abc-3: the next write must still complete and be read
+func onrrScenario(algorithm string) func(s *Simulation) error {
+	return func(s *Simulation) error {
+		if err := s.SetupWith("abc", 3, Options{Settings: map[string]string{"onrr_algorithm": algorithm}}); err != nil {
+			return err
+		}
+
+		writes, reads := 0, 0
+		for _, v := range []int32{7, 8} {
+			if v == 8 {
+				s.Crash("abc-3")
+			}
+
+			if err := s.hub.RegularWrite("x", v, []string{"abc-1"}); err != nil {
+				return err
+			}
+			if _, err := s.WaitDeliveries(pb.Message_APP_WRITE_RETURN, writes, 1); err != nil {
+				return err
+			}
+			writes++
+
+			alive := s.Alive()
+			if err := s.hub.RegularRead("x", alive); err != nil {
+				return err
+			}
+			ds, err := s.WaitDeliveries(pb.Message_APP_READ_RETURN, reads, len(alive))
+			if err != nil {
+				return err
+			}
+			reads += len(alive)
+
+			for _, d := range ds {
+				if r := d.Message.AppReadReturn; r.Kind != pb.RegisterKind_ONRR || !r.Value.Defined || r.Value.V != v {
+					return fmt.Errorf("%v read %v instead of %v", d.Process, r.Value, v)
+				}
+			}
+		}
+
+		return nil
+	}
+}
+
+// onarScenario makes abc-1, the writer, write the single-writer atomic register
+// while the others read it, checks that abc-2 may not write it, then crashes
+// abc-3 and goes on with the survivors
+func onarScenario(algorithm string) func(s *Simulation) error {
+	return func(s *Simulation) error {
+		settings := map[string]string{"onar_algorithm": algorithm, "onar_writer": "abc-1"}
+		if err := s.SetupWith("abc", 3, Options{Settings: settings}); err != nil {
+			return err
+		}
+
+		if err := s.hub.SingleWriterWrite("x", 5, []string{"abc-2"}); err != nil {
+			return err
+		}
+		ds, err := s.WaitDeliveries(pb.Message_APP_WRITE_RETURN, 0, 1)
+		if err != nil {
+			return err
+		}
+		if r := ds[0].Message.AppWriteReturn; r.Kind != pb.RegisterKind_ONAR || r.Error == "" {
+			return fmt.Errorf("%v wrote x although abc-1 is the writer", ds[0].Process)
+		}
+
+		writes, reads := 1, 0
+		for i := int32(1); i <= 2*hub.StormOps; i++ {
+			if i == hub.StormOps {
+				s.Crash("abc-3")
+			}
+
+			readers := s.Alive()[1:]
+			if err := s.hub.SingleWriterWrite("x", i, []string{"abc-1"}); err != nil {
+				return err
+			}
+			if err := s.hub.SingleWriterRead("x", readers); err != nil {
+				return err
+			}
+
+			ds, err := s.WaitDeliveries(pb.Message_APP_WRITE_RETURN, writes, 1)
+			if err != nil {
+				return err
+			}
+			if r := ds[0].Message.AppWriteReturn; r.Error != "" {
+				return fmt.Errorf("abc-1 failed to write %v: %v", i, r.Error)
+			}
+			writes++
+			if _, err := s.WaitDeliveries(pb.Message_APP_READ_RETURN, reads, len(readers)); err != nil {
+				return err
+			}
+			reads += len(readers)
+		}
+
+		return s.CheckLinearizable("x")
+	}
+}
+
+// onarCostScenario counts the internal messages of one write and one read of
+// nnar and of both single-writer atomic registers, in a new system for each.
+// The single-writer writes skip the read phase of nnar, and read-impose
+// write-all reads skip it too
+func onarCostScenario(s *Simulation) error {
+	if err := s.Setup("abc", 3); err != nil {
+		return err
+	}
+
+	nnarWrite, nnarRead, err := s.registerCost(s.hub.Write, s.hub.Read)
+	if err != nil {
+		return err
+	}
+
+	for _, algorithm := range []string{"rowa", "majority"} {
+		settings := map[string]string{"onar_algorithm": algorithm, "onar_writer": "abc-1"}
+		if err := s.hub.System([]string{"abc"}, settings); err != nil {
+			return err
+		}
+		s.RunFor(100 * time.Millisecond)
+
+		write, read, err := s.registerCost(s.hub.SingleWriterWrite, s.hub.SingleWriterRead)
+		if err != nil {
+			return err
+		}
+		log.Info("%v onar write %v read %v messages, nnar write %v read %v", algorithm, write, read, nnarWrite, nnarRead)
+
+		if write >= nnarWrite {
+			return fmt.Errorf("%v onar write sent %v messages, nnar %v", algorithm, write, nnarWrite)
+		}
+		if algorithm == "rowa" && read >= nnarRead || algorithm == "majority" && read != nnarRead {
+			return fmt.Errorf("%v onar read sent %v messages, nnar %v", algorithm, read, nnarRead)
+		}
+	}
+
+	return nil
+}
+
+// registerCost makes abc-1 write then read the register r and returns the
+// number of nnar internal messages each operation sent, the messages still
+// traveling when the operation returns included
+func (s *Simulation) registerCost(write func(string, int32, []string) error, read func(string, []string) error) (int, int, error) {
+	writes, reads := len(s.Deliveries(pb.Message_APP_WRITE_RETURN)), len(s.Deliveries(pb.Message_APP_READ_RETURN))
+
+	before := s.internalMessages()
+	if err := write("r", 42, []string{"abc-1"}); err != nil {
+		return 0, 0, err
+	}
+	if _, err := s.WaitDeliveries(pb.Message_APP_WRITE_RETURN, writes, 1); err != nil {
+		return 0, 0, err
+	}
+	s.RunFor(100 * time.Millisecond)
+	writeCost := s.internalMessages() - before
+
+	before = s.internalMessages()
+	if err := read("r", []string{"abc-1"}); err != nil {
+		return 0, 0, err
+	}
+	ds, err := s.WaitDeliveries(pb.Message_APP_READ_RETURN, reads, 1)
+	if err != nil {
+		return 0, 0, err
+	}
+	if v := ds[0].Message.AppReadReturn.Value; !v.Defined || v.V != 42 {
+		return 0, 0, fmt.Errorf("abc-1 read %v instead of 42", v)
+	}
+	s.RunFor(100 * time.Millisecond)
+
+	return writeCost, s.internalMessages() - before, nil
+}
+
+// internalMessages returns the number of nnar internal messages sent so far by
+// all the processes, which the single-writer registers use as well
+func (s *Simulation) internalMessages() int {
+	n := 0
+	for _, p := range s.processes {
+		for _, t := range []pb.Message_Type{pb.Message_NNAR_INTERNAL_READ, pb.Message_NNAR_INTERNAL_VALUE, pb.Message_NNAR_INTERNAL_WRITE, pb.Message_NNAR_INTERNAL_ACK} {
+			n += s.Sent(p.Name(), t)
+		}
+	}
+
+	return n
+}
+
+// CheckLinearizable verifies the operations triggered by the hub on the register
+func (s *Simulation) CheckLinearizable(register string) error {
+	ops, err := lin.Operations(s.recorder.Events())
+	if err != nil {
+		return err
+	}
+
+	for key, registerOps := range ops {
+		if !strings.HasSuffix(key, "/"+register) {
+			continue
+		}
+		if r := lin.Check(registerOps); !r.Linearizable {
+			return fmt.Errorf("history of %v is not linearizable:\n%v%v", key, lin.Render(r.Violation), lin.Format(r.Violation))
+		}
+	}
+
+	return nil
+}
+
+// logScenario appends two values on every process at once. The leader of
+// epoch 0 commits them in one accept round trip each, so no process sends
+// any prepare or new epoch message
+func logScenario(s *Simulation) error {
+	if err := s.Setup("abc", 3); err != nil {
+		return err
+	}
+
+	appended := make([]*pb.Value, 0)
+	for i := int32(1); i <= 2; i++ {
+		for _, name := range s.Alive() {
+			v := utils.Int32Value(10*i + int32(len(appended)))
+			appended = append(appended, v)
+			if err := s.hub.LogAppend(name, v); err != nil {
+				return err
+			}
+		}
+	}
+	if err := s.waitLog(s.Alive(), appended); err != nil {
+		return err
+	}
+
+	for _, name := range s.Alive() {
+		for _, t := range []pb.Message_Type{pb.Message_LOG_INTERNAL_PREPARE, pb.Message_EC_INTERNAL_NEW_EPOCH} {
+			if n := s.Sent(name, t); n > 0 {
+				return fmt.Errorf("%v sent %v %v messages while the leader did not change", name, n, t)
+			}
+		}
+	}
+
+	return nil
+}
+
+// logLeaderCrashScenario appends values, then crashes the leader right after
+// a follower appended one more, which the leader never committed. The others
+// elect a new leader through ec, which prepares once and commits the pending
+// value with the ones appended afterwards
+func logLeaderCrashScenario(s *Simulation) error {
+	if err := s.Setup("abc", 3); err != nil {
+		return err
+	}
+
+	ranks := s.hub.Ranks()
+	names := s.Alive()
+	sort.Slice(names, func(i, j int) bool { return ranks[names[i]] > ranks[names[j]] })
+	leader, next, follower := names[0], names[1], names[2]
+
+	appended := make([]*pb.Value, 0)
+	for _, name := range names {
+		v := utils.Int32Value(int32(len(appended) + 1))
+		appended = append(appended, v)
+		if err := s.hub.LogAppend(name, v); err != nil {
+			return err
+		}
+	}
+	if err := s.waitLog(names, appended); err != nil {
+		return err
+	}
+
+	v := utils.Int32Value(int32(len(appended) + 1))
+	appended = append(appended, v)
+	if err := s.hub.LogAppend(follower, v); err != nil {
+		return err
+	}
+	s.RunFor(time.Millisecond)
+	s.Crash(leader)
+
+	for _, name := range []string{next, follower} {
+		v := utils.Int32Value(int32(len(appended) + 1))
+		appended = append(appended, v)
+		if err := s.hub.LogAppend(name, v); err != nil {
+			return err
+		}
+	}
+	if err := s.waitLog([]string{next, follower}, appended); err != nil {
+		return err
+	}
+
+	if s.Sent(next, pb.Message_LOG_INTERNAL_PREPARE) == 0 {
+		return fmt.Errorf("%v committed without preparing its epoch", next)
+	}
+
+	return nil
+}
+
+// waitLog runs until the processes committed all the values, then checks
+// that they committed each of them once and in the same slot
+func (s *Simulation) waitLog(names []string, values []*pb.Value) error {
+	committed := func() map[string]map[int32]*pb.Value {
+		logs := make(map[string]map[int32]*pb.Value)
+		for _, d := range s.Deliveries(pb.Message_APP_LOG_COMMITTED) {
+			if _, ok := logs[d.Process]; !ok {
+				logs[d.Process] = make(map[int32]*pb.Value)
+			}
+			logs[d.Process][d.Message.AppLogCommitted.Slot] = d.Message.AppLogCommitted.Value
+		}
+
+		return logs
+	}
+	done := func() bool {
+		logs := committed()
+		for _, name := range names {
+			if len(logs[name]) < len(values) {
+				return false
+			}
+		}
+
+		return true
+	}
+	if !s.RunUntil(done, patience) {
+		return fmt.Errorf("expected %v values committed by %v after %v", len(values), strings.Join(names, " "), s.now)
+	}
+
+	logs := committed()
+	reference := logs[names[0]]
+	for _, name := range names {
+		seen := make(map[string]bool)
+		for slot, v := range logs[name] {
+			if r, ok := reference[slot]; !ok || !utils.EqualValues(r, v) {
+				return fmt.Errorf("%v committed %v in slot %v but %v committed %v", name, utils.ValueString(v), slot, names[0], utils.ValueString(r))
+			}
+			if seen[utils.ValueString(v)] {
+				return fmt.Errorf("%v committed %v twice", name, utils.ValueString(v))
+			}
+			seen[utils.ValueString(v)] = true
+		}
+		for _, v := range values {
+			if !seen[utils.ValueString(v)] {
+				return fmt.Errorf("%v did not commit %v", name, utils.ValueString(v))
+			}
+		}
+	}
+
+	return nil
+}
+
+func ucScenario(s *Simulation) error {
+	if err := s.Setup("abc", 3); err != nil {
+		return err
+	}
+
+	if err := s.hub.Consensus("t"); err != nil {
+		return err
+	}
+
+	ds, err := s.WaitDeliveries(pb.Message_APP_DECIDE, 0, 3)
+	if err != nil {
+		return err
+	}
+
+	return agreement(ds)
+}
+
+// ucLeaderCrashScenario makes 5 processes propose their own value and crashes
+// the first leader, the max-rank process, right after. The eventual leader
+// detector of the others suspects it at different times, so the next leader
+// may have its first epoch rejected and every process must catch up with the
+// epochs started without it. The links reorder frames, so the decision of an
+// epoch may arrive before its value. The others must all decide the same
+// proposed value
+func ucLeaderCrashScenario(s *Simulation) error {
+	return ucLeaderCrash(s, &fault.Config{Reorder: 0.3, ReorderWindow: 20 * time.Millisecond})
+}
+
+// ucSlowLeaderCrashScenario is ucLeaderCrashScenario over links with an
+// exponential latency, on which the eventual leader detectors also suspect
+// correct processes. Until they agree again, the processes reject the epochs
+// of the leaders they do not trust, which must neither multiply the epochs nor
+// leave a process in an epoch the others do not run
+func ucSlowLeaderCrashScenario(s *Simulation) error {
+	return ucLeaderCrash(s, &fault.Config{
+		Reorder:       0.3,
+		ReorderWindow: 20 * time.Millisecond,
+		Latency:       fault.Latency{Kind: fault.Exponential, Mean: 20 * time.Millisecond},
+	})
+}
+
+func ucLeaderCrash(s *Simulation, faults *fault.Config) error {
+	if err := s.SetupWith("abc", 5, Options{Faults: faults}); err != nil {
+		return err
+	}
+
+	ranks := s.hub.Ranks()
+	names := s.Alive()
+	sort.Slice(names, func(i, j int) bool { return ranks[names[i]] > ranks[names[j]] })
+
+	proposals := make(map[string]bool)
+	for i, name := range names {
+		v := utils.Int32Value(int32(100 + i))
+		proposals[utils.ValueString(v)] = true
+		if err := s.hub.Propose("t", v, []string{name}); err != nil {
+			return err
+		}
+	}
+	s.RunFor(5 * time.Millisecond)
+	s.Crash(names[0])
+
+	decided := func() map[string]*pb.Value {
+		vs := make(map[string]*pb.Value)
+		for _, d := range s.Reports(pb.Message_APP_DECIDE) {
+			vs[d.Process] = d.Message.AppDecide.Value
+		}
+		return vs
+	}
+	epochs := func() int {
+		n := 0
+		for _, name := range names {
+			n += s.Sent(name, pb.Message_EC_INTERNAL_NEW_EPOCH)
+		}
+		return n
+	}
+	ok := s.RunUntil(func() bool {
+		if epochs() > 100 {
+			return true
+		}
+		vs := decided()
+		for _, name := range names[1:] {
+			if _, ok := vs[name]; !ok {
+				return false
+			}
+		}
+		return true
+	}, patience)
+	if !ok {
+		return fmt.Errorf("only %v of the 4 correct processes decided after %v", len(decided()), s.now)
+	}
+	if n := epochs(); n > 100 {
+		return fmt.Errorf("%v new epoch messages were sent after %v", n, s.now)
+	}
+
+	var first *pb.Value
+	for _, name := range names[1:] {
+		v := decided()[name]
+		if !proposals[utils.ValueString(v)] {
+			return fmt.Errorf("%v decided %v, which was not proposed", name, utils.ValueString(v))
+		}
+		if first != nil && !utils.EqualValues(v, first) {
+			return fmt.Errorf("%v decided %v while another process decided %v", name, utils.ValueString(v), utils.ValueString(first))
+		}
+		first = v
+	}
+
+	return nil
+}
+
+func agreement(ds []*Delivery) error {
+	for _, d := range ds[1:] {
+		if d.Message.AppDecide.Value.V != ds[0].Message.AppDecide.Value.V {
+			return fmt.Errorf("%v decided %v but %v decided %v", d.Process, d.Message.AppDecide.Value.V, ds[0].Process, ds[0].Message.AppDecide.Value.V)
+		}
+	}
+
+	return nil
+}
+
+var raftSettings = map[string]string{"uc_algorithm": "raft"}
+
+func raftScenario(s *Simulation) error {
+	if err := s.SetupWith("abc", 3, Options{Settings: raftSettings}); err != nil {
+		return err
+	}
+
+	if err := s.hub.Consensus("t"); err != nil {
+		return err
+	}
+
+	ds, err := s.WaitDeliveries(pb.Message_APP_DECIDE, 0, 3)
+	if err != nil {
+		return err
+	}
+
+	return agreement(ds)
+}
+
+// raftSplitVoteScenario partitions 4 processes in two halves, so that every
+// candidate gets 2 votes out of the 3 needed. No process may decide until the
+// partition heals, after which a leader is elected and all of them decide
+func raftSplitVoteScenario(s *Simulation) error {
+	const heal = 2 * time.Second
+	faults := &fault.Config{
+		Partitions: []fault.Partition{{Groups: [][]string{{"abc-1", "abc-2"}, {"abc-3", "abc-4"}}, To: heal}},
+	}
+	if err := s.SetupWith("abc", 4, Options{Settings: raftSettings, Faults: faults}); err != nil {
+		return err
+	}
+
+	if err := s.hub.Consensus("t"); err != nil {
+		return err
+	}
+	s.RunFor(heal - s.now - 100*time.Millisecond)
+
+	if ds := s.Deliveries(pb.Message_APP_DECIDE); len(ds) > 0 {
+		return fmt.Errorf("%v decided %v during the partition", ds[0].Process, ds[0].Message.AppDecide.Value.V)
+	}
+	for _, group := range [][]string{{"abc-1", "abc-2"}, {"abc-3", "abc-4"}} {
+		if s.Sent(group[0], pb.Message_RAFT_INTERNAL_REQUEST_VOTE)+s.Sent(group[1], pb.Message_RAFT_INTERNAL_REQUEST_VOTE) == 0 {
+			return fmt.Errorf("neither %v nor %v started an election during the partition", group[0], group[1])
+		}
+	}
+
+	ds, err := s.WaitDeliveries(pb.Message_APP_DECIDE, 0, 4)
+	if err != nil {
+		return err
+	}
+
+	return agreement(ds)
+}
+
+// raftLeaderCrashScenario lets raft elect a leader before proposing, then
+// crashes the leader as soon as the proposals reach it. The others must elect
+// another leader and decide, the same value as the crashed leader if it did
+func raftLeaderCrashScenario(s *Simulation) error {
+	if err := s.SetupWith("abc", 3, Options{Settings: raftSettings}); err != nil {
+		return err
+	}
+
+	// the instance is created by the first message, the value of which is
+	// proposed by abc-1 only
+	if err := s.hub.Propose("t", utils.Int32Value(1), []string{"abc-1"}); err != nil {
+		return err
+	}
+	if _, err := s.WaitDeliveries(pb.Message_APP_DECIDE, 0, 3); err != nil {
+		return err
+	}
+
+	// the leader keeps sending heartbeats once the others decided
+	heartbeats := func() map[string]int {
+		sent := make(map[string]int)
+		for _, name := range s.Alive() {
+			sent[name] = s.Sent(name, pb.Message_RAFT_INTERNAL_APPEND_ENTRIES)
+		}
+		return sent
+	}
+	before := heartbeats()
+	s.RunFor(500 * time.Millisecond)
+	leader := ""
+	for name, n := range heartbeats() {
+		if n > before[name] {
+			leader = name
+		}
+	}
+	if leader == "" {
+		return errors.New("no raft leader sends heartbeats")
+	}
+
+	for i, name := range []string{"abc-1", "abc-2", "abc-3"} {
+		if err := s.hub.Propose("u", utils.Int32Value(int32(10+i)), []string{name}); err != nil {
+			return err
+		}
+	}
+	s.RunFor(time.Millisecond)
+	s.Crash(leader)
+
+	elections := 0
+	for _, name := range s.Alive() {
+		elections += s.Sent(name, pb.Message_RAFT_INTERNAL_REQUEST_VOTE)
+	}
+
+	decided := func() []*Delivery { return s.Deliveries(pb.Message_APP_DECIDE)[3:] }
+	survivors := func() bool {
+		count := 0
+		for _, d := range decided() {
+			if d.Process != leader {
+				count++
+			}
+		}
+		return count == 2
+	}
+	if !s.RunUntil(survivors, patience) {
+		return fmt.Errorf("the survivors of %v did not decide after %v", leader, s.now)
+	}
+
+	after := 0
+	for _, name := range s.Alive() {
+		after += s.Sent(name, pb.Message_RAFT_INTERNAL_REQUEST_VOTE)
+	}
+	if after == elections {
+		return fmt.Errorf("the survivors decided without electing another leader than %v", leader)
+	}
+
+	return agreement(decided())
+}
+
+// rcScenario makes every process propose its own value to the randomized
+// consensus, with the coin seeded so that a run replays exactly, then crashes
+// the last processes: one before it gets its proposal and the others right
+// after. The processes left must decide the same proposed value, once
+func rcScenario(n int, coin string, crashes int) func(s *Simulation) error {
+	return func(s *Simulation) error {
+		faults := &fault.Config{
+			Reorder:       0.3,
+			ReorderWindow: 50 * time.Millisecond,
+			Latency:       fault.Latency{Kind: fault.Normal, Mean: 20 * time.Millisecond, StdDev: 15 * time.Millisecond},
+		}
+		settings := map[string]string{"rc_coin": coin, "rc_seed": "7"}
+		if err := s.SetupWith("abc", n, Options{Settings: settings, Faults: faults}); err != nil {
+			return err
+		}
+
+		names := s.Alive()
+		if crashes > 0 {
+			s.Crash(names[n-1])
+		}
+		proposals := make(map[string]bool)
+		for i, name := range s.Alive() {
+			v := utils.Int32Value(int32(100 + i))
+			proposals[utils.ValueString(v)] = true
+			if err := s.hub.RcPropose("t", v, []string{name}); err != nil {
+				return err
+			}
+		}
+		s.RunFor(5 * time.Millisecond)
+		for i := n - crashes; i < n-1; i++ {
+			s.Crash(names[i])
+		}
+
+		alive := s.Alive()
+		decided := func() bool {
+			count := 0
+			for _, d := range s.Deliveries(pb.Message_APP_RC_DECIDE) {
+				if slices.Contains(alive, d.Process) {
+					count++
+				}
+			}
+			return count >= len(alive)
+		}
+		if !s.RunUntil(decided, patience) {
+			return fmt.Errorf("expected %v decisions, got %v after %v", len(alive), len(s.Deliveries(pb.Message_APP_RC_DECIDE)), s.now)
+		}
+		s.RunFor(time.Second)
+
+		ds := s.Deliveries(pb.Message_APP_RC_DECIDE)
+		seen := make(map[string]bool)
+		for _, d := range ds {
+			decided := d.Message.AppRcDecide.Value
+			if seen[d.Process] {
+				return fmt.Errorf("%v decided twice", d.Process)
+			}
+			seen[d.Process] = true
+			if !proposals[utils.ValueString(decided)] {
+				return fmt.Errorf("%v decided %v, which was not proposed", d.Process, utils.ValueString(decided))
+			}
+			if !utils.EqualValues(decided, ds[0].Message.AppRcDecide.Value) {
+				return fmt.Errorf("%v decided %v but %v decided %v", d.Process, utils.ValueString(decided), ds[0].Process, utils.ValueString(ds[0].Message.AppRcDecide.Value))
+			}
+		}
+
+		return nil
+	}
+}
+
+// failStopScenario makes 5 processes propose their own value to the flooding
+// or hierarchical consensus. With crash, the first two processes by rank (the
+// first leaders of the hierarchical ones) crash a few milliseconds apart while
+// the first rounds run. The others must decide the same proposed value, once,
+// and so must the crashed ones which decided when the algorithm is uniform
+func failStopScenario(algorithm string, uniform, crash bool) func(s *Simulation) error {
+	return func(s *Simulation) error {
+		const n = 5
+		if err := s.SetupWith("abc", n, Options{Settings: map[string]string{"uc_algorithm": algorithm}}); err != nil {
+			return err
+		}
+
+		ranks := s.hub.Ranks()
+		names := s.Alive()
+		sort.Slice(names, func(i, j int) bool { return ranks[names[i]] < ranks[names[j]] })
+
+		proposals := make(map[string]bool)
+		for i, name := range names {
+			v := utils.Int32Value(int32(100 + i))
+			proposals[utils.ValueString(v)] = true
+			if err := s.hub.Propose("t", v, []string{name}); err != nil {
+				return err
+			}
+		}
+		if crash {
+			s.RunFor(3 * time.Millisecond)
+			s.Crash(names[0])
+			s.RunFor(10 * time.Millisecond)
+			s.Crash(names[1])
+		}
+
+		alive := s.Alive()
+		decided := func() bool {
+			count := 0
+			for _, d := range s.Deliveries(pb.Message_APP_DECIDE) {
+				if slices.Contains(alive, d.Process) {
+					count++
+				}
+			}
+			return count >= len(alive)
+		}
+		if !s.RunUntil(decided, patience) {
+			return fmt.Errorf("expected %v decisions, got %v after %v", len(alive), len(s.Deliveries(pb.Message_APP_DECIDE)), s.now)
+		}
+		s.RunFor(time.Second)
+
+		var reference *Delivery
+		seen := make(map[string]bool)
+		for _, d := range s.Deliveries(pb.Message_APP_DECIDE) {
+			v := d.Message.AppDecide.Value
+			if seen[d.Process] {
+				return fmt.Errorf("%v decided twice", d.Process)
+			}
+			seen[d.Process] = true
+			if !proposals[utils.ValueString(v)] {
+				return fmt.Errorf("%v decided %v, which was not proposed", d.Process, utils.ValueString(v))
+			}
+			if !uniform && !slices.Contains(alive, d.Process) {
+				continue
+			}
+			if reference == nil {
+				reference = d
+			}
+			if !utils.EqualValues(v, reference.Message.AppDecide.Value) {
+				return fmt.Errorf("%v decided %v but %v decided %v", d.Process, utils.ValueString(v), reference.Process, utils.ValueString(reference.Message.AppDecide.Value))
+			}
+		}
+
+		return nil
+	}
+}
+
+// byzantineScenario has abc-1 and abc-4 of 4 processes broadcast 10 and 20
+// with the byzantine consistent or reliable broadcast. With malicious, abc-4
+// equivocates: abc-1 and abc-3 get 20+Equivocation from it, in its echoes and
+// readies too. The correct processes must deliver 10 once and never deliver
+// different values of abc-4, all or none of them delivering one when the
+// broadcast is reliable. Without, every process delivers both values once
+func byzantineScenario(reliable, malicious bool) func(s *Simulation) error {
+	return func(s *Simulation) error {
+		opts := Options{}
+		if malicious {
+			opts.Malicious = []string{"abc-4"}
+		}
+		if err := s.SetupWith("abc", 4, opts); err != nil {
+			return err
+		}
+
+		broadcast := s.hub.BcbBroadcast
+		if reliable {
+			broadcast = s.hub.BrbBroadcast
+		}
+		if err := broadcast("abc-1", 10); err != nil {
+			return err
+		}
+		if err := broadcast("abc-4", 20); err != nil {
+			return err
+		}
+		s.RunFor(2 * time.Second)
+
+		correct := []string{"abc-1", "abc-2", "abc-3", "abc-4"}
+		if malicious {
+			correct = correct[:3]
+		}
+
+		// value of abc-4 delivered by every correct process, 0 if none
+		delivered := make(map[string]int32)
+		for _, name := range correct {
+			seen := make(map[int32]bool)
+			for _, v := range s.deliveredValues(name) {
+				if seen[v] {
+					return fmt.Errorf("%v delivered %v twice", name, v)
+				}
+				seen[v] = true
+				if v != 10 {
+					delivered[name] = v
+				}
+			}
+			if !seen[10] {
+				return fmt.Errorf("%v did not deliver 10 of abc-1", name)
+			}
+			if len(seen) > 2 {
+				return fmt.Errorf("%v delivered %v values of abc-4", name, len(seen)-1)
+			}
+			if !malicious && delivered[name] != 20 {
+				return fmt.Errorf("%v did not deliver 20 of abc-4", name)
+			}
+		}
+
+		var value int32
+		for name, v := range delivered {
+			if value != 0 && v != value {
+				return fmt.Errorf("%v delivered %v of abc-4 while others delivered %v", name, v, value)
+			}
+			value = v
+		}
+		// abc-1 and abc-3 get the same value from abc-4 and each other, so
+		// they deliver it even with the consistent broadcast
+		if value == 0 {
+			return errors.New("no correct process delivered a value of abc-4")
+		}
+		if reliable && len(delivered) != len(correct) {
+			return fmt.Errorf("only %v of the correct processes delivered %v of abc-4", len(delivered), value)
+		}
+
+		return nil
+	}
+}
@@ -0,0 +1,32 @@
+package sim
+
+import (
+	"amcds/utils/log"
+	"os"
+	"testing"
+	"time"
+)
+
+// seeds every scenario runs with, a failing one being replayed with
+// go run ./cmd/sim -scenario name -seed N -runs 1 -log debug
+var seeds = []int64{1, 2, 3}
+
+func TestMain(m *testing.M) {
+	log.Instantiate()
+	log.SetLevel("error")
+
+	os.Exit(m.Run())
+}
+
+func TestScenarios(t *testing.T) {
+	for _, sc := range Scenarios {
+		t.Run(sc.Name, func(t *testing.T) {
+			for _, seed := range seeds {
+				s := Create(Config{Seed: seed, MinDelay: time.Millisecond, MaxDelay: 10 * time.Millisecond})
+				if err := sc.Run(s); err != nil {
+					t.Errorf("seed %v at %v: %v", seed, s.Elapsed(), err)
+				}
+			}
+		})
+	}
+}
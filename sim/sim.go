@@ -0,0 +1,319 @@
+package sim
+
+import (
+	"amcds/hub"
+	"amcds/lin"
+	"amcds/pb"
+	"amcds/utils"
+	"amcds/utils/clock"
+	"container/heap"
+	"errors"
+	"math/rand"
+	"net"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	host    = "127.0.0.1"
+	hubPort = 5000
+)
+
+// virtual time starts at a fixed date so that recorded histories are reproducible
+var epoch = time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+type Config struct {
+	Seed int64
+	// every frame is delivered after a random delay in [MinDelay, MaxDelay],
+	// which is also what reorders frames sent close to each other
+	MinDelay time.Duration
+	MaxDelay time.Duration
+}
+
+// Delivery is a message received by the hub from one of the processes
+type Delivery struct {
+	At       time.Duration
+	SystemId string
+	Process  string
+	Message  *pb.Message
+}
+
+// Simulation runs the hub and every process in a single goroutine. All the
+// choices (which system handles its next message, how long a frame travels)
+// are drawn from one seeded generator, and timers fire in virtual time, so a
+// seed always replays the same run
+type Simulation struct {
+	rng      *rand.Rand
+	now      time.Duration
+	seq      int
+	events   eventQueue
+	minDelay time.Duration
+	maxDelay time.Duration
+
+	endpoints map[string]func(data []byte)
+	processes []*Process
+	hub       *hub.Hub
+	recorder  *lin.Recorder
+
+	deliveries []*Delivery
+	reports    []*Delivery
+	frames     int
+	// frames sent per process name and message type
+	sent map[string]int
+}
+
+func Create(cfg Config) *Simulation {
+	s := &Simulation{
+		rng:        rand.New(rand.NewSource(cfg.Seed)),
+		events:     make(eventQueue, 0),
+		minDelay:   cfg.MinDelay,
+		maxDelay:   cfg.MaxDelay,
+		endpoints:  make(map[string]func(data []byte)),
+		processes:  make([]*Process, 0),
+		deliveries: make([]*Delivery, 0),
+		reports:    make([]*Delivery, 0),
+		sent:       make(map[string]int),
+	}
+
+	s.recorder = lin.CreateRecorder().CreateWithClock(s).CreateWithSource(lin.Process)
+	s.hub = hub.Create(host, hubPort, cfg.Seed).CreateWithRuntime(s, s)
+	s.hub.SetRecorder(s.recorder)
+	s.endpoints[s.HubAddress()] = s.deliverToHub
+
+	return s
+}
+
+func (s *Simulation) Hub() *hub.Hub {
+	return s.hub
+}
+
+func (s *Simulation) HubAddress() string {
+	return net.JoinHostPort(host, utils.Int32ToString(hubPort))
+}
+
+// Recorder holds the register operations triggered through the hub
+func (s *Simulation) Recorder() *lin.Recorder {
+	return s.recorder
+}
+
+// Elapsed returns the virtual time since the start of the simulation
+func (s *Simulation) Elapsed() time.Duration {
+	return s.now
+}
+
+// Frames returns the number of frames sent over the network so far
+func (s *Simulation) Frames() int {
+	return s.frames
+}
+
+// Sent returns the number of frames carrying the given message type sent so
+// far by the process
+func (s *Simulation) Sent(name string, t pb.Message_Type) int {
+	return s.sent[name+"/"+t.String()]
+}
+
+// Deliveries returns the messages of the given type received by the hub
+func (s *Simulation) Deliveries(t pb.Message_Type) []*Delivery {
+	return filter(s.deliveries, t)
+}
+
+// Reports returns the messages of the given type sent to the hub, in the order
+// the processes sent them, which the network may change on the way
+func (s *Simulation) Reports(t pb.Message_Type) []*Delivery {
+	return filter(s.reports, t)
+}
+
+func filter(ds []*Delivery, t pb.Message_Type) []*Delivery {
+	filtered := make([]*Delivery, 0)
+	for _, d := range ds {
+		if d.Message.Type == t {
+			filtered = append(filtered, d)
+		}
+	}
+
+	return filtered
+}
+
+// Now implements clock.Clock with the virtual time
+func (s *Simulation) Now() time.Time {
+	return epoch.Add(s.now)
+}
+
+// AfterFunc implements clock.Clock, f runs on the scheduler when the virtual
+// time reaches the deadline
+func (s *Simulation) AfterFunc(d time.Duration, f func()) clock.Timer {
+	return &timer{e: s.schedule(d, f)}
+}
+
+// Send implements pl.Transport, the frame is handed to the endpoint after a
+// random delay. Sending to an unknown address fails like a refused connection
+func (s *Simulation) Send(address string, data []byte) error {
+	if _, ok := s.endpoints[address]; !ok {
+		return errors.New("connection refused by " + address)
+	}
+	s.frames++
+
+	m := &pb.Message{}
+	if err := proto.Unmarshal(data, m); err == nil && m.NetworkMessage != nil && m.NetworkMessage.Message != nil {
+		name := s.processName(m.NetworkMessage.SenderHost, m.NetworkMessage.SenderListeningPort)
+		s.sent[name+"/"+m.NetworkMessage.Message.Type.String()]++
+
+		if address == s.HubAddress() {
+			s.reports = append(s.reports, &Delivery{
+				At:       s.now,
+				SystemId: m.SystemId,
+				Process:  name,
+				Message:  m.NetworkMessage.Message,
+			})
+		}
+	}
+
+	delay := s.minDelay
+	if s.maxDelay > s.minDelay {
+		delay += time.Duration(s.rng.Int63n(int64(s.maxDelay - s.minDelay + 1)))
+	}
+
+	s.schedule(delay, func() {
+		// the destination may have crashed while the frame was traveling
+		if h, ok := s.endpoints[address]; ok {
+			h(data)
+		}
+	})
+
+	return nil
+}
+
+// RunUntil advances the simulation until cond holds or the virtual time
+// exceeds the limit, returning whether cond holds
+func (s *Simulation) RunUntil(cond func() bool, limit time.Duration) bool {
+	deadline := s.now + limit
+	for !cond() {
+		if !s.step(deadline) {
+			return cond()
+		}
+	}
+
+	return true
+}
+
+// RunFor advances the simulation by d of virtual time
+func (s *Simulation) RunFor(d time.Duration) {
+	s.RunUntil(func() bool { return false }, d)
+}
+
+// step handles one queued message of a randomly chosen system, or when all the
+// queues are empty fires the next event. Returns false once nothing is left to
+// do before the deadline
+func (s *Simulation) step(deadline time.Duration) bool {
+	ready := make([]*Process, 0)
+	for _, p := range s.processes {
+		if p.pending() {
+			ready = append(ready, p)
+		}
+	}
+
+	if len(ready) > 0 {
+		ready[s.rng.Intn(len(ready))].step(s.rng)
+		return true
+	}
+
+	for s.events.Len() > 0 {
+		e := heap.Pop(&s.events).(*event)
+		if e.canceled {
+			continue
+		}
+		if e.at > deadline {
+			heap.Push(&s.events, e)
+			s.now = deadline
+			return false
+		}
+
+		s.now = e.at
+		e.fired = true
+		e.fn()
+		return true
+	}
+
+	s.now = deadline
+	return false
+}
+
+func (s *Simulation) schedule(d time.Duration, f func()) *event {
+	s.seq++
+	e := &event{at: s.now + d, seq: s.seq, fn: f}
+	heap.Push(&s.events, e)
+
+	return e
+}
+
+func (s *Simulation) deliverToHub(data []byte) {
+	m := &pb.Message{}
+	if err := proto.Unmarshal(data, m); err == nil && m.NetworkMessage != nil && m.NetworkMessage.Message != nil {
+		s.deliveries = append(s.deliveries, &Delivery{
+			At:       s.now,
+			SystemId: m.SystemId,
+			Process:  s.processName(m.NetworkMessage.SenderHost, m.NetworkMessage.SenderListeningPort),
+			Message:  m.NetworkMessage.Message,
+		})
+	}
+
+	s.hub.Handle(data)
+}
+
+// processName returns the name of the process listening on the address, hub
+// for the hub
+func (s *Simulation) processName(host string, port int32) string {
+	for _, p := range s.processes {
+		if p.Id.Host == host && p.Id.Port == port {
+			return p.Name()
+		}
+	}
+
+	return "hub"
+}
+
+type event struct {
+	at       time.Duration
+	seq      int
+	fn       func()
+	canceled bool
+	fired    bool
+}
+
+type timer struct {
+	e *event
+}
+
+func (t *timer) Stop() bool {
+	if t.e.canceled || t.e.fired {
+		return false
+	}
+	t.e.canceled = true
+
+	return true
+}
+
+// eventQueue orders events by time, then by scheduling order
+type eventQueue []*event
+
+func (q eventQueue) Len() int { return len(q) }
+
+func (q eventQueue) Less(i, j int) bool {
+	if q[i].at == q[j].at {
+		return q[i].seq < q[j].seq
+	}
+	return q[i].at < q[j].at
+}
+
+func (q eventQueue) Swap(i, j int) { q[i], q[j] = q[j], q[i] }
+
+func (q *eventQueue) Push(x any) { *q = append(*q, x.(*event)) }
+
+func (q *eventQueue) Pop() any {
+	old := *q
+	e := old[len(old)-1]
+	*q = old[:len(old)-1]
+
+	return e
+}
@@ -0,0 +1,259 @@
+package system
+
+import (
+	"amcds/utils/log"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	RbEager = "eager"
+	RbLazy  = "lazy"
+
+	UrbAllAck      = "all-ack"
+	UrbMajorityAck = "majority-ack"
+
+	CrbNoWaiting = "no-waiting"
+	CrbWaiting   = "waiting"
+
+	PbEager = "eager"
+	PbLazy  = "lazy"
+
+	MembershipStatic = "static"
+	MembershipGm     = "gm"
+
+	OnrrRowa     = "rowa"
+	OnrrMajority = "majority"
+
+	OnarRowa     = "rowa"
+	OnarMajority = "majority"
+
+	UcLeaderDriven        = "leader-driven"
+	UcRaft                = "raft"
+	UcFlooding            = "flooding"
+	UcFloodingUniform     = "flooding-uniform"
+	UcHierarchical        = "hierarchical"
+	UcHierarchicalUniform = "hierarchical-uniform"
+
+	RcCommon = "common"
+	RcLocal  = "local"
+)
+
+// Config holds the settings of the abstractions of a system. They are read
+// from the environment (main loads the .env file first), then the hub may
+// override them for one system in ProcInitializeSystem, using the same names
+// in lower case. The directories the process writes to are only read from the
+// environment
+type Config struct {
+	// directory where the register operations are recorded, HISTORY_DIR
+	HistoryDir string
+	// directory where the nnar registers log their state, recovered when a
+	// process restarts and gets the same system again, STATE_DIR
+	StateDir string
+	// how long the perfect failure detector waits for the heartbeats, PFD_TIMEOUT
+	PfdTimeout time.Duration
+	// eager or lazy reliable broadcast, RB_ALGORITHM
+	RbAlgorithm string
+	// all-ack or majority-ack uniform reliable broadcast, URB_ALGORITHM
+	UrbAlgorithm string
+	// no-waiting or waiting causal broadcast, CRB_ALGORITHM
+	CrbAlgorithm string
+	// eager or lazy probabilistic broadcast, PB_ALGORITHM, relaying every
+	// message to PB_FANOUT processes for PB_ROUNDS rounds. The lazy one stores
+	// messages with probability PB_STORE and waits PB_TIMEOUT for the missing
+	// ones. PB_SEED drives the random choices, 0 for a random seed
+	PbAlgorithm string
+	PbFanout    int
+	PbRounds    int
+	PbStore     float64
+	PbTimeout   time.Duration
+	PbSeed      int64
+	// static or gm membership, MEMBERSHIP. With gm the processes reported
+	// crashed leave the view, which app.beb and the nnar registers follow.
+	// Otherwise gm is only started by vs or by a reconfiguration
+	Membership string
+	// rowa (read-one-write-all) or majority (voting) regular registers,
+	// ONRR_ALGORITHM
+	OnrrAlgorithm string
+	// rowa (read-impose write-all) or majority (read-impose write-majority)
+	// single-writer atomic registers, ONAR_ALGORITHM. ONAR_WRITER names the
+	// process (owner-index) allowed to write them, the max-rank one if empty
+	OnarAlgorithm string
+	OnarWriter    string
+	// leader-driven (epoch change with epoch consensus) or raft uniform
+	// consensus, UC_ALGORITHM, or for fail-stop systems the flooding or
+	// hierarchical consensus, regular or uniform, with the perfect failure
+	// detector. A raft follower starts an election after RAFT_TIMEOUT to twice
+	// it without a leader, which sends heartbeats every RAFT_HEARTBEAT.
+	// RAFT_SEED drives the timeouts, 0 for a random seed
+	UcAlgorithm   string
+	RaftTimeout   time.Duration
+	RaftHeartbeat time.Duration
+	RaftSeed      int64
+	// common or local coin of the randomized consensus, RC_COIN. RC_SEED
+	// drives it: the common coin hashes it with the system, instance and
+	// round, the local one uses a random seed when it is 0
+	RcCoin string
+	RcSeed int64
+	// how long an operation of app.kv waits for its register to return before
+	// failing, KV_TIMEOUT
+	KvTimeout time.Duration
+}
+
+var settings = []string{
+	"PFD_TIMEOUT", "RB_ALGORITHM", "URB_ALGORITHM", "CRB_ALGORITHM",
+	"PB_ALGORITHM", "PB_FANOUT", "PB_ROUNDS", "PB_STORE", "PB_TIMEOUT", "PB_SEED",
+	"MEMBERSHIP", "ONRR_ALGORITHM", "ONAR_ALGORITHM", "ONAR_WRITER",
+	"UC_ALGORITHM", "RAFT_TIMEOUT", "RAFT_HEARTBEAT", "RAFT_SEED", "RC_COIN", "RC_SEED",
+	"KV_TIMEOUT",
+}
+
+func DefaultConfig() Config {
+	return Config{
+		PfdTimeout:    200 * time.Millisecond,
+		RbAlgorithm:   RbEager,
+		UrbAlgorithm:  UrbMajorityAck,
+		CrbAlgorithm:  CrbWaiting,
+		PbAlgorithm:   PbEager,
+		PbFanout:      3,
+		PbRounds:      3,
+		PbStore:       0.5,
+		PbTimeout:     time.Second,
+		Membership:    MembershipStatic,
+		OnrrAlgorithm: OnrrMajority,
+		OnarAlgorithm: OnarMajority,
+		UcAlgorithm:   UcLeaderDriven,
+		RaftTimeout:   300 * time.Millisecond,
+		RaftHeartbeat: 50 * time.Millisecond,
+		RcCoin:        RcCommon,
+		KvTimeout:     5 * time.Second,
+	}
+}
+
+// LoadConfig returns the default config overridden by the environment. Invalid
+// values are reported and ignored
+func LoadConfig() Config {
+	cfg := DefaultConfig()
+	cfg.HistoryDir = os.Getenv("HISTORY_DIR")
+	cfg.StateDir = os.Getenv("STATE_DIR")
+
+	for _, key := range settings {
+		if v := os.Getenv(key); v != "" {
+			if err := cfg.Set(key, v); err != nil {
+				log.Warn("Ignoring %v", err)
+			}
+		}
+	}
+
+	return cfg
+}
+
+// Apply overrides the config with the settings of a system. Invalid values are
+// reported and ignored
+func (c *Config) Apply(settings map[string]string) {
+	for k, v := range settings {
+		if err := c.Set(strings.ToUpper(k), v); err != nil {
+			log.Warn("Ignoring %v", err)
+		}
+	}
+}
+
+// Set changes one setting given its environment variable name
+func (c *Config) Set(key, value string) error {
+	switch key {
+	case "PFD_TIMEOUT":
+		return setDuration(&c.PfdTimeout, key, value)
+	case "RB_ALGORITHM":
+		return setChoice(&c.RbAlgorithm, key, value, RbEager, RbLazy)
+	case "URB_ALGORITHM":
+		return setChoice(&c.UrbAlgorithm, key, value, UrbAllAck, UrbMajorityAck)
+	case "CRB_ALGORITHM":
+		return setChoice(&c.CrbAlgorithm, key, value, CrbNoWaiting, CrbWaiting)
+	case "PB_ALGORITHM":
+		return setChoice(&c.PbAlgorithm, key, value, PbEager, PbLazy)
+	case "PB_FANOUT":
+		return setInt(&c.PbFanout, key, value)
+	case "PB_ROUNDS":
+		return setInt(&c.PbRounds, key, value)
+	case "PB_STORE":
+		p, err := strconv.ParseFloat(value, 64)
+		if err != nil || p < 0 || p > 1 {
+			return fmt.Errorf("invalid %v %v, expected a probability between 0 and 1", key, value)
+		}
+		c.PbStore = p
+	case "PB_TIMEOUT":
+		return setDuration(&c.PbTimeout, key, value)
+	case "PB_SEED":
+		return setSeed(&c.PbSeed, key, value)
+	case "MEMBERSHIP":
+		return setChoice(&c.Membership, key, value, MembershipStatic, MembershipGm)
+	case "ONRR_ALGORITHM":
+		return setChoice(&c.OnrrAlgorithm, key, value, OnrrRowa, OnrrMajority)
+	case "ONAR_ALGORITHM":
+		return setChoice(&c.OnarAlgorithm, key, value, OnarRowa, OnarMajority)
+	case "ONAR_WRITER":
+		c.OnarWriter = value
+	case "UC_ALGORITHM":
+		return setChoice(&c.UcAlgorithm, key, value, UcLeaderDriven, UcRaft, UcFlooding, UcFloodingUniform, UcHierarchical, UcHierarchicalUniform)
+	case "RAFT_TIMEOUT":
+		return setDuration(&c.RaftTimeout, key, value)
+	case "RAFT_HEARTBEAT":
+		return setDuration(&c.RaftHeartbeat, key, value)
+	case "RAFT_SEED":
+		return setSeed(&c.RaftSeed, key, value)
+	case "RC_COIN":
+		return setChoice(&c.RcCoin, key, value, RcCommon, RcLocal)
+	case "RC_SEED":
+		return setSeed(&c.RcSeed, key, value)
+	case "KV_TIMEOUT":
+		return setDuration(&c.KvTimeout, key, value)
+	default:
+		return fmt.Errorf("unknown setting %v", key)
+	}
+
+	return nil
+}
+
+func setDuration(d *time.Duration, key, value string) error {
+	parsed, err := time.ParseDuration(value)
+	if err != nil || parsed <= 0 {
+		return fmt.Errorf("invalid %v %v, expected a positive duration", key, value)
+	}
+	*d = parsed
+
+	return nil
+}
+
+func setInt(i *int, key, value string) error {
+	parsed, err := strconv.Atoi(value)
+	if err != nil || parsed <= 0 {
+		return fmt.Errorf("invalid %v %v, expected a positive integer", key, value)
+	}
+	*i = parsed
+
+	return nil
+}
+
+func setSeed(seed *int64, key, value string) error {
+	parsed, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid %v %v, expected an integer", key, value)
+	}
+	*seed = parsed
+
+	return nil
+}
+
+func setChoice(s *string, key, value string, choices ...string) error {
+	for _, c := range choices {
+		if value == c {
+			*s = value
+			return nil
+		}
+	}
+
+	return fmt.Errorf("invalid %v %v, expected one of %v", key, value, strings.Join(choices, ", "))
+}
@@ -0,0 +1,239 @@
+package system
+
+import (
+	"amcds/pb"
+	"amcds/register"
+	"amcds/utils"
+	"amcds/utils/log"
+	"errors"
+	"strings"
+)
+
+// reconfiguration (app.reconf) carries the state of the nnar registers over
+// the views installed by gm, one view at a time. When a view is installed, the
+// registers of the members of the previous view move to it, so they stop
+// answering in the previous one, and their state is sent to the members of the
+// new view. Every member of the new view holds back the messages of the
+// registers until it got the state from half of the previous view, which meets
+// every majority of it, and kept the highest timestamp of every register: any
+// operation completed in the previous view is then known by the new one.
+//
+// A process joining the system also holds back the messages of gm and vs, as
+// it learns the view adding it with the state
+type reconfiguration struct {
+	id     string
+	system *System
+
+	view *pb.GmView
+	// views of gm not handled yet, waiting for the state of the current one
+	views   []*pb.GmView
+	joining bool
+	waiting bool
+	// number of members of the view before the current one
+	previous int32
+	// states received, by view id and sender
+	states map[int32]map[string]*pb.ReconfInternalState
+	held   []*pb.Message
+}
+
+func createReconfiguration(abstractionId string, s *System, view *pb.GmView, joining bool) *reconfiguration {
+	return &reconfiguration{
+		id:      abstractionId,
+		system:  s,
+		view:    view,
+		joining: joining,
+		states:  make(map[int32]map[string]*pb.ReconfInternalState),
+	}
+}
+
+func (r *reconfiguration) Handle(m *pb.Message) error {
+	switch m.Type {
+	case pb.Message_PROC_RECONFIGURE_SYSTEM:
+		r.system.learn(m.ProcReconfigureSystem.Processes)
+		r.system.msgQueue <- &pb.Message{
+			Type:              pb.Message_GM_RECONFIGURE,
+			FromAbstractionId: r.id,
+			ToAbstractionId:   "app.gm",
+			SystemId:          m.SystemId,
+			GmReconfigure: &pb.GmReconfigure{
+				Members: m.ProcReconfigureSystem.Processes,
+			},
+		}
+	case pb.Message_GM_VIEW:
+		last := r.view.Id
+		if len(r.views) > 0 {
+			last = r.views[len(r.views)-1].Id
+		}
+		if r.joining || m.GmView.Id <= last {
+			break
+		}
+		r.views = append(r.views, m.GmView)
+		r.next(m.SystemId)
+	case pb.Message_PL_DELIVER:
+		switch m.PlDeliver.Message.Type {
+		case pb.Message_RECONF_INTERNAL_STATE:
+			r.collect(m.PlDeliver.Sender, m.PlDeliver.Message.ReconfInternalState, m.SystemId)
+		default:
+			return errors.New("reconf pl deliver message type not supported")
+		}
+	default:
+		return errors.New("reconf message type not supported")
+	}
+
+	return nil
+}
+
+func (r *reconfiguration) Destroy() {}
+
+// hold keeps the message for later if it belongs to an abstraction waiting
+// for the state of the previous view
+func (r *reconfiguration) hold(m *pb.Message) bool {
+	id := m.ToAbstractionId
+	waits := strings.HasPrefix(id, "app.nnar[")
+	if r.joining {
+		for _, aId := range []string{"app.gm", "app.vs"} {
+			waits = waits || id == aId || strings.HasPrefix(id, aId+".")
+		}
+		// the failure detector of gm keeps answering the heartbeats
+		waits = waits && id != "app.gm.pfd" && !strings.HasPrefix(id, "app.gm.pfd.")
+	}
+	if !waits || (!r.waiting && !r.joining) {
+		return false
+	}
+
+	r.held = append(r.held, m)
+
+	return true
+}
+
+// next moves to the views of gm in order, each one once the state of the
+// previous one was merged
+func (r *reconfiguration) next(systemId string) {
+	for !r.waiting && len(r.views) > 0 {
+		view := r.views[0]
+		r.views = r.views[1:]
+		r.move(view, systemId)
+	}
+}
+
+func (r *reconfiguration) move(view *pb.GmView, systemId string) {
+	self := r.system.ownProcess
+	previous := r.view
+	r.view = view
+	r.system.processes = view.Members
+	r.system.learn(view.Members)
+	log.Info("Moving to view %v with %v members", view.Id, len(view.Members))
+
+	if utils.ContainsProcess(view.Members, self) {
+		r.waiting = true
+		r.previous = int32(len(previous.Members))
+	}
+	if !utils.ContainsProcess(previous.Members, self) {
+		return
+	}
+
+	state := &pb.ReconfInternalState{
+		View:     view,
+		Previous: int32(len(previous.Members)),
+	}
+	for _, reg := range r.system.registers() {
+		reg.Handle(&pb.Message{
+			Type:              pb.Message_GM_VIEW,
+			FromAbstractionId: r.id,
+			ToAbstractionId:   "app.nnar[" + reg.Key + "]",
+			SystemId:          systemId,
+			GmView:            view,
+		})
+		state.Registers = append(state.Registers, reg.State())
+	}
+
+	for _, p := range view.Members {
+		if utils.GetProcessKey(p) == utils.GetProcessKey(self) {
+			continue
+		}
+		r.system.msgQueue <- &pb.Message{
+			Type:              pb.Message_PL_SEND,
+			FromAbstractionId: r.id,
+			ToAbstractionId:   r.id + ".pl",
+			SystemId:          systemId,
+			PlSend: &pb.PlSend{
+				Destination: p,
+				Message: &pb.Message{
+					Type:                pb.Message_RECONF_INTERNAL_STATE,
+					FromAbstractionId:   r.id,
+					ToAbstractionId:     r.id,
+					SystemId:            systemId,
+					ReconfInternalState: state,
+				},
+			},
+		}
+	}
+	r.collect(self, state, systemId)
+}
+
+// collect keeps the state sent for a view and merges the states of the current
+// one once they came from half of the previous view
+func (r *reconfiguration) collect(sender *pb.ProcessId, state *pb.ReconfInternalState, systemId string) {
+	if sender == nil {
+		return
+	}
+	if _, ok := r.states[state.View.Id]; !ok {
+		r.states[state.View.Id] = make(map[string]*pb.ReconfInternalState)
+	}
+	r.states[state.View.Id][utils.GetProcessKey(sender)] = state
+
+	// a process joining gets the view adding it with the first state
+	if r.joining && !r.waiting {
+		r.view = state.View
+		r.previous = state.Previous
+		r.waiting = true
+	}
+	if !r.waiting || int32(len(r.states[r.view.Id])) < (r.previous+1)/2 {
+		return
+	}
+
+	merged := make(map[string]*pb.NnarState)
+	for _, st := range r.states[r.view.Id] {
+		for _, reg := range st.Registers {
+			if current, ok := merged[reg.Key]; !ok || reg.Timestamp > current.Timestamp ||
+				(reg.Timestamp == current.Timestamp && reg.WriterRank > current.WriterRank) {
+				merged[reg.Key] = reg
+			}
+		}
+	}
+	for id := range r.states {
+		if id <= r.view.Id {
+			delete(r.states, id)
+		}
+	}
+
+	if r.joining {
+		log.Info("Joined view %v with %v members", r.view.Id, len(r.view.Members))
+		r.system.processes = r.view.Members
+		r.system.learn(r.view.Members)
+		r.system.msgQueue <- &pb.Message{
+			Type:              pb.Message_GM_VIEW,
+			FromAbstractionId: r.id,
+			ToAbstractionId:   "app.gm",
+			SystemId:          systemId,
+			GmView:            r.view,
+		}
+	}
+	r.joining = false
+	r.waiting = false
+
+	for key, st := range merged {
+		if _, ok := r.system.abstractions["app.nnar["+key+"]"]; !ok {
+			r.system.registerNnarAbstractions(key)
+		}
+		r.system.abstractions["app.nnar["+key+"]"].(*register.NnAtomicRegister).Restore(st)
+	}
+
+	held := r.held
+	r.held = nil
+	for _, m := range held {
+		r.system.msgQueue <- m
+	}
+
+	r.next(systemId)
+}
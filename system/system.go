@@ -3,16 +3,24 @@ package system
 import (
 	"amcds/app"
 	"amcds/broadcast"
+	"amcds/commit"
 	"amcds/consensus"
+	"amcds/kv"
+	"amcds/lin"
 	"amcds/pb"
 	"amcds/pl"
 	"amcds/register"
 	"amcds/utils"
 	"amcds/utils/abstraction"
+	"amcds/utils/clock"
 	"amcds/utils/log"
 	"net"
+	"net/url"
+	"path/filepath"
+	"sort"
 	"strconv"
 	"strings"
+	"time"
 )
 
 type System struct {
@@ -22,6 +30,17 @@ type System struct {
 	hubAddress   string
 	ownProcess   *pb.ProcessId
 	processes    []*pb.ProcessId
+	recorder     *lin.Recorder
+	store        register.Store
+	transport    pl.Transport
+	clock        clock.Clock
+	config       Config
+
+	// every process which was part of the system, for the links to know the
+	// senders after a reconfiguration
+	known []*pb.ProcessId
+	// configuration the process joins, for a system created by a reconfiguration
+	joining *pb.ProcReconfigureSystem
 }
 
 func (s *System) StartEventLoop() {
@@ -30,39 +49,207 @@ func (s *System) StartEventLoop() {
 
 func (s *System) run() {
 	for m := range s.msgQueue {
-		// check for non-existing registers
-		_, ok := s.abstractions[m.ToAbstractionId]
+		s.handle(m)
+	}
+}
 
+// Step handles at most one queued message without blocking, for callers that
+// drive the system themselves instead of starting the event loop. Returns
+// false if there was nothing to handle
+func (s *System) Step() bool {
+	select {
+	case m, ok := <-s.msgQueue:
 		if !ok {
-			if strings.HasPrefix(m.ToAbstractionId, "app.nnar") {
-				log.Info("Creating new nnar abstraction for %v", m.ToAbstractionId)
-				registerId := utils.GetRegisterId((m.ToAbstractionId))
-				log.Info("Register id %v", registerId)
-				s.registerNnarAbstractions(registerId)
+			return false
+		}
+		s.handle(m)
+		return true
+	default:
+		return false
+	}
+}
+
+// Pending returns the number of queued messages
+func (s *System) Pending() int {
+	return len(s.msgQueue)
+}
+
+func (s *System) handle(m *pb.Message) {
+	if r, ok := s.abstractions["app.reconf"].(*reconfiguration); ok && r.hold(m) {
+		log.Debug("Holding message %v for %v until the state is transferred", m.Type, m.ToAbstractionId)
+		return
+	}
+
+	// check for non-existing registers
+	_, ok := s.abstractions[m.ToAbstractionId]
+
+	if !ok {
+		if s.isUnknownInstance(m.ToAbstractionId, "app.nnar") {
+			log.Info("Creating new nnar abstraction for %v", m.ToAbstractionId)
+			registerId := utils.GetRegisterId((m.ToAbstractionId))
+			log.Info("Register id %v", registerId)
+			s.registerNnarAbstractions(registerId)
+		}
+		if s.isUnknownInstance(m.ToAbstractionId, "app.kv.nnar") {
+			log.Info("Creating new kv register for %v", m.ToAbstractionId)
+			s.registerKvAbstractions(utils.GetRegisterId(m.ToAbstractionId))
+		}
+		if s.isUnknownInstance(m.ToAbstractionId, "app.onrr") {
+			log.Info("Creating new onrr abstraction for %v", m.ToAbstractionId)
+			s.registerOnrrAbstractions(utils.GetRegisterId(m.ToAbstractionId))
+		}
+		if s.isUnknownInstance(m.ToAbstractionId, "app.onar") {
+			log.Info("Creating new onar abstraction for %v", m.ToAbstractionId)
+			s.registerOnarAbstractions(utils.GetRegisterId(m.ToAbstractionId))
+		}
+		if s.isUnknownInstance(m.ToAbstractionId, "app.uc") {
+			log.Info("Registering UC abstraction for %v", m.ToAbstractionId)
+			s.registerConsensusAbstractions("app", "app.uc["+utils.GetRegisterId(m.ToAbstractionId)+"]")
+		}
+		if s.isUnknownInstance(m.ToAbstractionId, "app.rc") {
+			s.registerRcAbstractions(utils.GetRegisterId(m.ToAbstractionId))
+		}
+		// the binary instances of a randomized consensus may be started by the
+		// others first
+		if i := strings.Index(m.ToAbstractionId, ".bc["); i > 0 && strings.HasPrefix(m.ToAbstractionId, "app.rc[") {
+			aId := m.ToAbstractionId[:i+strings.Index(m.ToAbstractionId[i:], "]")+1]
+			if s.isUnknownAbstraction(m.ToAbstractionId, aId) {
+				s.registerBcAbstractions(m.ToAbstractionId[:i], aId)
 			}
-			if m.Type == pb.Message_UC_PROPOSE {
-				log.Info("Registering UC abstraction for %v", m.ToAbstractionId)
-				s.registerConsensusAbstractions(utils.GetRegisterId((m.ToAbstractionId)))
+		}
+		if s.isUnknownAbstraction(m.ToAbstractionId, "app.rb") {
+			s.registerRbAbstractions("app")
+		}
+		// the leader election of an abstraction, e.g. app.le, is created by the
+		// first message for it or its pfd
+		if i := strings.Index(m.ToAbstractionId+".", ".le."); i > 0 && strings.HasPrefix(m.ToAbstractionId, "app.") {
+			if parentId := m.ToAbstractionId[:i]; s.isUnknownAbstraction(m.ToAbstractionId, parentId+".le") {
+				s.registerLeAbstractions(parentId)
 			}
 		}
-		handler, ok := s.abstractions[m.ToAbstractionId]
+		if s.isUnknownAbstraction(m.ToAbstractionId, "app.urb") {
+			s.registerUrbAbstractions("app")
+		}
+		if s.isUnknownAbstraction(m.ToAbstractionId, "app.frb") {
+			s.registerFrbAbstractions("app")
+		}
+		if s.isUnknownAbstraction(m.ToAbstractionId, "app.crb") {
+			s.registerCrbAbstractions("app")
+		}
+		if s.isUnknownAbstraction(m.ToAbstractionId, "app.pb") {
+			s.registerPbAbstractions("app")
+		}
+		if s.isUnknownAbstraction(m.ToAbstractionId, "app.bcb") {
+			s.registerByzantineAbstractions("app", "bcb")
+		}
+		if s.isUnknownAbstraction(m.ToAbstractionId, "app.brb") {
+			s.registerByzantineAbstractions("app", "brb")
+		}
+		if s.isUnknownAbstraction(m.ToAbstractionId, "app.tob") {
+			s.registerTobAbstractions("app")
+		}
+		if s.isUnknownAbstraction(m.ToAbstractionId, "app.log") {
+			s.registerLogAbstractions("app")
+		}
+		if s.isUnknownInstance(m.ToAbstractionId, "app.tob.uc") {
+			s.registerConsensusAbstractions("app.tob", "app.tob.uc["+utils.GetRegisterId(m.ToAbstractionId)+"]")
+		}
+		if s.isUnknownInstance(m.ToAbstractionId, "app.trb") {
+			s.registerTrbAbstractions(utils.GetRegisterId(m.ToAbstractionId))
+		}
+		if s.isUnknownInstance(m.ToAbstractionId, "app.nbac") {
+			s.registerNbacAbstractions(utils.GetRegisterId(m.ToAbstractionId))
+		}
+		if s.isUnknownAbstraction(m.ToAbstractionId, "app.gm") || s.isUnknownAbstraction(m.ToAbstractionId, "app.reconf") {
+			s.registerGmAbstractions()
+		}
+		// the next view is agreed on by the members of the current one, so that
+		// the view changes as long as a majority of them is correct
+		if s.isUnknownInstance(m.ToAbstractionId, "app.gm.uc") {
+			members := s.abstractions["app.gm"].(*consensus.GroupMembership).View().Members
+			s.registerConsensusAbstractionsAmong("app.gm", "app.gm.uc["+utils.GetRegisterId(m.ToAbstractionId)+"]", members)
+		}
+		if s.isUnknownAbstraction(m.ToAbstractionId, "app.vs") {
+			s.registerVsAbstractions()
+		}
+		if s.isUnknownInstance(m.ToAbstractionId, "app.vs.uc") {
+			members := s.abstractions["app.vs"].(*consensus.ViewSynchronousBroadcast).View().Members
+			s.registerConsensusAbstractionsAmong("app.vs", "app.vs.uc["+utils.GetRegisterId(m.ToAbstractionId)+"]", members)
+		}
+		for _, protocol := range []string{"2pc", "3pc"} {
+			if s.isUnknownInstance(m.ToAbstractionId, "app."+protocol) {
+				s.registerCommitAbstractions(protocol, utils.GetRegisterId(m.ToAbstractionId))
+			}
+		}
+	}
+	handler, ok := s.abstractions[m.ToAbstractionId]
 
-		if !ok {
-			log.Debug("Crap aici ca nu stiu sa imi instantitez")
-			log.Error("No handler defined for %v", m.ToAbstractionId)
-			continue
+	// the epoch of a uc instance may be started by the others first
+	if i := strings.Index(m.ToAbstractionId, ".ep["); !ok && i > 0 {
+		if uc, isUc := s.abstractions[m.ToAbstractionId[:i]].(*consensus.Uc); isUc {
+			log.Debug("Deferring message %v for %v", m.Type, m.ToAbstractionId)
+			uc.Defer(m)
+			return
 		}
+	}
 
-		log.Debug("["+m.ToAbstractionId+"] handling message %v", m.Type)
-		err := handler.Handle(m)
-		if err != nil {
-			log.Error("Failed to handle message %v", err)
+	if !ok {
+		log.Debug("Crap aici ca nu stiu sa imi instantitez")
+		log.Error("No handler defined for %v", m.ToAbstractionId)
+		return
+	}
+
+	log.Debug("["+m.ToAbstractionId+"] handling message %v", m.Type)
+	err := handler.Handle(m)
+	if err != nil {
+		log.Error("Failed to handle message %v", err)
+	}
+}
+
+// isUnknownInstance checks if the id belongs to an instance of the given
+// abstraction (e.g. app.uc[topic].ec.pl for app.uc) which was not created yet
+func (s *System) isUnknownInstance(id, prefix string) bool {
+	if !strings.HasPrefix(id, prefix+"[") {
+		return false
+	}
+
+	_, ok := s.abstractions[prefix+"["+utils.GetRegisterId(id)+"]"]
+
+	return !ok
+}
+
+// isUnknownAbstraction checks if the id belongs to the abstraction or the ones
+// below it (e.g. app.rb.beb.pl for app.rb) and the abstraction was not created
+// yet. This way the abstractions with timers only run in the systems using them
+func (s *System) isUnknownAbstraction(id, aId string) bool {
+	if id != aId && !strings.HasPrefix(id, aId+".") {
+		return false
+	}
+
+	_, ok := s.abstractions[aId]
+
+	return !ok
+}
+
+// Buffered returns the messages held back by the abstractions which buffer
+// them, by abstraction id
+func (s *System) Buffered() map[string]abstraction.BufferStats {
+	stats := make(map[string]abstraction.BufferStats)
+	for id, a := range s.abstractions {
+		if b, ok := a.(abstraction.Buffering); ok {
+			stats[id] = b.Buffered()
 		}
 	}
+
+	return stats
+}
+
+func (s *System) createPl() *pl.PerfectLink {
+	return pl.Create(s.ownProcess.Host, s.ownProcess.Port, s.hubAddress).CreateWithProps(s.systemId, s.msgQueue, &s.known).CreateWithTransport(s.transport)
 }
 
 func (s *System) RegisterAbstractions() {
-	pl := pl.Create(s.ownProcess.Host, s.ownProcess.Port, s.hubAddress).CreateWithProps(s.systemId, s.msgQueue, s.processes)
+	pl := s.createPl()
 
 	hubAddr, hubPortS, _ := net.SplitHostPort(s.hubAddress)
 	hubPort, _ := strconv.Atoi(hubPortS)
@@ -75,57 +262,594 @@ func (s *System) RegisterAbstractions() {
 
 	s.abstractions["app.beb"] = broadcast.Create(s.msgQueue, s.processes, "app.beb")
 	s.abstractions["app.beb.pl"] = pl.CreateCopyWithParentId("app.beb")
+	s.abstractions["app.kv"] = kv.Create("app.kv", s.systemId, s.msgQueue, s.config.KvTimeout, s.clock)
+
+	if s.config.Membership == MembershipGm || s.joining != nil {
+		s.registerGmAbstractions()
+	}
+
+	s.recoverRegisters()
+}
+
+// recoverRegisters creates the nnar registers logged in the store before the
+// process restarted, which resume the operations they were running
+func (s *System) recoverRegisters() {
+	if s.store == nil {
+		return
+	}
+
+	logs, err := s.store.Load()
+	if err != nil {
+		log.Error("Failed to load the registers of %v: %v", s.systemId, err)
+		return
+	}
+
+	// in a fixed order, for the simulations to replay the same
+	ids := make([]string, 0, len(logs))
+	for id := range logs {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	for _, id := range ids {
+		log.Info("Recovering register %v of %v", id, s.systemId)
+		key := utils.GetRegisterId(id)
+		if strings.HasPrefix(id, "app.kv.nnar[") {
+			s.registerKvAbstractions(key)
+		} else {
+			s.registerNnarAbstractions(key)
+		}
+		if err := s.abstractions[id].(*register.NnAtomicRegister).Recover(s.systemId, logs[id]); err != nil {
+			log.Error("Failed to recover register %v: %v", id, err)
+		}
+	}
+}
+
+// registerGmAbstractions creates the group membership of the system with the
+// perfect failure detector reporting the crashed members and the
+// reconfiguration carrying the state of the registers over its views. The uc
+// instances of every view are created when first used. From then on app.beb
+// and the registers follow the views
+func (s *System) registerGmAbstractions() {
+	pl := s.createPl()
+
+	gm := consensus.CreateGm("app", "app.gm", s.msgQueue, s.processes, s.ownProcess)
+	s.abstractions["app.gm"] = gm
+	s.registerPfdAbstractions("app.gm")
+	s.abstractions["app.reconf"] = createReconfiguration("app.reconf", s, gm.View(), s.joining != nil)
+	s.abstractions["app.reconf.pl"] = pl.CreateCopyWithParentId("app.reconf")
+
+	s.subscribe("app.gm.pfd")
+	s.subscribe("app.reconf")
+	s.subscribe("app.beb")
+	for _, reg := range s.registers() {
+		s.subscribe("app.nnar[" + reg.Key + "].beb")
+	}
+}
+
+// subscribe makes the abstraction follow the views of the group membership,
+// when the system runs one
+func (s *System) subscribe(aId string) {
+	if gm, ok := s.abstractions["app.gm"].(*consensus.GroupMembership); ok {
+		gm.Subscribe(aId)
+	}
+}
+
+// registerVsAbstractions creates the view-synchronous broadcast of the system
+// on top of the group membership, which is created with it if needed
+func (s *System) registerVsAbstractions() {
+	pl := s.createPl()
+	aId := "app.vs"
+
+	if _, ok := s.abstractions["app.gm"]; !ok {
+		s.registerGmAbstractions()
+	}
+	s.abstractions[aId] = consensus.CreateVs("app", aId, s.msgQueue, s.abstractions["app.gm"].(*consensus.GroupMembership).View(), s.ownProcess)
+	s.abstractions[aId+".beb"] = broadcast.Create(s.msgQueue, s.processes, aId+".beb")
+	s.abstractions[aId+".beb.pl"] = pl.CreateCopyWithParentId(aId + ".beb")
+	s.subscribe(aId)
+	s.subscribe(aId + ".beb")
+}
+
+// registerRbAbstractions creates the reliable broadcast of the parent, lazy or
+// eager depending on the config
+func (s *System) registerRbAbstractions(parentId string) {
+	pl := s.createPl()
+	aId := parentId + ".rb"
+
+	if s.config.RbAlgorithm == RbLazy {
+		s.abstractions[aId] = broadcast.CreateLazyRb(parentId, aId, s.msgQueue, s.processes, s.ownProcess)
+		s.registerPfdAbstractions(aId)
+	} else {
+		s.abstractions[aId] = broadcast.CreateEagerRb(parentId, aId, s.msgQueue, s.ownProcess)
+	}
+	s.abstractions[aId+".beb"] = broadcast.Create(s.msgQueue, s.processes, aId+".beb")
+	s.abstractions[aId+".beb.pl"] = pl.CreateCopyWithParentId(aId + ".beb")
+}
+
+// registerFrbAbstractions creates the fifo broadcast of the parent, with its rb
+func (s *System) registerFrbAbstractions(parentId string) {
+	aId := parentId + ".frb"
+
+	s.abstractions[aId] = broadcast.CreateFrb(parentId, aId, s.msgQueue)
+	s.registerRbAbstractions(aId)
+}
+
+// registerCrbAbstractions creates the causal broadcast of the parent, waiting
+// or no-waiting depending on the config, with its rb
+func (s *System) registerCrbAbstractions(parentId string) {
+	aId := parentId + ".crb"
+
+	if s.config.CrbAlgorithm == CrbNoWaiting {
+		s.abstractions[aId] = broadcast.CreateNoWaitingCrb(parentId, aId, s.msgQueue, s.ownProcess)
+	} else {
+		s.abstractions[aId] = broadcast.CreateWaitingCrb(parentId, aId, s.msgQueue, s.processes, s.ownProcess)
+	}
+	s.registerRbAbstractions(aId)
+}
+
+// registerPbAbstractions creates the probabilistic broadcast of the parent
+func (s *System) registerPbAbstractions(parentId string) {
+	pl := s.createPl()
+	aId := parentId + ".pb"
+
+	seed := s.config.PbSeed
+	if seed == 0 {
+		seed = time.Now().UnixNano()
+	}
+	cfg := broadcast.GossipConfig{
+		Lazy:    s.config.PbAlgorithm == PbLazy,
+		Fanout:  s.config.PbFanout,
+		Rounds:  int32(s.config.PbRounds),
+		Store:   s.config.PbStore,
+		Timeout: s.config.PbTimeout,
+		Seed:    seed,
+	}
+
+	s.abstractions[aId] = broadcast.CreatePb(parentId, aId, s.msgQueue, s.processes, s.ownProcess, cfg, s.clock)
+	s.abstractions[aId+".pl"] = pl.CreateCopyWithParentId(aId)
+}
+
+// registerByzantineAbstractions creates the byzantine consistent (bcb) or
+// reliable (brb) broadcast of the parent, over its own authenticated links
+func (s *System) registerByzantineAbstractions(parentId, name string) {
+	pl := s.createPl()
+	aId := parentId + "." + name
+
+	if name == "brb" {
+		s.abstractions[aId] = broadcast.CreateBrb(parentId, aId, s.msgQueue, s.processes, s.ownProcess)
+	} else {
+		s.abstractions[aId] = broadcast.CreateBcb(parentId, aId, s.msgQueue, s.processes, s.ownProcess)
+	}
+	s.abstractions[aId+".pl"] = pl.CreateCopyWithParentId(aId)
+}
+
+// registerUrbAbstractions creates the uniform reliable broadcast of the parent,
+// all-ack or majority-ack depending on the config
+func (s *System) registerUrbAbstractions(parentId string) {
+	pl := s.createPl()
+	aId := parentId + ".urb"
+
+	if s.config.UrbAlgorithm == UrbAllAck {
+		s.abstractions[aId] = broadcast.CreateAllAckUrb(parentId, aId, s.msgQueue, s.processes, s.ownProcess)
+		s.registerPfdAbstractions(aId)
+	} else {
+		s.abstractions[aId] = broadcast.CreateMajorityAckUrb(parentId, aId, s.msgQueue, s.processes, s.ownProcess)
+	}
+	s.abstractions[aId+".beb"] = broadcast.Create(s.msgQueue, s.processes, aId+".beb")
+	s.abstractions[aId+".beb.pl"] = pl.CreateCopyWithParentId(aId + ".beb")
 }
 
 func (s *System) registerNnarAbstractions(key string) {
-	pl := pl.Create(s.ownProcess.Host, s.ownProcess.Port, s.hubAddress).CreateWithProps(s.systemId, s.msgQueue, s.processes)
-	aId := "app.nnar[" + key + "]"
+	s.registerNnar("app", "app.nnar["+key+"]", key, s.recorder)
+}
+
+// registerKvAbstractions creates the register holding a key of app.kv. Its
+// operations are not recorded, the history being the one of app.nnar
+func (s *System) registerKvAbstractions(key string) {
+	s.registerNnar("app.kv", "app.kv.nnar["+key+"]", key, nil)
+}
+
+func (s *System) registerNnar(parentId, aId, key string, recorder *lin.Recorder) {
+	pl := s.createPl()
+
+	// the views of gm are given by the reconfiguration, once the state of
+	// the previous view is merged
+	view := &pb.GmView{Id: 0, Members: s.processes}
+	if r, ok := s.abstractions["app.reconf"].(*reconfiguration); ok {
+		view = r.view
+	}
 
 	s.abstractions[aId] = &register.NnAtomicRegister{
 		MsgQueue:   s.msgQueue,
-		N:          int32(len(s.processes)),
+		N:          int32(len(view.Members)),
+		ViewId:     view.Id,
 		Key:        key,
+		Rank:       s.ownProcess.Rank,
 		Timestamp:  0,
 		WriterRank: s.ownProcess.Rank,
-		Value:      -1,
+		Value:      &pb.Value{},
 		ReadList:   make(map[string]*pb.NnarInternalValue),
+		Id:         aId,
+		ParentId:   parentId,
+		Recorder:   recorder,
+		Process:    s.ownProcess.Owner + "-" + utils.Int32ToString(s.ownProcess.Index),
+		Store:      s.store,
 	}
 	s.abstractions[aId+".pl"] = pl.CreateCopyWithParentId(aId)
 	s.abstractions[aId+".beb"] = broadcast.Create(s.msgQueue, s.processes, aId+".beb")
 	s.abstractions[aId+".beb.pl"] = pl.CreateCopyWithParentId(aId + ".beb")
+	s.subscribe(aId + ".beb")
 }
 
-func (s *System) registerConsensusAbstractions(topic string) {
-	pl := pl.Create(s.ownProcess.Host, s.ownProcess.Port, s.hubAddress).CreateWithProps(s.systemId, s.msgQueue, s.processes)
-	aId := "app.uc[" + topic + "]"
+// registerOnrrAbstractions creates a regular register, read-one-write-all
+// with its perfect failure detector or majority voting depending on the config
+func (s *System) registerOnrrAbstractions(key string) {
+	pl := s.createPl()
+	aId := "app.onrr[" + key + "]"
 
-	s.abstractions[aId] = consensus.CreateUc(aId, s.msgQueue, s.abstractions, s.processes, s.ownProcess, pl)
-	s.abstractions[aId+".ec"] = consensus.CreateEc(aId, aId+".ec", s.ownProcess, s.msgQueue, s.processes)
-	s.abstractions[aId+".ec.pl"] = pl.CreateCopyWithParentId(aId + ".ec")
-	s.abstractions[aId+".ec.beb"] = broadcast.Create(s.msgQueue, s.processes, aId+".ec.beb")
-	s.abstractions[aId+".ec.beb.pl"] = pl.CreateCopyWithParentId(aId + ".ec.beb")
-	s.abstractions[aId+".ec.eld"] = consensus.CreateEld(aId+".ec", aId+".ec.eld", s.msgQueue, s.processes)
-	s.abstractions[aId+".ec.eld.epfd"] = consensus.CreateEpfd(aId+".ec.eld", aId+".ec.eld.epfd", s.msgQueue, s.processes)
-	s.abstractions[aId+".ec.eld.epfd.pl"] = pl.CreateCopyWithParentId(aId + ".ec.eld.epfd")
+	if s.config.OnrrAlgorithm == OnrrRowa {
+		s.abstractions[aId] = register.CreateRowaOnrr("app", aId, s.msgQueue, s.processes)
+		s.registerPfdAbstractions(aId)
+	} else {
+		s.abstractions[aId] = register.CreateMajorityOnrr("app", aId, s.msgQueue, s.processes)
+	}
+	s.abstractions[aId+".pl"] = pl.CreateCopyWithParentId(aId)
+	s.abstractions[aId+".beb"] = broadcast.Create(s.msgQueue, s.processes, aId+".beb")
+	s.abstractions[aId+".beb.pl"] = pl.CreateCopyWithParentId(aId + ".beb")
 }
 
+// registerOnarAbstractions creates a single-writer atomic register,
+// read-impose write-all with its perfect failure detector or read-impose
+// write-majority depending on the config
+func (s *System) registerOnarAbstractions(key string) {
+	pl := s.createPl()
+	aId := "app.onar[" + key + "]"
+
+	writer := utils.GetMaxRankSlice(s.processes)
+	if s.config.OnarWriter != "" {
+		writer = nil
+		for _, p := range s.processes {
+			if p.Owner+"-"+utils.Int32ToString(p.Index) == s.config.OnarWriter {
+				writer = p
+			}
+		}
+		if writer == nil {
+			writer = utils.GetMaxRankSlice(s.processes)
+			log.Warn("Writer %v of %v is not part of the system, %v-%v writes it instead", s.config.OnarWriter, aId, writer.Owner, writer.Index)
+		}
+	}
+
+	if s.config.OnarAlgorithm == OnarRowa {
+		s.abstractions[aId] = register.CreateRowaOnar("app", aId, s.msgQueue, s.processes, s.ownProcess, writer)
+		s.registerPfdAbstractions(aId)
+	} else {
+		s.abstractions[aId] = register.CreateMajorityOnar("app", aId, s.msgQueue, s.processes, s.ownProcess, writer)
+	}
+	s.abstractions[aId+".pl"] = pl.CreateCopyWithParentId(aId)
+	s.abstractions[aId+".beb"] = broadcast.Create(s.msgQueue, s.processes, aId+".beb")
+	s.abstractions[aId+".beb.pl"] = pl.CreateCopyWithParentId(aId + ".beb")
+}
+
+// registers returns the nnar registers created so far
+func (s *System) registers() []*register.NnAtomicRegister {
+	registers := make([]*register.NnAtomicRegister, 0)
+	for id, a := range s.abstractions {
+		if reg, ok := a.(*register.NnAtomicRegister); ok && strings.HasPrefix(id, "app.nnar[") {
+			registers = append(registers, reg)
+		}
+	}
+
+	return registers
+}
+
+// learn adds the processes to the ones known by the links
+func (s *System) learn(processes []*pb.ProcessId) {
+	for _, p := range processes {
+		if !utils.ContainsProcess(s.known, p) {
+			s.known = append(s.known, p)
+		}
+	}
+}
+
+// registerConsensusAbstractions creates a uniform consensus instance of the
+// parent, such as app.uc[topic]
+func (s *System) registerConsensusAbstractions(parentId, aId string) {
+	s.registerConsensusAbstractionsAmong(parentId, aId, s.processes)
+}
+
+// registerConsensusAbstractionsAmong creates a uniform consensus instance of
+// the parent run by the given processes only, which need a majority of them.
+// It is leader-driven or raft depending on the config, unless the config picks
+// one of the fail-stop algorithms
+func (s *System) registerConsensusAbstractionsAmong(parentId, aId string, processes []*pb.ProcessId) {
+	switch s.config.UcAlgorithm {
+	case UcFlooding, UcFloodingUniform, UcHierarchical, UcHierarchicalUniform:
+		s.registerFailStopConsensusAbstractions(parentId, aId, processes)
+		return
+	}
+
+	pl := s.createPl()
+
+	if s.config.UcAlgorithm == UcRaft {
+		seed := s.config.RaftSeed
+		if seed == 0 {
+			seed = time.Now().UnixNano()
+		}
+		cfg := consensus.RaftConfig{
+			ElectionTimeout: s.config.RaftTimeout,
+			Heartbeat:       s.config.RaftHeartbeat,
+			Seed:            seed,
+		}
+
+		s.abstractions[aId] = consensus.CreateRaft(parentId, aId, s.msgQueue, processes, s.ownProcess, cfg, s.clock)
+		s.abstractions[aId+".pl"] = pl.CreateCopyWithParentId(aId)
+		return
+	}
+
+	s.abstractions[aId] = consensus.CreateUc(parentId, aId, s.msgQueue, s.abstractions, processes, s.ownProcess, pl)
+	s.registerEcAbstractions(aId, processes)
+}
+
+// registerFailStopConsensusAbstractions creates the flooding or hierarchical
+// consensus instance of the config, with the perfect failure detector of the
+// processes running it
+func (s *System) registerFailStopConsensusAbstractions(parentId, aId string, processes []*pb.ProcessId) {
+	pl := s.createPl()
+
+	switch s.config.UcAlgorithm {
+	case UcFlooding:
+		s.abstractions[aId] = consensus.CreateFloodingConsensus(parentId, aId, s.msgQueue, processes)
+	case UcFloodingUniform:
+		s.abstractions[aId] = consensus.CreateFloodingUniformConsensus(parentId, aId, s.msgQueue, processes)
+	case UcHierarchical:
+		s.abstractions[aId] = consensus.CreateHierarchicalConsensus(parentId, aId, s.msgQueue, processes, s.ownProcess)
+	case UcHierarchicalUniform:
+		s.abstractions[aId] = consensus.CreateHierarchicalUniformConsensus(parentId, aId, s.msgQueue, processes, s.ownProcess)
+		s.abstractions[aId+".pl"] = pl.CreateCopyWithParentId(aId)
+		s.registerEagerRbAbstractions(aId, processes)
+	}
+	s.abstractions[aId+".beb"] = broadcast.Create(s.msgQueue, processes, aId+".beb")
+	s.abstractions[aId+".beb.pl"] = pl.CreateCopyWithParentId(aId + ".beb")
+	s.abstractions[aId+".pfd"] = consensus.CreatePfd(aId, aId+".pfd", s.msgQueue, processes, s.config.PfdTimeout, s.clock)
+	s.abstractions[aId+".pfd.pl"] = pl.CreateCopyWithParentId(aId + ".pfd")
+}
+
+// registerRcAbstractions creates the randomized consensus instance of the
+// topic with the rb of the proposals. The binary instances are created when
+// first used
+func (s *System) registerRcAbstractions(topic string) {
+	aId := "app.rc[" + topic + "]"
+
+	s.abstractions[aId] = consensus.CreateRc("app", aId, s.msgQueue, s.processes)
+	s.registerEagerRbAbstractions(aId, s.processes)
+}
+
+// registerBcAbstractions creates a binary instance of the randomized consensus
+// with the coin of the config
+func (s *System) registerBcAbstractions(parentId, aId string) {
+	pl := s.createPl()
+
+	var coin consensus.Coin = consensus.CreateCommonCoin(s.config.RcSeed, s.systemId+"/"+aId)
+	if s.config.RcCoin == RcLocal {
+		seed := s.config.RcSeed
+		if seed == 0 {
+			seed = time.Now().UnixNano()
+		}
+		coin = consensus.CreateLocalCoin(seed, s.ownProcess)
+	}
+
+	s.abstractions[aId] = consensus.CreateBc(parentId, aId, s.msgQueue, s.processes, coin)
+	s.abstractions[aId+".beb"] = broadcast.Create(s.msgQueue, s.processes, aId+".beb")
+	s.abstractions[aId+".beb.pl"] = pl.CreateCopyWithParentId(aId + ".beb")
+	s.registerEagerRbAbstractions(aId, s.processes)
+}
+
+// registerEagerRbAbstractions creates an eager rb of the parent among the
+// processes whatever the config, for the abstractions which must not rely on
+// failure detection
+func (s *System) registerEagerRbAbstractions(parentId string, processes []*pb.ProcessId) {
+	pl := s.createPl()
+	aId := parentId + ".rb"
+
+	s.abstractions[aId] = broadcast.CreateEagerRb(parentId, aId, s.msgQueue, s.ownProcess)
+	s.abstractions[aId+".beb"] = broadcast.Create(s.msgQueue, processes, aId+".beb")
+	s.abstractions[aId+".beb.pl"] = pl.CreateCopyWithParentId(aId + ".beb")
+}
+
+// registerEcAbstractions creates the epoch change of the parent abstraction
+// with the leader detector and the eventually perfect failure detector below it
+func (s *System) registerEcAbstractions(parentId string, processes []*pb.ProcessId) {
+	pl := s.createPl()
+	aId := parentId + ".ec"
+
+	s.abstractions[aId] = consensus.CreateEc(parentId, aId, s.ownProcess, s.msgQueue, processes)
+	s.abstractions[aId+".pl"] = pl.CreateCopyWithParentId(aId)
+	s.abstractions[aId+".beb"] = broadcast.Create(s.msgQueue, processes, aId+".beb")
+	s.abstractions[aId+".beb.pl"] = pl.CreateCopyWithParentId(aId + ".beb")
+	s.abstractions[aId+".eld"] = consensus.CreateEld(aId, aId+".eld", s.msgQueue, processes)
+	s.abstractions[aId+".eld.epfd"] = consensus.CreateEpfd(aId+".eld", aId+".eld.epfd", s.msgQueue, processes, s.clock)
+	s.abstractions[aId+".eld.epfd.pl"] = pl.CreateCopyWithParentId(aId + ".eld.epfd")
+}
+
+// registerTobAbstractions creates the total-order broadcast of the parent with
+// its rb. The uc instances of every round are created when first used
+func (s *System) registerTobAbstractions(parentId string) {
+	aId := parentId + ".tob"
+
+	s.abstractions[aId] = consensus.CreateTob(parentId, aId, s.msgQueue, s.ownProcess)
+	s.registerRbAbstractions(aId)
+}
+
+// registerLogAbstractions creates the replicated log of the parent with the
+// epoch change electing its leader
+func (s *System) registerLogAbstractions(parentId string) {
+	pl := s.createPl()
+	aId := parentId + ".log"
+
+	s.abstractions[aId] = consensus.CreateLog(parentId, aId, s.msgQueue, s.processes, s.ownProcess)
+	s.abstractions[aId+".pl"] = pl.CreateCopyWithParentId(aId)
+	s.abstractions[aId+".beb"] = broadcast.Create(s.msgQueue, s.processes, aId+".beb")
+	s.abstractions[aId+".beb.pl"] = pl.CreateCopyWithParentId(aId + ".beb")
+	s.registerEcAbstractions(aId, s.processes)
+}
+
+// registerTrbAbstractions creates the terminating reliable broadcast instance
+// of the topic with its uc and the perfect failure detector watching the sender
+func (s *System) registerTrbAbstractions(topic string) {
+	pl := s.createPl()
+	aId := "app.trb[" + topic + "]"
+
+	s.abstractions[aId] = consensus.CreateTrb("app", aId, s.msgQueue, s.ownProcess)
+	s.abstractions[aId+".beb"] = broadcast.Create(s.msgQueue, s.processes, aId+".beb")
+	s.abstractions[aId+".beb.pl"] = pl.CreateCopyWithParentId(aId + ".beb")
+	s.registerPfdAbstractions(aId)
+	s.registerConsensusAbstractions(aId, aId+".uc")
+}
+
+// registerNbacAbstractions creates the atomic commit instance of the topic
+// with its uc and the perfect failure detector watching the voters
+func (s *System) registerNbacAbstractions(topic string) {
+	pl := s.createPl()
+	aId := "app.nbac[" + topic + "]"
+
+	s.abstractions[aId] = consensus.CreateNbac("app", aId, s.msgQueue, s.processes)
+	s.abstractions[aId+".beb"] = broadcast.Create(s.msgQueue, s.processes, aId+".beb")
+	s.abstractions[aId+".beb.pl"] = pl.CreateCopyWithParentId(aId + ".beb")
+	s.registerPfdAbstractions(aId)
+	s.registerConsensusAbstractions(aId, aId+".uc")
+}
+
+// registerCommitAbstractions creates the two or three phase commit instance of
+// the topic with the eventually perfect failure detector watching the others
+func (s *System) registerCommitAbstractions(protocol, topic string) {
+	pl := s.createPl()
+	aId := "app." + protocol + "[" + topic + "]"
+
+	if protocol == "3pc" {
+		s.abstractions[aId] = commit.CreateThreePhaseCommit("app", aId, s.msgQueue, s.processes, s.ownProcess, s.clock)
+	} else {
+		s.abstractions[aId] = commit.CreateTwoPhaseCommit("app", aId, s.msgQueue, s.processes, s.ownProcess, s.clock)
+	}
+	s.abstractions[aId+".pl"] = pl.CreateCopyWithParentId(aId)
+	s.abstractions[aId+".epfd"] = consensus.CreateEpfd(aId, aId+".epfd", s.msgQueue, s.processes, s.clock)
+	s.abstractions[aId+".epfd.pl"] = pl.CreateCopyWithParentId(aId + ".epfd")
+}
+
+// registerPfdAbstractions creates the perfect failure detector of the parent
+// abstraction, which gets the PfdCrash indications
+func (s *System) registerPfdAbstractions(parentId string) {
+	pl := s.createPl()
+	aId := parentId + ".pfd"
+
+	s.abstractions[aId] = consensus.CreatePfd(parentId, aId, s.msgQueue, s.processes, s.config.PfdTimeout, s.clock)
+	s.abstractions[aId+".pl"] = pl.CreateCopyWithParentId(aId)
+}
+
+// registerLeAbstractions creates the leader election of the parent abstraction
+// together with the perfect failure detector it relies on
+func (s *System) registerLeAbstractions(parentId string) {
+	aId := parentId + ".le"
+
+	s.abstractions[aId] = consensus.CreateLe(parentId, aId, s.msgQueue, s.processes)
+	s.registerPfdAbstractions(aId)
+}
+
+// CreateSystem creates the system from ProcInitializeSystem or, for a process
+// joining a running system, from ProcReconfigureSystem
 func CreateSystem(m *pb.Message, host, owner, hubAddress string, port, index int32) *System {
 	log.Debug("Creating system %v", m.SystemId)
+	processes, settings := m.ProcInitializeSystem.GetProcesses(), m.ProcInitializeSystem.GetSettings()
+	if m.ProcReconfigureSystem != nil {
+		processes, settings = m.ProcReconfigureSystem.Processes, m.ProcReconfigureSystem.Settings
+	}
+
 	var ownProcess *pb.ProcessId
-	for _, p := range m.ProcInitializeSystem.Processes {
+	for _, p := range processes {
 		if p.Owner == owner && p.Index == index {
 			ownProcess = p
 		}
 	}
 
-	return &System{
+	config := LoadConfig()
+	config.Apply(settings)
+
+	// the system id comes from the hub, escaped so that the files stay in the
+	// configured directories
+	name := url.PathEscape(m.SystemId + "-" + owner + "-" + utils.Int32ToString(index))
+
+	// record the register operations of this process when a history directory is configured
+	var recorder *lin.Recorder
+	if config.HistoryDir != "" {
+		path := filepath.Join(config.HistoryDir, name+".jsonl")
+		r, err := lin.CreateFileRecorder(path)
+		if err != nil {
+			log.Error("Failed to create history file %v: %v", path, err)
+		} else {
+			recorder = r.CreateWithSource(lin.Process)
+		}
+	}
+
+	// log the state of the registers when a state directory is configured
+	var store register.Store
+	if config.StateDir != "" {
+		dir := filepath.Join(config.StateDir, name)
+		fs, err := register.CreateFileStore(dir)
+		if err != nil {
+			log.Error("Failed to create state directory %v: %v", dir, err)
+		} else {
+			store = fs
+		}
+	}
+
+	s := &System{
 		systemId:     m.SystemId,
 		msgQueue:     make(chan *pb.Message, 4096),
 		ownProcess:   ownProcess,
 		hubAddress:   hubAddress,
 		abstractions: make(map[string]abstraction.Abstraction),
-		processes:    m.ProcInitializeSystem.Processes,
+		processes:    processes,
+		joining:      m.ProcReconfigureSystem,
+		recorder:     recorder,
+		store:        store,
+		transport:    pl.TcpTransport{},
+		clock:        clock.Real,
+		config:       config,
 	}
+	s.learn(processes)
+	s.learn(m.ProcReconfigureSystem.GetPrevious())
+
+	return s
+}
+
+// CreateWithConfig replaces the settings loaded from the environment. Must be
+// called before registering the abstractions
+func (s *System) CreateWithConfig(c Config) *System {
+	s.config = c
+
+	return s
+}
+
+// CreateWithStore replaces the store where the registers log their state. Must
+// be called before registering the abstractions
+func (s *System) CreateWithStore(st register.Store) *System {
+	s.store = st
+
+	return s
+}
+
+// CreateWithRuntime replaces the network transport and the clock used by all
+// the abstractions of the system. Must be called before registering them
+func (s *System) CreateWithRuntime(t pl.Transport, c clock.Clock) *System {
+	s.transport = t
+	s.clock = c
+
+	return s
+}
+
+// Kv returns the key-value store of the system, for the clients to use
+func (s *System) Kv() *kv.Service {
+	return s.abstractions["app.kv"].(*kv.Service)
 }
 
 func (s *System) AddMessage(m *pb.Message) {
@@ -138,5 +862,8 @@ func (s *System) Destroy() {
 	for _, a := range s.abstractions {
 		a.Destroy()
 	}
+	if s.recorder != nil {
+		s.recorder.Close()
+	}
 	close(s.msgQueue)
 }
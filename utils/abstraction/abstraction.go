@@ -8,3 +8,15 @@ type Abstraction interface {
 }
 
 type Registry = map[string]Abstraction
+
+// BufferStats tells how many messages an abstraction holds back until it can
+// deliver them, now and at most since it was created
+type BufferStats struct {
+	Current int
+	Max     int
+}
+
+// Buffering is implemented by the abstractions which hold messages back
+type Buffering interface {
+	Buffered() BufferStats
+}
@@ -0,0 +1,27 @@
+package clock
+
+import "time"
+
+type Timer interface {
+	Stop() bool
+}
+
+// Clock is the source of time and timers of the abstractions, so that a
+// simulation can replace wall time with a virtual one
+type Clock interface {
+	Now() time.Time
+	AfterFunc(d time.Duration, f func()) Timer
+}
+
+type realClock struct{}
+
+// Real is the wall clock, timers fire on their own goroutines
+var Real Clock = realClock{}
+
+func (realClock) Now() time.Time {
+	return time.Now()
+}
+
+func (realClock) AfterFunc(d time.Duration, f func()) Timer {
+	return time.AfterFunc(d, f)
+}
@@ -9,6 +9,7 @@ import (
 )
 
 var logger *zap.SugaredLogger
+var level = zap.NewAtomicLevelAt(zapcore.DebugLevel)
 
 // Instantiate creates a new structured logger instance
 func Instantiate() error {
@@ -20,7 +21,7 @@ func Instantiate() error {
 	log.Default().Print("Log format: ", format)
 	zLogger, err := zap.Config{
 		Encoding:          format,
-		Level:             zap.NewAtomicLevelAt(zapcore.DebugLevel),
+		Level:             level,
 		DisableCaller:     false,
 		DisableStacktrace: false,
 		OutputPaths:       []string{"stdout"},
@@ -41,6 +42,16 @@ func Instantiate() error {
 	return nil
 }
 
+// SetLevel changes the minimum level of the logger at runtime. Trace is accepted
+// as an alias for debug since zap has no lower level
+func SetLevel(l string) error {
+	if l == "trace" {
+		l = "debug"
+	}
+
+	return level.UnmarshalText([]byte(l))
+}
+
 func Info(msg string, args ...interface{}) {
 	logger.Infof(msg, args...)
 }
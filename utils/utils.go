@@ -3,12 +3,13 @@ package utils
 import (
 	"amcds/pb"
 	"regexp"
+	"sort"
 	"strconv"
 )
 
 func GetRegisterId(abstractionId string) string {
-	// group the inside of the brackets
-	re := regexp.MustCompile(`\[(.*)\]`)
+	// group the inside of the first brackets (ids such as app.uc[topic].ep[0] have more)
+	re := regexp.MustCompile(`\[(.*?)\]`)
 
 	tokens := re.FindStringSubmatch(abstractionId)
 
@@ -51,3 +52,28 @@ func GetMaxRankSlice(processes []*pb.ProcessId) *pb.ProcessId {
 
 	return maxRank
 }
+
+// ContainsProcess tells whether p is one of the processes
+func ContainsProcess(processes []*pb.ProcessId, p *pb.ProcessId) bool {
+	for _, q := range processes {
+		if GetProcessKey(q) == GetProcessKey(p) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// RankPositions numbers the processes from 1 in the order of their ranks, as
+// the ranks of a system may leave gaps after a reconfiguration
+func RankPositions(processes []*pb.ProcessId) map[string]int32 {
+	ordered := append([]*pb.ProcessId(nil), processes...)
+	sort.Slice(ordered, func(i, j int) bool { return ordered[i].Rank < ordered[j].Rank })
+
+	positions := make(map[string]int32)
+	for i, p := range ordered {
+		positions[GetProcessKey(p)] = int32(i + 1)
+	}
+
+	return positions
+}
@@ -0,0 +1,148 @@
+package utils
+
+import (
+	"amcds/pb"
+	"bytes"
+	"cmp"
+	"encoding/hex"
+	"errors"
+	"strconv"
+	"strings"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// Int32Value returns the defined value v, as sent by the reference hub
+func Int32Value(v int32) *pb.Value {
+	return &pb.Value{Defined: true, V: v}
+}
+
+// PayloadValue makes a value out of the message of an abstraction, e.g. the
+// batch proposed by tob, carried as bytes so that consensus agrees on it
+// without looking into it
+func PayloadValue(payload proto.Message) (*pb.Value, error) {
+	data, err := proto.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.Value{Defined: true, Type: pb.ValueType_BYTES, BytesValue: data}, nil
+}
+
+// ReadPayload reads into payload the message carried by a value of
+// PayloadValue
+func ReadPayload(v *pb.Value, payload proto.Message) error {
+	if !v.GetDefined() || v.Type != pb.ValueType_BYTES {
+		return errors.New("value " + ValueString(v) + " carries no payload")
+	}
+
+	return proto.Unmarshal(v.BytesValue, payload)
+}
+
+// ParseValue reads a value typed on the command line, the way ValueString
+// renders it: an integer is an int32 when it fits and has no L suffix, an int64
+// otherwise, 0x followed by hex digits are bytes and anything else is a
+// string, quotes being removed
+func ParseValue(s string) (*pb.Value, error) {
+	if s == "" {
+		return nil, errors.New("empty value")
+	}
+
+	if i, err := strconv.ParseInt(s, 10, 64); err == nil {
+		if int64(int32(i)) == i {
+			return Int32Value(int32(i)), nil
+		}
+		return &pb.Value{Defined: true, Type: pb.ValueType_INT64, Int64Value: i}, nil
+	}
+
+	if l, ok := strings.CutSuffix(s, "L"); ok {
+		if i, err := strconv.ParseInt(l, 10, 64); err == nil {
+			return &pb.Value{Defined: true, Type: pb.ValueType_INT64, Int64Value: i}, nil
+		}
+	}
+
+	if h, ok := strings.CutPrefix(s, "0x"); ok {
+		b, err := hex.DecodeString(h)
+		if err != nil {
+			return nil, errors.New("invalid bytes " + s + ", expected 0x followed by pairs of hex digits")
+		}
+		return &pb.Value{Defined: true, Type: pb.ValueType_BYTES, BytesValue: b}, nil
+	}
+
+	if unquoted, err := strconv.Unquote(s); err == nil {
+		s = unquoted
+	}
+
+	return &pb.Value{Defined: true, Type: pb.ValueType_STRING, StringValue: s}, nil
+}
+
+// ValueString renders the value so that values of different types never look
+// the same: int32 as a number, int64 with an L suffix, strings quoted and bytes
+// in hex
+func ValueString(v *pb.Value) string {
+	if v == nil || !v.Defined {
+		return "undefined"
+	}
+
+	switch v.Type {
+	case pb.ValueType_BYTES:
+		return "0x" + hex.EncodeToString(v.BytesValue)
+	case pb.ValueType_STRING:
+		return strconv.Quote(v.StringValue)
+	case pb.ValueType_INT64:
+		return strconv.FormatInt(v.Int64Value, 10) + "L"
+	default:
+		return Int32ToString(v.V)
+	}
+}
+
+// EqualValues compares the definedness, the type and the field holding the
+// value of a and b, ignoring the other fields
+func EqualValues(a, b *pb.Value) bool {
+	if !a.GetDefined() || !b.GetDefined() {
+		return a.GetDefined() == b.GetDefined()
+	}
+	if a.Type != b.Type {
+		return false
+	}
+
+	switch a.Type {
+	case pb.ValueType_BYTES:
+		return bytes.Equal(a.BytesValue, b.BytesValue)
+	case pb.ValueType_STRING:
+		return a.StringValue == b.StringValue
+	case pb.ValueType_INT64:
+		return a.Int64Value == b.Int64Value
+	default:
+		return a.V == b.V
+	}
+}
+
+// CompareValues orders the values, undefined first, then by type and by the
+// field holding the value, returning -1, 0 or 1
+func CompareValues(a, b *pb.Value) int {
+	if !a.GetDefined() || !b.GetDefined() {
+		return cmp.Compare(boolToInt(a.GetDefined()), boolToInt(b.GetDefined()))
+	}
+	if a.Type != b.Type {
+		return cmp.Compare(a.Type, b.Type)
+	}
+
+	switch a.Type {
+	case pb.ValueType_BYTES:
+		return bytes.Compare(a.BytesValue, b.BytesValue)
+	case pb.ValueType_STRING:
+		return cmp.Compare(a.StringValue, b.StringValue)
+	case pb.ValueType_INT64:
+		return cmp.Compare(a.Int64Value, b.Int64Value)
+	default:
+		return cmp.Compare(a.V, b.V)
+	}
+}
+
+func boolToInt(b bool) int {
+	if b {
+		return 1
+	}
+	return 0
+}